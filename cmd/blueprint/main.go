@@ -0,0 +1,98 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"text/tabwriter"
+
+	"examination/internal/ent"
+	"examination/internal/features/content/service"
+
+	"modernc.org/sqlite"
+)
+
+func init() {
+	sql.Register("sqlite3", &sqlite.Driver{})
+}
+
+// blueprint generates a mock exam from the question bank.
+//
+//	go run ./cmd/blueprint -file blueprint.json [-dry-run]
+//
+// The blueprint file is the JSON form of service.Blueprint, see
+// cmd/blueprint/example.json. The generated exam is inactive, so only
+// authors and admins can preview it at /exams/{id}/preview before
+// activating it.
+func main() {
+	// 1. Parse Flags
+	file := flag.String("file", "", "Path to the blueprint JSON file")
+	dryRun := flag.Bool("dry-run", false, "Print the sampled plan without creating the exam")
+	flag.Parse()
+
+	if *file == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	raw, err := os.ReadFile(*file)
+	if err != nil {
+		log.Fatalf("failed reading blueprint: %v", err)
+	}
+	var bp service.Blueprint
+	if err := json.Unmarshal(raw, &bp); err != nil {
+		log.Fatalf("failed parsing blueprint: %v", err)
+	}
+
+	// 2. Database Connection
+	dbPath := os.Getenv("DB_PATH")
+	if dbPath == "" {
+		dbPath = "file:data/local.db?cache=shared&_pragma=foreign_keys(1)"
+	} else {
+		dbPath = fmt.Sprintf("file:%s?_pragma=foreign_keys(1)&_pragma=busy_timeout(5000)", dbPath)
+	}
+
+	client, err := ent.Open("sqlite3", dbPath)
+	if err != nil {
+		log.Fatalf("failed opening connection to sqlite: %v", err)
+	}
+	defer client.Close()
+
+	ctx := context.Background()
+	svc := service.NewContentService(client)
+
+	// 3. Plan or Generate
+	if *dryRun {
+		plan, err := svc.PlanBlueprint(ctx, bp)
+		if err != nil {
+			log.Fatalf("Failed to plan blueprint: %v", err)
+		}
+		printPlan(plan)
+		return
+	}
+
+	exam, plan, err := svc.GenerateExam(ctx, bp)
+	if err != nil {
+		log.Fatalf("Failed to generate exam: %v", err)
+	}
+	printPlan(plan)
+	fmt.Printf("\nCreated inactive exam %d. Sign in as an author or admin to preview it at /exams/%d/preview\n", exam.ID, exam.ID)
+}
+
+func printPlan(plan *service.Plan) {
+	fmt.Printf("%s (seed %d)\n", plan.Blueprint.Title, plan.Blueprint.Seed)
+	for _, g := range plan.Groups {
+		fmt.Printf("\n%s %q: %d problems, mean difficulty %.2f\n", g.Kind, g.Title, len(g.Problems), g.Mean)
+
+		w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+		fmt.Fprintln(w, "  PROBLEM\tDIFFICULTY\tUNIT")
+		for _, p := range g.Problems {
+			fmt.Fprintf(w, "  %d\t%d\t%s\n", p.ID, p.Difficulty, p.Edges.Unit.Title)
+		}
+		w.Flush()
+	}
+}
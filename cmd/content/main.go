@@ -0,0 +1,225 @@
+package main
+
+import (
+	"archive/zip"
+	"context"
+	"database/sql"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"examination/internal/ent"
+	"examination/internal/features/content/assetstore"
+	"examination/internal/features/content/bundle"
+	"examination/internal/features/content/qti"
+	"examination/internal/features/content/service"
+
+	"modernc.org/sqlite"
+)
+
+func init() {
+	sql.Register("sqlite3", &sqlite.Driver{})
+}
+
+const usage = `usage:
+  content export -exam ID [-o PATH] [-locale LOCALE]   write the exam as a bundle (YAML on stdout by default)
+  content import PATH                                 create or update the exam described by a bundle
+  content export-translations -exam ID -target LOCALE [-source LOCALE] [-o PATH]
+                                                      write a CSV translation sheet (stdout by default)
+  content import-translations -exam ID PATH           create or update translations from a filled-in sheet
+  content report-translations [-exam ID] [-locale LOCALES]
+                                                      report translation coverage; exits 1 when incomplete
+
+The format is chosen by the file extension: .json, .yaml or .yml. A path
+without an extension is a markdown folder (exam.yaml plus one markdown file
+per problem and locale), and a .zip file a QTI 2.1 content package in one
+locale. Bundles carry the images and attachments their content references,
+stored under ASSET_DIR (data/assets by default). See docs/content-bundle.md
+for the bundle format and docs/translation-sheets.md for translation sheets.
+`
+
+// content exports exams to bundle files and imports them back.
+//
+//	go run ./cmd/content export -exam 1 -o exams/sdd.yaml
+//	go run ./cmd/content import exams/sdd.yaml
+//	go run ./cmd/content export -exam 1 -o exams/sdd
+//	go run ./cmd/content export -exam 1 -o exams/sdd.zip -locale ko
+func main() {
+	log.SetFlags(0)
+	if len(os.Args) < 2 {
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
+	}
+
+	// 1. Database Connection
+	dbPath := os.Getenv("DB_PATH")
+	if dbPath == "" {
+		dbPath = "file:data/local.db?cache=shared&_pragma=foreign_keys(1)"
+	} else {
+		dbPath = fmt.Sprintf("file:%s?_pragma=foreign_keys(1)&_pragma=busy_timeout(5000)", dbPath)
+	}
+
+	client, err := ent.Open("sqlite3", dbPath)
+	if err != nil {
+		log.Fatalf("failed opening connection to sqlite: %v", err)
+	}
+	defer client.Close()
+
+	assetDir := os.Getenv("ASSET_DIR")
+	if assetDir == "" {
+		assetDir = "data/assets"
+	}
+
+	ctx := context.Background()
+	svc := service.NewContentService(client)
+	assets := service.NewAssetService(client, assetstore.NewDisk(assetDir))
+
+	// 2. Run the subcommand
+	switch cmd, args := os.Args[1], os.Args[2:]; cmd {
+	case "export":
+		err = export(ctx, svc, assets, args)
+	case "import":
+		err = importBundle(ctx, svc, assets, args)
+	case "export-translations":
+		err = exportTranslations(ctx, svc, args)
+	case "import-translations":
+		err = importTranslations(ctx, svc, args)
+	case "report-translations":
+		err = reportTranslations(ctx, svc, args)
+	default:
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
+	}
+	if err != nil {
+		client.Close()
+		log.Fatal(err)
+	}
+}
+
+func export(ctx context.Context, svc *service.ContentService, assets *service.AssetService, args []string) error {
+	fs := flag.NewFlagSet("export", flag.ExitOnError)
+	examID := fs.Int("exam", 0, "ID of the exam to export")
+	out := fs.String("o", "", "Output file (.json, .yaml, .yml or .zip) or folder; YAML on stdout when empty")
+	locale := fs.String("locale", "en", "Locale of the problems in a QTI package")
+	fs.Parse(args)
+	if *examID <= 0 {
+		fs.Usage()
+		os.Exit(2)
+	}
+
+	format := bundle.YAML
+	if *out != "" && !isQTI(*out) {
+		var err error
+		if format, err = bundle.FormatOf(*out); err != nil {
+			return err
+		}
+	}
+
+	b, err := svc.ExportBundle(ctx, *examID)
+	if err != nil {
+		return fmt.Errorf("exporting exam %d: %w", *examID, err)
+	}
+	if err := assets.ExportBundle(ctx, b); err != nil {
+		return fmt.Errorf("exporting assets: %w", err)
+	}
+	switch {
+	case *out != "" && isQTI(*out):
+		f, err := os.Create(*out)
+		if err != nil {
+			return fmt.Errorf("creating %s: %w", *out, err)
+		}
+		defer f.Close()
+		issues, err := qti.Encode(f, b, *locale)
+		if err != nil {
+			return fmt.Errorf("writing package: %w", err)
+		}
+		warn(issues)
+	case format == bundle.Markdown:
+		if err := bundle.EncodeDir(*out, b); err != nil {
+			return fmt.Errorf("writing bundle: %w", err)
+		}
+	default:
+		var w io.Writer = os.Stdout
+		if *out != "" {
+			f, err := os.Create(*out)
+			if err != nil {
+				return fmt.Errorf("creating %s: %w", *out, err)
+			}
+			defer f.Close()
+			w = f
+		}
+		if err := bundle.Encode(w, b, format); err != nil {
+			return fmt.Errorf("writing bundle: %w", err)
+		}
+	}
+	if *out != "" {
+		log.Printf("Exported exam %d (%s) to %s", *examID, b.Key, *out)
+	}
+	return nil
+}
+
+func importBundle(ctx context.Context, svc *service.ContentService, assets *service.AssetService, args []string) error {
+	if len(args) != 1 {
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
+	}
+	path := args[0]
+	b, err := read(path)
+	if err != nil {
+		return fmt.Errorf("reading %s: %w", path, err)
+	}
+	// Assets first, so that the content never references a missing file
+	stored, err := assets.ImportBundle(ctx, b)
+	if err != nil {
+		return fmt.Errorf("importing assets of %s: %w", path, err)
+	}
+	e, stats, err := svc.ImportBundle(ctx, b)
+	if err != nil {
+		return fmt.Errorf("importing %s: %w", path, err)
+	}
+	log.Printf("Imported exam %d (%s): %d created, %d updated, %d deleted, %d new assets",
+		e.ID, e.Key, stats.Created, stats.Updated, stats.Deleted, stored)
+	return nil
+}
+
+// read decodes the bundle at path in the format its extension names.
+func read(path string) (*bundle.Exam, error) {
+	if isQTI(path) {
+		zr, err := zip.OpenReader(path)
+		if err != nil {
+			return nil, err
+		}
+		defer zr.Close()
+		b, issues, err := qti.Decode(zr)
+		warn(issues)
+		return b, err
+	}
+	format, err := bundle.FormatOf(path)
+	if err != nil {
+		return nil, err
+	}
+	if format == bundle.Markdown {
+		return bundle.DecodeDir(os.DirFS(path))
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return bundle.Decode(f, format)
+}
+
+func isQTI(path string) bool {
+	return strings.EqualFold(filepath.Ext(path), ".zip")
+}
+
+// warn prints what a QTI conversion could not carry over.
+func warn(issues []qti.Issue) {
+	for _, i := range issues {
+		log.Printf("warning: %s", i)
+	}
+}
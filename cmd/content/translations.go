@@ -0,0 +1,132 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"strings"
+
+	"examination/internal/features/content/service"
+	"examination/internal/features/content/sheet"
+)
+
+func exportTranslations(ctx context.Context, svc *service.ContentService, args []string) error {
+	fs := flag.NewFlagSet("export-translations", flag.ExitOnError)
+	examID := fs.Int("exam", 0, "ID of the exam to export")
+	source := fs.String("source", "en", "Locale to translate from")
+	target := fs.String("target", "", "Locale to translate into")
+	out := fs.String("o", "", "Output CSV file; stdout when empty")
+	fs.Parse(args)
+	if *examID <= 0 || *target == "" || *target == *source {
+		fs.Usage()
+		os.Exit(2)
+	}
+
+	sh, err := svc.ExportTranslations(ctx, *examID, *source, *target)
+	if err != nil {
+		return fmt.Errorf("exporting exam %d: %w", *examID, err)
+	}
+	var w io.Writer = os.Stdout
+	if *out != "" {
+		f, err := os.Create(*out)
+		if err != nil {
+			return fmt.Errorf("creating %s: %w", *out, err)
+		}
+		defer f.Close()
+		w = f
+	}
+	if err := sheet.Encode(w, sh); err != nil {
+		return fmt.Errorf("writing sheet: %w", err)
+	}
+	if *out != "" {
+		log.Printf("Exported %d texts of exam %d to %s", len(sh.Rows), *examID, *out)
+	}
+	return nil
+}
+
+func importTranslations(ctx context.Context, svc *service.ContentService, args []string) error {
+	fs := flag.NewFlagSet("import-translations", flag.ExitOnError)
+	examID := fs.Int("exam", 0, "ID of the exam the sheet was exported from")
+	fs.Parse(args)
+	if *examID <= 0 || fs.NArg() != 1 {
+		fs.Usage()
+		os.Exit(2)
+	}
+	path := fs.Arg(0)
+
+	f, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("opening %s: %w", path, err)
+	}
+	defer f.Close()
+	sh, err := sheet.Decode(f)
+	if err != nil {
+		return fmt.Errorf("reading %s: %w", path, err)
+	}
+	stats, err := svc.ImportTranslations(ctx, *examID, sh)
+	if err != nil {
+		return fmt.Errorf("importing %s: %w", path, err)
+	}
+	log.Printf("Imported %s translations of exam %d: %d created, %d updated, %d deleted",
+		sh.Target, *examID, stats.Created, stats.Updated, stats.Deleted)
+	return nil
+}
+
+// errIncomplete makes the command exit with status 1 when a report has
+// gaps, so that it can gate a release.
+var errIncomplete = errors.New("translations are incomplete")
+
+func reportTranslations(ctx context.Context, svc *service.ContentService, args []string) error {
+	fs := flag.NewFlagSet("report-translations", flag.ExitOnError)
+	examID := fs.Int("exam", 0, "ID of the exam to report on; every exam when 0")
+	locale := fs.String("locale", "", "Comma-separated locales to report on even without any translation, e.g. ko")
+	fs.Parse(args)
+
+	var locales []string
+	for _, l := range strings.Split(*locale, ",") {
+		if l = strings.TrimSpace(l); l != "" {
+			locales = append(locales, l)
+		}
+	}
+	var reports []*service.TranslationReport
+	if *examID > 0 {
+		r, err := svc.TranslationReport(ctx, *examID, locales...)
+		if err != nil {
+			return fmt.Errorf("reporting on exam %d: %w", *examID, err)
+		}
+		reports = append(reports, r)
+	} else {
+		var err error
+		if reports, err = svc.TranslationReports(ctx, locales...); err != nil {
+			return fmt.Errorf("reporting: %w", err)
+		}
+	}
+
+	complete := true
+	for _, r := range reports {
+		writeReport(os.Stdout, r)
+		complete = complete && r.Complete()
+	}
+	if !complete {
+		return errIncomplete
+	}
+	return nil
+}
+
+func writeReport(w io.Writer, r *service.TranslationReport) {
+	fmt.Fprintf(w, "Exam %d: %s (%d problems)\n", r.Exam.ID, r.Exam.Title, r.Problems)
+	for _, c := range r.Locales {
+		fmt.Fprintf(w, "  %-6s %5.1f%%  %d/%d\n", c.Locale, c.Percent(), c.Translated, c.Problems)
+	}
+	for _, m := range r.Missing {
+		fmt.Fprintf(w, "  missing     Q%d problem %d: %s\n", m.Number, m.Problem.ID, strings.Join(m.Locales, ", "))
+	}
+	for _, m := range r.Mismatches {
+		fmt.Fprintf(w, "  mismatch    Q%d problem %d: %s %s\n", m.Number, m.Problem.ID, m.Locale, m.Message)
+	}
+	fmt.Fprintln(w)
+}
@@ -0,0 +1,150 @@
+package main
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"crypto/sha512"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+// katexVersion is the KaTeX release the server ships, and katexIntegrity
+// the SRI hash of its npm tarball.
+const (
+	katexVersion   = "0.16.11"
+	katexIntegrity = "sha512-RQrI8rlHY92OLf3rho/Ts8i/XvjgguEjOkO1BEXcU3N8BqPpSzBNwV/G0Ukr+P/l3ivvJUE/Fa/CwbS6HesGNQ=="
+)
+
+// katex fetches a KaTeX release from the npm registry into the static
+// assets embedded by internal/features/content/ui, so that math renders
+// without a CDN.
+//
+//	go run ./cmd/katex [-version 0.16.11 -integrity sha512-...] [-out internal/features/content/ui/static/katex]
+//
+// The tarball is checked against a hash kept in this file, not the one the
+// registry serves next to it, so a tampered registry response is caught.
+// Fetching another version needs its hash passed with -integrity.
+// Only katex.min.js, katex.min.css and the woff2 fonts are kept; every
+// browser the exam pages support reads woff2.
+func main() {
+	version := flag.String("version", katexVersion, "KaTeX version to fetch")
+	integrity := flag.String("integrity", "", "SRI hash of the version's npm tarball, required for versions other than "+katexVersion)
+	out := flag.String("out", "internal/features/content/ui/static/katex", "Directory to write the assets to")
+	flag.Parse()
+
+	want := *integrity
+	if want == "" {
+		if *version != katexVersion {
+			log.Fatalf("no pinned integrity for katex %s; pass -integrity", *version)
+		}
+		want = katexIntegrity
+	}
+
+	// 1. Look up the release
+	var meta struct {
+		Dist struct {
+			Tarball string `json:"tarball"`
+		} `json:"dist"`
+	}
+	raw, err := fetch("https://registry.npmjs.org/katex/" + *version)
+	if err != nil {
+		log.Fatalf("failed looking up katex %s: %v", *version, err)
+	}
+	if err := json.Unmarshal(raw, &meta); err != nil {
+		log.Fatalf("failed parsing release metadata: %v", err)
+	}
+
+	// 2. Download and verify the tarball
+	tarball, err := fetch(meta.Dist.Tarball)
+	if err != nil {
+		log.Fatalf("failed downloading %s: %v", meta.Dist.Tarball, err)
+	}
+	if err := verify(tarball, want); err != nil {
+		log.Fatalf("failed verifying %s: %v", meta.Dist.Tarball, err)
+	}
+
+	// 3. Extract the assets
+	n, err := extract(tarball, *out)
+	if err != nil {
+		log.Fatalf("failed extracting assets: %v", err)
+	}
+	fmt.Printf("Wrote %d KaTeX %s files to %s\n", n, *version, *out)
+}
+
+func fetch(url string) ([]byte, error) {
+	resp, err := http.Get(url)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("GET %s: %s", url, resp.Status)
+	}
+	return io.ReadAll(resp.Body)
+}
+
+// verify checks data against an SRI integrity string such as "sha512-...".
+func verify(data []byte, integrity string) error {
+	want, ok := strings.CutPrefix(integrity, "sha512-")
+	if !ok {
+		return fmt.Errorf("unsupported integrity %q", integrity)
+	}
+	sum := sha512.Sum512(data)
+	if got := base64.StdEncoding.EncodeToString(sum[:]); got != want {
+		return errors.New("integrity mismatch")
+	}
+	return nil
+}
+
+// extract writes the minified script, the stylesheet and the woff2 fonts
+// of the package to dir and returns how many files were written.
+func extract(tarball []byte, dir string) (int, error) {
+	gz, err := gzip.NewReader(bytes.NewReader(tarball))
+	if err != nil {
+		return 0, err
+	}
+	tr := tar.NewReader(gz)
+	n := 0
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			return n, nil
+		}
+		if err != nil {
+			return n, err
+		}
+		name, ok := strings.CutPrefix(hdr.Name, "package/dist/")
+		if !ok || hdr.Typeflag != tar.TypeReg {
+			continue
+		}
+		keep := name == "katex.min.js" || name == "katex.min.css" ||
+			path.Dir(name) == "fonts" && path.Ext(name) == ".woff2"
+		if !keep {
+			continue
+		}
+
+		dst := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(dst), 0o755); err != nil {
+			return n, err
+		}
+		data, err := io.ReadAll(tr)
+		if err != nil {
+			return n, err
+		}
+		if err := os.WriteFile(dst, data, 0o644); err != nil {
+			return n, err
+		}
+		n++
+	}
+}
@@ -3,12 +3,14 @@ package main
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 	"log"
 
 	"examination/internal/ent"
 	"examination/internal/ent/exam"
 	"examination/internal/ent/unit"
+	"examination/internal/features/content/service"
 
 	"entgo.io/ent/dialect"
 	"modernc.org/sqlite"
@@ -36,49 +38,52 @@ func main() {
 
 	fmt.Println(">> Schema created successfully!")
 
+	svc := service.NewContentService(client)
+
 	// 1. Create an Exam
-	khExam, err := client.Exam.Create().
-		SetTitle("Sungsil Univ 2024 Transfer Math").
-		SetDescription("The 2024 transfer examination for Sungsil University.").
-		SetTimeLimit(60).
-		Save(ctx)
+	khExam, err := svc.CreateExam(ctx, service.ExamInput{
+		Title:       "Sungsil Univ 2024 Transfer Math",
+		Description: "The 2024 transfer examination for Sungsil University.",
+		TimeLimit:   60,
+		IsActive:    true,
+	})
 	if err != nil {
 		log.Fatalf("failed creating exam: %v", err)
 	}
 	fmt.Printf(">> Created Exam: %s (ID: %d)\n", khExam.Title, khExam.ID)
 
 	// 2. Create Hierarchy (Section -> Topic -> Unit)
-	// We can use the fluent builder to link them.
+	// The content service links them by ID.
 
 	// Section A
-	sectA, err := client.Section.Create().
-		SetTitle("Section A: Calculus").
-		SetSeq(1).
-		SetExam(khExam).
-		Save(ctx)
+	sectA, err := svc.CreateSection(ctx, service.SectionInput{
+		ExamID: khExam.ID,
+		Title:  "Section A: Calculus",
+		Seq:    1,
+	})
 	if err != nil {
 		log.Fatalf("failed creating section: %v", err)
 	}
 
 	// Topic 1
-	top1, err := client.Topic.Create().
-		SetTitle("Limits").
-		SetSeq(1).
-		SetExam(khExam).
-		SetSection(sectA).
-		Save(ctx)
+	top1, err := svc.CreateTopic(ctx, service.TopicInput{
+		ExamID:    khExam.ID,
+		SectionID: &sectA.ID,
+		Title:     "Limits",
+		Seq:       1,
+	})
 	if err != nil {
 		log.Fatalf("failed creating topic: %v", err)
 	}
 
 	// Unit 1
-	u1, err := client.Unit.Create().
-		SetTitle("Limit Definition").
-		SetSeq(1).
-		SetExam(khExam).
-		SetSection(sectA).
-		SetTopic(top1).
-		Save(ctx)
+	u1, err := svc.CreateUnit(ctx, service.UnitInput{
+		ExamID:    khExam.ID,
+		SectionID: &sectA.ID,
+		TopicID:   &top1.ID,
+		Title:     "Limit Definition",
+		Seq:       1,
+	})
 	if err != nil {
 		log.Fatalf("failed creating unit: %v", err)
 	}
@@ -113,13 +118,13 @@ func main() {
 	// 4. Verify Inclusive Parent Hook (Unit)
 	fmt.Println("\n>> Verifying Exclusive Parent Hook...")
 	// Try creating a Unit with BOTH Topic and Section
-	u2, err := client.Unit.Create().
-		SetTitle("Hook Test Unit").
-		SetSeq(2).
-		SetExam(khExam).
-		SetSection(sectA). // Setting Section
-		SetTopic(top1).    // Setting Topic (Should clear Section)
-		Save(ctx)
+	u2, err := svc.CreateUnit(ctx, service.UnitInput{
+		ExamID:    khExam.ID,
+		SectionID: &sectA.ID, // Setting Section
+		TopicID:   &top1.ID,  // Setting Topic (Should clear Section)
+		Title:     "Hook Test Unit",
+		Seq:       2,
+	})
 	if err != nil {
 		log.Fatalf("failed creating hook test unit: %v", err)
 	}
@@ -139,4 +144,38 @@ func main() {
 	} else {
 		log.Fatalf(">> FAILED: Section was NOT cleared! Topic: %v, Section: %v", u2.Edges.Topic, u2.Edges.Section)
 	}
+
+	// 5. Verify LaTeX Validation
+	fmt.Println("\n>> Verifying LaTeX validation...")
+	p1, err := svc.CreateProblem(ctx, service.ProblemInput{UnitID: u1.ID, Difficulty: 2})
+	if err != nil {
+		log.Fatalf("failed creating problem: %v", err)
+	}
+	tr, err := svc.CreateProblemTranslation(ctx, service.ProblemTranslationInput{
+		ProblemID: p1.ID,
+		Locale:    "en",
+		Title:     "Limit of sin x / x",
+		Content:   "Evaluate $$\\lim_{x \\to 0} \\frac{\\sin x}{x}$$",
+		Choices: []service.ChoiceInput{
+			{Content: "$0$", Seq: 1},
+			{Content: "$1$", IsCorrect: true, Seq: 2},
+			{Content: "$\\infty$", Seq: 3},
+		},
+	})
+	if err != nil {
+		log.Fatalf("failed creating translation with math: %v", err)
+	}
+	fmt.Printf(">> Created Problem with math: %s\n", tr.Title)
+
+	// A missing brace must be rejected
+	_, err = svc.UpdateProblemTranslation(ctx, tr.ID, service.ProblemTranslationInput{
+		Title:   tr.Title,
+		Content: "Evaluate $$\\lim_{x \\to 0} \\frac{\\sin x}{x$$",
+	})
+	var verr *service.ValidationError
+	if errors.As(err, &verr) && verr.Violations[0].Code == service.CodeInvalidMath {
+		fmt.Printf(">> PASSED: Malformed math was rejected: %s\n", verr.Violations[0].Message)
+	} else {
+		log.Fatalf(">> FAILED: Malformed math was NOT rejected: %v", err)
+	}
 }
@@ -0,0 +1,29 @@
+package seeds
+
+import (
+	"context"
+	"crypto/rand"
+	"examination/internal/ent"
+	"examination/internal/features/auth/service"
+	"log"
+)
+
+// SeedUser creates or resets the user with the email and roles. An empty
+// password is replaced by a random one, which is printed.
+func SeedUser(ctx context.Context, client *ent.Client, email, password string, roles []string) error {
+	if password == "" {
+		password = rand.Text()
+		log.Printf("Generated password for %s: %s", email, password)
+	}
+
+	u, err := service.NewAuthService(client).SaveUser(ctx, service.UserInput{
+		Email:    email,
+		Password: password,
+		Roles:    roles,
+	})
+	if err != nil {
+		return err
+	}
+	log.Printf("Saved user %s (ID: %d) with roles %v", u.Email, u.ID, roles)
+	return nil
+}
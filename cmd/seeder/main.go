@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"strings"
 
 	"examination/cmd/seeder/internal/seeds"
 	"examination/internal/ent"
@@ -41,8 +42,10 @@ func main() {
 	defer client.Close()
 
 	// 3. Parse Flags
-	seedName := flag.String("name", "exam_preview", "Name of the seeder to run (e.g., exam_preview)")
+	seedName := flag.String("name", "exam_preview", "Name of the seeder to run (exam_preview or user)")
 	clean := flag.Bool("clean", false, "Clean existing data before seeding")
+	email := flag.String("email", "admin@example.com", "Email of the user to seed (-name=user)")
+	roles := flag.String("role", "admin", "Comma-separated roles of the user to seed (-name=user)")
 	flag.Parse()
 
 	ctx := context.Background()
@@ -64,6 +67,13 @@ func main() {
 			log.Fatalf("Failed to seed exam preview: %v", err)
 		}
 		log.Println("Seeding exam_preview completed successfully.")
+	case "user":
+		// The password comes from the environment so that it stays out of
+		// the shell history; without one a random password is generated
+		if err := seeds.SeedUser(ctx, client, *email, os.Getenv("SEED_PASSWORD"), strings.Split(*roles, ",")); err != nil {
+			log.Fatalf("Failed to seed user: %v", err)
+		}
+		log.Println("Seeding user completed successfully.")
 	default:
 		log.Fatalf("Unknown seed name: %s", *seedName)
 	}
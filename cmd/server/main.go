@@ -4,6 +4,12 @@ import (
 	"context"
 	"database/sql"
 	"examination/internal/ent"
+	attempthandler "examination/internal/features/attempt/handler"
+	attemptservice "examination/internal/features/attempt/service"
+	authhandler "examination/internal/features/auth/handler"
+	authservice "examination/internal/features/auth/service"
+	"examination/internal/features/content/assetstore"
+	contenthandler "examination/internal/features/content/handler"
 	"examination/internal/features/exam/handler"
 	"fmt"
 	"log"
@@ -42,6 +48,12 @@ func main() {
 	r := chi.NewRouter()
 	r.Use(middleware.Logger)
 
+	// Attach the logged-in user, if any, to every request
+	authHandler := authhandler.NewAuthHandler(client)
+	r.Use(authHandler.Session)
+	staffOnly := authhandler.RequireRole(authservice.StaffRoles...)
+	candidatesOnly := authhandler.RequireRole(authservice.RoleCandidate)
+
 	// 3. Health Check
 	r.Get("/health", func(w http.ResponseWriter, r *http.Request) {
 		// Validating DB connection by running a simple query
@@ -56,8 +68,74 @@ func main() {
 	var wg sync.WaitGroup
 
 	// 4. Feature Handlers
+	r.Handle("/static/*", contenthandler.NewStaticHandler("/static/"))
+
+	// Images and attachments of problem content, public when an active exam previews them
+	assetDir := os.Getenv("ASSET_DIR")
+	if assetDir == "" {
+		assetDir = "data/assets"
+	}
+	assetHandler := contenthandler.NewAssetHandler(client, assetstore.NewDisk(assetDir))
+	r.With(assetHandler.RequireSignInForPrivate).Get("/assets/{hash}", assetHandler.Serve)
+
+	r.Get("/login", authHandler.LoginForm)
+	r.Post("/login", authHandler.Login)
+	r.Post("/logout", authHandler.Logout)
+
+	examIndexHandler := handler.NewExamIndexHandler(client)
+	r.Get("/exams", examIndexHandler.ServeHTTP)
+
 	examHandler := handler.NewExamPreviewHandler(client)
-	r.Get("/exams/preview", examHandler.ServeHTTP)
+	r.With(authHandler.RequireStaffForInactive).Get("/exams/{examID}/preview", examHandler.ServeHTTP)
+	r.With(authHandler.RequireStaffForInactive).Get("/exams/{examID}/preview/page", examHandler.Page)
+
+	attemptHandler := attempthandler.NewAttemptHandler(client)
+	r.Group(func(r chi.Router) {
+		r.Use(candidatesOnly)
+		r.Post("/exams/{examID}/attempts", attemptHandler.Start)
+		r.Get("/attempts/{attemptID}", attemptHandler.Show)
+		r.Post("/attempts/{attemptID}/answers", attemptHandler.SaveAnswer)
+		r.Post("/attempts/{attemptID}/submit", attemptHandler.Submit)
+		r.Get("/attempts/{attemptID}/review", attemptHandler.Review)
+	})
+
+	variantHandler := contenthandler.NewVariantHandler(client)
+	reportHandler := contenthandler.NewTranslationReportHandler(client)
+	r.Group(func(r chi.Router) {
+		r.Use(staffOnly)
+		r.Post("/problems/{problemID}/variants", variantHandler.Clone)
+		r.Get("/problems/{problemID}/variants", variantHandler.Tree)
+		r.Get("/problems/{problemID}/diff", variantHandler.Diff)
+		r.Get("/translations", reportHandler.Index)
+		r.Get("/exams/{examID}/translations", reportHandler.Exam)
+	})
+
+	adminHandler := contenthandler.NewAdminHandler(client)
+	r.Route("/admin", func(r chi.Router) {
+		r.Use(staffOnly)
+		r.Get("/", adminHandler.Exams)
+		r.Post("/exams", adminHandler.CreateExam)
+		r.Get("/exams/{examID}", adminHandler.Exam)
+		r.Put("/exams/{examID}", adminHandler.UpdateExam)
+		r.Delete("/exams/{examID}", adminHandler.DeleteExam)
+		r.Post("/exams/{examID}/nodes", adminHandler.CreateNode)
+		r.Put("/exams/{examID}/nodes/{kind}/{id}", adminHandler.RenameNode)
+		r.Delete("/exams/{examID}/nodes/{kind}/{id}", adminHandler.DeleteNode)
+		r.Post("/exams/{examID}/move", adminHandler.MoveNode)
+		r.Get("/units/{unitID}", adminHandler.Unit)
+		r.Post("/units/{unitID}/problems", adminHandler.CreateProblem)
+		r.Get("/problems/{problemID}", adminHandler.Problem)
+		r.Put("/problems/{problemID}", adminHandler.UpdateProblem)
+		r.Delete("/problems/{problemID}", adminHandler.DeleteProblem)
+		r.Post("/problems/{problemID}/translations", adminHandler.CreateTranslation)
+		r.Put("/translations/{translationID}", adminHandler.UpdateTranslation)
+		r.Delete("/translations/{translationID}", adminHandler.DeleteTranslation)
+		r.Post("/translations/{translationID}/choices", adminHandler.CreateChoice)
+		r.Post("/translations/{translationID}/move", adminHandler.MoveChoice)
+		r.Put("/choices/{choiceID}", adminHandler.UpdateChoice)
+		r.Delete("/choices/{choiceID}", adminHandler.DeleteChoice)
+		r.Post("/assets", assetHandler.Upload)
+	})
 
 	r.Get("/", func(w http.ResponseWriter, r *http.Request) {
 		w.Write([]byte("Examination Service - SSR/HTMX Mode"))
@@ -78,6 +156,15 @@ func main() {
 		w.Write([]byte("Request received. Background task started!"))
 	})
 
+	// Auto-submit attempts whose time limit has passed
+	sweepCtx, stopSweeper := context.WithCancel(context.Background())
+	sweeper := attemptservice.NewSweeper(attemptservice.NewAttemptService(client), 30*time.Second)
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		sweeper.Run(sweepCtx)
+	}()
+
 	port := os.Getenv("PORT")
 	if port == "" {
 		port = "8180" // Default changed to 8180 to avoid conflicts
@@ -111,6 +198,9 @@ func main() {
 		log.Fatalf("Server forced to shutdown: %v", err)
 	}
 
+	// Stop the sweeper; a sweep in progress still completes
+	stopSweeper()
+
 	// Wait for all background goroutines to finish
 	log.Println("Waiting for background tasks to complete...")
 	wg.Wait()
@@ -0,0 +1,219 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"examination/internal/ent/answer"
+	"examination/internal/ent/attempt"
+	"examination/internal/ent/problem"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// Answer is the model entity for the Answer schema.
+type Answer struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Typed answer of NUMERIC and TEXT problems
+	Response string `json:"response,omitempty"`
+	// Choice seqs in the order given for an ORDERING problem
+	ChoiceOrder []int `json:"choice_order,omitempty"`
+	// AttemptID holds the value of the "attempt_id" field.
+	AttemptID int `json:"attempt_id,omitempty"`
+	// ProblemID holds the value of the "problem_id" field.
+	ProblemID int `json:"problem_id,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the AnswerQuery when eager-loading is set.
+	Edges        AnswerEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// AnswerEdges holds the relations/edges for other nodes in the graph.
+type AnswerEdges struct {
+	// Attempt holds the value of the attempt edge.
+	Attempt *Attempt `json:"attempt,omitempty"`
+	// Problem holds the value of the problem edge.
+	Problem *Problem `json:"problem,omitempty"`
+	// Choices holds the value of the choices edge.
+	Choices []*Choice `json:"choices,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [3]bool
+}
+
+// AttemptOrErr returns the Attempt value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e AnswerEdges) AttemptOrErr() (*Attempt, error) {
+	if e.Attempt != nil {
+		return e.Attempt, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: attempt.Label}
+	}
+	return nil, &NotLoadedError{edge: "attempt"}
+}
+
+// ProblemOrErr returns the Problem value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e AnswerEdges) ProblemOrErr() (*Problem, error) {
+	if e.Problem != nil {
+		return e.Problem, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: problem.Label}
+	}
+	return nil, &NotLoadedError{edge: "problem"}
+}
+
+// ChoicesOrErr returns the Choices value or an error if the edge
+// was not loaded in eager-loading.
+func (e AnswerEdges) ChoicesOrErr() ([]*Choice, error) {
+	if e.loadedTypes[2] {
+		return e.Choices, nil
+	}
+	return nil, &NotLoadedError{edge: "choices"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Answer) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case answer.FieldChoiceOrder:
+			values[i] = new([]byte)
+		case answer.FieldID, answer.FieldAttemptID, answer.FieldProblemID:
+			values[i] = new(sql.NullInt64)
+		case answer.FieldResponse:
+			values[i] = new(sql.NullString)
+		case answer.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Answer fields.
+func (_m *Answer) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case answer.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case answer.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case answer.FieldResponse:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field response", values[i])
+			} else if value.Valid {
+				_m.Response = value.String
+			}
+		case answer.FieldChoiceOrder:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field choice_order", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.ChoiceOrder); err != nil {
+					return fmt.Errorf("unmarshal field choice_order: %w", err)
+				}
+			}
+		case answer.FieldAttemptID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field attempt_id", values[i])
+			} else if value.Valid {
+				_m.AttemptID = int(value.Int64)
+			}
+		case answer.FieldProblemID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field problem_id", values[i])
+			} else if value.Valid {
+				_m.ProblemID = int(value.Int64)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Answer.
+// This includes values selected through modifiers, order, etc.
+func (_m *Answer) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryAttempt queries the "attempt" edge of the Answer entity.
+func (_m *Answer) QueryAttempt() *AttemptQuery {
+	return NewAnswerClient(_m.config).QueryAttempt(_m)
+}
+
+// QueryProblem queries the "problem" edge of the Answer entity.
+func (_m *Answer) QueryProblem() *ProblemQuery {
+	return NewAnswerClient(_m.config).QueryProblem(_m)
+}
+
+// QueryChoices queries the "choices" edge of the Answer entity.
+func (_m *Answer) QueryChoices() *ChoiceQuery {
+	return NewAnswerClient(_m.config).QueryChoices(_m)
+}
+
+// Update returns a builder for updating this Answer.
+// Note that you need to call Answer.Unwrap() before calling this method if this Answer
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *Answer) Update() *AnswerUpdateOne {
+	return NewAnswerClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the Answer entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *Answer) Unwrap() *Answer {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Answer is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *Answer) String() string {
+	var builder strings.Builder
+	builder.WriteString("Answer(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("response=")
+	builder.WriteString(_m.Response)
+	builder.WriteString(", ")
+	builder.WriteString("choice_order=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ChoiceOrder))
+	builder.WriteString(", ")
+	builder.WriteString("attempt_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AttemptID))
+	builder.WriteString(", ")
+	builder.WriteString("problem_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ProblemID))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Answers is a parsable slice of Answer.
+type Answers []*Answer
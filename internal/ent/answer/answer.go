@@ -0,0 +1,155 @@
+// Code generated by ent, DO NOT EDIT.
+
+package answer
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the answer type in the database.
+	Label = "answer"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldResponse holds the string denoting the response field in the database.
+	FieldResponse = "response"
+	// FieldChoiceOrder holds the string denoting the choice_order field in the database.
+	FieldChoiceOrder = "choice_order"
+	// FieldAttemptID holds the string denoting the attempt_id field in the database.
+	FieldAttemptID = "attempt_id"
+	// FieldProblemID holds the string denoting the problem_id field in the database.
+	FieldProblemID = "problem_id"
+	// EdgeAttempt holds the string denoting the attempt edge name in mutations.
+	EdgeAttempt = "attempt"
+	// EdgeProblem holds the string denoting the problem edge name in mutations.
+	EdgeProblem = "problem"
+	// EdgeChoices holds the string denoting the choices edge name in mutations.
+	EdgeChoices = "choices"
+	// Table holds the table name of the answer in the database.
+	Table = "answers"
+	// AttemptTable is the table that holds the attempt relation/edge.
+	AttemptTable = "answers"
+	// AttemptInverseTable is the table name for the Attempt entity.
+	// It exists in this package in order to avoid circular dependency with the "attempt" package.
+	AttemptInverseTable = "attempts"
+	// AttemptColumn is the table column denoting the attempt relation/edge.
+	AttemptColumn = "attempt_id"
+	// ProblemTable is the table that holds the problem relation/edge.
+	ProblemTable = "answers"
+	// ProblemInverseTable is the table name for the Problem entity.
+	// It exists in this package in order to avoid circular dependency with the "problem" package.
+	ProblemInverseTable = "problems"
+	// ProblemColumn is the table column denoting the problem relation/edge.
+	ProblemColumn = "problem_id"
+	// ChoicesTable is the table that holds the choices relation/edge. The primary key declared below.
+	ChoicesTable = "answer_choices"
+	// ChoicesInverseTable is the table name for the Choice entity.
+	// It exists in this package in order to avoid circular dependency with the "choice" package.
+	ChoicesInverseTable = "choices"
+)
+
+// Columns holds all SQL columns for answer fields.
+var Columns = []string{
+	FieldID,
+	FieldUpdatedAt,
+	FieldResponse,
+	FieldChoiceOrder,
+	FieldAttemptID,
+	FieldProblemID,
+}
+
+var (
+	// ChoicesPrimaryKey and ChoicesColumn2 are the table columns denoting the
+	// primary key for the choices relation (M2M).
+	ChoicesPrimaryKey = []string{"answer_id", "choice_id"}
+)
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// OrderOption defines the ordering options for the Answer queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByResponse orders the results by the response field.
+func ByResponse(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldResponse, opts...).ToFunc()
+}
+
+// ByAttemptID orders the results by the attempt_id field.
+func ByAttemptID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAttemptID, opts...).ToFunc()
+}
+
+// ByProblemID orders the results by the problem_id field.
+func ByProblemID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldProblemID, opts...).ToFunc()
+}
+
+// ByAttemptField orders the results by attempt field.
+func ByAttemptField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAttemptStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByProblemField orders the results by problem field.
+func ByProblemField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newProblemStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByChoicesCount orders the results by choices count.
+func ByChoicesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newChoicesStep(), opts...)
+	}
+}
+
+// ByChoices orders the results by choices terms.
+func ByChoices(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newChoicesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newAttemptStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(AttemptInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, AttemptTable, AttemptColumn),
+	)
+}
+func newProblemStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ProblemInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, ProblemTable, ProblemColumn),
+	)
+}
+func newChoicesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ChoicesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2M, false, ChoicesTable, ChoicesPrimaryKey...),
+	)
+}
@@ -0,0 +1,314 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"examination/internal/ent/answer"
+	"examination/internal/ent/attempt"
+	"examination/internal/ent/choice"
+	"examination/internal/ent/problem"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// AnswerCreate is the builder for creating a Answer entity.
+type AnswerCreate struct {
+	config
+	mutation *AnswerMutation
+	hooks    []Hook
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *AnswerCreate) SetUpdatedAt(v time.Time) *AnswerCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetResponse sets the "response" field.
+func (_c *AnswerCreate) SetResponse(v string) *AnswerCreate {
+	_c.mutation.SetResponse(v)
+	return _c
+}
+
+// SetNillableResponse sets the "response" field if the given value is not nil.
+func (_c *AnswerCreate) SetNillableResponse(v *string) *AnswerCreate {
+	if v != nil {
+		_c.SetResponse(*v)
+	}
+	return _c
+}
+
+// SetChoiceOrder sets the "choice_order" field.
+func (_c *AnswerCreate) SetChoiceOrder(v []int) *AnswerCreate {
+	_c.mutation.SetChoiceOrder(v)
+	return _c
+}
+
+// SetAttemptID sets the "attempt_id" field.
+func (_c *AnswerCreate) SetAttemptID(v int) *AnswerCreate {
+	_c.mutation.SetAttemptID(v)
+	return _c
+}
+
+// SetProblemID sets the "problem_id" field.
+func (_c *AnswerCreate) SetProblemID(v int) *AnswerCreate {
+	_c.mutation.SetProblemID(v)
+	return _c
+}
+
+// SetAttempt sets the "attempt" edge to the Attempt entity.
+func (_c *AnswerCreate) SetAttempt(v *Attempt) *AnswerCreate {
+	return _c.SetAttemptID(v.ID)
+}
+
+// SetProblem sets the "problem" edge to the Problem entity.
+func (_c *AnswerCreate) SetProblem(v *Problem) *AnswerCreate {
+	return _c.SetProblemID(v.ID)
+}
+
+// AddChoiceIDs adds the "choices" edge to the Choice entity by IDs.
+func (_c *AnswerCreate) AddChoiceIDs(ids ...int) *AnswerCreate {
+	_c.mutation.AddChoiceIDs(ids...)
+	return _c
+}
+
+// AddChoices adds the "choices" edges to the Choice entity.
+func (_c *AnswerCreate) AddChoices(v ...*Choice) *AnswerCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddChoiceIDs(ids...)
+}
+
+// Mutation returns the AnswerMutation object of the builder.
+func (_c *AnswerCreate) Mutation() *AnswerMutation {
+	return _c.mutation
+}
+
+// Save creates the Answer in the database.
+func (_c *AnswerCreate) Save(ctx context.Context) (*Answer, error) {
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *AnswerCreate) SaveX(ctx context.Context) *Answer {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AnswerCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AnswerCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *AnswerCreate) check() error {
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Answer.updated_at"`)}
+	}
+	if _, ok := _c.mutation.AttemptID(); !ok {
+		return &ValidationError{Name: "attempt_id", err: errors.New(`ent: missing required field "Answer.attempt_id"`)}
+	}
+	if _, ok := _c.mutation.ProblemID(); !ok {
+		return &ValidationError{Name: "problem_id", err: errors.New(`ent: missing required field "Answer.problem_id"`)}
+	}
+	if len(_c.mutation.AttemptIDs()) == 0 {
+		return &ValidationError{Name: "attempt", err: errors.New(`ent: missing required edge "Answer.attempt"`)}
+	}
+	if len(_c.mutation.ProblemIDs()) == 0 {
+		return &ValidationError{Name: "problem", err: errors.New(`ent: missing required edge "Answer.problem"`)}
+	}
+	return nil
+}
+
+func (_c *AnswerCreate) sqlSave(ctx context.Context) (*Answer, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *AnswerCreate) createSpec() (*Answer, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Answer{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(answer.Table, sqlgraph.NewFieldSpec(answer.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(answer.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.Response(); ok {
+		_spec.SetField(answer.FieldResponse, field.TypeString, value)
+		_node.Response = value
+	}
+	if value, ok := _c.mutation.ChoiceOrder(); ok {
+		_spec.SetField(answer.FieldChoiceOrder, field.TypeJSON, value)
+		_node.ChoiceOrder = value
+	}
+	if nodes := _c.mutation.AttemptIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   answer.AttemptTable,
+			Columns: []string{answer.AttemptColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attempt.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.AttemptID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ProblemIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   answer.ProblemTable,
+			Columns: []string{answer.ProblemColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(problem.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ProblemID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ChoicesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   answer.ChoicesTable,
+			Columns: answer.ChoicesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(choice.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// AnswerCreateBulk is the builder for creating many Answer entities in bulk.
+type AnswerCreateBulk struct {
+	config
+	err      error
+	builders []*AnswerCreate
+}
+
+// Save creates the Answer entities in the database.
+func (_c *AnswerCreateBulk) Save(ctx context.Context) ([]*Answer, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Answer, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AnswerMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *AnswerCreateBulk) SaveX(ctx context.Context) []*Answer {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AnswerCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AnswerCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
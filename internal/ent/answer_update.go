@@ -0,0 +1,730 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"examination/internal/ent/answer"
+	"examination/internal/ent/attempt"
+	"examination/internal/ent/choice"
+	"examination/internal/ent/predicate"
+	"examination/internal/ent/problem"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// AnswerUpdate is the builder for updating Answer entities.
+type AnswerUpdate struct {
+	config
+	hooks    []Hook
+	mutation *AnswerMutation
+}
+
+// Where appends a list predicates to the AnswerUpdate builder.
+func (_u *AnswerUpdate) Where(ps ...predicate.Answer) *AnswerUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *AnswerUpdate) SetUpdatedAt(v time.Time) *AnswerUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_u *AnswerUpdate) SetNillableUpdatedAt(v *time.Time) *AnswerUpdate {
+	if v != nil {
+		_u.SetUpdatedAt(*v)
+	}
+	return _u
+}
+
+// SetResponse sets the "response" field.
+func (_u *AnswerUpdate) SetResponse(v string) *AnswerUpdate {
+	_u.mutation.SetResponse(v)
+	return _u
+}
+
+// SetNillableResponse sets the "response" field if the given value is not nil.
+func (_u *AnswerUpdate) SetNillableResponse(v *string) *AnswerUpdate {
+	if v != nil {
+		_u.SetResponse(*v)
+	}
+	return _u
+}
+
+// ClearResponse clears the value of the "response" field.
+func (_u *AnswerUpdate) ClearResponse() *AnswerUpdate {
+	_u.mutation.ClearResponse()
+	return _u
+}
+
+// SetChoiceOrder sets the "choice_order" field.
+func (_u *AnswerUpdate) SetChoiceOrder(v []int) *AnswerUpdate {
+	_u.mutation.SetChoiceOrder(v)
+	return _u
+}
+
+// AppendChoiceOrder appends value to the "choice_order" field.
+func (_u *AnswerUpdate) AppendChoiceOrder(v []int) *AnswerUpdate {
+	_u.mutation.AppendChoiceOrder(v)
+	return _u
+}
+
+// ClearChoiceOrder clears the value of the "choice_order" field.
+func (_u *AnswerUpdate) ClearChoiceOrder() *AnswerUpdate {
+	_u.mutation.ClearChoiceOrder()
+	return _u
+}
+
+// SetAttemptID sets the "attempt_id" field.
+func (_u *AnswerUpdate) SetAttemptID(v int) *AnswerUpdate {
+	_u.mutation.SetAttemptID(v)
+	return _u
+}
+
+// SetNillableAttemptID sets the "attempt_id" field if the given value is not nil.
+func (_u *AnswerUpdate) SetNillableAttemptID(v *int) *AnswerUpdate {
+	if v != nil {
+		_u.SetAttemptID(*v)
+	}
+	return _u
+}
+
+// SetProblemID sets the "problem_id" field.
+func (_u *AnswerUpdate) SetProblemID(v int) *AnswerUpdate {
+	_u.mutation.SetProblemID(v)
+	return _u
+}
+
+// SetNillableProblemID sets the "problem_id" field if the given value is not nil.
+func (_u *AnswerUpdate) SetNillableProblemID(v *int) *AnswerUpdate {
+	if v != nil {
+		_u.SetProblemID(*v)
+	}
+	return _u
+}
+
+// SetAttempt sets the "attempt" edge to the Attempt entity.
+func (_u *AnswerUpdate) SetAttempt(v *Attempt) *AnswerUpdate {
+	return _u.SetAttemptID(v.ID)
+}
+
+// SetProblem sets the "problem" edge to the Problem entity.
+func (_u *AnswerUpdate) SetProblem(v *Problem) *AnswerUpdate {
+	return _u.SetProblemID(v.ID)
+}
+
+// AddChoiceIDs adds the "choices" edge to the Choice entity by IDs.
+func (_u *AnswerUpdate) AddChoiceIDs(ids ...int) *AnswerUpdate {
+	_u.mutation.AddChoiceIDs(ids...)
+	return _u
+}
+
+// AddChoices adds the "choices" edges to the Choice entity.
+func (_u *AnswerUpdate) AddChoices(v ...*Choice) *AnswerUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddChoiceIDs(ids...)
+}
+
+// Mutation returns the AnswerMutation object of the builder.
+func (_u *AnswerUpdate) Mutation() *AnswerMutation {
+	return _u.mutation
+}
+
+// ClearAttempt clears the "attempt" edge to the Attempt entity.
+func (_u *AnswerUpdate) ClearAttempt() *AnswerUpdate {
+	_u.mutation.ClearAttempt()
+	return _u
+}
+
+// ClearProblem clears the "problem" edge to the Problem entity.
+func (_u *AnswerUpdate) ClearProblem() *AnswerUpdate {
+	_u.mutation.ClearProblem()
+	return _u
+}
+
+// ClearChoices clears all "choices" edges to the Choice entity.
+func (_u *AnswerUpdate) ClearChoices() *AnswerUpdate {
+	_u.mutation.ClearChoices()
+	return _u
+}
+
+// RemoveChoiceIDs removes the "choices" edge to Choice entities by IDs.
+func (_u *AnswerUpdate) RemoveChoiceIDs(ids ...int) *AnswerUpdate {
+	_u.mutation.RemoveChoiceIDs(ids...)
+	return _u
+}
+
+// RemoveChoices removes "choices" edges to Choice entities.
+func (_u *AnswerUpdate) RemoveChoices(v ...*Choice) *AnswerUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveChoiceIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *AnswerUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AnswerUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *AnswerUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AnswerUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *AnswerUpdate) check() error {
+	if _u.mutation.AttemptCleared() && len(_u.mutation.AttemptIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Answer.attempt"`)
+	}
+	if _u.mutation.ProblemCleared() && len(_u.mutation.ProblemIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Answer.problem"`)
+	}
+	return nil
+}
+
+func (_u *AnswerUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(answer.Table, answer.Columns, sqlgraph.NewFieldSpec(answer.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(answer.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Response(); ok {
+		_spec.SetField(answer.FieldResponse, field.TypeString, value)
+	}
+	if _u.mutation.ResponseCleared() {
+		_spec.ClearField(answer.FieldResponse, field.TypeString)
+	}
+	if value, ok := _u.mutation.ChoiceOrder(); ok {
+		_spec.SetField(answer.FieldChoiceOrder, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedChoiceOrder(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, answer.FieldChoiceOrder, value)
+		})
+	}
+	if _u.mutation.ChoiceOrderCleared() {
+		_spec.ClearField(answer.FieldChoiceOrder, field.TypeJSON)
+	}
+	if _u.mutation.AttemptCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   answer.AttemptTable,
+			Columns: []string{answer.AttemptColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attempt.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AttemptIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   answer.AttemptTable,
+			Columns: []string{answer.AttemptColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attempt.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ProblemCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   answer.ProblemTable,
+			Columns: []string{answer.ProblemColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(problem.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ProblemIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   answer.ProblemTable,
+			Columns: []string{answer.ProblemColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(problem.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ChoicesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   answer.ChoicesTable,
+			Columns: answer.ChoicesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(choice.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedChoicesIDs(); len(nodes) > 0 && !_u.mutation.ChoicesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   answer.ChoicesTable,
+			Columns: answer.ChoicesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(choice.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ChoicesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   answer.ChoicesTable,
+			Columns: answer.ChoicesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(choice.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{answer.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// AnswerUpdateOne is the builder for updating a single Answer entity.
+type AnswerUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *AnswerMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *AnswerUpdateOne) SetUpdatedAt(v time.Time) *AnswerUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_u *AnswerUpdateOne) SetNillableUpdatedAt(v *time.Time) *AnswerUpdateOne {
+	if v != nil {
+		_u.SetUpdatedAt(*v)
+	}
+	return _u
+}
+
+// SetResponse sets the "response" field.
+func (_u *AnswerUpdateOne) SetResponse(v string) *AnswerUpdateOne {
+	_u.mutation.SetResponse(v)
+	return _u
+}
+
+// SetNillableResponse sets the "response" field if the given value is not nil.
+func (_u *AnswerUpdateOne) SetNillableResponse(v *string) *AnswerUpdateOne {
+	if v != nil {
+		_u.SetResponse(*v)
+	}
+	return _u
+}
+
+// ClearResponse clears the value of the "response" field.
+func (_u *AnswerUpdateOne) ClearResponse() *AnswerUpdateOne {
+	_u.mutation.ClearResponse()
+	return _u
+}
+
+// SetChoiceOrder sets the "choice_order" field.
+func (_u *AnswerUpdateOne) SetChoiceOrder(v []int) *AnswerUpdateOne {
+	_u.mutation.SetChoiceOrder(v)
+	return _u
+}
+
+// AppendChoiceOrder appends value to the "choice_order" field.
+func (_u *AnswerUpdateOne) AppendChoiceOrder(v []int) *AnswerUpdateOne {
+	_u.mutation.AppendChoiceOrder(v)
+	return _u
+}
+
+// ClearChoiceOrder clears the value of the "choice_order" field.
+func (_u *AnswerUpdateOne) ClearChoiceOrder() *AnswerUpdateOne {
+	_u.mutation.ClearChoiceOrder()
+	return _u
+}
+
+// SetAttemptID sets the "attempt_id" field.
+func (_u *AnswerUpdateOne) SetAttemptID(v int) *AnswerUpdateOne {
+	_u.mutation.SetAttemptID(v)
+	return _u
+}
+
+// SetNillableAttemptID sets the "attempt_id" field if the given value is not nil.
+func (_u *AnswerUpdateOne) SetNillableAttemptID(v *int) *AnswerUpdateOne {
+	if v != nil {
+		_u.SetAttemptID(*v)
+	}
+	return _u
+}
+
+// SetProblemID sets the "problem_id" field.
+func (_u *AnswerUpdateOne) SetProblemID(v int) *AnswerUpdateOne {
+	_u.mutation.SetProblemID(v)
+	return _u
+}
+
+// SetNillableProblemID sets the "problem_id" field if the given value is not nil.
+func (_u *AnswerUpdateOne) SetNillableProblemID(v *int) *AnswerUpdateOne {
+	if v != nil {
+		_u.SetProblemID(*v)
+	}
+	return _u
+}
+
+// SetAttempt sets the "attempt" edge to the Attempt entity.
+func (_u *AnswerUpdateOne) SetAttempt(v *Attempt) *AnswerUpdateOne {
+	return _u.SetAttemptID(v.ID)
+}
+
+// SetProblem sets the "problem" edge to the Problem entity.
+func (_u *AnswerUpdateOne) SetProblem(v *Problem) *AnswerUpdateOne {
+	return _u.SetProblemID(v.ID)
+}
+
+// AddChoiceIDs adds the "choices" edge to the Choice entity by IDs.
+func (_u *AnswerUpdateOne) AddChoiceIDs(ids ...int) *AnswerUpdateOne {
+	_u.mutation.AddChoiceIDs(ids...)
+	return _u
+}
+
+// AddChoices adds the "choices" edges to the Choice entity.
+func (_u *AnswerUpdateOne) AddChoices(v ...*Choice) *AnswerUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddChoiceIDs(ids...)
+}
+
+// Mutation returns the AnswerMutation object of the builder.
+func (_u *AnswerUpdateOne) Mutation() *AnswerMutation {
+	return _u.mutation
+}
+
+// ClearAttempt clears the "attempt" edge to the Attempt entity.
+func (_u *AnswerUpdateOne) ClearAttempt() *AnswerUpdateOne {
+	_u.mutation.ClearAttempt()
+	return _u
+}
+
+// ClearProblem clears the "problem" edge to the Problem entity.
+func (_u *AnswerUpdateOne) ClearProblem() *AnswerUpdateOne {
+	_u.mutation.ClearProblem()
+	return _u
+}
+
+// ClearChoices clears all "choices" edges to the Choice entity.
+func (_u *AnswerUpdateOne) ClearChoices() *AnswerUpdateOne {
+	_u.mutation.ClearChoices()
+	return _u
+}
+
+// RemoveChoiceIDs removes the "choices" edge to Choice entities by IDs.
+func (_u *AnswerUpdateOne) RemoveChoiceIDs(ids ...int) *AnswerUpdateOne {
+	_u.mutation.RemoveChoiceIDs(ids...)
+	return _u
+}
+
+// RemoveChoices removes "choices" edges to Choice entities.
+func (_u *AnswerUpdateOne) RemoveChoices(v ...*Choice) *AnswerUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveChoiceIDs(ids...)
+}
+
+// Where appends a list predicates to the AnswerUpdate builder.
+func (_u *AnswerUpdateOne) Where(ps ...predicate.Answer) *AnswerUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *AnswerUpdateOne) Select(field string, fields ...string) *AnswerUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Answer entity.
+func (_u *AnswerUpdateOne) Save(ctx context.Context) (*Answer, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AnswerUpdateOne) SaveX(ctx context.Context) *Answer {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *AnswerUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AnswerUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *AnswerUpdateOne) check() error {
+	if _u.mutation.AttemptCleared() && len(_u.mutation.AttemptIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Answer.attempt"`)
+	}
+	if _u.mutation.ProblemCleared() && len(_u.mutation.ProblemIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Answer.problem"`)
+	}
+	return nil
+}
+
+func (_u *AnswerUpdateOne) sqlSave(ctx context.Context) (_node *Answer, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(answer.Table, answer.Columns, sqlgraph.NewFieldSpec(answer.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Answer.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, answer.FieldID)
+		for _, f := range fields {
+			if !answer.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != answer.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(answer.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Response(); ok {
+		_spec.SetField(answer.FieldResponse, field.TypeString, value)
+	}
+	if _u.mutation.ResponseCleared() {
+		_spec.ClearField(answer.FieldResponse, field.TypeString)
+	}
+	if value, ok := _u.mutation.ChoiceOrder(); ok {
+		_spec.SetField(answer.FieldChoiceOrder, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedChoiceOrder(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, answer.FieldChoiceOrder, value)
+		})
+	}
+	if _u.mutation.ChoiceOrderCleared() {
+		_spec.ClearField(answer.FieldChoiceOrder, field.TypeJSON)
+	}
+	if _u.mutation.AttemptCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   answer.AttemptTable,
+			Columns: []string{answer.AttemptColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attempt.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AttemptIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   answer.AttemptTable,
+			Columns: []string{answer.AttemptColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attempt.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ProblemCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   answer.ProblemTable,
+			Columns: []string{answer.ProblemColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(problem.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ProblemIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   answer.ProblemTable,
+			Columns: []string{answer.ProblemColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(problem.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ChoicesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   answer.ChoicesTable,
+			Columns: answer.ChoicesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(choice.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedChoicesIDs(); len(nodes) > 0 && !_u.mutation.ChoicesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   answer.ChoicesTable,
+			Columns: answer.ChoicesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(choice.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ChoicesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   answer.ChoicesTable,
+			Columns: answer.ChoicesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(choice.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Answer{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{answer.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
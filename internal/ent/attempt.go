@@ -0,0 +1,310 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"examination/internal/ent/attempt"
+	"examination/internal/ent/exam"
+	"examination/internal/ent/user"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// Attempt is the model entity for the Attempt schema.
+type Attempt struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Status holds the value of the "status" field.
+	Status attempt.Status `json:"status,omitempty"`
+	// Locale negotiated when the attempt started
+	Locale string `json:"locale,omitempty"`
+	// StartedAt holds the value of the "started_at" field.
+	StartedAt time.Time `json:"started_at,omitempty"`
+	// Deadline derived from the exam time limit at start; nil means untimed
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+	// SubmittedAt holds the value of the "submitted_at" field.
+	SubmittedAt *time.Time `json:"submitted_at,omitempty"`
+	// Submitted by the server after the deadline
+	AutoSubmitted bool `json:"auto_submitted,omitempty"`
+	// Edition the attempt was started for; nil means every problem
+	EditionYear *int `json:"edition_year,omitempty"`
+	// EditionRound holds the value of the "edition_round" field.
+	EditionRound int `json:"edition_round,omitempty"`
+	// EditionCategory holds the value of the "edition_category" field.
+	EditionCategory string `json:"edition_category,omitempty"`
+	// ExamID holds the value of the "exam_id" field.
+	ExamID int `json:"exam_id,omitempty"`
+	// Candidate taking the attempt
+	UserID *int `json:"user_id,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the AttemptQuery when eager-loading is set.
+	Edges        AttemptEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// AttemptEdges holds the relations/edges for other nodes in the graph.
+type AttemptEdges struct {
+	// Exam holds the value of the exam edge.
+	Exam *Exam `json:"exam,omitempty"`
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// Answers holds the value of the answers edge.
+	Answers []*Answer `json:"answers,omitempty"`
+	// Items holds the value of the items edge.
+	Items []*AttemptItem `json:"items,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [4]bool
+}
+
+// ExamOrErr returns the Exam value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e AttemptEdges) ExamOrErr() (*Exam, error) {
+	if e.Exam != nil {
+		return e.Exam, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: exam.Label}
+	}
+	return nil, &NotLoadedError{edge: "exam"}
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e AttemptEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// AnswersOrErr returns the Answers value or an error if the edge
+// was not loaded in eager-loading.
+func (e AttemptEdges) AnswersOrErr() ([]*Answer, error) {
+	if e.loadedTypes[2] {
+		return e.Answers, nil
+	}
+	return nil, &NotLoadedError{edge: "answers"}
+}
+
+// ItemsOrErr returns the Items value or an error if the edge
+// was not loaded in eager-loading.
+func (e AttemptEdges) ItemsOrErr() ([]*AttemptItem, error) {
+	if e.loadedTypes[3] {
+		return e.Items, nil
+	}
+	return nil, &NotLoadedError{edge: "items"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Attempt) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case attempt.FieldAutoSubmitted:
+			values[i] = new(sql.NullBool)
+		case attempt.FieldID, attempt.FieldEditionYear, attempt.FieldEditionRound, attempt.FieldExamID, attempt.FieldUserID:
+			values[i] = new(sql.NullInt64)
+		case attempt.FieldStatus, attempt.FieldLocale, attempt.FieldEditionCategory:
+			values[i] = new(sql.NullString)
+		case attempt.FieldStartedAt, attempt.FieldExpiresAt, attempt.FieldSubmittedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Attempt fields.
+func (_m *Attempt) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case attempt.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case attempt.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = attempt.Status(value.String)
+			}
+		case attempt.FieldLocale:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field locale", values[i])
+			} else if value.Valid {
+				_m.Locale = value.String
+			}
+		case attempt.FieldStartedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field started_at", values[i])
+			} else if value.Valid {
+				_m.StartedAt = value.Time
+			}
+		case attempt.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				_m.ExpiresAt = new(time.Time)
+				*_m.ExpiresAt = value.Time
+			}
+		case attempt.FieldSubmittedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field submitted_at", values[i])
+			} else if value.Valid {
+				_m.SubmittedAt = new(time.Time)
+				*_m.SubmittedAt = value.Time
+			}
+		case attempt.FieldAutoSubmitted:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field auto_submitted", values[i])
+			} else if value.Valid {
+				_m.AutoSubmitted = value.Bool
+			}
+		case attempt.FieldEditionYear:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field edition_year", values[i])
+			} else if value.Valid {
+				_m.EditionYear = new(int)
+				*_m.EditionYear = int(value.Int64)
+			}
+		case attempt.FieldEditionRound:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field edition_round", values[i])
+			} else if value.Valid {
+				_m.EditionRound = int(value.Int64)
+			}
+		case attempt.FieldEditionCategory:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field edition_category", values[i])
+			} else if value.Valid {
+				_m.EditionCategory = value.String
+			}
+		case attempt.FieldExamID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field exam_id", values[i])
+			} else if value.Valid {
+				_m.ExamID = int(value.Int64)
+			}
+		case attempt.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				_m.UserID = new(int)
+				*_m.UserID = int(value.Int64)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Attempt.
+// This includes values selected through modifiers, order, etc.
+func (_m *Attempt) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryExam queries the "exam" edge of the Attempt entity.
+func (_m *Attempt) QueryExam() *ExamQuery {
+	return NewAttemptClient(_m.config).QueryExam(_m)
+}
+
+// QueryUser queries the "user" edge of the Attempt entity.
+func (_m *Attempt) QueryUser() *UserQuery {
+	return NewAttemptClient(_m.config).QueryUser(_m)
+}
+
+// QueryAnswers queries the "answers" edge of the Attempt entity.
+func (_m *Attempt) QueryAnswers() *AnswerQuery {
+	return NewAttemptClient(_m.config).QueryAnswers(_m)
+}
+
+// QueryItems queries the "items" edge of the Attempt entity.
+func (_m *Attempt) QueryItems() *AttemptItemQuery {
+	return NewAttemptClient(_m.config).QueryItems(_m)
+}
+
+// Update returns a builder for updating this Attempt.
+// Note that you need to call Attempt.Unwrap() before calling this method if this Attempt
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *Attempt) Update() *AttemptUpdateOne {
+	return NewAttemptClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the Attempt entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *Attempt) Unwrap() *Attempt {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Attempt is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *Attempt) String() string {
+	var builder strings.Builder
+	builder.WriteString("Attempt(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Status))
+	builder.WriteString(", ")
+	builder.WriteString("locale=")
+	builder.WriteString(_m.Locale)
+	builder.WriteString(", ")
+	builder.WriteString("started_at=")
+	builder.WriteString(_m.StartedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.ExpiresAt; v != nil {
+		builder.WriteString("expires_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.SubmittedAt; v != nil {
+		builder.WriteString("submitted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("auto_submitted=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AutoSubmitted))
+	builder.WriteString(", ")
+	if v := _m.EditionYear; v != nil {
+		builder.WriteString("edition_year=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("edition_round=")
+	builder.WriteString(fmt.Sprintf("%v", _m.EditionRound))
+	builder.WriteString(", ")
+	builder.WriteString("edition_category=")
+	builder.WriteString(_m.EditionCategory)
+	builder.WriteString(", ")
+	builder.WriteString("exam_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ExamID))
+	builder.WriteString(", ")
+	if v := _m.UserID; v != nil {
+		builder.WriteString("user_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Attempts is a parsable slice of Attempt.
+type Attempts []*Attempt
@@ -0,0 +1,267 @@
+// Code generated by ent, DO NOT EDIT.
+
+package attempt
+
+import (
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the attempt type in the database.
+	Label = "attempt"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldLocale holds the string denoting the locale field in the database.
+	FieldLocale = "locale"
+	// FieldStartedAt holds the string denoting the started_at field in the database.
+	FieldStartedAt = "started_at"
+	// FieldExpiresAt holds the string denoting the expires_at field in the database.
+	FieldExpiresAt = "expires_at"
+	// FieldSubmittedAt holds the string denoting the submitted_at field in the database.
+	FieldSubmittedAt = "submitted_at"
+	// FieldAutoSubmitted holds the string denoting the auto_submitted field in the database.
+	FieldAutoSubmitted = "auto_submitted"
+	// FieldEditionYear holds the string denoting the edition_year field in the database.
+	FieldEditionYear = "edition_year"
+	// FieldEditionRound holds the string denoting the edition_round field in the database.
+	FieldEditionRound = "edition_round"
+	// FieldEditionCategory holds the string denoting the edition_category field in the database.
+	FieldEditionCategory = "edition_category"
+	// FieldExamID holds the string denoting the exam_id field in the database.
+	FieldExamID = "exam_id"
+	// FieldUserID holds the string denoting the user_id field in the database.
+	FieldUserID = "user_id"
+	// EdgeExam holds the string denoting the exam edge name in mutations.
+	EdgeExam = "exam"
+	// EdgeUser holds the string denoting the user edge name in mutations.
+	EdgeUser = "user"
+	// EdgeAnswers holds the string denoting the answers edge name in mutations.
+	EdgeAnswers = "answers"
+	// EdgeItems holds the string denoting the items edge name in mutations.
+	EdgeItems = "items"
+	// Table holds the table name of the attempt in the database.
+	Table = "attempts"
+	// ExamTable is the table that holds the exam relation/edge.
+	ExamTable = "attempts"
+	// ExamInverseTable is the table name for the Exam entity.
+	// It exists in this package in order to avoid circular dependency with the "exam" package.
+	ExamInverseTable = "exams"
+	// ExamColumn is the table column denoting the exam relation/edge.
+	ExamColumn = "exam_id"
+	// UserTable is the table that holds the user relation/edge.
+	UserTable = "attempts"
+	// UserInverseTable is the table name for the User entity.
+	// It exists in this package in order to avoid circular dependency with the "user" package.
+	UserInverseTable = "users"
+	// UserColumn is the table column denoting the user relation/edge.
+	UserColumn = "user_id"
+	// AnswersTable is the table that holds the answers relation/edge.
+	AnswersTable = "answers"
+	// AnswersInverseTable is the table name for the Answer entity.
+	// It exists in this package in order to avoid circular dependency with the "answer" package.
+	AnswersInverseTable = "answers"
+	// AnswersColumn is the table column denoting the answers relation/edge.
+	AnswersColumn = "attempt_id"
+	// ItemsTable is the table that holds the items relation/edge.
+	ItemsTable = "attempt_items"
+	// ItemsInverseTable is the table name for the AttemptItem entity.
+	// It exists in this package in order to avoid circular dependency with the "attemptitem" package.
+	ItemsInverseTable = "attempt_items"
+	// ItemsColumn is the table column denoting the items relation/edge.
+	ItemsColumn = "attempt_id"
+)
+
+// Columns holds all SQL columns for attempt fields.
+var Columns = []string{
+	FieldID,
+	FieldStatus,
+	FieldLocale,
+	FieldStartedAt,
+	FieldExpiresAt,
+	FieldSubmittedAt,
+	FieldAutoSubmitted,
+	FieldEditionYear,
+	FieldEditionRound,
+	FieldEditionCategory,
+	FieldExamID,
+	FieldUserID,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultAutoSubmitted holds the default value on creation for the "auto_submitted" field.
+	DefaultAutoSubmitted bool
+)
+
+// Status defines the type for the "status" enum field.
+type Status string
+
+// StatusIN_PROGRESS is the default value of the Status enum.
+const DefaultStatus = StatusIN_PROGRESS
+
+// Status values.
+const (
+	StatusIN_PROGRESS Status = "IN_PROGRESS"
+	StatusSUBMITTED   Status = "SUBMITTED"
+)
+
+func (s Status) String() string {
+	return string(s)
+}
+
+// StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
+func StatusValidator(s Status) error {
+	switch s {
+	case StatusIN_PROGRESS, StatusSUBMITTED:
+		return nil
+	default:
+		return fmt.Errorf("attempt: invalid enum value for status field: %q", s)
+	}
+}
+
+// OrderOption defines the ordering options for the Attempt queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByLocale orders the results by the locale field.
+func ByLocale(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLocale, opts...).ToFunc()
+}
+
+// ByStartedAt orders the results by the started_at field.
+func ByStartedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStartedAt, opts...).ToFunc()
+}
+
+// ByExpiresAt orders the results by the expires_at field.
+func ByExpiresAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExpiresAt, opts...).ToFunc()
+}
+
+// BySubmittedAt orders the results by the submitted_at field.
+func BySubmittedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSubmittedAt, opts...).ToFunc()
+}
+
+// ByAutoSubmitted orders the results by the auto_submitted field.
+func ByAutoSubmitted(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAutoSubmitted, opts...).ToFunc()
+}
+
+// ByEditionYear orders the results by the edition_year field.
+func ByEditionYear(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEditionYear, opts...).ToFunc()
+}
+
+// ByEditionRound orders the results by the edition_round field.
+func ByEditionRound(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEditionRound, opts...).ToFunc()
+}
+
+// ByEditionCategory orders the results by the edition_category field.
+func ByEditionCategory(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEditionCategory, opts...).ToFunc()
+}
+
+// ByExamID orders the results by the exam_id field.
+func ByExamID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExamID, opts...).ToFunc()
+}
+
+// ByUserID orders the results by the user_id field.
+func ByUserID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUserID, opts...).ToFunc()
+}
+
+// ByExamField orders the results by exam field.
+func ByExamField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newExamStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByUserField orders the results by user field.
+func ByUserField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newUserStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByAnswersCount orders the results by answers count.
+func ByAnswersCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newAnswersStep(), opts...)
+	}
+}
+
+// ByAnswers orders the results by answers terms.
+func ByAnswers(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAnswersStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByItemsCount orders the results by items count.
+func ByItemsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newItemsStep(), opts...)
+	}
+}
+
+// ByItems orders the results by items terms.
+func ByItems(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newItemsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newExamStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ExamInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, ExamTable, ExamColumn),
+	)
+}
+func newUserStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(UserInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
+	)
+}
+func newAnswersStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(AnswersInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, AnswersTable, AnswersColumn),
+	)
+}
+func newItemsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ItemsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ItemsTable, ItemsColumn),
+	)
+}
@@ -0,0 +1,673 @@
+// Code generated by ent, DO NOT EDIT.
+
+package attempt
+
+import (
+	"examination/internal/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.Attempt {
+	return predicate.Attempt(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.Attempt {
+	return predicate.Attempt(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.Attempt {
+	return predicate.Attempt(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.Attempt {
+	return predicate.Attempt(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.Attempt {
+	return predicate.Attempt(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.Attempt {
+	return predicate.Attempt(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.Attempt {
+	return predicate.Attempt(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.Attempt {
+	return predicate.Attempt(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.Attempt {
+	return predicate.Attempt(sql.FieldLTE(FieldID, id))
+}
+
+// Locale applies equality check predicate on the "locale" field. It's identical to LocaleEQ.
+func Locale(v string) predicate.Attempt {
+	return predicate.Attempt(sql.FieldEQ(FieldLocale, v))
+}
+
+// StartedAt applies equality check predicate on the "started_at" field. It's identical to StartedAtEQ.
+func StartedAt(v time.Time) predicate.Attempt {
+	return predicate.Attempt(sql.FieldEQ(FieldStartedAt, v))
+}
+
+// ExpiresAt applies equality check predicate on the "expires_at" field. It's identical to ExpiresAtEQ.
+func ExpiresAt(v time.Time) predicate.Attempt {
+	return predicate.Attempt(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// SubmittedAt applies equality check predicate on the "submitted_at" field. It's identical to SubmittedAtEQ.
+func SubmittedAt(v time.Time) predicate.Attempt {
+	return predicate.Attempt(sql.FieldEQ(FieldSubmittedAt, v))
+}
+
+// AutoSubmitted applies equality check predicate on the "auto_submitted" field. It's identical to AutoSubmittedEQ.
+func AutoSubmitted(v bool) predicate.Attempt {
+	return predicate.Attempt(sql.FieldEQ(FieldAutoSubmitted, v))
+}
+
+// EditionYear applies equality check predicate on the "edition_year" field. It's identical to EditionYearEQ.
+func EditionYear(v int) predicate.Attempt {
+	return predicate.Attempt(sql.FieldEQ(FieldEditionYear, v))
+}
+
+// EditionRound applies equality check predicate on the "edition_round" field. It's identical to EditionRoundEQ.
+func EditionRound(v int) predicate.Attempt {
+	return predicate.Attempt(sql.FieldEQ(FieldEditionRound, v))
+}
+
+// EditionCategory applies equality check predicate on the "edition_category" field. It's identical to EditionCategoryEQ.
+func EditionCategory(v string) predicate.Attempt {
+	return predicate.Attempt(sql.FieldEQ(FieldEditionCategory, v))
+}
+
+// ExamID applies equality check predicate on the "exam_id" field. It's identical to ExamIDEQ.
+func ExamID(v int) predicate.Attempt {
+	return predicate.Attempt(sql.FieldEQ(FieldExamID, v))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v int) predicate.Attempt {
+	return predicate.Attempt(sql.FieldEQ(FieldUserID, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.Attempt {
+	return predicate.Attempt(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.Attempt {
+	return predicate.Attempt(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.Attempt {
+	return predicate.Attempt(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.Attempt {
+	return predicate.Attempt(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// LocaleEQ applies the EQ predicate on the "locale" field.
+func LocaleEQ(v string) predicate.Attempt {
+	return predicate.Attempt(sql.FieldEQ(FieldLocale, v))
+}
+
+// LocaleNEQ applies the NEQ predicate on the "locale" field.
+func LocaleNEQ(v string) predicate.Attempt {
+	return predicate.Attempt(sql.FieldNEQ(FieldLocale, v))
+}
+
+// LocaleIn applies the In predicate on the "locale" field.
+func LocaleIn(vs ...string) predicate.Attempt {
+	return predicate.Attempt(sql.FieldIn(FieldLocale, vs...))
+}
+
+// LocaleNotIn applies the NotIn predicate on the "locale" field.
+func LocaleNotIn(vs ...string) predicate.Attempt {
+	return predicate.Attempt(sql.FieldNotIn(FieldLocale, vs...))
+}
+
+// LocaleGT applies the GT predicate on the "locale" field.
+func LocaleGT(v string) predicate.Attempt {
+	return predicate.Attempt(sql.FieldGT(FieldLocale, v))
+}
+
+// LocaleGTE applies the GTE predicate on the "locale" field.
+func LocaleGTE(v string) predicate.Attempt {
+	return predicate.Attempt(sql.FieldGTE(FieldLocale, v))
+}
+
+// LocaleLT applies the LT predicate on the "locale" field.
+func LocaleLT(v string) predicate.Attempt {
+	return predicate.Attempt(sql.FieldLT(FieldLocale, v))
+}
+
+// LocaleLTE applies the LTE predicate on the "locale" field.
+func LocaleLTE(v string) predicate.Attempt {
+	return predicate.Attempt(sql.FieldLTE(FieldLocale, v))
+}
+
+// LocaleContains applies the Contains predicate on the "locale" field.
+func LocaleContains(v string) predicate.Attempt {
+	return predicate.Attempt(sql.FieldContains(FieldLocale, v))
+}
+
+// LocaleHasPrefix applies the HasPrefix predicate on the "locale" field.
+func LocaleHasPrefix(v string) predicate.Attempt {
+	return predicate.Attempt(sql.FieldHasPrefix(FieldLocale, v))
+}
+
+// LocaleHasSuffix applies the HasSuffix predicate on the "locale" field.
+func LocaleHasSuffix(v string) predicate.Attempt {
+	return predicate.Attempt(sql.FieldHasSuffix(FieldLocale, v))
+}
+
+// LocaleEqualFold applies the EqualFold predicate on the "locale" field.
+func LocaleEqualFold(v string) predicate.Attempt {
+	return predicate.Attempt(sql.FieldEqualFold(FieldLocale, v))
+}
+
+// LocaleContainsFold applies the ContainsFold predicate on the "locale" field.
+func LocaleContainsFold(v string) predicate.Attempt {
+	return predicate.Attempt(sql.FieldContainsFold(FieldLocale, v))
+}
+
+// StartedAtEQ applies the EQ predicate on the "started_at" field.
+func StartedAtEQ(v time.Time) predicate.Attempt {
+	return predicate.Attempt(sql.FieldEQ(FieldStartedAt, v))
+}
+
+// StartedAtNEQ applies the NEQ predicate on the "started_at" field.
+func StartedAtNEQ(v time.Time) predicate.Attempt {
+	return predicate.Attempt(sql.FieldNEQ(FieldStartedAt, v))
+}
+
+// StartedAtIn applies the In predicate on the "started_at" field.
+func StartedAtIn(vs ...time.Time) predicate.Attempt {
+	return predicate.Attempt(sql.FieldIn(FieldStartedAt, vs...))
+}
+
+// StartedAtNotIn applies the NotIn predicate on the "started_at" field.
+func StartedAtNotIn(vs ...time.Time) predicate.Attempt {
+	return predicate.Attempt(sql.FieldNotIn(FieldStartedAt, vs...))
+}
+
+// StartedAtGT applies the GT predicate on the "started_at" field.
+func StartedAtGT(v time.Time) predicate.Attempt {
+	return predicate.Attempt(sql.FieldGT(FieldStartedAt, v))
+}
+
+// StartedAtGTE applies the GTE predicate on the "started_at" field.
+func StartedAtGTE(v time.Time) predicate.Attempt {
+	return predicate.Attempt(sql.FieldGTE(FieldStartedAt, v))
+}
+
+// StartedAtLT applies the LT predicate on the "started_at" field.
+func StartedAtLT(v time.Time) predicate.Attempt {
+	return predicate.Attempt(sql.FieldLT(FieldStartedAt, v))
+}
+
+// StartedAtLTE applies the LTE predicate on the "started_at" field.
+func StartedAtLTE(v time.Time) predicate.Attempt {
+	return predicate.Attempt(sql.FieldLTE(FieldStartedAt, v))
+}
+
+// ExpiresAtEQ applies the EQ predicate on the "expires_at" field.
+func ExpiresAtEQ(v time.Time) predicate.Attempt {
+	return predicate.Attempt(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtNEQ applies the NEQ predicate on the "expires_at" field.
+func ExpiresAtNEQ(v time.Time) predicate.Attempt {
+	return predicate.Attempt(sql.FieldNEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtIn applies the In predicate on the "expires_at" field.
+func ExpiresAtIn(vs ...time.Time) predicate.Attempt {
+	return predicate.Attempt(sql.FieldIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtNotIn applies the NotIn predicate on the "expires_at" field.
+func ExpiresAtNotIn(vs ...time.Time) predicate.Attempt {
+	return predicate.Attempt(sql.FieldNotIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtGT applies the GT predicate on the "expires_at" field.
+func ExpiresAtGT(v time.Time) predicate.Attempt {
+	return predicate.Attempt(sql.FieldGT(FieldExpiresAt, v))
+}
+
+// ExpiresAtGTE applies the GTE predicate on the "expires_at" field.
+func ExpiresAtGTE(v time.Time) predicate.Attempt {
+	return predicate.Attempt(sql.FieldGTE(FieldExpiresAt, v))
+}
+
+// ExpiresAtLT applies the LT predicate on the "expires_at" field.
+func ExpiresAtLT(v time.Time) predicate.Attempt {
+	return predicate.Attempt(sql.FieldLT(FieldExpiresAt, v))
+}
+
+// ExpiresAtLTE applies the LTE predicate on the "expires_at" field.
+func ExpiresAtLTE(v time.Time) predicate.Attempt {
+	return predicate.Attempt(sql.FieldLTE(FieldExpiresAt, v))
+}
+
+// ExpiresAtIsNil applies the IsNil predicate on the "expires_at" field.
+func ExpiresAtIsNil() predicate.Attempt {
+	return predicate.Attempt(sql.FieldIsNull(FieldExpiresAt))
+}
+
+// ExpiresAtNotNil applies the NotNil predicate on the "expires_at" field.
+func ExpiresAtNotNil() predicate.Attempt {
+	return predicate.Attempt(sql.FieldNotNull(FieldExpiresAt))
+}
+
+// SubmittedAtEQ applies the EQ predicate on the "submitted_at" field.
+func SubmittedAtEQ(v time.Time) predicate.Attempt {
+	return predicate.Attempt(sql.FieldEQ(FieldSubmittedAt, v))
+}
+
+// SubmittedAtNEQ applies the NEQ predicate on the "submitted_at" field.
+func SubmittedAtNEQ(v time.Time) predicate.Attempt {
+	return predicate.Attempt(sql.FieldNEQ(FieldSubmittedAt, v))
+}
+
+// SubmittedAtIn applies the In predicate on the "submitted_at" field.
+func SubmittedAtIn(vs ...time.Time) predicate.Attempt {
+	return predicate.Attempt(sql.FieldIn(FieldSubmittedAt, vs...))
+}
+
+// SubmittedAtNotIn applies the NotIn predicate on the "submitted_at" field.
+func SubmittedAtNotIn(vs ...time.Time) predicate.Attempt {
+	return predicate.Attempt(sql.FieldNotIn(FieldSubmittedAt, vs...))
+}
+
+// SubmittedAtGT applies the GT predicate on the "submitted_at" field.
+func SubmittedAtGT(v time.Time) predicate.Attempt {
+	return predicate.Attempt(sql.FieldGT(FieldSubmittedAt, v))
+}
+
+// SubmittedAtGTE applies the GTE predicate on the "submitted_at" field.
+func SubmittedAtGTE(v time.Time) predicate.Attempt {
+	return predicate.Attempt(sql.FieldGTE(FieldSubmittedAt, v))
+}
+
+// SubmittedAtLT applies the LT predicate on the "submitted_at" field.
+func SubmittedAtLT(v time.Time) predicate.Attempt {
+	return predicate.Attempt(sql.FieldLT(FieldSubmittedAt, v))
+}
+
+// SubmittedAtLTE applies the LTE predicate on the "submitted_at" field.
+func SubmittedAtLTE(v time.Time) predicate.Attempt {
+	return predicate.Attempt(sql.FieldLTE(FieldSubmittedAt, v))
+}
+
+// SubmittedAtIsNil applies the IsNil predicate on the "submitted_at" field.
+func SubmittedAtIsNil() predicate.Attempt {
+	return predicate.Attempt(sql.FieldIsNull(FieldSubmittedAt))
+}
+
+// SubmittedAtNotNil applies the NotNil predicate on the "submitted_at" field.
+func SubmittedAtNotNil() predicate.Attempt {
+	return predicate.Attempt(sql.FieldNotNull(FieldSubmittedAt))
+}
+
+// AutoSubmittedEQ applies the EQ predicate on the "auto_submitted" field.
+func AutoSubmittedEQ(v bool) predicate.Attempt {
+	return predicate.Attempt(sql.FieldEQ(FieldAutoSubmitted, v))
+}
+
+// AutoSubmittedNEQ applies the NEQ predicate on the "auto_submitted" field.
+func AutoSubmittedNEQ(v bool) predicate.Attempt {
+	return predicate.Attempt(sql.FieldNEQ(FieldAutoSubmitted, v))
+}
+
+// EditionYearEQ applies the EQ predicate on the "edition_year" field.
+func EditionYearEQ(v int) predicate.Attempt {
+	return predicate.Attempt(sql.FieldEQ(FieldEditionYear, v))
+}
+
+// EditionYearNEQ applies the NEQ predicate on the "edition_year" field.
+func EditionYearNEQ(v int) predicate.Attempt {
+	return predicate.Attempt(sql.FieldNEQ(FieldEditionYear, v))
+}
+
+// EditionYearIn applies the In predicate on the "edition_year" field.
+func EditionYearIn(vs ...int) predicate.Attempt {
+	return predicate.Attempt(sql.FieldIn(FieldEditionYear, vs...))
+}
+
+// EditionYearNotIn applies the NotIn predicate on the "edition_year" field.
+func EditionYearNotIn(vs ...int) predicate.Attempt {
+	return predicate.Attempt(sql.FieldNotIn(FieldEditionYear, vs...))
+}
+
+// EditionYearGT applies the GT predicate on the "edition_year" field.
+func EditionYearGT(v int) predicate.Attempt {
+	return predicate.Attempt(sql.FieldGT(FieldEditionYear, v))
+}
+
+// EditionYearGTE applies the GTE predicate on the "edition_year" field.
+func EditionYearGTE(v int) predicate.Attempt {
+	return predicate.Attempt(sql.FieldGTE(FieldEditionYear, v))
+}
+
+// EditionYearLT applies the LT predicate on the "edition_year" field.
+func EditionYearLT(v int) predicate.Attempt {
+	return predicate.Attempt(sql.FieldLT(FieldEditionYear, v))
+}
+
+// EditionYearLTE applies the LTE predicate on the "edition_year" field.
+func EditionYearLTE(v int) predicate.Attempt {
+	return predicate.Attempt(sql.FieldLTE(FieldEditionYear, v))
+}
+
+// EditionYearIsNil applies the IsNil predicate on the "edition_year" field.
+func EditionYearIsNil() predicate.Attempt {
+	return predicate.Attempt(sql.FieldIsNull(FieldEditionYear))
+}
+
+// EditionYearNotNil applies the NotNil predicate on the "edition_year" field.
+func EditionYearNotNil() predicate.Attempt {
+	return predicate.Attempt(sql.FieldNotNull(FieldEditionYear))
+}
+
+// EditionRoundEQ applies the EQ predicate on the "edition_round" field.
+func EditionRoundEQ(v int) predicate.Attempt {
+	return predicate.Attempt(sql.FieldEQ(FieldEditionRound, v))
+}
+
+// EditionRoundNEQ applies the NEQ predicate on the "edition_round" field.
+func EditionRoundNEQ(v int) predicate.Attempt {
+	return predicate.Attempt(sql.FieldNEQ(FieldEditionRound, v))
+}
+
+// EditionRoundIn applies the In predicate on the "edition_round" field.
+func EditionRoundIn(vs ...int) predicate.Attempt {
+	return predicate.Attempt(sql.FieldIn(FieldEditionRound, vs...))
+}
+
+// EditionRoundNotIn applies the NotIn predicate on the "edition_round" field.
+func EditionRoundNotIn(vs ...int) predicate.Attempt {
+	return predicate.Attempt(sql.FieldNotIn(FieldEditionRound, vs...))
+}
+
+// EditionRoundGT applies the GT predicate on the "edition_round" field.
+func EditionRoundGT(v int) predicate.Attempt {
+	return predicate.Attempt(sql.FieldGT(FieldEditionRound, v))
+}
+
+// EditionRoundGTE applies the GTE predicate on the "edition_round" field.
+func EditionRoundGTE(v int) predicate.Attempt {
+	return predicate.Attempt(sql.FieldGTE(FieldEditionRound, v))
+}
+
+// EditionRoundLT applies the LT predicate on the "edition_round" field.
+func EditionRoundLT(v int) predicate.Attempt {
+	return predicate.Attempt(sql.FieldLT(FieldEditionRound, v))
+}
+
+// EditionRoundLTE applies the LTE predicate on the "edition_round" field.
+func EditionRoundLTE(v int) predicate.Attempt {
+	return predicate.Attempt(sql.FieldLTE(FieldEditionRound, v))
+}
+
+// EditionRoundIsNil applies the IsNil predicate on the "edition_round" field.
+func EditionRoundIsNil() predicate.Attempt {
+	return predicate.Attempt(sql.FieldIsNull(FieldEditionRound))
+}
+
+// EditionRoundNotNil applies the NotNil predicate on the "edition_round" field.
+func EditionRoundNotNil() predicate.Attempt {
+	return predicate.Attempt(sql.FieldNotNull(FieldEditionRound))
+}
+
+// EditionCategoryEQ applies the EQ predicate on the "edition_category" field.
+func EditionCategoryEQ(v string) predicate.Attempt {
+	return predicate.Attempt(sql.FieldEQ(FieldEditionCategory, v))
+}
+
+// EditionCategoryNEQ applies the NEQ predicate on the "edition_category" field.
+func EditionCategoryNEQ(v string) predicate.Attempt {
+	return predicate.Attempt(sql.FieldNEQ(FieldEditionCategory, v))
+}
+
+// EditionCategoryIn applies the In predicate on the "edition_category" field.
+func EditionCategoryIn(vs ...string) predicate.Attempt {
+	return predicate.Attempt(sql.FieldIn(FieldEditionCategory, vs...))
+}
+
+// EditionCategoryNotIn applies the NotIn predicate on the "edition_category" field.
+func EditionCategoryNotIn(vs ...string) predicate.Attempt {
+	return predicate.Attempt(sql.FieldNotIn(FieldEditionCategory, vs...))
+}
+
+// EditionCategoryGT applies the GT predicate on the "edition_category" field.
+func EditionCategoryGT(v string) predicate.Attempt {
+	return predicate.Attempt(sql.FieldGT(FieldEditionCategory, v))
+}
+
+// EditionCategoryGTE applies the GTE predicate on the "edition_category" field.
+func EditionCategoryGTE(v string) predicate.Attempt {
+	return predicate.Attempt(sql.FieldGTE(FieldEditionCategory, v))
+}
+
+// EditionCategoryLT applies the LT predicate on the "edition_category" field.
+func EditionCategoryLT(v string) predicate.Attempt {
+	return predicate.Attempt(sql.FieldLT(FieldEditionCategory, v))
+}
+
+// EditionCategoryLTE applies the LTE predicate on the "edition_category" field.
+func EditionCategoryLTE(v string) predicate.Attempt {
+	return predicate.Attempt(sql.FieldLTE(FieldEditionCategory, v))
+}
+
+// EditionCategoryContains applies the Contains predicate on the "edition_category" field.
+func EditionCategoryContains(v string) predicate.Attempt {
+	return predicate.Attempt(sql.FieldContains(FieldEditionCategory, v))
+}
+
+// EditionCategoryHasPrefix applies the HasPrefix predicate on the "edition_category" field.
+func EditionCategoryHasPrefix(v string) predicate.Attempt {
+	return predicate.Attempt(sql.FieldHasPrefix(FieldEditionCategory, v))
+}
+
+// EditionCategoryHasSuffix applies the HasSuffix predicate on the "edition_category" field.
+func EditionCategoryHasSuffix(v string) predicate.Attempt {
+	return predicate.Attempt(sql.FieldHasSuffix(FieldEditionCategory, v))
+}
+
+// EditionCategoryIsNil applies the IsNil predicate on the "edition_category" field.
+func EditionCategoryIsNil() predicate.Attempt {
+	return predicate.Attempt(sql.FieldIsNull(FieldEditionCategory))
+}
+
+// EditionCategoryNotNil applies the NotNil predicate on the "edition_category" field.
+func EditionCategoryNotNil() predicate.Attempt {
+	return predicate.Attempt(sql.FieldNotNull(FieldEditionCategory))
+}
+
+// EditionCategoryEqualFold applies the EqualFold predicate on the "edition_category" field.
+func EditionCategoryEqualFold(v string) predicate.Attempt {
+	return predicate.Attempt(sql.FieldEqualFold(FieldEditionCategory, v))
+}
+
+// EditionCategoryContainsFold applies the ContainsFold predicate on the "edition_category" field.
+func EditionCategoryContainsFold(v string) predicate.Attempt {
+	return predicate.Attempt(sql.FieldContainsFold(FieldEditionCategory, v))
+}
+
+// ExamIDEQ applies the EQ predicate on the "exam_id" field.
+func ExamIDEQ(v int) predicate.Attempt {
+	return predicate.Attempt(sql.FieldEQ(FieldExamID, v))
+}
+
+// ExamIDNEQ applies the NEQ predicate on the "exam_id" field.
+func ExamIDNEQ(v int) predicate.Attempt {
+	return predicate.Attempt(sql.FieldNEQ(FieldExamID, v))
+}
+
+// ExamIDIn applies the In predicate on the "exam_id" field.
+func ExamIDIn(vs ...int) predicate.Attempt {
+	return predicate.Attempt(sql.FieldIn(FieldExamID, vs...))
+}
+
+// ExamIDNotIn applies the NotIn predicate on the "exam_id" field.
+func ExamIDNotIn(vs ...int) predicate.Attempt {
+	return predicate.Attempt(sql.FieldNotIn(FieldExamID, vs...))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v int) predicate.Attempt {
+	return predicate.Attempt(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v int) predicate.Attempt {
+	return predicate.Attempt(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...int) predicate.Attempt {
+	return predicate.Attempt(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...int) predicate.Attempt {
+	return predicate.Attempt(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDIsNil applies the IsNil predicate on the "user_id" field.
+func UserIDIsNil() predicate.Attempt {
+	return predicate.Attempt(sql.FieldIsNull(FieldUserID))
+}
+
+// UserIDNotNil applies the NotNil predicate on the "user_id" field.
+func UserIDNotNil() predicate.Attempt {
+	return predicate.Attempt(sql.FieldNotNull(FieldUserID))
+}
+
+// HasExam applies the HasEdge predicate on the "exam" edge.
+func HasExam() predicate.Attempt {
+	return predicate.Attempt(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ExamTable, ExamColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasExamWith applies the HasEdge predicate on the "exam" edge with a given conditions (other predicates).
+func HasExamWith(preds ...predicate.Exam) predicate.Attempt {
+	return predicate.Attempt(func(s *sql.Selector) {
+		step := newExamStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasUser applies the HasEdge predicate on the "user" edge.
+func HasUser() predicate.Attempt {
+	return predicate.Attempt(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUserWith applies the HasEdge predicate on the "user" edge with a given conditions (other predicates).
+func HasUserWith(preds ...predicate.User) predicate.Attempt {
+	return predicate.Attempt(func(s *sql.Selector) {
+		step := newUserStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasAnswers applies the HasEdge predicate on the "answers" edge.
+func HasAnswers() predicate.Attempt {
+	return predicate.Attempt(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, AnswersTable, AnswersColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAnswersWith applies the HasEdge predicate on the "answers" edge with a given conditions (other predicates).
+func HasAnswersWith(preds ...predicate.Answer) predicate.Attempt {
+	return predicate.Attempt(func(s *sql.Selector) {
+		step := newAnswersStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasItems applies the HasEdge predicate on the "items" edge.
+func HasItems() predicate.Attempt {
+	return predicate.Attempt(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ItemsTable, ItemsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasItemsWith applies the HasEdge predicate on the "items" edge with a given conditions (other predicates).
+func HasItemsWith(preds ...predicate.AttemptItem) predicate.Attempt {
+	return predicate.Attempt(func(s *sql.Selector) {
+		step := newItemsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Attempt) predicate.Attempt {
+	return predicate.Attempt(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Attempt) predicate.Attempt {
+	return predicate.Attempt(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Attempt) predicate.Attempt {
+	return predicate.Attempt(sql.NotPredicates(p))
+}
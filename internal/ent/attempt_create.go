@@ -0,0 +1,484 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"examination/internal/ent/answer"
+	"examination/internal/ent/attempt"
+	"examination/internal/ent/attemptitem"
+	"examination/internal/ent/exam"
+	"examination/internal/ent/user"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// AttemptCreate is the builder for creating a Attempt entity.
+type AttemptCreate struct {
+	config
+	mutation *AttemptMutation
+	hooks    []Hook
+}
+
+// SetStatus sets the "status" field.
+func (_c *AttemptCreate) SetStatus(v attempt.Status) *AttemptCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *AttemptCreate) SetNillableStatus(v *attempt.Status) *AttemptCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetLocale sets the "locale" field.
+func (_c *AttemptCreate) SetLocale(v string) *AttemptCreate {
+	_c.mutation.SetLocale(v)
+	return _c
+}
+
+// SetStartedAt sets the "started_at" field.
+func (_c *AttemptCreate) SetStartedAt(v time.Time) *AttemptCreate {
+	_c.mutation.SetStartedAt(v)
+	return _c
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_c *AttemptCreate) SetExpiresAt(v time.Time) *AttemptCreate {
+	_c.mutation.SetExpiresAt(v)
+	return _c
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_c *AttemptCreate) SetNillableExpiresAt(v *time.Time) *AttemptCreate {
+	if v != nil {
+		_c.SetExpiresAt(*v)
+	}
+	return _c
+}
+
+// SetSubmittedAt sets the "submitted_at" field.
+func (_c *AttemptCreate) SetSubmittedAt(v time.Time) *AttemptCreate {
+	_c.mutation.SetSubmittedAt(v)
+	return _c
+}
+
+// SetNillableSubmittedAt sets the "submitted_at" field if the given value is not nil.
+func (_c *AttemptCreate) SetNillableSubmittedAt(v *time.Time) *AttemptCreate {
+	if v != nil {
+		_c.SetSubmittedAt(*v)
+	}
+	return _c
+}
+
+// SetAutoSubmitted sets the "auto_submitted" field.
+func (_c *AttemptCreate) SetAutoSubmitted(v bool) *AttemptCreate {
+	_c.mutation.SetAutoSubmitted(v)
+	return _c
+}
+
+// SetNillableAutoSubmitted sets the "auto_submitted" field if the given value is not nil.
+func (_c *AttemptCreate) SetNillableAutoSubmitted(v *bool) *AttemptCreate {
+	if v != nil {
+		_c.SetAutoSubmitted(*v)
+	}
+	return _c
+}
+
+// SetEditionYear sets the "edition_year" field.
+func (_c *AttemptCreate) SetEditionYear(v int) *AttemptCreate {
+	_c.mutation.SetEditionYear(v)
+	return _c
+}
+
+// SetNillableEditionYear sets the "edition_year" field if the given value is not nil.
+func (_c *AttemptCreate) SetNillableEditionYear(v *int) *AttemptCreate {
+	if v != nil {
+		_c.SetEditionYear(*v)
+	}
+	return _c
+}
+
+// SetEditionRound sets the "edition_round" field.
+func (_c *AttemptCreate) SetEditionRound(v int) *AttemptCreate {
+	_c.mutation.SetEditionRound(v)
+	return _c
+}
+
+// SetNillableEditionRound sets the "edition_round" field if the given value is not nil.
+func (_c *AttemptCreate) SetNillableEditionRound(v *int) *AttemptCreate {
+	if v != nil {
+		_c.SetEditionRound(*v)
+	}
+	return _c
+}
+
+// SetEditionCategory sets the "edition_category" field.
+func (_c *AttemptCreate) SetEditionCategory(v string) *AttemptCreate {
+	_c.mutation.SetEditionCategory(v)
+	return _c
+}
+
+// SetNillableEditionCategory sets the "edition_category" field if the given value is not nil.
+func (_c *AttemptCreate) SetNillableEditionCategory(v *string) *AttemptCreate {
+	if v != nil {
+		_c.SetEditionCategory(*v)
+	}
+	return _c
+}
+
+// SetExamID sets the "exam_id" field.
+func (_c *AttemptCreate) SetExamID(v int) *AttemptCreate {
+	_c.mutation.SetExamID(v)
+	return _c
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *AttemptCreate) SetUserID(v int) *AttemptCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_c *AttemptCreate) SetNillableUserID(v *int) *AttemptCreate {
+	if v != nil {
+		_c.SetUserID(*v)
+	}
+	return _c
+}
+
+// SetExam sets the "exam" edge to the Exam entity.
+func (_c *AttemptCreate) SetExam(v *Exam) *AttemptCreate {
+	return _c.SetExamID(v.ID)
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_c *AttemptCreate) SetUser(v *User) *AttemptCreate {
+	return _c.SetUserID(v.ID)
+}
+
+// AddAnswerIDs adds the "answers" edge to the Answer entity by IDs.
+func (_c *AttemptCreate) AddAnswerIDs(ids ...int) *AttemptCreate {
+	_c.mutation.AddAnswerIDs(ids...)
+	return _c
+}
+
+// AddAnswers adds the "answers" edges to the Answer entity.
+func (_c *AttemptCreate) AddAnswers(v ...*Answer) *AttemptCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddAnswerIDs(ids...)
+}
+
+// AddItemIDs adds the "items" edge to the AttemptItem entity by IDs.
+func (_c *AttemptCreate) AddItemIDs(ids ...int) *AttemptCreate {
+	_c.mutation.AddItemIDs(ids...)
+	return _c
+}
+
+// AddItems adds the "items" edges to the AttemptItem entity.
+func (_c *AttemptCreate) AddItems(v ...*AttemptItem) *AttemptCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddItemIDs(ids...)
+}
+
+// Mutation returns the AttemptMutation object of the builder.
+func (_c *AttemptCreate) Mutation() *AttemptMutation {
+	return _c.mutation
+}
+
+// Save creates the Attempt in the database.
+func (_c *AttemptCreate) Save(ctx context.Context) (*Attempt, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *AttemptCreate) SaveX(ctx context.Context) *Attempt {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AttemptCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AttemptCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *AttemptCreate) defaults() {
+	if _, ok := _c.mutation.Status(); !ok {
+		v := attempt.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.AutoSubmitted(); !ok {
+		v := attempt.DefaultAutoSubmitted
+		_c.mutation.SetAutoSubmitted(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *AttemptCreate) check() error {
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "Attempt.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := attempt.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Attempt.status": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Locale(); !ok {
+		return &ValidationError{Name: "locale", err: errors.New(`ent: missing required field "Attempt.locale"`)}
+	}
+	if _, ok := _c.mutation.StartedAt(); !ok {
+		return &ValidationError{Name: "started_at", err: errors.New(`ent: missing required field "Attempt.started_at"`)}
+	}
+	if _, ok := _c.mutation.AutoSubmitted(); !ok {
+		return &ValidationError{Name: "auto_submitted", err: errors.New(`ent: missing required field "Attempt.auto_submitted"`)}
+	}
+	if _, ok := _c.mutation.ExamID(); !ok {
+		return &ValidationError{Name: "exam_id", err: errors.New(`ent: missing required field "Attempt.exam_id"`)}
+	}
+	if len(_c.mutation.ExamIDs()) == 0 {
+		return &ValidationError{Name: "exam", err: errors.New(`ent: missing required edge "Attempt.exam"`)}
+	}
+	return nil
+}
+
+func (_c *AttemptCreate) sqlSave(ctx context.Context) (*Attempt, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *AttemptCreate) createSpec() (*Attempt, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Attempt{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(attempt.Table, sqlgraph.NewFieldSpec(attempt.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(attempt.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.Locale(); ok {
+		_spec.SetField(attempt.FieldLocale, field.TypeString, value)
+		_node.Locale = value
+	}
+	if value, ok := _c.mutation.StartedAt(); ok {
+		_spec.SetField(attempt.FieldStartedAt, field.TypeTime, value)
+		_node.StartedAt = value
+	}
+	if value, ok := _c.mutation.ExpiresAt(); ok {
+		_spec.SetField(attempt.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = &value
+	}
+	if value, ok := _c.mutation.SubmittedAt(); ok {
+		_spec.SetField(attempt.FieldSubmittedAt, field.TypeTime, value)
+		_node.SubmittedAt = &value
+	}
+	if value, ok := _c.mutation.AutoSubmitted(); ok {
+		_spec.SetField(attempt.FieldAutoSubmitted, field.TypeBool, value)
+		_node.AutoSubmitted = value
+	}
+	if value, ok := _c.mutation.EditionYear(); ok {
+		_spec.SetField(attempt.FieldEditionYear, field.TypeInt, value)
+		_node.EditionYear = &value
+	}
+	if value, ok := _c.mutation.EditionRound(); ok {
+		_spec.SetField(attempt.FieldEditionRound, field.TypeInt, value)
+		_node.EditionRound = value
+	}
+	if value, ok := _c.mutation.EditionCategory(); ok {
+		_spec.SetField(attempt.FieldEditionCategory, field.TypeString, value)
+		_node.EditionCategory = value
+	}
+	if nodes := _c.mutation.ExamIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   attempt.ExamTable,
+			Columns: []string{attempt.ExamColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(exam.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ExamID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   attempt.UserTable,
+			Columns: []string{attempt.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.AnswersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   attempt.AnswersTable,
+			Columns: []string{attempt.AnswersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(answer.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ItemsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   attempt.ItemsTable,
+			Columns: []string{attempt.ItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attemptitem.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// AttemptCreateBulk is the builder for creating many Attempt entities in bulk.
+type AttemptCreateBulk struct {
+	config
+	err      error
+	builders []*AttemptCreate
+}
+
+// Save creates the Attempt entities in the database.
+func (_c *AttemptCreateBulk) Save(ctx context.Context) ([]*Attempt, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Attempt, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AttemptMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *AttemptCreateBulk) SaveX(ctx context.Context) []*Attempt {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AttemptCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AttemptCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
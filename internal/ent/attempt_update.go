@@ -0,0 +1,1200 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"examination/internal/ent/answer"
+	"examination/internal/ent/attempt"
+	"examination/internal/ent/attemptitem"
+	"examination/internal/ent/exam"
+	"examination/internal/ent/predicate"
+	"examination/internal/ent/user"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// AttemptUpdate is the builder for updating Attempt entities.
+type AttemptUpdate struct {
+	config
+	hooks    []Hook
+	mutation *AttemptMutation
+}
+
+// Where appends a list predicates to the AttemptUpdate builder.
+func (_u *AttemptUpdate) Where(ps ...predicate.Attempt) *AttemptUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *AttemptUpdate) SetStatus(v attempt.Status) *AttemptUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *AttemptUpdate) SetNillableStatus(v *attempt.Status) *AttemptUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetLocale sets the "locale" field.
+func (_u *AttemptUpdate) SetLocale(v string) *AttemptUpdate {
+	_u.mutation.SetLocale(v)
+	return _u
+}
+
+// SetNillableLocale sets the "locale" field if the given value is not nil.
+func (_u *AttemptUpdate) SetNillableLocale(v *string) *AttemptUpdate {
+	if v != nil {
+		_u.SetLocale(*v)
+	}
+	return _u
+}
+
+// SetStartedAt sets the "started_at" field.
+func (_u *AttemptUpdate) SetStartedAt(v time.Time) *AttemptUpdate {
+	_u.mutation.SetStartedAt(v)
+	return _u
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (_u *AttemptUpdate) SetNillableStartedAt(v *time.Time) *AttemptUpdate {
+	if v != nil {
+		_u.SetStartedAt(*v)
+	}
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *AttemptUpdate) SetExpiresAt(v time.Time) *AttemptUpdate {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *AttemptUpdate) SetNillableExpiresAt(v *time.Time) *AttemptUpdate {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (_u *AttemptUpdate) ClearExpiresAt() *AttemptUpdate {
+	_u.mutation.ClearExpiresAt()
+	return _u
+}
+
+// SetSubmittedAt sets the "submitted_at" field.
+func (_u *AttemptUpdate) SetSubmittedAt(v time.Time) *AttemptUpdate {
+	_u.mutation.SetSubmittedAt(v)
+	return _u
+}
+
+// SetNillableSubmittedAt sets the "submitted_at" field if the given value is not nil.
+func (_u *AttemptUpdate) SetNillableSubmittedAt(v *time.Time) *AttemptUpdate {
+	if v != nil {
+		_u.SetSubmittedAt(*v)
+	}
+	return _u
+}
+
+// ClearSubmittedAt clears the value of the "submitted_at" field.
+func (_u *AttemptUpdate) ClearSubmittedAt() *AttemptUpdate {
+	_u.mutation.ClearSubmittedAt()
+	return _u
+}
+
+// SetAutoSubmitted sets the "auto_submitted" field.
+func (_u *AttemptUpdate) SetAutoSubmitted(v bool) *AttemptUpdate {
+	_u.mutation.SetAutoSubmitted(v)
+	return _u
+}
+
+// SetNillableAutoSubmitted sets the "auto_submitted" field if the given value is not nil.
+func (_u *AttemptUpdate) SetNillableAutoSubmitted(v *bool) *AttemptUpdate {
+	if v != nil {
+		_u.SetAutoSubmitted(*v)
+	}
+	return _u
+}
+
+// SetEditionYear sets the "edition_year" field.
+func (_u *AttemptUpdate) SetEditionYear(v int) *AttemptUpdate {
+	_u.mutation.ResetEditionYear()
+	_u.mutation.SetEditionYear(v)
+	return _u
+}
+
+// SetNillableEditionYear sets the "edition_year" field if the given value is not nil.
+func (_u *AttemptUpdate) SetNillableEditionYear(v *int) *AttemptUpdate {
+	if v != nil {
+		_u.SetEditionYear(*v)
+	}
+	return _u
+}
+
+// AddEditionYear adds value to the "edition_year" field.
+func (_u *AttemptUpdate) AddEditionYear(v int) *AttemptUpdate {
+	_u.mutation.AddEditionYear(v)
+	return _u
+}
+
+// ClearEditionYear clears the value of the "edition_year" field.
+func (_u *AttemptUpdate) ClearEditionYear() *AttemptUpdate {
+	_u.mutation.ClearEditionYear()
+	return _u
+}
+
+// SetEditionRound sets the "edition_round" field.
+func (_u *AttemptUpdate) SetEditionRound(v int) *AttemptUpdate {
+	_u.mutation.ResetEditionRound()
+	_u.mutation.SetEditionRound(v)
+	return _u
+}
+
+// SetNillableEditionRound sets the "edition_round" field if the given value is not nil.
+func (_u *AttemptUpdate) SetNillableEditionRound(v *int) *AttemptUpdate {
+	if v != nil {
+		_u.SetEditionRound(*v)
+	}
+	return _u
+}
+
+// AddEditionRound adds value to the "edition_round" field.
+func (_u *AttemptUpdate) AddEditionRound(v int) *AttemptUpdate {
+	_u.mutation.AddEditionRound(v)
+	return _u
+}
+
+// ClearEditionRound clears the value of the "edition_round" field.
+func (_u *AttemptUpdate) ClearEditionRound() *AttemptUpdate {
+	_u.mutation.ClearEditionRound()
+	return _u
+}
+
+// SetEditionCategory sets the "edition_category" field.
+func (_u *AttemptUpdate) SetEditionCategory(v string) *AttemptUpdate {
+	_u.mutation.SetEditionCategory(v)
+	return _u
+}
+
+// SetNillableEditionCategory sets the "edition_category" field if the given value is not nil.
+func (_u *AttemptUpdate) SetNillableEditionCategory(v *string) *AttemptUpdate {
+	if v != nil {
+		_u.SetEditionCategory(*v)
+	}
+	return _u
+}
+
+// ClearEditionCategory clears the value of the "edition_category" field.
+func (_u *AttemptUpdate) ClearEditionCategory() *AttemptUpdate {
+	_u.mutation.ClearEditionCategory()
+	return _u
+}
+
+// SetExamID sets the "exam_id" field.
+func (_u *AttemptUpdate) SetExamID(v int) *AttemptUpdate {
+	_u.mutation.SetExamID(v)
+	return _u
+}
+
+// SetNillableExamID sets the "exam_id" field if the given value is not nil.
+func (_u *AttemptUpdate) SetNillableExamID(v *int) *AttemptUpdate {
+	if v != nil {
+		_u.SetExamID(*v)
+	}
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *AttemptUpdate) SetUserID(v int) *AttemptUpdate {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *AttemptUpdate) SetNillableUserID(v *int) *AttemptUpdate {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (_u *AttemptUpdate) ClearUserID() *AttemptUpdate {
+	_u.mutation.ClearUserID()
+	return _u
+}
+
+// SetExam sets the "exam" edge to the Exam entity.
+func (_u *AttemptUpdate) SetExam(v *Exam) *AttemptUpdate {
+	return _u.SetExamID(v.ID)
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *AttemptUpdate) SetUser(v *User) *AttemptUpdate {
+	return _u.SetUserID(v.ID)
+}
+
+// AddAnswerIDs adds the "answers" edge to the Answer entity by IDs.
+func (_u *AttemptUpdate) AddAnswerIDs(ids ...int) *AttemptUpdate {
+	_u.mutation.AddAnswerIDs(ids...)
+	return _u
+}
+
+// AddAnswers adds the "answers" edges to the Answer entity.
+func (_u *AttemptUpdate) AddAnswers(v ...*Answer) *AttemptUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddAnswerIDs(ids...)
+}
+
+// AddItemIDs adds the "items" edge to the AttemptItem entity by IDs.
+func (_u *AttemptUpdate) AddItemIDs(ids ...int) *AttemptUpdate {
+	_u.mutation.AddItemIDs(ids...)
+	return _u
+}
+
+// AddItems adds the "items" edges to the AttemptItem entity.
+func (_u *AttemptUpdate) AddItems(v ...*AttemptItem) *AttemptUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddItemIDs(ids...)
+}
+
+// Mutation returns the AttemptMutation object of the builder.
+func (_u *AttemptUpdate) Mutation() *AttemptMutation {
+	return _u.mutation
+}
+
+// ClearExam clears the "exam" edge to the Exam entity.
+func (_u *AttemptUpdate) ClearExam() *AttemptUpdate {
+	_u.mutation.ClearExam()
+	return _u
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *AttemptUpdate) ClearUser() *AttemptUpdate {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// ClearAnswers clears all "answers" edges to the Answer entity.
+func (_u *AttemptUpdate) ClearAnswers() *AttemptUpdate {
+	_u.mutation.ClearAnswers()
+	return _u
+}
+
+// RemoveAnswerIDs removes the "answers" edge to Answer entities by IDs.
+func (_u *AttemptUpdate) RemoveAnswerIDs(ids ...int) *AttemptUpdate {
+	_u.mutation.RemoveAnswerIDs(ids...)
+	return _u
+}
+
+// RemoveAnswers removes "answers" edges to Answer entities.
+func (_u *AttemptUpdate) RemoveAnswers(v ...*Answer) *AttemptUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveAnswerIDs(ids...)
+}
+
+// ClearItems clears all "items" edges to the AttemptItem entity.
+func (_u *AttemptUpdate) ClearItems() *AttemptUpdate {
+	_u.mutation.ClearItems()
+	return _u
+}
+
+// RemoveItemIDs removes the "items" edge to AttemptItem entities by IDs.
+func (_u *AttemptUpdate) RemoveItemIDs(ids ...int) *AttemptUpdate {
+	_u.mutation.RemoveItemIDs(ids...)
+	return _u
+}
+
+// RemoveItems removes "items" edges to AttemptItem entities.
+func (_u *AttemptUpdate) RemoveItems(v ...*AttemptItem) *AttemptUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveItemIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *AttemptUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AttemptUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *AttemptUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AttemptUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *AttemptUpdate) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := attempt.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Attempt.status": %w`, err)}
+		}
+	}
+	if _u.mutation.ExamCleared() && len(_u.mutation.ExamIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Attempt.exam"`)
+	}
+	return nil
+}
+
+func (_u *AttemptUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(attempt.Table, attempt.Columns, sqlgraph.NewFieldSpec(attempt.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(attempt.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Locale(); ok {
+		_spec.SetField(attempt.FieldLocale, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.StartedAt(); ok {
+		_spec.SetField(attempt.FieldStartedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(attempt.FieldExpiresAt, field.TypeTime, value)
+	}
+	if _u.mutation.ExpiresAtCleared() {
+		_spec.ClearField(attempt.FieldExpiresAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.SubmittedAt(); ok {
+		_spec.SetField(attempt.FieldSubmittedAt, field.TypeTime, value)
+	}
+	if _u.mutation.SubmittedAtCleared() {
+		_spec.ClearField(attempt.FieldSubmittedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.AutoSubmitted(); ok {
+		_spec.SetField(attempt.FieldAutoSubmitted, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.EditionYear(); ok {
+		_spec.SetField(attempt.FieldEditionYear, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedEditionYear(); ok {
+		_spec.AddField(attempt.FieldEditionYear, field.TypeInt, value)
+	}
+	if _u.mutation.EditionYearCleared() {
+		_spec.ClearField(attempt.FieldEditionYear, field.TypeInt)
+	}
+	if value, ok := _u.mutation.EditionRound(); ok {
+		_spec.SetField(attempt.FieldEditionRound, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedEditionRound(); ok {
+		_spec.AddField(attempt.FieldEditionRound, field.TypeInt, value)
+	}
+	if _u.mutation.EditionRoundCleared() {
+		_spec.ClearField(attempt.FieldEditionRound, field.TypeInt)
+	}
+	if value, ok := _u.mutation.EditionCategory(); ok {
+		_spec.SetField(attempt.FieldEditionCategory, field.TypeString, value)
+	}
+	if _u.mutation.EditionCategoryCleared() {
+		_spec.ClearField(attempt.FieldEditionCategory, field.TypeString)
+	}
+	if _u.mutation.ExamCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   attempt.ExamTable,
+			Columns: []string{attempt.ExamColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(exam.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ExamIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   attempt.ExamTable,
+			Columns: []string{attempt.ExamColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(exam.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   attempt.UserTable,
+			Columns: []string{attempt.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   attempt.UserTable,
+			Columns: []string{attempt.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.AnswersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   attempt.AnswersTable,
+			Columns: []string{attempt.AnswersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(answer.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedAnswersIDs(); len(nodes) > 0 && !_u.mutation.AnswersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   attempt.AnswersTable,
+			Columns: []string{attempt.AnswersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(answer.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AnswersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   attempt.AnswersTable,
+			Columns: []string{attempt.AnswersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(answer.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ItemsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   attempt.ItemsTable,
+			Columns: []string{attempt.ItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attemptitem.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedItemsIDs(); len(nodes) > 0 && !_u.mutation.ItemsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   attempt.ItemsTable,
+			Columns: []string{attempt.ItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attemptitem.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ItemsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   attempt.ItemsTable,
+			Columns: []string{attempt.ItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attemptitem.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{attempt.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// AttemptUpdateOne is the builder for updating a single Attempt entity.
+type AttemptUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *AttemptMutation
+}
+
+// SetStatus sets the "status" field.
+func (_u *AttemptUpdateOne) SetStatus(v attempt.Status) *AttemptUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *AttemptUpdateOne) SetNillableStatus(v *attempt.Status) *AttemptUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetLocale sets the "locale" field.
+func (_u *AttemptUpdateOne) SetLocale(v string) *AttemptUpdateOne {
+	_u.mutation.SetLocale(v)
+	return _u
+}
+
+// SetNillableLocale sets the "locale" field if the given value is not nil.
+func (_u *AttemptUpdateOne) SetNillableLocale(v *string) *AttemptUpdateOne {
+	if v != nil {
+		_u.SetLocale(*v)
+	}
+	return _u
+}
+
+// SetStartedAt sets the "started_at" field.
+func (_u *AttemptUpdateOne) SetStartedAt(v time.Time) *AttemptUpdateOne {
+	_u.mutation.SetStartedAt(v)
+	return _u
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (_u *AttemptUpdateOne) SetNillableStartedAt(v *time.Time) *AttemptUpdateOne {
+	if v != nil {
+		_u.SetStartedAt(*v)
+	}
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *AttemptUpdateOne) SetExpiresAt(v time.Time) *AttemptUpdateOne {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *AttemptUpdateOne) SetNillableExpiresAt(v *time.Time) *AttemptUpdateOne {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (_u *AttemptUpdateOne) ClearExpiresAt() *AttemptUpdateOne {
+	_u.mutation.ClearExpiresAt()
+	return _u
+}
+
+// SetSubmittedAt sets the "submitted_at" field.
+func (_u *AttemptUpdateOne) SetSubmittedAt(v time.Time) *AttemptUpdateOne {
+	_u.mutation.SetSubmittedAt(v)
+	return _u
+}
+
+// SetNillableSubmittedAt sets the "submitted_at" field if the given value is not nil.
+func (_u *AttemptUpdateOne) SetNillableSubmittedAt(v *time.Time) *AttemptUpdateOne {
+	if v != nil {
+		_u.SetSubmittedAt(*v)
+	}
+	return _u
+}
+
+// ClearSubmittedAt clears the value of the "submitted_at" field.
+func (_u *AttemptUpdateOne) ClearSubmittedAt() *AttemptUpdateOne {
+	_u.mutation.ClearSubmittedAt()
+	return _u
+}
+
+// SetAutoSubmitted sets the "auto_submitted" field.
+func (_u *AttemptUpdateOne) SetAutoSubmitted(v bool) *AttemptUpdateOne {
+	_u.mutation.SetAutoSubmitted(v)
+	return _u
+}
+
+// SetNillableAutoSubmitted sets the "auto_submitted" field if the given value is not nil.
+func (_u *AttemptUpdateOne) SetNillableAutoSubmitted(v *bool) *AttemptUpdateOne {
+	if v != nil {
+		_u.SetAutoSubmitted(*v)
+	}
+	return _u
+}
+
+// SetEditionYear sets the "edition_year" field.
+func (_u *AttemptUpdateOne) SetEditionYear(v int) *AttemptUpdateOne {
+	_u.mutation.ResetEditionYear()
+	_u.mutation.SetEditionYear(v)
+	return _u
+}
+
+// SetNillableEditionYear sets the "edition_year" field if the given value is not nil.
+func (_u *AttemptUpdateOne) SetNillableEditionYear(v *int) *AttemptUpdateOne {
+	if v != nil {
+		_u.SetEditionYear(*v)
+	}
+	return _u
+}
+
+// AddEditionYear adds value to the "edition_year" field.
+func (_u *AttemptUpdateOne) AddEditionYear(v int) *AttemptUpdateOne {
+	_u.mutation.AddEditionYear(v)
+	return _u
+}
+
+// ClearEditionYear clears the value of the "edition_year" field.
+func (_u *AttemptUpdateOne) ClearEditionYear() *AttemptUpdateOne {
+	_u.mutation.ClearEditionYear()
+	return _u
+}
+
+// SetEditionRound sets the "edition_round" field.
+func (_u *AttemptUpdateOne) SetEditionRound(v int) *AttemptUpdateOne {
+	_u.mutation.ResetEditionRound()
+	_u.mutation.SetEditionRound(v)
+	return _u
+}
+
+// SetNillableEditionRound sets the "edition_round" field if the given value is not nil.
+func (_u *AttemptUpdateOne) SetNillableEditionRound(v *int) *AttemptUpdateOne {
+	if v != nil {
+		_u.SetEditionRound(*v)
+	}
+	return _u
+}
+
+// AddEditionRound adds value to the "edition_round" field.
+func (_u *AttemptUpdateOne) AddEditionRound(v int) *AttemptUpdateOne {
+	_u.mutation.AddEditionRound(v)
+	return _u
+}
+
+// ClearEditionRound clears the value of the "edition_round" field.
+func (_u *AttemptUpdateOne) ClearEditionRound() *AttemptUpdateOne {
+	_u.mutation.ClearEditionRound()
+	return _u
+}
+
+// SetEditionCategory sets the "edition_category" field.
+func (_u *AttemptUpdateOne) SetEditionCategory(v string) *AttemptUpdateOne {
+	_u.mutation.SetEditionCategory(v)
+	return _u
+}
+
+// SetNillableEditionCategory sets the "edition_category" field if the given value is not nil.
+func (_u *AttemptUpdateOne) SetNillableEditionCategory(v *string) *AttemptUpdateOne {
+	if v != nil {
+		_u.SetEditionCategory(*v)
+	}
+	return _u
+}
+
+// ClearEditionCategory clears the value of the "edition_category" field.
+func (_u *AttemptUpdateOne) ClearEditionCategory() *AttemptUpdateOne {
+	_u.mutation.ClearEditionCategory()
+	return _u
+}
+
+// SetExamID sets the "exam_id" field.
+func (_u *AttemptUpdateOne) SetExamID(v int) *AttemptUpdateOne {
+	_u.mutation.SetExamID(v)
+	return _u
+}
+
+// SetNillableExamID sets the "exam_id" field if the given value is not nil.
+func (_u *AttemptUpdateOne) SetNillableExamID(v *int) *AttemptUpdateOne {
+	if v != nil {
+		_u.SetExamID(*v)
+	}
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *AttemptUpdateOne) SetUserID(v int) *AttemptUpdateOne {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *AttemptUpdateOne) SetNillableUserID(v *int) *AttemptUpdateOne {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (_u *AttemptUpdateOne) ClearUserID() *AttemptUpdateOne {
+	_u.mutation.ClearUserID()
+	return _u
+}
+
+// SetExam sets the "exam" edge to the Exam entity.
+func (_u *AttemptUpdateOne) SetExam(v *Exam) *AttemptUpdateOne {
+	return _u.SetExamID(v.ID)
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *AttemptUpdateOne) SetUser(v *User) *AttemptUpdateOne {
+	return _u.SetUserID(v.ID)
+}
+
+// AddAnswerIDs adds the "answers" edge to the Answer entity by IDs.
+func (_u *AttemptUpdateOne) AddAnswerIDs(ids ...int) *AttemptUpdateOne {
+	_u.mutation.AddAnswerIDs(ids...)
+	return _u
+}
+
+// AddAnswers adds the "answers" edges to the Answer entity.
+func (_u *AttemptUpdateOne) AddAnswers(v ...*Answer) *AttemptUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddAnswerIDs(ids...)
+}
+
+// AddItemIDs adds the "items" edge to the AttemptItem entity by IDs.
+func (_u *AttemptUpdateOne) AddItemIDs(ids ...int) *AttemptUpdateOne {
+	_u.mutation.AddItemIDs(ids...)
+	return _u
+}
+
+// AddItems adds the "items" edges to the AttemptItem entity.
+func (_u *AttemptUpdateOne) AddItems(v ...*AttemptItem) *AttemptUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddItemIDs(ids...)
+}
+
+// Mutation returns the AttemptMutation object of the builder.
+func (_u *AttemptUpdateOne) Mutation() *AttemptMutation {
+	return _u.mutation
+}
+
+// ClearExam clears the "exam" edge to the Exam entity.
+func (_u *AttemptUpdateOne) ClearExam() *AttemptUpdateOne {
+	_u.mutation.ClearExam()
+	return _u
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *AttemptUpdateOne) ClearUser() *AttemptUpdateOne {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// ClearAnswers clears all "answers" edges to the Answer entity.
+func (_u *AttemptUpdateOne) ClearAnswers() *AttemptUpdateOne {
+	_u.mutation.ClearAnswers()
+	return _u
+}
+
+// RemoveAnswerIDs removes the "answers" edge to Answer entities by IDs.
+func (_u *AttemptUpdateOne) RemoveAnswerIDs(ids ...int) *AttemptUpdateOne {
+	_u.mutation.RemoveAnswerIDs(ids...)
+	return _u
+}
+
+// RemoveAnswers removes "answers" edges to Answer entities.
+func (_u *AttemptUpdateOne) RemoveAnswers(v ...*Answer) *AttemptUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveAnswerIDs(ids...)
+}
+
+// ClearItems clears all "items" edges to the AttemptItem entity.
+func (_u *AttemptUpdateOne) ClearItems() *AttemptUpdateOne {
+	_u.mutation.ClearItems()
+	return _u
+}
+
+// RemoveItemIDs removes the "items" edge to AttemptItem entities by IDs.
+func (_u *AttemptUpdateOne) RemoveItemIDs(ids ...int) *AttemptUpdateOne {
+	_u.mutation.RemoveItemIDs(ids...)
+	return _u
+}
+
+// RemoveItems removes "items" edges to AttemptItem entities.
+func (_u *AttemptUpdateOne) RemoveItems(v ...*AttemptItem) *AttemptUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveItemIDs(ids...)
+}
+
+// Where appends a list predicates to the AttemptUpdate builder.
+func (_u *AttemptUpdateOne) Where(ps ...predicate.Attempt) *AttemptUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *AttemptUpdateOne) Select(field string, fields ...string) *AttemptUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Attempt entity.
+func (_u *AttemptUpdateOne) Save(ctx context.Context) (*Attempt, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AttemptUpdateOne) SaveX(ctx context.Context) *Attempt {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *AttemptUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AttemptUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *AttemptUpdateOne) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := attempt.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Attempt.status": %w`, err)}
+		}
+	}
+	if _u.mutation.ExamCleared() && len(_u.mutation.ExamIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Attempt.exam"`)
+	}
+	return nil
+}
+
+func (_u *AttemptUpdateOne) sqlSave(ctx context.Context) (_node *Attempt, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(attempt.Table, attempt.Columns, sqlgraph.NewFieldSpec(attempt.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Attempt.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, attempt.FieldID)
+		for _, f := range fields {
+			if !attempt.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != attempt.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(attempt.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Locale(); ok {
+		_spec.SetField(attempt.FieldLocale, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.StartedAt(); ok {
+		_spec.SetField(attempt.FieldStartedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(attempt.FieldExpiresAt, field.TypeTime, value)
+	}
+	if _u.mutation.ExpiresAtCleared() {
+		_spec.ClearField(attempt.FieldExpiresAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.SubmittedAt(); ok {
+		_spec.SetField(attempt.FieldSubmittedAt, field.TypeTime, value)
+	}
+	if _u.mutation.SubmittedAtCleared() {
+		_spec.ClearField(attempt.FieldSubmittedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.AutoSubmitted(); ok {
+		_spec.SetField(attempt.FieldAutoSubmitted, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.EditionYear(); ok {
+		_spec.SetField(attempt.FieldEditionYear, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedEditionYear(); ok {
+		_spec.AddField(attempt.FieldEditionYear, field.TypeInt, value)
+	}
+	if _u.mutation.EditionYearCleared() {
+		_spec.ClearField(attempt.FieldEditionYear, field.TypeInt)
+	}
+	if value, ok := _u.mutation.EditionRound(); ok {
+		_spec.SetField(attempt.FieldEditionRound, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedEditionRound(); ok {
+		_spec.AddField(attempt.FieldEditionRound, field.TypeInt, value)
+	}
+	if _u.mutation.EditionRoundCleared() {
+		_spec.ClearField(attempt.FieldEditionRound, field.TypeInt)
+	}
+	if value, ok := _u.mutation.EditionCategory(); ok {
+		_spec.SetField(attempt.FieldEditionCategory, field.TypeString, value)
+	}
+	if _u.mutation.EditionCategoryCleared() {
+		_spec.ClearField(attempt.FieldEditionCategory, field.TypeString)
+	}
+	if _u.mutation.ExamCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   attempt.ExamTable,
+			Columns: []string{attempt.ExamColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(exam.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ExamIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   attempt.ExamTable,
+			Columns: []string{attempt.ExamColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(exam.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   attempt.UserTable,
+			Columns: []string{attempt.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   attempt.UserTable,
+			Columns: []string{attempt.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.AnswersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   attempt.AnswersTable,
+			Columns: []string{attempt.AnswersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(answer.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedAnswersIDs(); len(nodes) > 0 && !_u.mutation.AnswersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   attempt.AnswersTable,
+			Columns: []string{attempt.AnswersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(answer.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AnswersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   attempt.AnswersTable,
+			Columns: []string{attempt.AnswersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(answer.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ItemsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   attempt.ItemsTable,
+			Columns: []string{attempt.ItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attemptitem.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedItemsIDs(); len(nodes) > 0 && !_u.mutation.ItemsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   attempt.ItemsTable,
+			Columns: []string{attempt.ItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attemptitem.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ItemsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   attempt.ItemsTable,
+			Columns: []string{attempt.ItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attemptitem.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Attempt{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{attempt.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,176 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"examination/internal/ent/attempt"
+	"examination/internal/ent/attemptitem"
+	"examination/internal/ent/problem"
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// AttemptItem is the model entity for the AttemptItem schema.
+type AttemptItem struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Choice seqs in display order; empty keeps the authored order
+	ChoiceOrder []int `json:"choice_order,omitempty"`
+	// AttemptID holds the value of the "attempt_id" field.
+	AttemptID int `json:"attempt_id,omitempty"`
+	// ProblemID holds the value of the "problem_id" field.
+	ProblemID int `json:"problem_id,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the AttemptItemQuery when eager-loading is set.
+	Edges        AttemptItemEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// AttemptItemEdges holds the relations/edges for other nodes in the graph.
+type AttemptItemEdges struct {
+	// Attempt holds the value of the attempt edge.
+	Attempt *Attempt `json:"attempt,omitempty"`
+	// Problem holds the value of the problem edge.
+	Problem *Problem `json:"problem,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// AttemptOrErr returns the Attempt value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e AttemptItemEdges) AttemptOrErr() (*Attempt, error) {
+	if e.Attempt != nil {
+		return e.Attempt, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: attempt.Label}
+	}
+	return nil, &NotLoadedError{edge: "attempt"}
+}
+
+// ProblemOrErr returns the Problem value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e AttemptItemEdges) ProblemOrErr() (*Problem, error) {
+	if e.Problem != nil {
+		return e.Problem, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: problem.Label}
+	}
+	return nil, &NotLoadedError{edge: "problem"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*AttemptItem) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case attemptitem.FieldChoiceOrder:
+			values[i] = new([]byte)
+		case attemptitem.FieldID, attemptitem.FieldAttemptID, attemptitem.FieldProblemID:
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the AttemptItem fields.
+func (_m *AttemptItem) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case attemptitem.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case attemptitem.FieldChoiceOrder:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field choice_order", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.ChoiceOrder); err != nil {
+					return fmt.Errorf("unmarshal field choice_order: %w", err)
+				}
+			}
+		case attemptitem.FieldAttemptID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field attempt_id", values[i])
+			} else if value.Valid {
+				_m.AttemptID = int(value.Int64)
+			}
+		case attemptitem.FieldProblemID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field problem_id", values[i])
+			} else if value.Valid {
+				_m.ProblemID = int(value.Int64)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the AttemptItem.
+// This includes values selected through modifiers, order, etc.
+func (_m *AttemptItem) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryAttempt queries the "attempt" edge of the AttemptItem entity.
+func (_m *AttemptItem) QueryAttempt() *AttemptQuery {
+	return NewAttemptItemClient(_m.config).QueryAttempt(_m)
+}
+
+// QueryProblem queries the "problem" edge of the AttemptItem entity.
+func (_m *AttemptItem) QueryProblem() *ProblemQuery {
+	return NewAttemptItemClient(_m.config).QueryProblem(_m)
+}
+
+// Update returns a builder for updating this AttemptItem.
+// Note that you need to call AttemptItem.Unwrap() before calling this method if this AttemptItem
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *AttemptItem) Update() *AttemptItemUpdateOne {
+	return NewAttemptItemClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the AttemptItem entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *AttemptItem) Unwrap() *AttemptItem {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: AttemptItem is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *AttemptItem) String() string {
+	var builder strings.Builder
+	builder.WriteString("AttemptItem(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("choice_order=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ChoiceOrder))
+	builder.WriteString(", ")
+	builder.WriteString("attempt_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AttemptID))
+	builder.WriteString(", ")
+	builder.WriteString("problem_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ProblemID))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// AttemptItems is a parsable slice of AttemptItem.
+type AttemptItems []*AttemptItem
@@ -0,0 +1,105 @@
+// Code generated by ent, DO NOT EDIT.
+
+package attemptitem
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the attemptitem type in the database.
+	Label = "attempt_item"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldChoiceOrder holds the string denoting the choice_order field in the database.
+	FieldChoiceOrder = "choice_order"
+	// FieldAttemptID holds the string denoting the attempt_id field in the database.
+	FieldAttemptID = "attempt_id"
+	// FieldProblemID holds the string denoting the problem_id field in the database.
+	FieldProblemID = "problem_id"
+	// EdgeAttempt holds the string denoting the attempt edge name in mutations.
+	EdgeAttempt = "attempt"
+	// EdgeProblem holds the string denoting the problem edge name in mutations.
+	EdgeProblem = "problem"
+	// Table holds the table name of the attemptitem in the database.
+	Table = "attempt_items"
+	// AttemptTable is the table that holds the attempt relation/edge.
+	AttemptTable = "attempt_items"
+	// AttemptInverseTable is the table name for the Attempt entity.
+	// It exists in this package in order to avoid circular dependency with the "attempt" package.
+	AttemptInverseTable = "attempts"
+	// AttemptColumn is the table column denoting the attempt relation/edge.
+	AttemptColumn = "attempt_id"
+	// ProblemTable is the table that holds the problem relation/edge.
+	ProblemTable = "attempt_items"
+	// ProblemInverseTable is the table name for the Problem entity.
+	// It exists in this package in order to avoid circular dependency with the "problem" package.
+	ProblemInverseTable = "problems"
+	// ProblemColumn is the table column denoting the problem relation/edge.
+	ProblemColumn = "problem_id"
+)
+
+// Columns holds all SQL columns for attemptitem fields.
+var Columns = []string{
+	FieldID,
+	FieldChoiceOrder,
+	FieldAttemptID,
+	FieldProblemID,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// OrderOption defines the ordering options for the AttemptItem queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByAttemptID orders the results by the attempt_id field.
+func ByAttemptID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAttemptID, opts...).ToFunc()
+}
+
+// ByProblemID orders the results by the problem_id field.
+func ByProblemID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldProblemID, opts...).ToFunc()
+}
+
+// ByAttemptField orders the results by attempt field.
+func ByAttemptField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAttemptStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByProblemField orders the results by problem field.
+func ByProblemField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newProblemStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newAttemptStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(AttemptInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, AttemptTable, AttemptColumn),
+	)
+}
+func newProblemStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ProblemInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, ProblemTable, ProblemColumn),
+	)
+}
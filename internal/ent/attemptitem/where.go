@@ -0,0 +1,176 @@
+// Code generated by ent, DO NOT EDIT.
+
+package attemptitem
+
+import (
+	"examination/internal/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.AttemptItem {
+	return predicate.AttemptItem(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.AttemptItem {
+	return predicate.AttemptItem(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.AttemptItem {
+	return predicate.AttemptItem(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.AttemptItem {
+	return predicate.AttemptItem(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.AttemptItem {
+	return predicate.AttemptItem(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.AttemptItem {
+	return predicate.AttemptItem(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.AttemptItem {
+	return predicate.AttemptItem(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.AttemptItem {
+	return predicate.AttemptItem(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.AttemptItem {
+	return predicate.AttemptItem(sql.FieldLTE(FieldID, id))
+}
+
+// AttemptID applies equality check predicate on the "attempt_id" field. It's identical to AttemptIDEQ.
+func AttemptID(v int) predicate.AttemptItem {
+	return predicate.AttemptItem(sql.FieldEQ(FieldAttemptID, v))
+}
+
+// ProblemID applies equality check predicate on the "problem_id" field. It's identical to ProblemIDEQ.
+func ProblemID(v int) predicate.AttemptItem {
+	return predicate.AttemptItem(sql.FieldEQ(FieldProblemID, v))
+}
+
+// ChoiceOrderIsNil applies the IsNil predicate on the "choice_order" field.
+func ChoiceOrderIsNil() predicate.AttemptItem {
+	return predicate.AttemptItem(sql.FieldIsNull(FieldChoiceOrder))
+}
+
+// ChoiceOrderNotNil applies the NotNil predicate on the "choice_order" field.
+func ChoiceOrderNotNil() predicate.AttemptItem {
+	return predicate.AttemptItem(sql.FieldNotNull(FieldChoiceOrder))
+}
+
+// AttemptIDEQ applies the EQ predicate on the "attempt_id" field.
+func AttemptIDEQ(v int) predicate.AttemptItem {
+	return predicate.AttemptItem(sql.FieldEQ(FieldAttemptID, v))
+}
+
+// AttemptIDNEQ applies the NEQ predicate on the "attempt_id" field.
+func AttemptIDNEQ(v int) predicate.AttemptItem {
+	return predicate.AttemptItem(sql.FieldNEQ(FieldAttemptID, v))
+}
+
+// AttemptIDIn applies the In predicate on the "attempt_id" field.
+func AttemptIDIn(vs ...int) predicate.AttemptItem {
+	return predicate.AttemptItem(sql.FieldIn(FieldAttemptID, vs...))
+}
+
+// AttemptIDNotIn applies the NotIn predicate on the "attempt_id" field.
+func AttemptIDNotIn(vs ...int) predicate.AttemptItem {
+	return predicate.AttemptItem(sql.FieldNotIn(FieldAttemptID, vs...))
+}
+
+// ProblemIDEQ applies the EQ predicate on the "problem_id" field.
+func ProblemIDEQ(v int) predicate.AttemptItem {
+	return predicate.AttemptItem(sql.FieldEQ(FieldProblemID, v))
+}
+
+// ProblemIDNEQ applies the NEQ predicate on the "problem_id" field.
+func ProblemIDNEQ(v int) predicate.AttemptItem {
+	return predicate.AttemptItem(sql.FieldNEQ(FieldProblemID, v))
+}
+
+// ProblemIDIn applies the In predicate on the "problem_id" field.
+func ProblemIDIn(vs ...int) predicate.AttemptItem {
+	return predicate.AttemptItem(sql.FieldIn(FieldProblemID, vs...))
+}
+
+// ProblemIDNotIn applies the NotIn predicate on the "problem_id" field.
+func ProblemIDNotIn(vs ...int) predicate.AttemptItem {
+	return predicate.AttemptItem(sql.FieldNotIn(FieldProblemID, vs...))
+}
+
+// HasAttempt applies the HasEdge predicate on the "attempt" edge.
+func HasAttempt() predicate.AttemptItem {
+	return predicate.AttemptItem(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, AttemptTable, AttemptColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAttemptWith applies the HasEdge predicate on the "attempt" edge with a given conditions (other predicates).
+func HasAttemptWith(preds ...predicate.Attempt) predicate.AttemptItem {
+	return predicate.AttemptItem(func(s *sql.Selector) {
+		step := newAttemptStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasProblem applies the HasEdge predicate on the "problem" edge.
+func HasProblem() predicate.AttemptItem {
+	return predicate.AttemptItem(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ProblemTable, ProblemColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasProblemWith applies the HasEdge predicate on the "problem" edge with a given conditions (other predicates).
+func HasProblemWith(preds ...predicate.Problem) predicate.AttemptItem {
+	return predicate.AttemptItem(func(s *sql.Selector) {
+		step := newProblemStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.AttemptItem) predicate.AttemptItem {
+	return predicate.AttemptItem(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.AttemptItem) predicate.AttemptItem {
+	return predicate.AttemptItem(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.AttemptItem) predicate.AttemptItem {
+	return predicate.AttemptItem(sql.NotPredicates(p))
+}
@@ -0,0 +1,250 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"examination/internal/ent/attempt"
+	"examination/internal/ent/attemptitem"
+	"examination/internal/ent/problem"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// AttemptItemCreate is the builder for creating a AttemptItem entity.
+type AttemptItemCreate struct {
+	config
+	mutation *AttemptItemMutation
+	hooks    []Hook
+}
+
+// SetChoiceOrder sets the "choice_order" field.
+func (_c *AttemptItemCreate) SetChoiceOrder(v []int) *AttemptItemCreate {
+	_c.mutation.SetChoiceOrder(v)
+	return _c
+}
+
+// SetAttemptID sets the "attempt_id" field.
+func (_c *AttemptItemCreate) SetAttemptID(v int) *AttemptItemCreate {
+	_c.mutation.SetAttemptID(v)
+	return _c
+}
+
+// SetProblemID sets the "problem_id" field.
+func (_c *AttemptItemCreate) SetProblemID(v int) *AttemptItemCreate {
+	_c.mutation.SetProblemID(v)
+	return _c
+}
+
+// SetAttempt sets the "attempt" edge to the Attempt entity.
+func (_c *AttemptItemCreate) SetAttempt(v *Attempt) *AttemptItemCreate {
+	return _c.SetAttemptID(v.ID)
+}
+
+// SetProblem sets the "problem" edge to the Problem entity.
+func (_c *AttemptItemCreate) SetProblem(v *Problem) *AttemptItemCreate {
+	return _c.SetProblemID(v.ID)
+}
+
+// Mutation returns the AttemptItemMutation object of the builder.
+func (_c *AttemptItemCreate) Mutation() *AttemptItemMutation {
+	return _c.mutation
+}
+
+// Save creates the AttemptItem in the database.
+func (_c *AttemptItemCreate) Save(ctx context.Context) (*AttemptItem, error) {
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *AttemptItemCreate) SaveX(ctx context.Context) *AttemptItem {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AttemptItemCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AttemptItemCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *AttemptItemCreate) check() error {
+	if _, ok := _c.mutation.AttemptID(); !ok {
+		return &ValidationError{Name: "attempt_id", err: errors.New(`ent: missing required field "AttemptItem.attempt_id"`)}
+	}
+	if _, ok := _c.mutation.ProblemID(); !ok {
+		return &ValidationError{Name: "problem_id", err: errors.New(`ent: missing required field "AttemptItem.problem_id"`)}
+	}
+	if len(_c.mutation.AttemptIDs()) == 0 {
+		return &ValidationError{Name: "attempt", err: errors.New(`ent: missing required edge "AttemptItem.attempt"`)}
+	}
+	if len(_c.mutation.ProblemIDs()) == 0 {
+		return &ValidationError{Name: "problem", err: errors.New(`ent: missing required edge "AttemptItem.problem"`)}
+	}
+	return nil
+}
+
+func (_c *AttemptItemCreate) sqlSave(ctx context.Context) (*AttemptItem, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *AttemptItemCreate) createSpec() (*AttemptItem, *sqlgraph.CreateSpec) {
+	var (
+		_node = &AttemptItem{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(attemptitem.Table, sqlgraph.NewFieldSpec(attemptitem.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.ChoiceOrder(); ok {
+		_spec.SetField(attemptitem.FieldChoiceOrder, field.TypeJSON, value)
+		_node.ChoiceOrder = value
+	}
+	if nodes := _c.mutation.AttemptIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   attemptitem.AttemptTable,
+			Columns: []string{attemptitem.AttemptColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attempt.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.AttemptID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ProblemIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   attemptitem.ProblemTable,
+			Columns: []string{attemptitem.ProblemColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(problem.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ProblemID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// AttemptItemCreateBulk is the builder for creating many AttemptItem entities in bulk.
+type AttemptItemCreateBulk struct {
+	config
+	err      error
+	builders []*AttemptItemCreate
+}
+
+// Save creates the AttemptItem entities in the database.
+func (_c *AttemptItemCreateBulk) Save(ctx context.Context) ([]*AttemptItem, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*AttemptItem, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AttemptItemMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *AttemptItemCreateBulk) SaveX(ctx context.Context) []*AttemptItem {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AttemptItemCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AttemptItemCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,480 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"examination/internal/ent/attempt"
+	"examination/internal/ent/attemptitem"
+	"examination/internal/ent/predicate"
+	"examination/internal/ent/problem"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// AttemptItemUpdate is the builder for updating AttemptItem entities.
+type AttemptItemUpdate struct {
+	config
+	hooks    []Hook
+	mutation *AttemptItemMutation
+}
+
+// Where appends a list predicates to the AttemptItemUpdate builder.
+func (_u *AttemptItemUpdate) Where(ps ...predicate.AttemptItem) *AttemptItemUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetChoiceOrder sets the "choice_order" field.
+func (_u *AttemptItemUpdate) SetChoiceOrder(v []int) *AttemptItemUpdate {
+	_u.mutation.SetChoiceOrder(v)
+	return _u
+}
+
+// AppendChoiceOrder appends value to the "choice_order" field.
+func (_u *AttemptItemUpdate) AppendChoiceOrder(v []int) *AttemptItemUpdate {
+	_u.mutation.AppendChoiceOrder(v)
+	return _u
+}
+
+// ClearChoiceOrder clears the value of the "choice_order" field.
+func (_u *AttemptItemUpdate) ClearChoiceOrder() *AttemptItemUpdate {
+	_u.mutation.ClearChoiceOrder()
+	return _u
+}
+
+// SetAttemptID sets the "attempt_id" field.
+func (_u *AttemptItemUpdate) SetAttemptID(v int) *AttemptItemUpdate {
+	_u.mutation.SetAttemptID(v)
+	return _u
+}
+
+// SetNillableAttemptID sets the "attempt_id" field if the given value is not nil.
+func (_u *AttemptItemUpdate) SetNillableAttemptID(v *int) *AttemptItemUpdate {
+	if v != nil {
+		_u.SetAttemptID(*v)
+	}
+	return _u
+}
+
+// SetProblemID sets the "problem_id" field.
+func (_u *AttemptItemUpdate) SetProblemID(v int) *AttemptItemUpdate {
+	_u.mutation.SetProblemID(v)
+	return _u
+}
+
+// SetNillableProblemID sets the "problem_id" field if the given value is not nil.
+func (_u *AttemptItemUpdate) SetNillableProblemID(v *int) *AttemptItemUpdate {
+	if v != nil {
+		_u.SetProblemID(*v)
+	}
+	return _u
+}
+
+// SetAttempt sets the "attempt" edge to the Attempt entity.
+func (_u *AttemptItemUpdate) SetAttempt(v *Attempt) *AttemptItemUpdate {
+	return _u.SetAttemptID(v.ID)
+}
+
+// SetProblem sets the "problem" edge to the Problem entity.
+func (_u *AttemptItemUpdate) SetProblem(v *Problem) *AttemptItemUpdate {
+	return _u.SetProblemID(v.ID)
+}
+
+// Mutation returns the AttemptItemMutation object of the builder.
+func (_u *AttemptItemUpdate) Mutation() *AttemptItemMutation {
+	return _u.mutation
+}
+
+// ClearAttempt clears the "attempt" edge to the Attempt entity.
+func (_u *AttemptItemUpdate) ClearAttempt() *AttemptItemUpdate {
+	_u.mutation.ClearAttempt()
+	return _u
+}
+
+// ClearProblem clears the "problem" edge to the Problem entity.
+func (_u *AttemptItemUpdate) ClearProblem() *AttemptItemUpdate {
+	_u.mutation.ClearProblem()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *AttemptItemUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AttemptItemUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *AttemptItemUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AttemptItemUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *AttemptItemUpdate) check() error {
+	if _u.mutation.AttemptCleared() && len(_u.mutation.AttemptIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "AttemptItem.attempt"`)
+	}
+	if _u.mutation.ProblemCleared() && len(_u.mutation.ProblemIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "AttemptItem.problem"`)
+	}
+	return nil
+}
+
+func (_u *AttemptItemUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(attemptitem.Table, attemptitem.Columns, sqlgraph.NewFieldSpec(attemptitem.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.ChoiceOrder(); ok {
+		_spec.SetField(attemptitem.FieldChoiceOrder, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedChoiceOrder(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, attemptitem.FieldChoiceOrder, value)
+		})
+	}
+	if _u.mutation.ChoiceOrderCleared() {
+		_spec.ClearField(attemptitem.FieldChoiceOrder, field.TypeJSON)
+	}
+	if _u.mutation.AttemptCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   attemptitem.AttemptTable,
+			Columns: []string{attemptitem.AttemptColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attempt.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AttemptIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   attemptitem.AttemptTable,
+			Columns: []string{attemptitem.AttemptColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attempt.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ProblemCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   attemptitem.ProblemTable,
+			Columns: []string{attemptitem.ProblemColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(problem.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ProblemIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   attemptitem.ProblemTable,
+			Columns: []string{attemptitem.ProblemColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(problem.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{attemptitem.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// AttemptItemUpdateOne is the builder for updating a single AttemptItem entity.
+type AttemptItemUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *AttemptItemMutation
+}
+
+// SetChoiceOrder sets the "choice_order" field.
+func (_u *AttemptItemUpdateOne) SetChoiceOrder(v []int) *AttemptItemUpdateOne {
+	_u.mutation.SetChoiceOrder(v)
+	return _u
+}
+
+// AppendChoiceOrder appends value to the "choice_order" field.
+func (_u *AttemptItemUpdateOne) AppendChoiceOrder(v []int) *AttemptItemUpdateOne {
+	_u.mutation.AppendChoiceOrder(v)
+	return _u
+}
+
+// ClearChoiceOrder clears the value of the "choice_order" field.
+func (_u *AttemptItemUpdateOne) ClearChoiceOrder() *AttemptItemUpdateOne {
+	_u.mutation.ClearChoiceOrder()
+	return _u
+}
+
+// SetAttemptID sets the "attempt_id" field.
+func (_u *AttemptItemUpdateOne) SetAttemptID(v int) *AttemptItemUpdateOne {
+	_u.mutation.SetAttemptID(v)
+	return _u
+}
+
+// SetNillableAttemptID sets the "attempt_id" field if the given value is not nil.
+func (_u *AttemptItemUpdateOne) SetNillableAttemptID(v *int) *AttemptItemUpdateOne {
+	if v != nil {
+		_u.SetAttemptID(*v)
+	}
+	return _u
+}
+
+// SetProblemID sets the "problem_id" field.
+func (_u *AttemptItemUpdateOne) SetProblemID(v int) *AttemptItemUpdateOne {
+	_u.mutation.SetProblemID(v)
+	return _u
+}
+
+// SetNillableProblemID sets the "problem_id" field if the given value is not nil.
+func (_u *AttemptItemUpdateOne) SetNillableProblemID(v *int) *AttemptItemUpdateOne {
+	if v != nil {
+		_u.SetProblemID(*v)
+	}
+	return _u
+}
+
+// SetAttempt sets the "attempt" edge to the Attempt entity.
+func (_u *AttemptItemUpdateOne) SetAttempt(v *Attempt) *AttemptItemUpdateOne {
+	return _u.SetAttemptID(v.ID)
+}
+
+// SetProblem sets the "problem" edge to the Problem entity.
+func (_u *AttemptItemUpdateOne) SetProblem(v *Problem) *AttemptItemUpdateOne {
+	return _u.SetProblemID(v.ID)
+}
+
+// Mutation returns the AttemptItemMutation object of the builder.
+func (_u *AttemptItemUpdateOne) Mutation() *AttemptItemMutation {
+	return _u.mutation
+}
+
+// ClearAttempt clears the "attempt" edge to the Attempt entity.
+func (_u *AttemptItemUpdateOne) ClearAttempt() *AttemptItemUpdateOne {
+	_u.mutation.ClearAttempt()
+	return _u
+}
+
+// ClearProblem clears the "problem" edge to the Problem entity.
+func (_u *AttemptItemUpdateOne) ClearProblem() *AttemptItemUpdateOne {
+	_u.mutation.ClearProblem()
+	return _u
+}
+
+// Where appends a list predicates to the AttemptItemUpdate builder.
+func (_u *AttemptItemUpdateOne) Where(ps ...predicate.AttemptItem) *AttemptItemUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *AttemptItemUpdateOne) Select(field string, fields ...string) *AttemptItemUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated AttemptItem entity.
+func (_u *AttemptItemUpdateOne) Save(ctx context.Context) (*AttemptItem, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AttemptItemUpdateOne) SaveX(ctx context.Context) *AttemptItem {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *AttemptItemUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AttemptItemUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *AttemptItemUpdateOne) check() error {
+	if _u.mutation.AttemptCleared() && len(_u.mutation.AttemptIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "AttemptItem.attempt"`)
+	}
+	if _u.mutation.ProblemCleared() && len(_u.mutation.ProblemIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "AttemptItem.problem"`)
+	}
+	return nil
+}
+
+func (_u *AttemptItemUpdateOne) sqlSave(ctx context.Context) (_node *AttemptItem, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(attemptitem.Table, attemptitem.Columns, sqlgraph.NewFieldSpec(attemptitem.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "AttemptItem.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, attemptitem.FieldID)
+		for _, f := range fields {
+			if !attemptitem.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != attemptitem.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.ChoiceOrder(); ok {
+		_spec.SetField(attemptitem.FieldChoiceOrder, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedChoiceOrder(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, attemptitem.FieldChoiceOrder, value)
+		})
+	}
+	if _u.mutation.ChoiceOrderCleared() {
+		_spec.ClearField(attemptitem.FieldChoiceOrder, field.TypeJSON)
+	}
+	if _u.mutation.AttemptCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   attemptitem.AttemptTable,
+			Columns: []string{attemptitem.AttemptColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attempt.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AttemptIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   attemptitem.AttemptTable,
+			Columns: []string{attemptitem.AttemptColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attempt.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ProblemCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   attemptitem.ProblemTable,
+			Columns: []string{attemptitem.ProblemColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(problem.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ProblemIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   attemptitem.ProblemTable,
+			Columns: []string{attemptitem.ProblemColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(problem.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &AttemptItem{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{attemptitem.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
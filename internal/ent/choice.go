@@ -37,9 +37,11 @@ type Choice struct {
 type ChoiceEdges struct {
 	// ProblemTranslation holds the value of the problem_translation edge.
 	ProblemTranslation *ProblemTranslation `json:"problem_translation,omitempty"`
+	// Answers holds the value of the answers edge.
+	Answers []*Answer `json:"answers,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [1]bool
+	loadedTypes [2]bool
 }
 
 // ProblemTranslationOrErr returns the ProblemTranslation value or an error if the edge
@@ -53,6 +55,15 @@ func (e ChoiceEdges) ProblemTranslationOrErr() (*ProblemTranslation, error) {
 	return nil, &NotLoadedError{edge: "problem_translation"}
 }
 
+// AnswersOrErr returns the Answers value or an error if the edge
+// was not loaded in eager-loading.
+func (e ChoiceEdges) AnswersOrErr() ([]*Answer, error) {
+	if e.loadedTypes[1] {
+		return e.Answers, nil
+	}
+	return nil, &NotLoadedError{edge: "answers"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Choice) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -133,6 +144,11 @@ func (_m *Choice) QueryProblemTranslation() *ProblemTranslationQuery {
 	return NewChoiceClient(_m.config).QueryProblemTranslation(_m)
 }
 
+// QueryAnswers queries the "answers" edge of the Choice entity.
+func (_m *Choice) QueryAnswers() *AnswerQuery {
+	return NewChoiceClient(_m.config).QueryAnswers(_m)
+}
+
 // Update returns a builder for updating this Choice.
 // Note that you need to call Choice.Unwrap() before calling this method if this Choice
 // was returned from a transaction, and the transaction was committed or rolled back.
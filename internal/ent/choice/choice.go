@@ -24,6 +24,8 @@ const (
 	FieldProblemTranslationID = "problem_translation_id"
 	// EdgeProblemTranslation holds the string denoting the problem_translation edge name in mutations.
 	EdgeProblemTranslation = "problem_translation"
+	// EdgeAnswers holds the string denoting the answers edge name in mutations.
+	EdgeAnswers = "answers"
 	// Table holds the table name of the choice in the database.
 	Table = "choices"
 	// ProblemTranslationTable is the table that holds the problem_translation relation/edge.
@@ -33,6 +35,11 @@ const (
 	ProblemTranslationInverseTable = "problem_translations"
 	// ProblemTranslationColumn is the table column denoting the problem_translation relation/edge.
 	ProblemTranslationColumn = "problem_translation_id"
+	// AnswersTable is the table that holds the answers relation/edge. The primary key declared below.
+	AnswersTable = "answer_choices"
+	// AnswersInverseTable is the table name for the Answer entity.
+	// It exists in this package in order to avoid circular dependency with the "answer" package.
+	AnswersInverseTable = "answers"
 )
 
 // Columns holds all SQL columns for choice fields.
@@ -45,6 +52,12 @@ var Columns = []string{
 	FieldProblemTranslationID,
 }
 
+var (
+	// AnswersPrimaryKey and AnswersColumn2 are the table columns denoting the
+	// primary key for the answers relation (M2M).
+	AnswersPrimaryKey = []string{"answer_id", "choice_id"}
+)
+
 // ValidColumn reports if the column name is valid (part of the table columns).
 func ValidColumn(column string) bool {
 	for i := range Columns {
@@ -101,6 +114,20 @@ func ByProblemTranslationField(field string, opts ...sql.OrderTermOption) OrderO
 		sqlgraph.OrderByNeighborTerms(s, newProblemTranslationStep(), sql.OrderByField(field, opts...))
 	}
 }
+
+// ByAnswersCount orders the results by answers count.
+func ByAnswersCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newAnswersStep(), opts...)
+	}
+}
+
+// ByAnswers orders the results by answers terms.
+func ByAnswers(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAnswersStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newProblemTranslationStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -108,3 +135,10 @@ func newProblemTranslationStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.M2O, true, ProblemTranslationTable, ProblemTranslationColumn),
 	)
 }
+func newAnswersStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(AnswersInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2M, true, AnswersTable, AnswersPrimaryKey...),
+	)
+}
@@ -5,6 +5,7 @@ package ent
 import (
 	"context"
 	"errors"
+	"examination/internal/ent/answer"
 	"examination/internal/ent/choice"
 	"examination/internal/ent/problemtranslation"
 	"fmt"
@@ -71,6 +72,21 @@ func (_c *ChoiceCreate) SetProblemTranslation(v *ProblemTranslation) *ChoiceCrea
 	return _c.SetProblemTranslationID(v.ID)
 }
 
+// AddAnswerIDs adds the "answers" edge to the Answer entity by IDs.
+func (_c *ChoiceCreate) AddAnswerIDs(ids ...int) *ChoiceCreate {
+	_c.mutation.AddAnswerIDs(ids...)
+	return _c
+}
+
+// AddAnswers adds the "answers" edges to the Answer entity.
+func (_c *ChoiceCreate) AddAnswers(v ...*Answer) *ChoiceCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddAnswerIDs(ids...)
+}
+
 // Mutation returns the ChoiceMutation object of the builder.
 func (_c *ChoiceCreate) Mutation() *ChoiceMutation {
 	return _c.mutation
@@ -193,6 +209,22 @@ func (_c *ChoiceCreate) createSpec() (*Choice, *sqlgraph.CreateSpec) {
 		_node.ProblemTranslationID = nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.AnswersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   choice.AnswersTable,
+			Columns: choice.AnswersPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(answer.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
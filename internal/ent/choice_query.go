@@ -4,6 +4,8 @@ package ent
 
 import (
 	"context"
+	"database/sql/driver"
+	"examination/internal/ent/answer"
 	"examination/internal/ent/choice"
 	"examination/internal/ent/predicate"
 	"examination/internal/ent/problemtranslation"
@@ -24,6 +26,7 @@ type ChoiceQuery struct {
 	inters                 []Interceptor
 	predicates             []predicate.Choice
 	withProblemTranslation *ProblemTranslationQuery
+	withAnswers            *AnswerQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -82,6 +85,28 @@ func (_q *ChoiceQuery) QueryProblemTranslation() *ProblemTranslationQuery {
 	return query
 }
 
+// QueryAnswers chains the current query on the "answers" edge.
+func (_q *ChoiceQuery) QueryAnswers() *AnswerQuery {
+	query := (&AnswerClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(choice.Table, choice.FieldID, selector),
+			sqlgraph.To(answer.Table, answer.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, choice.AnswersTable, choice.AnswersPrimaryKey...),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Choice entity from the query.
 // Returns a *NotFoundError when no Choice was found.
 func (_q *ChoiceQuery) First(ctx context.Context) (*Choice, error) {
@@ -275,6 +300,7 @@ func (_q *ChoiceQuery) Clone() *ChoiceQuery {
 		inters:                 append([]Interceptor{}, _q.inters...),
 		predicates:             append([]predicate.Choice{}, _q.predicates...),
 		withProblemTranslation: _q.withProblemTranslation.Clone(),
+		withAnswers:            _q.withAnswers.Clone(),
 		// clone intermediate query.
 		sql:  _q.sql.Clone(),
 		path: _q.path,
@@ -292,6 +318,17 @@ func (_q *ChoiceQuery) WithProblemTranslation(opts ...func(*ProblemTranslationQu
 	return _q
 }
 
+// WithAnswers tells the query-builder to eager-load the nodes that are connected to
+// the "answers" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *ChoiceQuery) WithAnswers(opts ...func(*AnswerQuery)) *ChoiceQuery {
+	query := (&AnswerClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withAnswers = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -370,8 +407,9 @@ func (_q *ChoiceQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Choic
 	var (
 		nodes       = []*Choice{}
 		_spec       = _q.querySpec()
-		loadedTypes = [1]bool{
+		loadedTypes = [2]bool{
 			_q.withProblemTranslation != nil,
+			_q.withAnswers != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -398,6 +436,13 @@ func (_q *ChoiceQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Choic
 			return nil, err
 		}
 	}
+	if query := _q.withAnswers; query != nil {
+		if err := _q.loadAnswers(ctx, query, nodes,
+			func(n *Choice) { n.Edges.Answers = []*Answer{} },
+			func(n *Choice, e *Answer) { n.Edges.Answers = append(n.Edges.Answers, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -430,6 +475,67 @@ func (_q *ChoiceQuery) loadProblemTranslation(ctx context.Context, query *Proble
 	}
 	return nil
 }
+func (_q *ChoiceQuery) loadAnswers(ctx context.Context, query *AnswerQuery, nodes []*Choice, init func(*Choice), assign func(*Choice, *Answer)) error {
+	edgeIDs := make([]driver.Value, len(nodes))
+	byID := make(map[int]*Choice)
+	nids := make(map[int]map[*Choice]struct{})
+	for i, node := range nodes {
+		edgeIDs[i] = node.ID
+		byID[node.ID] = node
+		if init != nil {
+			init(node)
+		}
+	}
+	query.Where(func(s *sql.Selector) {
+		joinT := sql.Table(choice.AnswersTable)
+		s.Join(joinT).On(s.C(answer.FieldID), joinT.C(choice.AnswersPrimaryKey[0]))
+		s.Where(sql.InValues(joinT.C(choice.AnswersPrimaryKey[1]), edgeIDs...))
+		columns := s.SelectedColumns()
+		s.Select(joinT.C(choice.AnswersPrimaryKey[1]))
+		s.AppendSelect(columns...)
+		s.SetDistinct(false)
+	})
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		return query.sqlAll(ctx, func(_ context.Context, spec *sqlgraph.QuerySpec) {
+			assign := spec.Assign
+			values := spec.ScanValues
+			spec.ScanValues = func(columns []string) ([]any, error) {
+				values, err := values(columns[1:])
+				if err != nil {
+					return nil, err
+				}
+				return append([]any{new(sql.NullInt64)}, values...), nil
+			}
+			spec.Assign = func(columns []string, values []any) error {
+				outValue := int(values[0].(*sql.NullInt64).Int64)
+				inValue := int(values[1].(*sql.NullInt64).Int64)
+				if nids[inValue] == nil {
+					nids[inValue] = map[*Choice]struct{}{byID[outValue]: {}}
+					return assign(columns[1:], values[1:])
+				}
+				nids[inValue][byID[outValue]] = struct{}{}
+				return nil
+			}
+		})
+	})
+	neighbors, err := withInterceptors[[]*Answer](ctx, query, qr, query.inters)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected "answers" node returned %v`, n.ID)
+		}
+		for kn := range nodes {
+			assign(kn, n)
+		}
+	}
+	return nil
+}
 
 func (_q *ChoiceQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
@@ -5,6 +5,7 @@ package ent
 import (
 	"context"
 	"errors"
+	"examination/internal/ent/answer"
 	"examination/internal/ent/choice"
 	"examination/internal/ent/predicate"
 	"examination/internal/ent/problemtranslation"
@@ -116,6 +117,21 @@ func (_u *ChoiceUpdate) SetProblemTranslation(v *ProblemTranslation) *ChoiceUpda
 	return _u.SetProblemTranslationID(v.ID)
 }
 
+// AddAnswerIDs adds the "answers" edge to the Answer entity by IDs.
+func (_u *ChoiceUpdate) AddAnswerIDs(ids ...int) *ChoiceUpdate {
+	_u.mutation.AddAnswerIDs(ids...)
+	return _u
+}
+
+// AddAnswers adds the "answers" edges to the Answer entity.
+func (_u *ChoiceUpdate) AddAnswers(v ...*Answer) *ChoiceUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddAnswerIDs(ids...)
+}
+
 // Mutation returns the ChoiceMutation object of the builder.
 func (_u *ChoiceUpdate) Mutation() *ChoiceMutation {
 	return _u.mutation
@@ -127,6 +143,27 @@ func (_u *ChoiceUpdate) ClearProblemTranslation() *ChoiceUpdate {
 	return _u
 }
 
+// ClearAnswers clears all "answers" edges to the Answer entity.
+func (_u *ChoiceUpdate) ClearAnswers() *ChoiceUpdate {
+	_u.mutation.ClearAnswers()
+	return _u
+}
+
+// RemoveAnswerIDs removes the "answers" edge to Answer entities by IDs.
+func (_u *ChoiceUpdate) RemoveAnswerIDs(ids ...int) *ChoiceUpdate {
+	_u.mutation.RemoveAnswerIDs(ids...)
+	return _u
+}
+
+// RemoveAnswers removes "answers" edges to Answer entities.
+func (_u *ChoiceUpdate) RemoveAnswers(v ...*Answer) *ChoiceUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveAnswerIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *ChoiceUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
@@ -226,6 +263,51 @@ func (_u *ChoiceUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.AnswersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   choice.AnswersTable,
+			Columns: choice.AnswersPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(answer.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedAnswersIDs(); len(nodes) > 0 && !_u.mutation.AnswersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   choice.AnswersTable,
+			Columns: choice.AnswersPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(answer.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AnswersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   choice.AnswersTable,
+			Columns: choice.AnswersPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(answer.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{choice.Label}
@@ -334,6 +416,21 @@ func (_u *ChoiceUpdateOne) SetProblemTranslation(v *ProblemTranslation) *ChoiceU
 	return _u.SetProblemTranslationID(v.ID)
 }
 
+// AddAnswerIDs adds the "answers" edge to the Answer entity by IDs.
+func (_u *ChoiceUpdateOne) AddAnswerIDs(ids ...int) *ChoiceUpdateOne {
+	_u.mutation.AddAnswerIDs(ids...)
+	return _u
+}
+
+// AddAnswers adds the "answers" edges to the Answer entity.
+func (_u *ChoiceUpdateOne) AddAnswers(v ...*Answer) *ChoiceUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddAnswerIDs(ids...)
+}
+
 // Mutation returns the ChoiceMutation object of the builder.
 func (_u *ChoiceUpdateOne) Mutation() *ChoiceMutation {
 	return _u.mutation
@@ -345,6 +442,27 @@ func (_u *ChoiceUpdateOne) ClearProblemTranslation() *ChoiceUpdateOne {
 	return _u
 }
 
+// ClearAnswers clears all "answers" edges to the Answer entity.
+func (_u *ChoiceUpdateOne) ClearAnswers() *ChoiceUpdateOne {
+	_u.mutation.ClearAnswers()
+	return _u
+}
+
+// RemoveAnswerIDs removes the "answers" edge to Answer entities by IDs.
+func (_u *ChoiceUpdateOne) RemoveAnswerIDs(ids ...int) *ChoiceUpdateOne {
+	_u.mutation.RemoveAnswerIDs(ids...)
+	return _u
+}
+
+// RemoveAnswers removes "answers" edges to Answer entities.
+func (_u *ChoiceUpdateOne) RemoveAnswers(v ...*Answer) *ChoiceUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveAnswerIDs(ids...)
+}
+
 // Where appends a list predicates to the ChoiceUpdate builder.
 func (_u *ChoiceUpdateOne) Where(ps ...predicate.Choice) *ChoiceUpdateOne {
 	_u.mutation.Where(ps...)
@@ -474,6 +592,51 @@ func (_u *ChoiceUpdateOne) sqlSave(ctx context.Context) (_node *Choice, err erro
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.AnswersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   choice.AnswersTable,
+			Columns: choice.AnswersPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(answer.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedAnswersIDs(); len(nodes) > 0 && !_u.mutation.AnswersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   choice.AnswersTable,
+			Columns: choice.AnswersPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(answer.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AnswersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   choice.AnswersTable,
+			Columns: choice.AnswersPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(answer.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Choice{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
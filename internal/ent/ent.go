@@ -5,13 +5,20 @@ package ent
 import (
 	"context"
 	"errors"
+	"examination/internal/ent/answer"
+	"examination/internal/ent/asset"
+	"examination/internal/ent/attempt"
+	"examination/internal/ent/attemptitem"
 	"examination/internal/ent/choice"
 	"examination/internal/ent/exam"
 	"examination/internal/ent/problem"
 	"examination/internal/ent/problemtranslation"
+	"examination/internal/ent/role"
 	"examination/internal/ent/section"
+	"examination/internal/ent/session"
 	"examination/internal/ent/topic"
 	"examination/internal/ent/unit"
+	"examination/internal/ent/user"
 	"examination/internal/ent/versionrule"
 	"fmt"
 	"reflect"
@@ -80,13 +87,20 @@ var (
 func checkColumn(t, c string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
+			answer.Table:             answer.ValidColumn,
+			asset.Table:              asset.ValidColumn,
+			attempt.Table:            attempt.ValidColumn,
+			attemptitem.Table:        attemptitem.ValidColumn,
 			choice.Table:             choice.ValidColumn,
 			exam.Table:               exam.ValidColumn,
 			problem.Table:            problem.ValidColumn,
 			problemtranslation.Table: problemtranslation.ValidColumn,
+			role.Table:               role.ValidColumn,
 			section.Table:            section.ValidColumn,
+			session.Table:            session.ValidColumn,
 			topic.Table:              topic.ValidColumn,
 			unit.Table:               unit.ValidColumn,
+			user.Table:               user.ValidColumn,
 			versionrule.Table:        versionrule.ValidColumn,
 		})
 	})
@@ -16,6 +16,8 @@ type Exam struct {
 	config `json:"-"`
 	// ID of the ent.
 	ID int `json:"id,omitempty"`
+	// Stable external key used by content bundles
+	Key string `json:"key,omitempty"`
 	// Title holds the value of the "title" field.
 	Title string `json:"title,omitempty"`
 	// Description holds the value of the "description" field.
@@ -24,6 +26,14 @@ type Exam struct {
 	TimeLimit int `json:"time_limit,omitempty"`
 	// IsActive holds the value of the "is_active" field.
 	IsActive bool `json:"is_active,omitempty"`
+	// Shuffle choice order per attempt
+	ShuffleChoices bool `json:"shuffle_choices,omitempty"`
+	// Weight each problem by its difficulty when scoring
+	WeightByDifficulty bool `json:"weight_by_difficulty,omitempty"`
+	// Award partial credit on problems with several parts
+	PartialCredit bool `json:"partial_credit,omitempty"`
+	// Let wrong answers cost points
+	NegativeMarking bool `json:"negative_marking,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the ExamQuery when eager-loading is set.
 	Edges        ExamEdges `json:"edges"`
@@ -40,9 +50,11 @@ type ExamEdges struct {
 	Units []*Unit `json:"units,omitempty"`
 	// VersionRules holds the value of the version_rules edge.
 	VersionRules []*VersionRule `json:"version_rules,omitempty"`
+	// Attempts holds the value of the attempts edge.
+	Attempts []*Attempt `json:"attempts,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [4]bool
+	loadedTypes [5]bool
 }
 
 // SectionsOrErr returns the Sections value or an error if the edge
@@ -81,16 +93,25 @@ func (e ExamEdges) VersionRulesOrErr() ([]*VersionRule, error) {
 	return nil, &NotLoadedError{edge: "version_rules"}
 }
 
+// AttemptsOrErr returns the Attempts value or an error if the edge
+// was not loaded in eager-loading.
+func (e ExamEdges) AttemptsOrErr() ([]*Attempt, error) {
+	if e.loadedTypes[4] {
+		return e.Attempts, nil
+	}
+	return nil, &NotLoadedError{edge: "attempts"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Exam) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case exam.FieldIsActive:
+		case exam.FieldIsActive, exam.FieldShuffleChoices, exam.FieldWeightByDifficulty, exam.FieldPartialCredit, exam.FieldNegativeMarking:
 			values[i] = new(sql.NullBool)
 		case exam.FieldID, exam.FieldTimeLimit:
 			values[i] = new(sql.NullInt64)
-		case exam.FieldTitle, exam.FieldDescription:
+		case exam.FieldKey, exam.FieldTitle, exam.FieldDescription:
 			values[i] = new(sql.NullString)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -113,6 +134,12 @@ func (_m *Exam) assignValues(columns []string, values []any) error {
 				return fmt.Errorf("unexpected type %T for field id", value)
 			}
 			_m.ID = int(value.Int64)
+		case exam.FieldKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field key", values[i])
+			} else if value.Valid {
+				_m.Key = value.String
+			}
 		case exam.FieldTitle:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field title", values[i])
@@ -137,6 +164,30 @@ func (_m *Exam) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.IsActive = value.Bool
 			}
+		case exam.FieldShuffleChoices:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field shuffle_choices", values[i])
+			} else if value.Valid {
+				_m.ShuffleChoices = value.Bool
+			}
+		case exam.FieldWeightByDifficulty:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field weight_by_difficulty", values[i])
+			} else if value.Valid {
+				_m.WeightByDifficulty = value.Bool
+			}
+		case exam.FieldPartialCredit:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field partial_credit", values[i])
+			} else if value.Valid {
+				_m.PartialCredit = value.Bool
+			}
+		case exam.FieldNegativeMarking:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field negative_marking", values[i])
+			} else if value.Valid {
+				_m.NegativeMarking = value.Bool
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -170,6 +221,11 @@ func (_m *Exam) QueryVersionRules() *VersionRuleQuery {
 	return NewExamClient(_m.config).QueryVersionRules(_m)
 }
 
+// QueryAttempts queries the "attempts" edge of the Exam entity.
+func (_m *Exam) QueryAttempts() *AttemptQuery {
+	return NewExamClient(_m.config).QueryAttempts(_m)
+}
+
 // Update returns a builder for updating this Exam.
 // Note that you need to call Exam.Unwrap() before calling this method if this Exam
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -193,6 +249,9 @@ func (_m *Exam) String() string {
 	var builder strings.Builder
 	builder.WriteString("Exam(")
 	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("key=")
+	builder.WriteString(_m.Key)
+	builder.WriteString(", ")
 	builder.WriteString("title=")
 	builder.WriteString(_m.Title)
 	builder.WriteString(", ")
@@ -204,6 +263,18 @@ func (_m *Exam) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("is_active=")
 	builder.WriteString(fmt.Sprintf("%v", _m.IsActive))
+	builder.WriteString(", ")
+	builder.WriteString("shuffle_choices=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ShuffleChoices))
+	builder.WriteString(", ")
+	builder.WriteString("weight_by_difficulty=")
+	builder.WriteString(fmt.Sprintf("%v", _m.WeightByDifficulty))
+	builder.WriteString(", ")
+	builder.WriteString("partial_credit=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PartialCredit))
+	builder.WriteString(", ")
+	builder.WriteString("negative_marking=")
+	builder.WriteString(fmt.Sprintf("%v", _m.NegativeMarking))
 	builder.WriteByte(')')
 	return builder.String()
 }
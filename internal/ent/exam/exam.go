@@ -12,6 +12,8 @@ const (
 	Label = "exam"
 	// FieldID holds the string denoting the id field in the database.
 	FieldID = "id"
+	// FieldKey holds the string denoting the key field in the database.
+	FieldKey = "key"
 	// FieldTitle holds the string denoting the title field in the database.
 	FieldTitle = "title"
 	// FieldDescription holds the string denoting the description field in the database.
@@ -20,6 +22,14 @@ const (
 	FieldTimeLimit = "time_limit"
 	// FieldIsActive holds the string denoting the is_active field in the database.
 	FieldIsActive = "is_active"
+	// FieldShuffleChoices holds the string denoting the shuffle_choices field in the database.
+	FieldShuffleChoices = "shuffle_choices"
+	// FieldWeightByDifficulty holds the string denoting the weight_by_difficulty field in the database.
+	FieldWeightByDifficulty = "weight_by_difficulty"
+	// FieldPartialCredit holds the string denoting the partial_credit field in the database.
+	FieldPartialCredit = "partial_credit"
+	// FieldNegativeMarking holds the string denoting the negative_marking field in the database.
+	FieldNegativeMarking = "negative_marking"
 	// EdgeSections holds the string denoting the sections edge name in mutations.
 	EdgeSections = "sections"
 	// EdgeTopics holds the string denoting the topics edge name in mutations.
@@ -28,6 +38,8 @@ const (
 	EdgeUnits = "units"
 	// EdgeVersionRules holds the string denoting the version_rules edge name in mutations.
 	EdgeVersionRules = "version_rules"
+	// EdgeAttempts holds the string denoting the attempts edge name in mutations.
+	EdgeAttempts = "attempts"
 	// Table holds the table name of the exam in the database.
 	Table = "exams"
 	// SectionsTable is the table that holds the sections relation/edge.
@@ -58,15 +70,27 @@ const (
 	VersionRulesInverseTable = "version_rules"
 	// VersionRulesColumn is the table column denoting the version_rules relation/edge.
 	VersionRulesColumn = "exam_id"
+	// AttemptsTable is the table that holds the attempts relation/edge.
+	AttemptsTable = "attempts"
+	// AttemptsInverseTable is the table name for the Attempt entity.
+	// It exists in this package in order to avoid circular dependency with the "attempt" package.
+	AttemptsInverseTable = "attempts"
+	// AttemptsColumn is the table column denoting the attempts relation/edge.
+	AttemptsColumn = "exam_id"
 )
 
 // Columns holds all SQL columns for exam fields.
 var Columns = []string{
 	FieldID,
+	FieldKey,
 	FieldTitle,
 	FieldDescription,
 	FieldTimeLimit,
 	FieldIsActive,
+	FieldShuffleChoices,
+	FieldWeightByDifficulty,
+	FieldPartialCredit,
+	FieldNegativeMarking,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -84,6 +108,14 @@ var (
 	TitleValidator func(string) error
 	// DefaultIsActive holds the default value on creation for the "is_active" field.
 	DefaultIsActive bool
+	// DefaultShuffleChoices holds the default value on creation for the "shuffle_choices" field.
+	DefaultShuffleChoices bool
+	// DefaultWeightByDifficulty holds the default value on creation for the "weight_by_difficulty" field.
+	DefaultWeightByDifficulty bool
+	// DefaultPartialCredit holds the default value on creation for the "partial_credit" field.
+	DefaultPartialCredit bool
+	// DefaultNegativeMarking holds the default value on creation for the "negative_marking" field.
+	DefaultNegativeMarking bool
 )
 
 // OrderOption defines the ordering options for the Exam queries.
@@ -94,6 +126,11 @@ func ByID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldID, opts...).ToFunc()
 }
 
+// ByKey orders the results by the key field.
+func ByKey(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldKey, opts...).ToFunc()
+}
+
 // ByTitle orders the results by the title field.
 func ByTitle(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldTitle, opts...).ToFunc()
@@ -114,6 +151,26 @@ func ByIsActive(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldIsActive, opts...).ToFunc()
 }
 
+// ByShuffleChoices orders the results by the shuffle_choices field.
+func ByShuffleChoices(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldShuffleChoices, opts...).ToFunc()
+}
+
+// ByWeightByDifficulty orders the results by the weight_by_difficulty field.
+func ByWeightByDifficulty(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldWeightByDifficulty, opts...).ToFunc()
+}
+
+// ByPartialCredit orders the results by the partial_credit field.
+func ByPartialCredit(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPartialCredit, opts...).ToFunc()
+}
+
+// ByNegativeMarking orders the results by the negative_marking field.
+func ByNegativeMarking(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNegativeMarking, opts...).ToFunc()
+}
+
 // BySectionsCount orders the results by sections count.
 func BySectionsCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -169,6 +226,20 @@ func ByVersionRules(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newVersionRulesStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByAttemptsCount orders the results by attempts count.
+func ByAttemptsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newAttemptsStep(), opts...)
+	}
+}
+
+// ByAttempts orders the results by attempts terms.
+func ByAttempts(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAttemptsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newSectionsStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -197,3 +268,10 @@ func newVersionRulesStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, VersionRulesTable, VersionRulesColumn),
 	)
 }
+func newAttemptsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(AttemptsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, AttemptsTable, AttemptsColumn),
+	)
+}
@@ -54,6 +54,11 @@ func IDLTE(id int) predicate.Exam {
 	return predicate.Exam(sql.FieldLTE(FieldID, id))
 }
 
+// Key applies equality check predicate on the "key" field. It's identical to KeyEQ.
+func Key(v string) predicate.Exam {
+	return predicate.Exam(sql.FieldEQ(FieldKey, v))
+}
+
 // Title applies equality check predicate on the "title" field. It's identical to TitleEQ.
 func Title(v string) predicate.Exam {
 	return predicate.Exam(sql.FieldEQ(FieldTitle, v))
@@ -74,6 +79,101 @@ func IsActive(v bool) predicate.Exam {
 	return predicate.Exam(sql.FieldEQ(FieldIsActive, v))
 }
 
+// ShuffleChoices applies equality check predicate on the "shuffle_choices" field. It's identical to ShuffleChoicesEQ.
+func ShuffleChoices(v bool) predicate.Exam {
+	return predicate.Exam(sql.FieldEQ(FieldShuffleChoices, v))
+}
+
+// WeightByDifficulty applies equality check predicate on the "weight_by_difficulty" field. It's identical to WeightByDifficultyEQ.
+func WeightByDifficulty(v bool) predicate.Exam {
+	return predicate.Exam(sql.FieldEQ(FieldWeightByDifficulty, v))
+}
+
+// PartialCredit applies equality check predicate on the "partial_credit" field. It's identical to PartialCreditEQ.
+func PartialCredit(v bool) predicate.Exam {
+	return predicate.Exam(sql.FieldEQ(FieldPartialCredit, v))
+}
+
+// NegativeMarking applies equality check predicate on the "negative_marking" field. It's identical to NegativeMarkingEQ.
+func NegativeMarking(v bool) predicate.Exam {
+	return predicate.Exam(sql.FieldEQ(FieldNegativeMarking, v))
+}
+
+// KeyEQ applies the EQ predicate on the "key" field.
+func KeyEQ(v string) predicate.Exam {
+	return predicate.Exam(sql.FieldEQ(FieldKey, v))
+}
+
+// KeyNEQ applies the NEQ predicate on the "key" field.
+func KeyNEQ(v string) predicate.Exam {
+	return predicate.Exam(sql.FieldNEQ(FieldKey, v))
+}
+
+// KeyIn applies the In predicate on the "key" field.
+func KeyIn(vs ...string) predicate.Exam {
+	return predicate.Exam(sql.FieldIn(FieldKey, vs...))
+}
+
+// KeyNotIn applies the NotIn predicate on the "key" field.
+func KeyNotIn(vs ...string) predicate.Exam {
+	return predicate.Exam(sql.FieldNotIn(FieldKey, vs...))
+}
+
+// KeyGT applies the GT predicate on the "key" field.
+func KeyGT(v string) predicate.Exam {
+	return predicate.Exam(sql.FieldGT(FieldKey, v))
+}
+
+// KeyGTE applies the GTE predicate on the "key" field.
+func KeyGTE(v string) predicate.Exam {
+	return predicate.Exam(sql.FieldGTE(FieldKey, v))
+}
+
+// KeyLT applies the LT predicate on the "key" field.
+func KeyLT(v string) predicate.Exam {
+	return predicate.Exam(sql.FieldLT(FieldKey, v))
+}
+
+// KeyLTE applies the LTE predicate on the "key" field.
+func KeyLTE(v string) predicate.Exam {
+	return predicate.Exam(sql.FieldLTE(FieldKey, v))
+}
+
+// KeyContains applies the Contains predicate on the "key" field.
+func KeyContains(v string) predicate.Exam {
+	return predicate.Exam(sql.FieldContains(FieldKey, v))
+}
+
+// KeyHasPrefix applies the HasPrefix predicate on the "key" field.
+func KeyHasPrefix(v string) predicate.Exam {
+	return predicate.Exam(sql.FieldHasPrefix(FieldKey, v))
+}
+
+// KeyHasSuffix applies the HasSuffix predicate on the "key" field.
+func KeyHasSuffix(v string) predicate.Exam {
+	return predicate.Exam(sql.FieldHasSuffix(FieldKey, v))
+}
+
+// KeyIsNil applies the IsNil predicate on the "key" field.
+func KeyIsNil() predicate.Exam {
+	return predicate.Exam(sql.FieldIsNull(FieldKey))
+}
+
+// KeyNotNil applies the NotNil predicate on the "key" field.
+func KeyNotNil() predicate.Exam {
+	return predicate.Exam(sql.FieldNotNull(FieldKey))
+}
+
+// KeyEqualFold applies the EqualFold predicate on the "key" field.
+func KeyEqualFold(v string) predicate.Exam {
+	return predicate.Exam(sql.FieldEqualFold(FieldKey, v))
+}
+
+// KeyContainsFold applies the ContainsFold predicate on the "key" field.
+func KeyContainsFold(v string) predicate.Exam {
+	return predicate.Exam(sql.FieldContainsFold(FieldKey, v))
+}
+
 // TitleEQ applies the EQ predicate on the "title" field.
 func TitleEQ(v string) predicate.Exam {
 	return predicate.Exam(sql.FieldEQ(FieldTitle, v))
@@ -264,6 +364,46 @@ func IsActiveNEQ(v bool) predicate.Exam {
 	return predicate.Exam(sql.FieldNEQ(FieldIsActive, v))
 }
 
+// ShuffleChoicesEQ applies the EQ predicate on the "shuffle_choices" field.
+func ShuffleChoicesEQ(v bool) predicate.Exam {
+	return predicate.Exam(sql.FieldEQ(FieldShuffleChoices, v))
+}
+
+// ShuffleChoicesNEQ applies the NEQ predicate on the "shuffle_choices" field.
+func ShuffleChoicesNEQ(v bool) predicate.Exam {
+	return predicate.Exam(sql.FieldNEQ(FieldShuffleChoices, v))
+}
+
+// WeightByDifficultyEQ applies the EQ predicate on the "weight_by_difficulty" field.
+func WeightByDifficultyEQ(v bool) predicate.Exam {
+	return predicate.Exam(sql.FieldEQ(FieldWeightByDifficulty, v))
+}
+
+// WeightByDifficultyNEQ applies the NEQ predicate on the "weight_by_difficulty" field.
+func WeightByDifficultyNEQ(v bool) predicate.Exam {
+	return predicate.Exam(sql.FieldNEQ(FieldWeightByDifficulty, v))
+}
+
+// PartialCreditEQ applies the EQ predicate on the "partial_credit" field.
+func PartialCreditEQ(v bool) predicate.Exam {
+	return predicate.Exam(sql.FieldEQ(FieldPartialCredit, v))
+}
+
+// PartialCreditNEQ applies the NEQ predicate on the "partial_credit" field.
+func PartialCreditNEQ(v bool) predicate.Exam {
+	return predicate.Exam(sql.FieldNEQ(FieldPartialCredit, v))
+}
+
+// NegativeMarkingEQ applies the EQ predicate on the "negative_marking" field.
+func NegativeMarkingEQ(v bool) predicate.Exam {
+	return predicate.Exam(sql.FieldEQ(FieldNegativeMarking, v))
+}
+
+// NegativeMarkingNEQ applies the NEQ predicate on the "negative_marking" field.
+func NegativeMarkingNEQ(v bool) predicate.Exam {
+	return predicate.Exam(sql.FieldNEQ(FieldNegativeMarking, v))
+}
+
 // HasSections applies the HasEdge predicate on the "sections" edge.
 func HasSections() predicate.Exam {
 	return predicate.Exam(func(s *sql.Selector) {
@@ -356,6 +496,29 @@ func HasVersionRulesWith(preds ...predicate.VersionRule) predicate.Exam {
 	})
 }
 
+// HasAttempts applies the HasEdge predicate on the "attempts" edge.
+func HasAttempts() predicate.Exam {
+	return predicate.Exam(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, AttemptsTable, AttemptsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAttemptsWith applies the HasEdge predicate on the "attempts" edge with a given conditions (other predicates).
+func HasAttemptsWith(preds ...predicate.Attempt) predicate.Exam {
+	return predicate.Exam(func(s *sql.Selector) {
+		step := newAttemptsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Exam) predicate.Exam {
 	return predicate.Exam(sql.AndPredicates(predicates...))
@@ -5,6 +5,7 @@ package ent
 import (
 	"context"
 	"errors"
+	"examination/internal/ent/attempt"
 	"examination/internal/ent/exam"
 	"examination/internal/ent/section"
 	"examination/internal/ent/topic"
@@ -23,6 +24,20 @@ type ExamCreate struct {
 	hooks    []Hook
 }
 
+// SetKey sets the "key" field.
+func (_c *ExamCreate) SetKey(v string) *ExamCreate {
+	_c.mutation.SetKey(v)
+	return _c
+}
+
+// SetNillableKey sets the "key" field if the given value is not nil.
+func (_c *ExamCreate) SetNillableKey(v *string) *ExamCreate {
+	if v != nil {
+		_c.SetKey(*v)
+	}
+	return _c
+}
+
 // SetTitle sets the "title" field.
 func (_c *ExamCreate) SetTitle(v string) *ExamCreate {
 	_c.mutation.SetTitle(v)
@@ -63,6 +78,62 @@ func (_c *ExamCreate) SetNillableIsActive(v *bool) *ExamCreate {
 	return _c
 }
 
+// SetShuffleChoices sets the "shuffle_choices" field.
+func (_c *ExamCreate) SetShuffleChoices(v bool) *ExamCreate {
+	_c.mutation.SetShuffleChoices(v)
+	return _c
+}
+
+// SetNillableShuffleChoices sets the "shuffle_choices" field if the given value is not nil.
+func (_c *ExamCreate) SetNillableShuffleChoices(v *bool) *ExamCreate {
+	if v != nil {
+		_c.SetShuffleChoices(*v)
+	}
+	return _c
+}
+
+// SetWeightByDifficulty sets the "weight_by_difficulty" field.
+func (_c *ExamCreate) SetWeightByDifficulty(v bool) *ExamCreate {
+	_c.mutation.SetWeightByDifficulty(v)
+	return _c
+}
+
+// SetNillableWeightByDifficulty sets the "weight_by_difficulty" field if the given value is not nil.
+func (_c *ExamCreate) SetNillableWeightByDifficulty(v *bool) *ExamCreate {
+	if v != nil {
+		_c.SetWeightByDifficulty(*v)
+	}
+	return _c
+}
+
+// SetPartialCredit sets the "partial_credit" field.
+func (_c *ExamCreate) SetPartialCredit(v bool) *ExamCreate {
+	_c.mutation.SetPartialCredit(v)
+	return _c
+}
+
+// SetNillablePartialCredit sets the "partial_credit" field if the given value is not nil.
+func (_c *ExamCreate) SetNillablePartialCredit(v *bool) *ExamCreate {
+	if v != nil {
+		_c.SetPartialCredit(*v)
+	}
+	return _c
+}
+
+// SetNegativeMarking sets the "negative_marking" field.
+func (_c *ExamCreate) SetNegativeMarking(v bool) *ExamCreate {
+	_c.mutation.SetNegativeMarking(v)
+	return _c
+}
+
+// SetNillableNegativeMarking sets the "negative_marking" field if the given value is not nil.
+func (_c *ExamCreate) SetNillableNegativeMarking(v *bool) *ExamCreate {
+	if v != nil {
+		_c.SetNegativeMarking(*v)
+	}
+	return _c
+}
+
 // AddSectionIDs adds the "sections" edge to the Section entity by IDs.
 func (_c *ExamCreate) AddSectionIDs(ids ...int) *ExamCreate {
 	_c.mutation.AddSectionIDs(ids...)
@@ -123,6 +194,21 @@ func (_c *ExamCreate) AddVersionRules(v ...*VersionRule) *ExamCreate {
 	return _c.AddVersionRuleIDs(ids...)
 }
 
+// AddAttemptIDs adds the "attempts" edge to the Attempt entity by IDs.
+func (_c *ExamCreate) AddAttemptIDs(ids ...int) *ExamCreate {
+	_c.mutation.AddAttemptIDs(ids...)
+	return _c
+}
+
+// AddAttempts adds the "attempts" edges to the Attempt entity.
+func (_c *ExamCreate) AddAttempts(v ...*Attempt) *ExamCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddAttemptIDs(ids...)
+}
+
 // Mutation returns the ExamMutation object of the builder.
 func (_c *ExamCreate) Mutation() *ExamMutation {
 	return _c.mutation
@@ -162,6 +248,22 @@ func (_c *ExamCreate) defaults() {
 		v := exam.DefaultIsActive
 		_c.mutation.SetIsActive(v)
 	}
+	if _, ok := _c.mutation.ShuffleChoices(); !ok {
+		v := exam.DefaultShuffleChoices
+		_c.mutation.SetShuffleChoices(v)
+	}
+	if _, ok := _c.mutation.WeightByDifficulty(); !ok {
+		v := exam.DefaultWeightByDifficulty
+		_c.mutation.SetWeightByDifficulty(v)
+	}
+	if _, ok := _c.mutation.PartialCredit(); !ok {
+		v := exam.DefaultPartialCredit
+		_c.mutation.SetPartialCredit(v)
+	}
+	if _, ok := _c.mutation.NegativeMarking(); !ok {
+		v := exam.DefaultNegativeMarking
+		_c.mutation.SetNegativeMarking(v)
+	}
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -180,6 +282,18 @@ func (_c *ExamCreate) check() error {
 	if _, ok := _c.mutation.IsActive(); !ok {
 		return &ValidationError{Name: "is_active", err: errors.New(`ent: missing required field "Exam.is_active"`)}
 	}
+	if _, ok := _c.mutation.ShuffleChoices(); !ok {
+		return &ValidationError{Name: "shuffle_choices", err: errors.New(`ent: missing required field "Exam.shuffle_choices"`)}
+	}
+	if _, ok := _c.mutation.WeightByDifficulty(); !ok {
+		return &ValidationError{Name: "weight_by_difficulty", err: errors.New(`ent: missing required field "Exam.weight_by_difficulty"`)}
+	}
+	if _, ok := _c.mutation.PartialCredit(); !ok {
+		return &ValidationError{Name: "partial_credit", err: errors.New(`ent: missing required field "Exam.partial_credit"`)}
+	}
+	if _, ok := _c.mutation.NegativeMarking(); !ok {
+		return &ValidationError{Name: "negative_marking", err: errors.New(`ent: missing required field "Exam.negative_marking"`)}
+	}
 	return nil
 }
 
@@ -206,6 +320,10 @@ func (_c *ExamCreate) createSpec() (*Exam, *sqlgraph.CreateSpec) {
 		_node = &Exam{config: _c.config}
 		_spec = sqlgraph.NewCreateSpec(exam.Table, sqlgraph.NewFieldSpec(exam.FieldID, field.TypeInt))
 	)
+	if value, ok := _c.mutation.Key(); ok {
+		_spec.SetField(exam.FieldKey, field.TypeString, value)
+		_node.Key = value
+	}
 	if value, ok := _c.mutation.Title(); ok {
 		_spec.SetField(exam.FieldTitle, field.TypeString, value)
 		_node.Title = value
@@ -222,6 +340,22 @@ func (_c *ExamCreate) createSpec() (*Exam, *sqlgraph.CreateSpec) {
 		_spec.SetField(exam.FieldIsActive, field.TypeBool, value)
 		_node.IsActive = value
 	}
+	if value, ok := _c.mutation.ShuffleChoices(); ok {
+		_spec.SetField(exam.FieldShuffleChoices, field.TypeBool, value)
+		_node.ShuffleChoices = value
+	}
+	if value, ok := _c.mutation.WeightByDifficulty(); ok {
+		_spec.SetField(exam.FieldWeightByDifficulty, field.TypeBool, value)
+		_node.WeightByDifficulty = value
+	}
+	if value, ok := _c.mutation.PartialCredit(); ok {
+		_spec.SetField(exam.FieldPartialCredit, field.TypeBool, value)
+		_node.PartialCredit = value
+	}
+	if value, ok := _c.mutation.NegativeMarking(); ok {
+		_spec.SetField(exam.FieldNegativeMarking, field.TypeBool, value)
+		_node.NegativeMarking = value
+	}
 	if nodes := _c.mutation.SectionsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -286,6 +420,22 @@ func (_c *ExamCreate) createSpec() (*Exam, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.AttemptsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   exam.AttemptsTable,
+			Columns: []string{exam.AttemptsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attempt.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
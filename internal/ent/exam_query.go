@@ -5,6 +5,7 @@ package ent
 import (
 	"context"
 	"database/sql/driver"
+	"examination/internal/ent/attempt"
 	"examination/internal/ent/exam"
 	"examination/internal/ent/predicate"
 	"examination/internal/ent/section"
@@ -31,6 +32,7 @@ type ExamQuery struct {
 	withTopics       *TopicQuery
 	withUnits        *UnitQuery
 	withVersionRules *VersionRuleQuery
+	withAttempts     *AttemptQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -155,6 +157,28 @@ func (_q *ExamQuery) QueryVersionRules() *VersionRuleQuery {
 	return query
 }
 
+// QueryAttempts chains the current query on the "attempts" edge.
+func (_q *ExamQuery) QueryAttempts() *AttemptQuery {
+	query := (&AttemptClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(exam.Table, exam.FieldID, selector),
+			sqlgraph.To(attempt.Table, attempt.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, exam.AttemptsTable, exam.AttemptsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Exam entity from the query.
 // Returns a *NotFoundError when no Exam was found.
 func (_q *ExamQuery) First(ctx context.Context) (*Exam, error) {
@@ -351,6 +375,7 @@ func (_q *ExamQuery) Clone() *ExamQuery {
 		withTopics:       _q.withTopics.Clone(),
 		withUnits:        _q.withUnits.Clone(),
 		withVersionRules: _q.withVersionRules.Clone(),
+		withAttempts:     _q.withAttempts.Clone(),
 		// clone intermediate query.
 		sql:  _q.sql.Clone(),
 		path: _q.path,
@@ -401,18 +426,29 @@ func (_q *ExamQuery) WithVersionRules(opts ...func(*VersionRuleQuery)) *ExamQuer
 	return _q
 }
 
+// WithAttempts tells the query-builder to eager-load the nodes that are connected to
+// the "attempts" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *ExamQuery) WithAttempts(opts ...func(*AttemptQuery)) *ExamQuery {
+	query := (&AttemptClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withAttempts = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
 // Example:
 //
 //	var v []struct {
-//		Title string `json:"title,omitempty"`
+//		Key string `json:"key,omitempty"`
 //		Count int `json:"count,omitempty"`
 //	}
 //
 //	client.Exam.Query().
-//		GroupBy(exam.FieldTitle).
+//		GroupBy(exam.FieldKey).
 //		Aggregate(ent.Count()).
 //		Scan(ctx, &v)
 func (_q *ExamQuery) GroupBy(field string, fields ...string) *ExamGroupBy {
@@ -430,11 +466,11 @@ func (_q *ExamQuery) GroupBy(field string, fields ...string) *ExamGroupBy {
 // Example:
 //
 //	var v []struct {
-//		Title string `json:"title,omitempty"`
+//		Key string `json:"key,omitempty"`
 //	}
 //
 //	client.Exam.Query().
-//		Select(exam.FieldTitle).
+//		Select(exam.FieldKey).
 //		Scan(ctx, &v)
 func (_q *ExamQuery) Select(fields ...string) *ExamSelect {
 	_q.ctx.Fields = append(_q.ctx.Fields, fields...)
@@ -479,11 +515,12 @@ func (_q *ExamQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Exam, e
 	var (
 		nodes       = []*Exam{}
 		_spec       = _q.querySpec()
-		loadedTypes = [4]bool{
+		loadedTypes = [5]bool{
 			_q.withSections != nil,
 			_q.withTopics != nil,
 			_q.withUnits != nil,
 			_q.withVersionRules != nil,
+			_q.withAttempts != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -532,6 +569,13 @@ func (_q *ExamQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Exam, e
 			return nil, err
 		}
 	}
+	if query := _q.withAttempts; query != nil {
+		if err := _q.loadAttempts(ctx, query, nodes,
+			func(n *Exam) { n.Edges.Attempts = []*Attempt{} },
+			func(n *Exam, e *Attempt) { n.Edges.Attempts = append(n.Edges.Attempts, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -655,6 +699,36 @@ func (_q *ExamQuery) loadVersionRules(ctx context.Context, query *VersionRuleQue
 	}
 	return nil
 }
+func (_q *ExamQuery) loadAttempts(ctx context.Context, query *AttemptQuery, nodes []*Exam, init func(*Exam), assign func(*Exam, *Attempt)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Exam)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(attempt.FieldExamID)
+	}
+	query.Where(predicate.Attempt(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(exam.AttemptsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.ExamID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "exam_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (_q *ExamQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
@@ -5,6 +5,7 @@ package ent
 import (
 	"context"
 	"errors"
+	"examination/internal/ent/attempt"
 	"examination/internal/ent/exam"
 	"examination/internal/ent/predicate"
 	"examination/internal/ent/section"
@@ -31,6 +32,26 @@ func (_u *ExamUpdate) Where(ps ...predicate.Exam) *ExamUpdate {
 	return _u
 }
 
+// SetKey sets the "key" field.
+func (_u *ExamUpdate) SetKey(v string) *ExamUpdate {
+	_u.mutation.SetKey(v)
+	return _u
+}
+
+// SetNillableKey sets the "key" field if the given value is not nil.
+func (_u *ExamUpdate) SetNillableKey(v *string) *ExamUpdate {
+	if v != nil {
+		_u.SetKey(*v)
+	}
+	return _u
+}
+
+// ClearKey clears the value of the "key" field.
+func (_u *ExamUpdate) ClearKey() *ExamUpdate {
+	_u.mutation.ClearKey()
+	return _u
+}
+
 // SetTitle sets the "title" field.
 func (_u *ExamUpdate) SetTitle(v string) *ExamUpdate {
 	_u.mutation.SetTitle(v)
@@ -100,6 +121,62 @@ func (_u *ExamUpdate) SetNillableIsActive(v *bool) *ExamUpdate {
 	return _u
 }
 
+// SetShuffleChoices sets the "shuffle_choices" field.
+func (_u *ExamUpdate) SetShuffleChoices(v bool) *ExamUpdate {
+	_u.mutation.SetShuffleChoices(v)
+	return _u
+}
+
+// SetNillableShuffleChoices sets the "shuffle_choices" field if the given value is not nil.
+func (_u *ExamUpdate) SetNillableShuffleChoices(v *bool) *ExamUpdate {
+	if v != nil {
+		_u.SetShuffleChoices(*v)
+	}
+	return _u
+}
+
+// SetWeightByDifficulty sets the "weight_by_difficulty" field.
+func (_u *ExamUpdate) SetWeightByDifficulty(v bool) *ExamUpdate {
+	_u.mutation.SetWeightByDifficulty(v)
+	return _u
+}
+
+// SetNillableWeightByDifficulty sets the "weight_by_difficulty" field if the given value is not nil.
+func (_u *ExamUpdate) SetNillableWeightByDifficulty(v *bool) *ExamUpdate {
+	if v != nil {
+		_u.SetWeightByDifficulty(*v)
+	}
+	return _u
+}
+
+// SetPartialCredit sets the "partial_credit" field.
+func (_u *ExamUpdate) SetPartialCredit(v bool) *ExamUpdate {
+	_u.mutation.SetPartialCredit(v)
+	return _u
+}
+
+// SetNillablePartialCredit sets the "partial_credit" field if the given value is not nil.
+func (_u *ExamUpdate) SetNillablePartialCredit(v *bool) *ExamUpdate {
+	if v != nil {
+		_u.SetPartialCredit(*v)
+	}
+	return _u
+}
+
+// SetNegativeMarking sets the "negative_marking" field.
+func (_u *ExamUpdate) SetNegativeMarking(v bool) *ExamUpdate {
+	_u.mutation.SetNegativeMarking(v)
+	return _u
+}
+
+// SetNillableNegativeMarking sets the "negative_marking" field if the given value is not nil.
+func (_u *ExamUpdate) SetNillableNegativeMarking(v *bool) *ExamUpdate {
+	if v != nil {
+		_u.SetNegativeMarking(*v)
+	}
+	return _u
+}
+
 // AddSectionIDs adds the "sections" edge to the Section entity by IDs.
 func (_u *ExamUpdate) AddSectionIDs(ids ...int) *ExamUpdate {
 	_u.mutation.AddSectionIDs(ids...)
@@ -160,6 +237,21 @@ func (_u *ExamUpdate) AddVersionRules(v ...*VersionRule) *ExamUpdate {
 	return _u.AddVersionRuleIDs(ids...)
 }
 
+// AddAttemptIDs adds the "attempts" edge to the Attempt entity by IDs.
+func (_u *ExamUpdate) AddAttemptIDs(ids ...int) *ExamUpdate {
+	_u.mutation.AddAttemptIDs(ids...)
+	return _u
+}
+
+// AddAttempts adds the "attempts" edges to the Attempt entity.
+func (_u *ExamUpdate) AddAttempts(v ...*Attempt) *ExamUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddAttemptIDs(ids...)
+}
+
 // Mutation returns the ExamMutation object of the builder.
 func (_u *ExamUpdate) Mutation() *ExamMutation {
 	return _u.mutation
@@ -249,6 +341,27 @@ func (_u *ExamUpdate) RemoveVersionRules(v ...*VersionRule) *ExamUpdate {
 	return _u.RemoveVersionRuleIDs(ids...)
 }
 
+// ClearAttempts clears all "attempts" edges to the Attempt entity.
+func (_u *ExamUpdate) ClearAttempts() *ExamUpdate {
+	_u.mutation.ClearAttempts()
+	return _u
+}
+
+// RemoveAttemptIDs removes the "attempts" edge to Attempt entities by IDs.
+func (_u *ExamUpdate) RemoveAttemptIDs(ids ...int) *ExamUpdate {
+	_u.mutation.RemoveAttemptIDs(ids...)
+	return _u
+}
+
+// RemoveAttempts removes "attempts" edges to Attempt entities.
+func (_u *ExamUpdate) RemoveAttempts(v ...*Attempt) *ExamUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveAttemptIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *ExamUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
@@ -298,6 +411,12 @@ func (_u *ExamUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 			}
 		}
 	}
+	if value, ok := _u.mutation.Key(); ok {
+		_spec.SetField(exam.FieldKey, field.TypeString, value)
+	}
+	if _u.mutation.KeyCleared() {
+		_spec.ClearField(exam.FieldKey, field.TypeString)
+	}
 	if value, ok := _u.mutation.Title(); ok {
 		_spec.SetField(exam.FieldTitle, field.TypeString, value)
 	}
@@ -316,6 +435,18 @@ func (_u *ExamUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.IsActive(); ok {
 		_spec.SetField(exam.FieldIsActive, field.TypeBool, value)
 	}
+	if value, ok := _u.mutation.ShuffleChoices(); ok {
+		_spec.SetField(exam.FieldShuffleChoices, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.WeightByDifficulty(); ok {
+		_spec.SetField(exam.FieldWeightByDifficulty, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.PartialCredit(); ok {
+		_spec.SetField(exam.FieldPartialCredit, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.NegativeMarking(); ok {
+		_spec.SetField(exam.FieldNegativeMarking, field.TypeBool, value)
+	}
 	if _u.mutation.SectionsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -496,6 +627,51 @@ func (_u *ExamUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.AttemptsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   exam.AttemptsTable,
+			Columns: []string{exam.AttemptsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attempt.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedAttemptsIDs(); len(nodes) > 0 && !_u.mutation.AttemptsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   exam.AttemptsTable,
+			Columns: []string{exam.AttemptsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attempt.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AttemptsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   exam.AttemptsTable,
+			Columns: []string{exam.AttemptsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attempt.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{exam.Label}
@@ -516,6 +692,26 @@ type ExamUpdateOne struct {
 	mutation *ExamMutation
 }
 
+// SetKey sets the "key" field.
+func (_u *ExamUpdateOne) SetKey(v string) *ExamUpdateOne {
+	_u.mutation.SetKey(v)
+	return _u
+}
+
+// SetNillableKey sets the "key" field if the given value is not nil.
+func (_u *ExamUpdateOne) SetNillableKey(v *string) *ExamUpdateOne {
+	if v != nil {
+		_u.SetKey(*v)
+	}
+	return _u
+}
+
+// ClearKey clears the value of the "key" field.
+func (_u *ExamUpdateOne) ClearKey() *ExamUpdateOne {
+	_u.mutation.ClearKey()
+	return _u
+}
+
 // SetTitle sets the "title" field.
 func (_u *ExamUpdateOne) SetTitle(v string) *ExamUpdateOne {
 	_u.mutation.SetTitle(v)
@@ -585,6 +781,62 @@ func (_u *ExamUpdateOne) SetNillableIsActive(v *bool) *ExamUpdateOne {
 	return _u
 }
 
+// SetShuffleChoices sets the "shuffle_choices" field.
+func (_u *ExamUpdateOne) SetShuffleChoices(v bool) *ExamUpdateOne {
+	_u.mutation.SetShuffleChoices(v)
+	return _u
+}
+
+// SetNillableShuffleChoices sets the "shuffle_choices" field if the given value is not nil.
+func (_u *ExamUpdateOne) SetNillableShuffleChoices(v *bool) *ExamUpdateOne {
+	if v != nil {
+		_u.SetShuffleChoices(*v)
+	}
+	return _u
+}
+
+// SetWeightByDifficulty sets the "weight_by_difficulty" field.
+func (_u *ExamUpdateOne) SetWeightByDifficulty(v bool) *ExamUpdateOne {
+	_u.mutation.SetWeightByDifficulty(v)
+	return _u
+}
+
+// SetNillableWeightByDifficulty sets the "weight_by_difficulty" field if the given value is not nil.
+func (_u *ExamUpdateOne) SetNillableWeightByDifficulty(v *bool) *ExamUpdateOne {
+	if v != nil {
+		_u.SetWeightByDifficulty(*v)
+	}
+	return _u
+}
+
+// SetPartialCredit sets the "partial_credit" field.
+func (_u *ExamUpdateOne) SetPartialCredit(v bool) *ExamUpdateOne {
+	_u.mutation.SetPartialCredit(v)
+	return _u
+}
+
+// SetNillablePartialCredit sets the "partial_credit" field if the given value is not nil.
+func (_u *ExamUpdateOne) SetNillablePartialCredit(v *bool) *ExamUpdateOne {
+	if v != nil {
+		_u.SetPartialCredit(*v)
+	}
+	return _u
+}
+
+// SetNegativeMarking sets the "negative_marking" field.
+func (_u *ExamUpdateOne) SetNegativeMarking(v bool) *ExamUpdateOne {
+	_u.mutation.SetNegativeMarking(v)
+	return _u
+}
+
+// SetNillableNegativeMarking sets the "negative_marking" field if the given value is not nil.
+func (_u *ExamUpdateOne) SetNillableNegativeMarking(v *bool) *ExamUpdateOne {
+	if v != nil {
+		_u.SetNegativeMarking(*v)
+	}
+	return _u
+}
+
 // AddSectionIDs adds the "sections" edge to the Section entity by IDs.
 func (_u *ExamUpdateOne) AddSectionIDs(ids ...int) *ExamUpdateOne {
 	_u.mutation.AddSectionIDs(ids...)
@@ -645,6 +897,21 @@ func (_u *ExamUpdateOne) AddVersionRules(v ...*VersionRule) *ExamUpdateOne {
 	return _u.AddVersionRuleIDs(ids...)
 }
 
+// AddAttemptIDs adds the "attempts" edge to the Attempt entity by IDs.
+func (_u *ExamUpdateOne) AddAttemptIDs(ids ...int) *ExamUpdateOne {
+	_u.mutation.AddAttemptIDs(ids...)
+	return _u
+}
+
+// AddAttempts adds the "attempts" edges to the Attempt entity.
+func (_u *ExamUpdateOne) AddAttempts(v ...*Attempt) *ExamUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddAttemptIDs(ids...)
+}
+
 // Mutation returns the ExamMutation object of the builder.
 func (_u *ExamUpdateOne) Mutation() *ExamMutation {
 	return _u.mutation
@@ -734,6 +1001,27 @@ func (_u *ExamUpdateOne) RemoveVersionRules(v ...*VersionRule) *ExamUpdateOne {
 	return _u.RemoveVersionRuleIDs(ids...)
 }
 
+// ClearAttempts clears all "attempts" edges to the Attempt entity.
+func (_u *ExamUpdateOne) ClearAttempts() *ExamUpdateOne {
+	_u.mutation.ClearAttempts()
+	return _u
+}
+
+// RemoveAttemptIDs removes the "attempts" edge to Attempt entities by IDs.
+func (_u *ExamUpdateOne) RemoveAttemptIDs(ids ...int) *ExamUpdateOne {
+	_u.mutation.RemoveAttemptIDs(ids...)
+	return _u
+}
+
+// RemoveAttempts removes "attempts" edges to Attempt entities.
+func (_u *ExamUpdateOne) RemoveAttempts(v ...*Attempt) *ExamUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveAttemptIDs(ids...)
+}
+
 // Where appends a list predicates to the ExamUpdate builder.
 func (_u *ExamUpdateOne) Where(ps ...predicate.Exam) *ExamUpdateOne {
 	_u.mutation.Where(ps...)
@@ -813,6 +1101,12 @@ func (_u *ExamUpdateOne) sqlSave(ctx context.Context) (_node *Exam, err error) {
 			}
 		}
 	}
+	if value, ok := _u.mutation.Key(); ok {
+		_spec.SetField(exam.FieldKey, field.TypeString, value)
+	}
+	if _u.mutation.KeyCleared() {
+		_spec.ClearField(exam.FieldKey, field.TypeString)
+	}
 	if value, ok := _u.mutation.Title(); ok {
 		_spec.SetField(exam.FieldTitle, field.TypeString, value)
 	}
@@ -831,6 +1125,18 @@ func (_u *ExamUpdateOne) sqlSave(ctx context.Context) (_node *Exam, err error) {
 	if value, ok := _u.mutation.IsActive(); ok {
 		_spec.SetField(exam.FieldIsActive, field.TypeBool, value)
 	}
+	if value, ok := _u.mutation.ShuffleChoices(); ok {
+		_spec.SetField(exam.FieldShuffleChoices, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.WeightByDifficulty(); ok {
+		_spec.SetField(exam.FieldWeightByDifficulty, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.PartialCredit(); ok {
+		_spec.SetField(exam.FieldPartialCredit, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.NegativeMarking(); ok {
+		_spec.SetField(exam.FieldNegativeMarking, field.TypeBool, value)
+	}
 	if _u.mutation.SectionsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -1011,6 +1317,51 @@ func (_u *ExamUpdateOne) sqlSave(ctx context.Context) (_node *Exam, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.AttemptsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   exam.AttemptsTable,
+			Columns: []string{exam.AttemptsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attempt.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedAttemptsIDs(); len(nodes) > 0 && !_u.mutation.AttemptsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   exam.AttemptsTable,
+			Columns: []string{exam.AttemptsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attempt.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AttemptsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   exam.AttemptsTable,
+			Columns: []string{exam.AttemptsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attempt.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Exam{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
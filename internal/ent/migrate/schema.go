@@ -8,6 +8,126 @@ import (
 )
 
 var (
+	// AnswersColumns holds the columns for the "answers" table.
+	AnswersColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "response", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "choice_order", Type: field.TypeJSON, Nullable: true},
+		{Name: "attempt_id", Type: field.TypeInt},
+		{Name: "problem_id", Type: field.TypeInt},
+	}
+	// AnswersTable holds the schema information for the "answers" table.
+	AnswersTable = &schema.Table{
+		Name:       "answers",
+		Columns:    AnswersColumns,
+		PrimaryKey: []*schema.Column{AnswersColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "answers_attempts_answers",
+				Columns:    []*schema.Column{AnswersColumns[4]},
+				RefColumns: []*schema.Column{AttemptsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "answers_problems_answers",
+				Columns:    []*schema.Column{AnswersColumns[5]},
+				RefColumns: []*schema.Column{ProblemsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "answer_attempt_id_problem_id",
+				Unique:  true,
+				Columns: []*schema.Column{AnswersColumns[4], AnswersColumns[5]},
+			},
+		},
+	}
+	// AssetsColumns holds the columns for the "assets" table.
+	AssetsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "hash", Type: field.TypeString, Unique: true},
+		{Name: "name", Type: field.TypeString},
+		{Name: "content_type", Type: field.TypeString},
+		{Name: "size", Type: field.TypeInt64},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// AssetsTable holds the schema information for the "assets" table.
+	AssetsTable = &schema.Table{
+		Name:       "assets",
+		Columns:    AssetsColumns,
+		PrimaryKey: []*schema.Column{AssetsColumns[0]},
+	}
+	// AttemptsColumns holds the columns for the "attempts" table.
+	AttemptsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"IN_PROGRESS", "SUBMITTED"}, Default: "IN_PROGRESS"},
+		{Name: "locale", Type: field.TypeString},
+		{Name: "started_at", Type: field.TypeTime},
+		{Name: "expires_at", Type: field.TypeTime, Nullable: true},
+		{Name: "submitted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "auto_submitted", Type: field.TypeBool, Default: false},
+		{Name: "edition_year", Type: field.TypeInt, Nullable: true},
+		{Name: "edition_round", Type: field.TypeInt, Nullable: true},
+		{Name: "edition_category", Type: field.TypeString, Nullable: true},
+		{Name: "exam_id", Type: field.TypeInt},
+		{Name: "user_id", Type: field.TypeInt, Nullable: true},
+	}
+	// AttemptsTable holds the schema information for the "attempts" table.
+	AttemptsTable = &schema.Table{
+		Name:       "attempts",
+		Columns:    AttemptsColumns,
+		PrimaryKey: []*schema.Column{AttemptsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "attempts_exams_attempts",
+				Columns:    []*schema.Column{AttemptsColumns[10]},
+				RefColumns: []*schema.Column{ExamsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "attempts_users_attempts",
+				Columns:    []*schema.Column{AttemptsColumns[11]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+	}
+	// AttemptItemsColumns holds the columns for the "attempt_items" table.
+	AttemptItemsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "choice_order", Type: field.TypeJSON, Nullable: true},
+		{Name: "attempt_id", Type: field.TypeInt},
+		{Name: "problem_id", Type: field.TypeInt},
+	}
+	// AttemptItemsTable holds the schema information for the "attempt_items" table.
+	AttemptItemsTable = &schema.Table{
+		Name:       "attempt_items",
+		Columns:    AttemptItemsColumns,
+		PrimaryKey: []*schema.Column{AttemptItemsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "attempt_items_attempts_items",
+				Columns:    []*schema.Column{AttemptItemsColumns[2]},
+				RefColumns: []*schema.Column{AttemptsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "attempt_items_problems_attempt_items",
+				Columns:    []*schema.Column{AttemptItemsColumns[3]},
+				RefColumns: []*schema.Column{ProblemsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "attemptitem_attempt_id_problem_id",
+				Unique:  true,
+				Columns: []*schema.Column{AttemptItemsColumns[2], AttemptItemsColumns[3]},
+			},
+		},
+	}
 	// ChoicesColumns holds the columns for the "choices" table.
 	ChoicesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -34,10 +154,15 @@ var (
 	// ExamsColumns holds the columns for the "exams" table.
 	ExamsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "key", Type: field.TypeString, Unique: true, Nullable: true},
 		{Name: "title", Type: field.TypeString},
 		{Name: "description", Type: field.TypeString, Nullable: true, Size: 2147483647},
 		{Name: "time_limit", Type: field.TypeInt},
 		{Name: "is_active", Type: field.TypeBool, Default: true},
+		{Name: "shuffle_choices", Type: field.TypeBool, Default: false},
+		{Name: "weight_by_difficulty", Type: field.TypeBool, Default: false},
+		{Name: "partial_credit", Type: field.TypeBool, Default: false},
+		{Name: "negative_marking", Type: field.TypeBool, Default: false},
 	}
 	// ExamsTable holds the schema information for the "exams" table.
 	ExamsTable = &schema.Table{
@@ -48,8 +173,12 @@ var (
 	// ProblemsColumns holds the columns for the "problems" table.
 	ProblemsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "key", Type: field.TypeString, Nullable: true},
 		{Name: "type", Type: field.TypeEnum, Enums: []string{"SOURCE", "VARIANT"}, Default: "SOURCE"},
 		{Name: "difficulty", Type: field.TypeInt, Default: 1},
+		{Name: "interaction", Type: field.TypeEnum, Enums: []string{"SINGLE", "MULTIPLE", "ORDERING", "NUMERIC", "TEXT"}, Default: "SINGLE"},
+		{Name: "tolerance", Type: field.TypeFloat64, Default: 0},
+		{Name: "text_match", Type: field.TypeEnum, Enums: []string{"EXACT", "REGEX"}, Default: "EXACT"},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "parent_id", Type: field.TypeInt, Nullable: true},
 		{Name: "unit_id", Type: field.TypeInt},
@@ -62,13 +191,13 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "problems_problems_children",
-				Columns:    []*schema.Column{ProblemsColumns[4]},
+				Columns:    []*schema.Column{ProblemsColumns[8]},
 				RefColumns: []*schema.Column{ProblemsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "problems_units_problems",
-				Columns:    []*schema.Column{ProblemsColumns[5]},
+				Columns:    []*schema.Column{ProblemsColumns[9]},
 				RefColumns: []*schema.Column{UnitsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -104,9 +233,21 @@ var (
 			},
 		},
 	}
+	// RolesColumns holds the columns for the "roles" table.
+	RolesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "name", Type: field.TypeString, Unique: true},
+	}
+	// RolesTable holds the schema information for the "roles" table.
+	RolesTable = &schema.Table{
+		Name:       "roles",
+		Columns:    RolesColumns,
+		PrimaryKey: []*schema.Column{RolesColumns[0]},
+	}
 	// SectionsColumns holds the columns for the "sections" table.
 	SectionsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "key", Type: field.TypeString, Nullable: true},
 		{Name: "title", Type: field.TypeString},
 		{Name: "seq", Type: field.TypeInt},
 		{Name: "exam_id", Type: field.TypeInt},
@@ -119,15 +260,45 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "sections_exams_sections",
-				Columns:    []*schema.Column{SectionsColumns[3]},
+				Columns:    []*schema.Column{SectionsColumns[4]},
 				RefColumns: []*schema.Column{ExamsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "section_exam_id_key",
+				Unique:  true,
+				Columns: []*schema.Column{SectionsColumns[4], SectionsColumns[1]},
+			},
+		},
+	}
+	// SessionsColumns holds the columns for the "sessions" table.
+	SessionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "token_hash", Type: field.TypeString, Unique: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeInt},
+	}
+	// SessionsTable holds the schema information for the "sessions" table.
+	SessionsTable = &schema.Table{
+		Name:       "sessions",
+		Columns:    SessionsColumns,
+		PrimaryKey: []*schema.Column{SessionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "sessions_users_sessions",
+				Columns:    []*schema.Column{SessionsColumns[4]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
 	}
 	// TopicsColumns holds the columns for the "topics" table.
 	TopicsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "key", Type: field.TypeString, Nullable: true},
 		{Name: "title", Type: field.TypeString},
 		{Name: "seq", Type: field.TypeInt},
 		{Name: "exam_id", Type: field.TypeInt},
@@ -141,21 +312,29 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "topics_exams_topics",
-				Columns:    []*schema.Column{TopicsColumns[3]},
+				Columns:    []*schema.Column{TopicsColumns[4]},
 				RefColumns: []*schema.Column{ExamsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 			{
 				Symbol:     "topics_sections_topics",
-				Columns:    []*schema.Column{TopicsColumns[4]},
+				Columns:    []*schema.Column{TopicsColumns[5]},
 				RefColumns: []*schema.Column{SectionsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "topic_exam_id_key",
+				Unique:  true,
+				Columns: []*schema.Column{TopicsColumns[4], TopicsColumns[1]},
+			},
+		},
 	}
 	// UnitsColumns holds the columns for the "units" table.
 	UnitsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "key", Type: field.TypeString, Nullable: true},
 		{Name: "title", Type: field.TypeString},
 		{Name: "seq", Type: field.TypeInt},
 		{Name: "exam_id", Type: field.TypeInt},
@@ -170,23 +349,44 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "units_exams_units",
-				Columns:    []*schema.Column{UnitsColumns[3]},
+				Columns:    []*schema.Column{UnitsColumns[4]},
 				RefColumns: []*schema.Column{ExamsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 			{
 				Symbol:     "units_sections_units",
-				Columns:    []*schema.Column{UnitsColumns[4]},
+				Columns:    []*schema.Column{UnitsColumns[5]},
 				RefColumns: []*schema.Column{SectionsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "units_topics_units",
-				Columns:    []*schema.Column{UnitsColumns[5]},
+				Columns:    []*schema.Column{UnitsColumns[6]},
 				RefColumns: []*schema.Column{TopicsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "unit_exam_id_key",
+				Unique:  true,
+				Columns: []*schema.Column{UnitsColumns[4], UnitsColumns[1]},
+			},
+		},
+	}
+	// UsersColumns holds the columns for the "users" table.
+	UsersColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "email", Type: field.TypeString, Unique: true},
+		{Name: "name", Type: field.TypeString, Nullable: true},
+		{Name: "password_hash", Type: field.TypeString},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// UsersTable holds the schema information for the "users" table.
+	UsersTable = &schema.Table{
+		Name:       "users",
+		Columns:    UsersColumns,
+		PrimaryKey: []*schema.Column{UsersColumns[0]},
 	}
 	// VersionRulesColumns holds the columns for the "version_rules" table.
 	VersionRulesColumns = []*schema.Column{
@@ -219,25 +419,91 @@ var (
 			},
 		},
 	}
+	// AnswerChoicesColumns holds the columns for the "answer_choices" table.
+	AnswerChoicesColumns = []*schema.Column{
+		{Name: "answer_id", Type: field.TypeInt},
+		{Name: "choice_id", Type: field.TypeInt},
+	}
+	// AnswerChoicesTable holds the schema information for the "answer_choices" table.
+	AnswerChoicesTable = &schema.Table{
+		Name:       "answer_choices",
+		Columns:    AnswerChoicesColumns,
+		PrimaryKey: []*schema.Column{AnswerChoicesColumns[0], AnswerChoicesColumns[1]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "answer_choices_answer_id",
+				Columns:    []*schema.Column{AnswerChoicesColumns[0]},
+				RefColumns: []*schema.Column{AnswersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "answer_choices_choice_id",
+				Columns:    []*schema.Column{AnswerChoicesColumns[1]},
+				RefColumns: []*schema.Column{ChoicesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
+	// UserRolesColumns holds the columns for the "user_roles" table.
+	UserRolesColumns = []*schema.Column{
+		{Name: "user_id", Type: field.TypeInt},
+		{Name: "role_id", Type: field.TypeInt},
+	}
+	// UserRolesTable holds the schema information for the "user_roles" table.
+	UserRolesTable = &schema.Table{
+		Name:       "user_roles",
+		Columns:    UserRolesColumns,
+		PrimaryKey: []*schema.Column{UserRolesColumns[0], UserRolesColumns[1]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "user_roles_user_id",
+				Columns:    []*schema.Column{UserRolesColumns[0]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "user_roles_role_id",
+				Columns:    []*schema.Column{UserRolesColumns[1]},
+				RefColumns: []*schema.Column{RolesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		AnswersTable,
+		AssetsTable,
+		AttemptsTable,
+		AttemptItemsTable,
 		ChoicesTable,
 		ExamsTable,
 		ProblemsTable,
 		ProblemTranslationsTable,
+		RolesTable,
 		SectionsTable,
+		SessionsTable,
 		TopicsTable,
 		UnitsTable,
+		UsersTable,
 		VersionRulesTable,
+		AnswerChoicesTable,
+		UserRolesTable,
 	}
 )
 
 func init() {
+	AnswersTable.ForeignKeys[0].RefTable = AttemptsTable
+	AnswersTable.ForeignKeys[1].RefTable = ProblemsTable
+	AttemptsTable.ForeignKeys[0].RefTable = ExamsTable
+	AttemptsTable.ForeignKeys[1].RefTable = UsersTable
+	AttemptItemsTable.ForeignKeys[0].RefTable = AttemptsTable
+	AttemptItemsTable.ForeignKeys[1].RefTable = ProblemsTable
 	ChoicesTable.ForeignKeys[0].RefTable = ProblemTranslationsTable
 	ProblemsTable.ForeignKeys[0].RefTable = ProblemsTable
 	ProblemsTable.ForeignKeys[1].RefTable = UnitsTable
 	ProblemTranslationsTable.ForeignKeys[0].RefTable = ProblemsTable
 	SectionsTable.ForeignKeys[0].RefTable = ExamsTable
+	SessionsTable.ForeignKeys[0].RefTable = UsersTable
 	TopicsTable.ForeignKeys[0].RefTable = ExamsTable
 	TopicsTable.ForeignKeys[1].RefTable = SectionsTable
 	UnitsTable.ForeignKeys[0].RefTable = ExamsTable
@@ -245,4 +511,8 @@ func init() {
 	UnitsTable.ForeignKeys[2].RefTable = TopicsTable
 	VersionRulesTable.ForeignKeys[0].RefTable = ExamsTable
 	VersionRulesTable.ForeignKeys[1].RefTable = ProblemsTable
+	AnswerChoicesTable.ForeignKeys[0].RefTable = AnswersTable
+	AnswerChoicesTable.ForeignKeys[1].RefTable = ChoicesTable
+	UserRolesTable.ForeignKeys[0].RefTable = UsersTable
+	UserRolesTable.ForeignKeys[1].RefTable = RolesTable
 }
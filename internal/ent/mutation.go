@@ -5,14 +5,21 @@ package ent
 import (
 	"context"
 	"errors"
+	"examination/internal/ent/answer"
+	"examination/internal/ent/asset"
+	"examination/internal/ent/attempt"
+	"examination/internal/ent/attemptitem"
 	"examination/internal/ent/choice"
 	"examination/internal/ent/exam"
 	"examination/internal/ent/predicate"
 	"examination/internal/ent/problem"
 	"examination/internal/ent/problemtranslation"
+	"examination/internal/ent/role"
 	"examination/internal/ent/section"
+	"examination/internal/ent/session"
 	"examination/internal/ent/topic"
 	"examination/internal/ent/unit"
+	"examination/internal/ent/user"
 	"examination/internal/ent/versionrule"
 	"fmt"
 	"sync"
@@ -31,46 +38,57 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
+	TypeAnswer             = "Answer"
+	TypeAsset              = "Asset"
+	TypeAttempt            = "Attempt"
+	TypeAttemptItem        = "AttemptItem"
 	TypeChoice             = "Choice"
 	TypeExam               = "Exam"
 	TypeProblem            = "Problem"
 	TypeProblemTranslation = "ProblemTranslation"
+	TypeRole               = "Role"
 	TypeSection            = "Section"
+	TypeSession            = "Session"
 	TypeTopic              = "Topic"
 	TypeUnit               = "Unit"
+	TypeUser               = "User"
 	TypeVersionRule        = "VersionRule"
 )
 
-// ChoiceMutation represents an operation that mutates the Choice nodes in the graph.
-type ChoiceMutation struct {
+// AnswerMutation represents an operation that mutates the Answer nodes in the graph.
+type AnswerMutation struct {
 	config
-	op                         Op
-	typ                        string
-	id                         *int
-	content                    *string
-	is_correct                 *bool
-	explanation                *string
-	seq                        *int
-	addseq                     *int
-	clearedFields              map[string]struct{}
-	problem_translation        *int
-	clearedproblem_translation bool
-	done                       bool
-	oldValue                   func(context.Context) (*Choice, error)
-	predicates                 []predicate.Choice
-}
-
-var _ ent.Mutation = (*ChoiceMutation)(nil)
-
-// choiceOption allows management of the mutation configuration using functional options.
-type choiceOption func(*ChoiceMutation)
-
-// newChoiceMutation creates new mutation for the Choice entity.
-func newChoiceMutation(c config, op Op, opts ...choiceOption) *ChoiceMutation {
-	m := &ChoiceMutation{
+	op                 Op
+	typ                string
+	id                 *int
+	updated_at         *time.Time
+	response           *string
+	choice_order       *[]int
+	appendchoice_order []int
+	clearedFields      map[string]struct{}
+	attempt            *int
+	clearedattempt     bool
+	problem            *int
+	clearedproblem     bool
+	choices            map[int]struct{}
+	removedchoices     map[int]struct{}
+	clearedchoices     bool
+	done               bool
+	oldValue           func(context.Context) (*Answer, error)
+	predicates         []predicate.Answer
+}
+
+var _ ent.Mutation = (*AnswerMutation)(nil)
+
+// answerOption allows management of the mutation configuration using functional options.
+type answerOption func(*AnswerMutation)
+
+// newAnswerMutation creates new mutation for the Answer entity.
+func newAnswerMutation(c config, op Op, opts ...answerOption) *AnswerMutation {
+	m := &AnswerMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeChoice,
+		typ:           TypeAnswer,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -79,20 +97,20 @@ func newChoiceMutation(c config, op Op, opts ...choiceOption) *ChoiceMutation {
 	return m
 }
 
-// withChoiceID sets the ID field of the mutation.
-func withChoiceID(id int) choiceOption {
-	return func(m *ChoiceMutation) {
+// withAnswerID sets the ID field of the mutation.
+func withAnswerID(id int) answerOption {
+	return func(m *AnswerMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Choice
+			value *Answer
 		)
-		m.oldValue = func(ctx context.Context) (*Choice, error) {
+		m.oldValue = func(ctx context.Context) (*Answer, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Choice.Get(ctx, id)
+					value, err = m.Client().Answer.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -101,10 +119,10 @@ func withChoiceID(id int) choiceOption {
 	}
 }
 
-// withChoice sets the old Choice of the mutation.
-func withChoice(node *Choice) choiceOption {
-	return func(m *ChoiceMutation) {
-		m.oldValue = func(context.Context) (*Choice, error) {
+// withAnswer sets the old Answer of the mutation.
+func withAnswer(node *Answer) answerOption {
+	return func(m *AnswerMutation) {
+		m.oldValue = func(context.Context) (*Answer, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -113,7 +131,7 @@ func withChoice(node *Choice) choiceOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m ChoiceMutation) Client() *Client {
+func (m AnswerMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -121,7 +139,7 @@ func (m ChoiceMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m ChoiceMutation) Tx() (*Tx, error) {
+func (m AnswerMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -132,7 +150,7 @@ func (m ChoiceMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *ChoiceMutation) ID() (id int, exists bool) {
+func (m *AnswerMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -143,7 +161,7 @@ func (m *ChoiceMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *ChoiceMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *AnswerMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -152,301 +170,391 @@ func (m *ChoiceMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Choice.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Answer.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetContent sets the "content" field.
-func (m *ChoiceMutation) SetContent(s string) {
-	m.content = &s
+// SetUpdatedAt sets the "updated_at" field.
+func (m *AnswerMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
 }
 
-// Content returns the value of the "content" field in the mutation.
-func (m *ChoiceMutation) Content() (r string, exists bool) {
-	v := m.content
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *AnswerMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldContent returns the old "content" field's value of the Choice entity.
-// If the Choice object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the Answer entity.
+// If the Answer object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ChoiceMutation) OldContent(ctx context.Context) (v string, err error) {
+func (m *AnswerMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldContent is only allowed on UpdateOne operations")
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldContent requires an ID field in the mutation")
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldContent: %w", err)
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
 	}
-	return oldValue.Content, nil
+	return oldValue.UpdatedAt, nil
 }
 
-// ResetContent resets all changes to the "content" field.
-func (m *ChoiceMutation) ResetContent() {
-	m.content = nil
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *AnswerMutation) ResetUpdatedAt() {
+	m.updated_at = nil
 }
 
-// SetIsCorrect sets the "is_correct" field.
-func (m *ChoiceMutation) SetIsCorrect(b bool) {
-	m.is_correct = &b
+// SetResponse sets the "response" field.
+func (m *AnswerMutation) SetResponse(s string) {
+	m.response = &s
 }
 
-// IsCorrect returns the value of the "is_correct" field in the mutation.
-func (m *ChoiceMutation) IsCorrect() (r bool, exists bool) {
-	v := m.is_correct
+// Response returns the value of the "response" field in the mutation.
+func (m *AnswerMutation) Response() (r string, exists bool) {
+	v := m.response
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldIsCorrect returns the old "is_correct" field's value of the Choice entity.
-// If the Choice object wasn't provided to the builder, the object is fetched from the database.
+// OldResponse returns the old "response" field's value of the Answer entity.
+// If the Answer object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ChoiceMutation) OldIsCorrect(ctx context.Context) (v bool, err error) {
+func (m *AnswerMutation) OldResponse(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIsCorrect is only allowed on UpdateOne operations")
+		return v, errors.New("OldResponse is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIsCorrect requires an ID field in the mutation")
+		return v, errors.New("OldResponse requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIsCorrect: %w", err)
+		return v, fmt.Errorf("querying old value for OldResponse: %w", err)
 	}
-	return oldValue.IsCorrect, nil
+	return oldValue.Response, nil
 }
 
-// ResetIsCorrect resets all changes to the "is_correct" field.
-func (m *ChoiceMutation) ResetIsCorrect() {
-	m.is_correct = nil
+// ClearResponse clears the value of the "response" field.
+func (m *AnswerMutation) ClearResponse() {
+	m.response = nil
+	m.clearedFields[answer.FieldResponse] = struct{}{}
 }
 
-// SetExplanation sets the "explanation" field.
-func (m *ChoiceMutation) SetExplanation(s string) {
-	m.explanation = &s
+// ResponseCleared returns if the "response" field was cleared in this mutation.
+func (m *AnswerMutation) ResponseCleared() bool {
+	_, ok := m.clearedFields[answer.FieldResponse]
+	return ok
 }
 
-// Explanation returns the value of the "explanation" field in the mutation.
-func (m *ChoiceMutation) Explanation() (r string, exists bool) {
-	v := m.explanation
+// ResetResponse resets all changes to the "response" field.
+func (m *AnswerMutation) ResetResponse() {
+	m.response = nil
+	delete(m.clearedFields, answer.FieldResponse)
+}
+
+// SetChoiceOrder sets the "choice_order" field.
+func (m *AnswerMutation) SetChoiceOrder(i []int) {
+	m.choice_order = &i
+	m.appendchoice_order = nil
+}
+
+// ChoiceOrder returns the value of the "choice_order" field in the mutation.
+func (m *AnswerMutation) ChoiceOrder() (r []int, exists bool) {
+	v := m.choice_order
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldExplanation returns the old "explanation" field's value of the Choice entity.
-// If the Choice object wasn't provided to the builder, the object is fetched from the database.
+// OldChoiceOrder returns the old "choice_order" field's value of the Answer entity.
+// If the Answer object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ChoiceMutation) OldExplanation(ctx context.Context) (v string, err error) {
+func (m *AnswerMutation) OldChoiceOrder(ctx context.Context) (v []int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldExplanation is only allowed on UpdateOne operations")
+		return v, errors.New("OldChoiceOrder is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldExplanation requires an ID field in the mutation")
+		return v, errors.New("OldChoiceOrder requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldExplanation: %w", err)
+		return v, fmt.Errorf("querying old value for OldChoiceOrder: %w", err)
 	}
-	return oldValue.Explanation, nil
+	return oldValue.ChoiceOrder, nil
 }
 
-// ClearExplanation clears the value of the "explanation" field.
-func (m *ChoiceMutation) ClearExplanation() {
-	m.explanation = nil
-	m.clearedFields[choice.FieldExplanation] = struct{}{}
+// AppendChoiceOrder adds i to the "choice_order" field.
+func (m *AnswerMutation) AppendChoiceOrder(i []int) {
+	m.appendchoice_order = append(m.appendchoice_order, i...)
 }
 
-// ExplanationCleared returns if the "explanation" field was cleared in this mutation.
-func (m *ChoiceMutation) ExplanationCleared() bool {
-	_, ok := m.clearedFields[choice.FieldExplanation]
+// AppendedChoiceOrder returns the list of values that were appended to the "choice_order" field in this mutation.
+func (m *AnswerMutation) AppendedChoiceOrder() ([]int, bool) {
+	if len(m.appendchoice_order) == 0 {
+		return nil, false
+	}
+	return m.appendchoice_order, true
+}
+
+// ClearChoiceOrder clears the value of the "choice_order" field.
+func (m *AnswerMutation) ClearChoiceOrder() {
+	m.choice_order = nil
+	m.appendchoice_order = nil
+	m.clearedFields[answer.FieldChoiceOrder] = struct{}{}
+}
+
+// ChoiceOrderCleared returns if the "choice_order" field was cleared in this mutation.
+func (m *AnswerMutation) ChoiceOrderCleared() bool {
+	_, ok := m.clearedFields[answer.FieldChoiceOrder]
 	return ok
 }
 
-// ResetExplanation resets all changes to the "explanation" field.
-func (m *ChoiceMutation) ResetExplanation() {
-	m.explanation = nil
-	delete(m.clearedFields, choice.FieldExplanation)
+// ResetChoiceOrder resets all changes to the "choice_order" field.
+func (m *AnswerMutation) ResetChoiceOrder() {
+	m.choice_order = nil
+	m.appendchoice_order = nil
+	delete(m.clearedFields, answer.FieldChoiceOrder)
 }
 
-// SetSeq sets the "seq" field.
-func (m *ChoiceMutation) SetSeq(i int) {
-	m.seq = &i
-	m.addseq = nil
+// SetAttemptID sets the "attempt_id" field.
+func (m *AnswerMutation) SetAttemptID(i int) {
+	m.attempt = &i
 }
 
-// Seq returns the value of the "seq" field in the mutation.
-func (m *ChoiceMutation) Seq() (r int, exists bool) {
-	v := m.seq
+// AttemptID returns the value of the "attempt_id" field in the mutation.
+func (m *AnswerMutation) AttemptID() (r int, exists bool) {
+	v := m.attempt
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSeq returns the old "seq" field's value of the Choice entity.
-// If the Choice object wasn't provided to the builder, the object is fetched from the database.
+// OldAttemptID returns the old "attempt_id" field's value of the Answer entity.
+// If the Answer object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ChoiceMutation) OldSeq(ctx context.Context) (v int, err error) {
+func (m *AnswerMutation) OldAttemptID(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSeq is only allowed on UpdateOne operations")
+		return v, errors.New("OldAttemptID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSeq requires an ID field in the mutation")
+		return v, errors.New("OldAttemptID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSeq: %w", err)
-	}
-	return oldValue.Seq, nil
-}
-
-// AddSeq adds i to the "seq" field.
-func (m *ChoiceMutation) AddSeq(i int) {
-	if m.addseq != nil {
-		*m.addseq += i
-	} else {
-		m.addseq = &i
-	}
-}
-
-// AddedSeq returns the value that was added to the "seq" field in this mutation.
-func (m *ChoiceMutation) AddedSeq() (r int, exists bool) {
-	v := m.addseq
-	if v == nil {
-		return
+		return v, fmt.Errorf("querying old value for OldAttemptID: %w", err)
 	}
-	return *v, true
+	return oldValue.AttemptID, nil
 }
 
-// ResetSeq resets all changes to the "seq" field.
-func (m *ChoiceMutation) ResetSeq() {
-	m.seq = nil
-	m.addseq = nil
+// ResetAttemptID resets all changes to the "attempt_id" field.
+func (m *AnswerMutation) ResetAttemptID() {
+	m.attempt = nil
 }
 
-// SetProblemTranslationID sets the "problem_translation_id" field.
-func (m *ChoiceMutation) SetProblemTranslationID(i int) {
-	m.problem_translation = &i
+// SetProblemID sets the "problem_id" field.
+func (m *AnswerMutation) SetProblemID(i int) {
+	m.problem = &i
 }
 
-// ProblemTranslationID returns the value of the "problem_translation_id" field in the mutation.
-func (m *ChoiceMutation) ProblemTranslationID() (r int, exists bool) {
-	v := m.problem_translation
+// ProblemID returns the value of the "problem_id" field in the mutation.
+func (m *AnswerMutation) ProblemID() (r int, exists bool) {
+	v := m.problem
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldProblemTranslationID returns the old "problem_translation_id" field's value of the Choice entity.
-// If the Choice object wasn't provided to the builder, the object is fetched from the database.
+// OldProblemID returns the old "problem_id" field's value of the Answer entity.
+// If the Answer object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ChoiceMutation) OldProblemTranslationID(ctx context.Context) (v int, err error) {
+func (m *AnswerMutation) OldProblemID(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldProblemTranslationID is only allowed on UpdateOne operations")
+		return v, errors.New("OldProblemID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldProblemTranslationID requires an ID field in the mutation")
+		return v, errors.New("OldProblemID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldProblemTranslationID: %w", err)
+		return v, fmt.Errorf("querying old value for OldProblemID: %w", err)
 	}
-	return oldValue.ProblemTranslationID, nil
+	return oldValue.ProblemID, nil
 }
 
-// ResetProblemTranslationID resets all changes to the "problem_translation_id" field.
-func (m *ChoiceMutation) ResetProblemTranslationID() {
-	m.problem_translation = nil
+// ResetProblemID resets all changes to the "problem_id" field.
+func (m *AnswerMutation) ResetProblemID() {
+	m.problem = nil
 }
 
-// ClearProblemTranslation clears the "problem_translation" edge to the ProblemTranslation entity.
-func (m *ChoiceMutation) ClearProblemTranslation() {
-	m.clearedproblem_translation = true
-	m.clearedFields[choice.FieldProblemTranslationID] = struct{}{}
+// ClearAttempt clears the "attempt" edge to the Attempt entity.
+func (m *AnswerMutation) ClearAttempt() {
+	m.clearedattempt = true
+	m.clearedFields[answer.FieldAttemptID] = struct{}{}
 }
 
-// ProblemTranslationCleared reports if the "problem_translation" edge to the ProblemTranslation entity was cleared.
-func (m *ChoiceMutation) ProblemTranslationCleared() bool {
-	return m.clearedproblem_translation
+// AttemptCleared reports if the "attempt" edge to the Attempt entity was cleared.
+func (m *AnswerMutation) AttemptCleared() bool {
+	return m.clearedattempt
 }
 
-// ProblemTranslationIDs returns the "problem_translation" edge IDs in the mutation.
+// AttemptIDs returns the "attempt" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// ProblemTranslationID instead. It exists only for internal usage by the builders.
-func (m *ChoiceMutation) ProblemTranslationIDs() (ids []int) {
-	if id := m.problem_translation; id != nil {
+// AttemptID instead. It exists only for internal usage by the builders.
+func (m *AnswerMutation) AttemptIDs() (ids []int) {
+	if id := m.attempt; id != nil {
 		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetProblemTranslation resets all changes to the "problem_translation" edge.
-func (m *ChoiceMutation) ResetProblemTranslation() {
-	m.problem_translation = nil
-	m.clearedproblem_translation = false
+// ResetAttempt resets all changes to the "attempt" edge.
+func (m *AnswerMutation) ResetAttempt() {
+	m.attempt = nil
+	m.clearedattempt = false
 }
 
-// Where appends a list predicates to the ChoiceMutation builder.
-func (m *ChoiceMutation) Where(ps ...predicate.Choice) {
-	m.predicates = append(m.predicates, ps...)
+// ClearProblem clears the "problem" edge to the Problem entity.
+func (m *AnswerMutation) ClearProblem() {
+	m.clearedproblem = true
+	m.clearedFields[answer.FieldProblemID] = struct{}{}
 }
 
-// WhereP appends storage-level predicates to the ChoiceMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *ChoiceMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Choice, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
+// ProblemCleared reports if the "problem" edge to the Problem entity was cleared.
+func (m *AnswerMutation) ProblemCleared() bool {
+	return m.clearedproblem
+}
+
+// ProblemIDs returns the "problem" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ProblemID instead. It exists only for internal usage by the builders.
+func (m *AnswerMutation) ProblemIDs() (ids []int) {
+	if id := m.problem; id != nil {
+		ids = append(ids, *id)
 	}
-	m.Where(p...)
+	return
 }
 
-// Op returns the operation name.
-func (m *ChoiceMutation) Op() Op {
-	return m.op
+// ResetProblem resets all changes to the "problem" edge.
+func (m *AnswerMutation) ResetProblem() {
+	m.problem = nil
+	m.clearedproblem = false
 }
 
-// SetOp allows setting the mutation operation.
-func (m *ChoiceMutation) SetOp(op Op) {
-	m.op = op
+// AddChoiceIDs adds the "choices" edge to the Choice entity by ids.
+func (m *AnswerMutation) AddChoiceIDs(ids ...int) {
+	if m.choices == nil {
+		m.choices = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.choices[ids[i]] = struct{}{}
+	}
 }
 
-// Type returns the node type of this mutation (Choice).
-func (m *ChoiceMutation) Type() string {
-	return m.typ
+// ClearChoices clears the "choices" edge to the Choice entity.
+func (m *AnswerMutation) ClearChoices() {
+	m.clearedchoices = true
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *ChoiceMutation) Fields() []string {
-	fields := make([]string, 0, 5)
-	if m.content != nil {
-		fields = append(fields, choice.FieldContent)
+// ChoicesCleared reports if the "choices" edge to the Choice entity was cleared.
+func (m *AnswerMutation) ChoicesCleared() bool {
+	return m.clearedchoices
+}
+
+// RemoveChoiceIDs removes the "choices" edge to the Choice entity by IDs.
+func (m *AnswerMutation) RemoveChoiceIDs(ids ...int) {
+	if m.removedchoices == nil {
+		m.removedchoices = make(map[int]struct{})
 	}
-	if m.is_correct != nil {
-		fields = append(fields, choice.FieldIsCorrect)
+	for i := range ids {
+		delete(m.choices, ids[i])
+		m.removedchoices[ids[i]] = struct{}{}
 	}
-	if m.explanation != nil {
-		fields = append(fields, choice.FieldExplanation)
+}
+
+// RemovedChoices returns the removed IDs of the "choices" edge to the Choice entity.
+func (m *AnswerMutation) RemovedChoicesIDs() (ids []int) {
+	for id := range m.removedchoices {
+		ids = append(ids, id)
 	}
-	if m.seq != nil {
-		fields = append(fields, choice.FieldSeq)
+	return
+}
+
+// ChoicesIDs returns the "choices" edge IDs in the mutation.
+func (m *AnswerMutation) ChoicesIDs() (ids []int) {
+	for id := range m.choices {
+		ids = append(ids, id)
 	}
-	if m.problem_translation != nil {
-		fields = append(fields, choice.FieldProblemTranslationID)
+	return
+}
+
+// ResetChoices resets all changes to the "choices" edge.
+func (m *AnswerMutation) ResetChoices() {
+	m.choices = nil
+	m.clearedchoices = false
+	m.removedchoices = nil
+}
+
+// Where appends a list predicates to the AnswerMutation builder.
+func (m *AnswerMutation) Where(ps ...predicate.Answer) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the AnswerMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *AnswerMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Answer, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *AnswerMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *AnswerMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Answer).
+func (m *AnswerMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *AnswerMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.updated_at != nil {
+		fields = append(fields, answer.FieldUpdatedAt)
+	}
+	if m.response != nil {
+		fields = append(fields, answer.FieldResponse)
+	}
+	if m.choice_order != nil {
+		fields = append(fields, answer.FieldChoiceOrder)
+	}
+	if m.attempt != nil {
+		fields = append(fields, answer.FieldAttemptID)
+	}
+	if m.problem != nil {
+		fields = append(fields, answer.FieldProblemID)
 	}
 	return fields
 }
@@ -454,18 +562,18 @@ func (m *ChoiceMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *ChoiceMutation) Field(name string) (ent.Value, bool) {
+func (m *AnswerMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case choice.FieldContent:
-		return m.Content()
-	case choice.FieldIsCorrect:
-		return m.IsCorrect()
-	case choice.FieldExplanation:
-		return m.Explanation()
-	case choice.FieldSeq:
-		return m.Seq()
-	case choice.FieldProblemTranslationID:
-		return m.ProblemTranslationID()
+	case answer.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case answer.FieldResponse:
+		return m.Response()
+	case answer.FieldChoiceOrder:
+		return m.ChoiceOrder()
+	case answer.FieldAttemptID:
+		return m.AttemptID()
+	case answer.FieldProblemID:
+		return m.ProblemID()
 	}
 	return nil, false
 }
@@ -473,83 +581,78 @@ func (m *ChoiceMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *ChoiceMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *AnswerMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case choice.FieldContent:
-		return m.OldContent(ctx)
-	case choice.FieldIsCorrect:
-		return m.OldIsCorrect(ctx)
-	case choice.FieldExplanation:
-		return m.OldExplanation(ctx)
-	case choice.FieldSeq:
-		return m.OldSeq(ctx)
-	case choice.FieldProblemTranslationID:
-		return m.OldProblemTranslationID(ctx)
+	case answer.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case answer.FieldResponse:
+		return m.OldResponse(ctx)
+	case answer.FieldChoiceOrder:
+		return m.OldChoiceOrder(ctx)
+	case answer.FieldAttemptID:
+		return m.OldAttemptID(ctx)
+	case answer.FieldProblemID:
+		return m.OldProblemID(ctx)
 	}
-	return nil, fmt.Errorf("unknown Choice field %s", name)
+	return nil, fmt.Errorf("unknown Answer field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ChoiceMutation) SetField(name string, value ent.Value) error {
+func (m *AnswerMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case choice.FieldContent:
-		v, ok := value.(string)
+	case answer.FieldUpdatedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetContent(v)
+		m.SetUpdatedAt(v)
 		return nil
-	case choice.FieldIsCorrect:
-		v, ok := value.(bool)
+	case answer.FieldResponse:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetIsCorrect(v)
+		m.SetResponse(v)
 		return nil
-	case choice.FieldExplanation:
-		v, ok := value.(string)
+	case answer.FieldChoiceOrder:
+		v, ok := value.([]int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetExplanation(v)
+		m.SetChoiceOrder(v)
 		return nil
-	case choice.FieldSeq:
+	case answer.FieldAttemptID:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSeq(v)
+		m.SetAttemptID(v)
 		return nil
-	case choice.FieldProblemTranslationID:
+	case answer.FieldProblemID:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetProblemTranslationID(v)
+		m.SetProblemID(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Choice field %s", name)
+	return fmt.Errorf("unknown Answer field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *ChoiceMutation) AddedFields() []string {
+func (m *AnswerMutation) AddedFields() []string {
 	var fields []string
-	if m.addseq != nil {
-		fields = append(fields, choice.FieldSeq)
-	}
 	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *ChoiceMutation) AddedField(name string) (ent.Value, bool) {
+func (m *AnswerMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case choice.FieldSeq:
-		return m.AddedSeq()
 	}
 	return nil, false
 }
@@ -557,184 +660,218 @@ func (m *ChoiceMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ChoiceMutation) AddField(name string, value ent.Value) error {
+func (m *AnswerMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case choice.FieldSeq:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddSeq(v)
-		return nil
 	}
-	return fmt.Errorf("unknown Choice numeric field %s", name)
+	return fmt.Errorf("unknown Answer numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *ChoiceMutation) ClearedFields() []string {
+func (m *AnswerMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(choice.FieldExplanation) {
-		fields = append(fields, choice.FieldExplanation)
+	if m.FieldCleared(answer.FieldResponse) {
+		fields = append(fields, answer.FieldResponse)
+	}
+	if m.FieldCleared(answer.FieldChoiceOrder) {
+		fields = append(fields, answer.FieldChoiceOrder)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *ChoiceMutation) FieldCleared(name string) bool {
+func (m *AnswerMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *ChoiceMutation) ClearField(name string) error {
+func (m *AnswerMutation) ClearField(name string) error {
 	switch name {
-	case choice.FieldExplanation:
-		m.ClearExplanation()
+	case answer.FieldResponse:
+		m.ClearResponse()
+		return nil
+	case answer.FieldChoiceOrder:
+		m.ClearChoiceOrder()
 		return nil
 	}
-	return fmt.Errorf("unknown Choice nullable field %s", name)
+	return fmt.Errorf("unknown Answer nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *ChoiceMutation) ResetField(name string) error {
+func (m *AnswerMutation) ResetField(name string) error {
 	switch name {
-	case choice.FieldContent:
-		m.ResetContent()
+	case answer.FieldUpdatedAt:
+		m.ResetUpdatedAt()
 		return nil
-	case choice.FieldIsCorrect:
-		m.ResetIsCorrect()
+	case answer.FieldResponse:
+		m.ResetResponse()
 		return nil
-	case choice.FieldExplanation:
-		m.ResetExplanation()
+	case answer.FieldChoiceOrder:
+		m.ResetChoiceOrder()
 		return nil
-	case choice.FieldSeq:
-		m.ResetSeq()
+	case answer.FieldAttemptID:
+		m.ResetAttemptID()
 		return nil
-	case choice.FieldProblemTranslationID:
-		m.ResetProblemTranslationID()
+	case answer.FieldProblemID:
+		m.ResetProblemID()
 		return nil
 	}
-	return fmt.Errorf("unknown Choice field %s", name)
+	return fmt.Errorf("unknown Answer field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *ChoiceMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.problem_translation != nil {
-		edges = append(edges, choice.EdgeProblemTranslation)
+func (m *AnswerMutation) AddedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.attempt != nil {
+		edges = append(edges, answer.EdgeAttempt)
+	}
+	if m.problem != nil {
+		edges = append(edges, answer.EdgeProblem)
+	}
+	if m.choices != nil {
+		edges = append(edges, answer.EdgeChoices)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *ChoiceMutation) AddedIDs(name string) []ent.Value {
+func (m *AnswerMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case choice.EdgeProblemTranslation:
-		if id := m.problem_translation; id != nil {
+	case answer.EdgeAttempt:
+		if id := m.attempt; id != nil {
+			return []ent.Value{*id}
+		}
+	case answer.EdgeProblem:
+		if id := m.problem; id != nil {
 			return []ent.Value{*id}
 		}
+	case answer.EdgeChoices:
+		ids := make([]ent.Value, 0, len(m.choices))
+		for id := range m.choices {
+			ids = append(ids, id)
+		}
+		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *ChoiceMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
+func (m *AnswerMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.removedchoices != nil {
+		edges = append(edges, answer.EdgeChoices)
+	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *ChoiceMutation) RemovedIDs(name string) []ent.Value {
+func (m *AnswerMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case answer.EdgeChoices:
+		ids := make([]ent.Value, 0, len(m.removedchoices))
+		for id := range m.removedchoices {
+			ids = append(ids, id)
+		}
+		return ids
+	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *ChoiceMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.clearedproblem_translation {
-		edges = append(edges, choice.EdgeProblemTranslation)
+func (m *AnswerMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.clearedattempt {
+		edges = append(edges, answer.EdgeAttempt)
+	}
+	if m.clearedproblem {
+		edges = append(edges, answer.EdgeProblem)
+	}
+	if m.clearedchoices {
+		edges = append(edges, answer.EdgeChoices)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *ChoiceMutation) EdgeCleared(name string) bool {
+func (m *AnswerMutation) EdgeCleared(name string) bool {
 	switch name {
-	case choice.EdgeProblemTranslation:
-		return m.clearedproblem_translation
+	case answer.EdgeAttempt:
+		return m.clearedattempt
+	case answer.EdgeProblem:
+		return m.clearedproblem
+	case answer.EdgeChoices:
+		return m.clearedchoices
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *ChoiceMutation) ClearEdge(name string) error {
+func (m *AnswerMutation) ClearEdge(name string) error {
 	switch name {
-	case choice.EdgeProblemTranslation:
-		m.ClearProblemTranslation()
+	case answer.EdgeAttempt:
+		m.ClearAttempt()
+		return nil
+	case answer.EdgeProblem:
+		m.ClearProblem()
 		return nil
 	}
-	return fmt.Errorf("unknown Choice unique edge %s", name)
+	return fmt.Errorf("unknown Answer unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *ChoiceMutation) ResetEdge(name string) error {
+func (m *AnswerMutation) ResetEdge(name string) error {
 	switch name {
-	case choice.EdgeProblemTranslation:
-		m.ResetProblemTranslation()
+	case answer.EdgeAttempt:
+		m.ResetAttempt()
+		return nil
+	case answer.EdgeProblem:
+		m.ResetProblem()
+		return nil
+	case answer.EdgeChoices:
+		m.ResetChoices()
 		return nil
 	}
-	return fmt.Errorf("unknown Choice edge %s", name)
+	return fmt.Errorf("unknown Answer edge %s", name)
 }
 
-// ExamMutation represents an operation that mutates the Exam nodes in the graph.
-type ExamMutation struct {
+// AssetMutation represents an operation that mutates the Asset nodes in the graph.
+type AssetMutation struct {
 	config
-	op                   Op
-	typ                  string
-	id                   *int
-	title                *string
-	description          *string
-	time_limit           *int
-	addtime_limit        *int
-	is_active            *bool
-	clearedFields        map[string]struct{}
-	sections             map[int]struct{}
-	removedsections      map[int]struct{}
-	clearedsections      bool
-	topics               map[int]struct{}
-	removedtopics        map[int]struct{}
-	clearedtopics        bool
-	units                map[int]struct{}
-	removedunits         map[int]struct{}
-	clearedunits         bool
-	version_rules        map[int]struct{}
-	removedversion_rules map[int]struct{}
-	clearedversion_rules bool
-	done                 bool
-	oldValue             func(context.Context) (*Exam, error)
-	predicates           []predicate.Exam
+	op            Op
+	typ           string
+	id            *int
+	hash          *string
+	name          *string
+	content_type  *string
+	size          *int64
+	addsize       *int64
+	created_at    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*Asset, error)
+	predicates    []predicate.Asset
 }
 
-var _ ent.Mutation = (*ExamMutation)(nil)
+var _ ent.Mutation = (*AssetMutation)(nil)
 
-// examOption allows management of the mutation configuration using functional options.
-type examOption func(*ExamMutation)
+// assetOption allows management of the mutation configuration using functional options.
+type assetOption func(*AssetMutation)
 
-// newExamMutation creates new mutation for the Exam entity.
-func newExamMutation(c config, op Op, opts ...examOption) *ExamMutation {
-	m := &ExamMutation{
+// newAssetMutation creates new mutation for the Asset entity.
+func newAssetMutation(c config, op Op, opts ...assetOption) *AssetMutation {
+	m := &AssetMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeExam,
+		typ:           TypeAsset,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -743,20 +880,20 @@ func newExamMutation(c config, op Op, opts ...examOption) *ExamMutation {
 	return m
 }
 
-// withExamID sets the ID field of the mutation.
-func withExamID(id int) examOption {
-	return func(m *ExamMutation) {
+// withAssetID sets the ID field of the mutation.
+func withAssetID(id int) assetOption {
+	return func(m *AssetMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Exam
+			value *Asset
 		)
-		m.oldValue = func(ctx context.Context) (*Exam, error) {
+		m.oldValue = func(ctx context.Context) (*Asset, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Exam.Get(ctx, id)
+					value, err = m.Client().Asset.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -765,10 +902,10 @@ func withExamID(id int) examOption {
 	}
 }
 
-// withExam sets the old Exam of the mutation.
-func withExam(node *Exam) examOption {
-	return func(m *ExamMutation) {
-		m.oldValue = func(context.Context) (*Exam, error) {
+// withAsset sets the old Asset of the mutation.
+func withAsset(node *Asset) assetOption {
+	return func(m *AssetMutation) {
+		m.oldValue = func(context.Context) (*Asset, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -777,7 +914,7 @@ func withExam(node *Exam) examOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m ExamMutation) Client() *Client {
+func (m AssetMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -785,7 +922,7 @@ func (m ExamMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m ExamMutation) Tx() (*Tx, error) {
+func (m AssetMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -796,7 +933,7 @@ func (m ExamMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *ExamMutation) ID() (id int, exists bool) {
+func (m *AssetMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -807,7 +944,7 @@ func (m *ExamMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *ExamMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *AssetMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -816,295 +953,3722 @@ func (m *ExamMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Exam.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Asset.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetTitle sets the "title" field.
-func (m *ExamMutation) SetTitle(s string) {
-	m.title = &s
+// SetHash sets the "hash" field.
+func (m *AssetMutation) SetHash(s string) {
+	m.hash = &s
 }
 
-// Title returns the value of the "title" field in the mutation.
-func (m *ExamMutation) Title() (r string, exists bool) {
-	v := m.title
+// Hash returns the value of the "hash" field in the mutation.
+func (m *AssetMutation) Hash() (r string, exists bool) {
+	v := m.hash
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTitle returns the old "title" field's value of the Exam entity.
-// If the Exam object wasn't provided to the builder, the object is fetched from the database.
+// OldHash returns the old "hash" field's value of the Asset entity.
+// If the Asset object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ExamMutation) OldTitle(ctx context.Context) (v string, err error) {
+func (m *AssetMutation) OldHash(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
+		return v, errors.New("OldHash is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTitle requires an ID field in the mutation")
+		return v, errors.New("OldHash requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
+		return v, fmt.Errorf("querying old value for OldHash: %w", err)
 	}
-	return oldValue.Title, nil
+	return oldValue.Hash, nil
 }
 
-// ResetTitle resets all changes to the "title" field.
-func (m *ExamMutation) ResetTitle() {
-	m.title = nil
+// ResetHash resets all changes to the "hash" field.
+func (m *AssetMutation) ResetHash() {
+	m.hash = nil
 }
 
-// SetDescription sets the "description" field.
-func (m *ExamMutation) SetDescription(s string) {
-	m.description = &s
+// SetName sets the "name" field.
+func (m *AssetMutation) SetName(s string) {
+	m.name = &s
 }
 
-// Description returns the value of the "description" field in the mutation.
-func (m *ExamMutation) Description() (r string, exists bool) {
-	v := m.description
+// Name returns the value of the "name" field in the mutation.
+func (m *AssetMutation) Name() (r string, exists bool) {
+	v := m.name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDescription returns the old "description" field's value of the Exam entity.
-// If the Exam object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the Asset entity.
+// If the Asset object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ExamMutation) OldDescription(ctx context.Context) (v string, err error) {
+func (m *AssetMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDescription requires an ID field in the mutation")
+		return v, errors.New("OldName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
-	return oldValue.Description, nil
+	return oldValue.Name, nil
 }
 
-// ClearDescription clears the value of the "description" field.
-func (m *ExamMutation) ClearDescription() {
-	m.description = nil
-	m.clearedFields[exam.FieldDescription] = struct{}{}
+// ResetName resets all changes to the "name" field.
+func (m *AssetMutation) ResetName() {
+	m.name = nil
 }
 
-// DescriptionCleared returns if the "description" field was cleared in this mutation.
-func (m *ExamMutation) DescriptionCleared() bool {
-	_, ok := m.clearedFields[exam.FieldDescription]
-	return ok
+// SetContentType sets the "content_type" field.
+func (m *AssetMutation) SetContentType(s string) {
+	m.content_type = &s
 }
 
-// ResetDescription resets all changes to the "description" field.
-func (m *ExamMutation) ResetDescription() {
-	m.description = nil
-	delete(m.clearedFields, exam.FieldDescription)
+// ContentType returns the value of the "content_type" field in the mutation.
+func (m *AssetMutation) ContentType() (r string, exists bool) {
+	v := m.content_type
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// SetTimeLimit sets the "time_limit" field.
-func (m *ExamMutation) SetTimeLimit(i int) {
-	m.time_limit = &i
-	m.addtime_limit = nil
+// OldContentType returns the old "content_type" field's value of the Asset entity.
+// If the Asset object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AssetMutation) OldContentType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldContentType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldContentType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldContentType: %w", err)
+	}
+	return oldValue.ContentType, nil
 }
 
-// TimeLimit returns the value of the "time_limit" field in the mutation.
-func (m *ExamMutation) TimeLimit() (r int, exists bool) {
-	v := m.time_limit
+// ResetContentType resets all changes to the "content_type" field.
+func (m *AssetMutation) ResetContentType() {
+	m.content_type = nil
+}
+
+// SetSize sets the "size" field.
+func (m *AssetMutation) SetSize(i int64) {
+	m.size = &i
+	m.addsize = nil
+}
+
+// Size returns the value of the "size" field in the mutation.
+func (m *AssetMutation) Size() (r int64, exists bool) {
+	v := m.size
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTimeLimit returns the old "time_limit" field's value of the Exam entity.
-// If the Exam object wasn't provided to the builder, the object is fetched from the database.
+// OldSize returns the old "size" field's value of the Asset entity.
+// If the Asset object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ExamMutation) OldTimeLimit(ctx context.Context) (v int, err error) {
+func (m *AssetMutation) OldSize(ctx context.Context) (v int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTimeLimit is only allowed on UpdateOne operations")
+		return v, errors.New("OldSize is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTimeLimit requires an ID field in the mutation")
+		return v, errors.New("OldSize requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTimeLimit: %w", err)
+		return v, fmt.Errorf("querying old value for OldSize: %w", err)
 	}
-	return oldValue.TimeLimit, nil
+	return oldValue.Size, nil
 }
 
-// AddTimeLimit adds i to the "time_limit" field.
-func (m *ExamMutation) AddTimeLimit(i int) {
-	if m.addtime_limit != nil {
-		*m.addtime_limit += i
+// AddSize adds i to the "size" field.
+func (m *AssetMutation) AddSize(i int64) {
+	if m.addsize != nil {
+		*m.addsize += i
 	} else {
-		m.addtime_limit = &i
+		m.addsize = &i
 	}
 }
 
-// AddedTimeLimit returns the value that was added to the "time_limit" field in this mutation.
-func (m *ExamMutation) AddedTimeLimit() (r int, exists bool) {
-	v := m.addtime_limit
+// AddedSize returns the value that was added to the "size" field in this mutation.
+func (m *AssetMutation) AddedSize() (r int64, exists bool) {
+	v := m.addsize
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetTimeLimit resets all changes to the "time_limit" field.
-func (m *ExamMutation) ResetTimeLimit() {
-	m.time_limit = nil
-	m.addtime_limit = nil
+// ResetSize resets all changes to the "size" field.
+func (m *AssetMutation) ResetSize() {
+	m.size = nil
+	m.addsize = nil
 }
 
-// SetIsActive sets the "is_active" field.
-func (m *ExamMutation) SetIsActive(b bool) {
-	m.is_active = &b
+// SetCreatedAt sets the "created_at" field.
+func (m *AssetMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// IsActive returns the value of the "is_active" field in the mutation.
-func (m *ExamMutation) IsActive() (r bool, exists bool) {
-	v := m.is_active
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *AssetMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldIsActive returns the old "is_active" field's value of the Exam entity.
-// If the Exam object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the Asset entity.
+// If the Asset object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ExamMutation) OldIsActive(ctx context.Context) (v bool, err error) {
+func (m *AssetMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIsActive is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIsActive requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIsActive: %w", err)
-	}
-	return oldValue.IsActive, nil
-}
-
-// ResetIsActive resets all changes to the "is_active" field.
-func (m *ExamMutation) ResetIsActive() {
-	m.is_active = nil
-}
-
-// AddSectionIDs adds the "sections" edge to the Section entity by ids.
-func (m *ExamMutation) AddSectionIDs(ids ...int) {
-	if m.sections == nil {
-		m.sections = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.sections[ids[i]] = struct{}{}
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
+	return oldValue.CreatedAt, nil
 }
 
-// ClearSections clears the "sections" edge to the Section entity.
-func (m *ExamMutation) ClearSections() {
-	m.clearedsections = true
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *AssetMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// SectionsCleared reports if the "sections" edge to the Section entity was cleared.
-func (m *ExamMutation) SectionsCleared() bool {
-	return m.clearedsections
+// Where appends a list predicates to the AssetMutation builder.
+func (m *AssetMutation) Where(ps ...predicate.Asset) {
+	m.predicates = append(m.predicates, ps...)
 }
 
-// RemoveSectionIDs removes the "sections" edge to the Section entity by IDs.
-func (m *ExamMutation) RemoveSectionIDs(ids ...int) {
-	if m.removedsections == nil {
-		m.removedsections = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.sections, ids[i])
-		m.removedsections[ids[i]] = struct{}{}
+// WhereP appends storage-level predicates to the AssetMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *AssetMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Asset, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
 	}
+	m.Where(p...)
 }
 
-// RemovedSections returns the removed IDs of the "sections" edge to the Section entity.
-func (m *ExamMutation) RemovedSectionsIDs() (ids []int) {
-	for id := range m.removedsections {
-		ids = append(ids, id)
-	}
-	return
+// Op returns the operation name.
+func (m *AssetMutation) Op() Op {
+	return m.op
 }
 
-// SectionsIDs returns the "sections" edge IDs in the mutation.
-func (m *ExamMutation) SectionsIDs() (ids []int) {
-	for id := range m.sections {
-		ids = append(ids, id)
-	}
-	return
+// SetOp allows setting the mutation operation.
+func (m *AssetMutation) SetOp(op Op) {
+	m.op = op
 }
 
-// ResetSections resets all changes to the "sections" edge.
-func (m *ExamMutation) ResetSections() {
-	m.sections = nil
-	m.clearedsections = false
-	m.removedsections = nil
+// Type returns the node type of this mutation (Asset).
+func (m *AssetMutation) Type() string {
+	return m.typ
 }
 
-// AddTopicIDs adds the "topics" edge to the Topic entity by ids.
-func (m *ExamMutation) AddTopicIDs(ids ...int) {
-	if m.topics == nil {
-		m.topics = make(map[int]struct{})
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *AssetMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.hash != nil {
+		fields = append(fields, asset.FieldHash)
 	}
-	for i := range ids {
-		m.topics[ids[i]] = struct{}{}
+	if m.name != nil {
+		fields = append(fields, asset.FieldName)
+	}
+	if m.content_type != nil {
+		fields = append(fields, asset.FieldContentType)
 	}
+	if m.size != nil {
+		fields = append(fields, asset.FieldSize)
+	}
+	if m.created_at != nil {
+		fields = append(fields, asset.FieldCreatedAt)
+	}
+	return fields
 }
 
-// ClearTopics clears the "topics" edge to the Topic entity.
-func (m *ExamMutation) ClearTopics() {
-	m.clearedtopics = true
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *AssetMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case asset.FieldHash:
+		return m.Hash()
+	case asset.FieldName:
+		return m.Name()
+	case asset.FieldContentType:
+		return m.ContentType()
+	case asset.FieldSize:
+		return m.Size()
+	case asset.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
 }
 
-// TopicsCleared reports if the "topics" edge to the Topic entity was cleared.
-func (m *ExamMutation) TopicsCleared() bool {
-	return m.clearedtopics
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *AssetMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case asset.FieldHash:
+		return m.OldHash(ctx)
+	case asset.FieldName:
+		return m.OldName(ctx)
+	case asset.FieldContentType:
+		return m.OldContentType(ctx)
+	case asset.FieldSize:
+		return m.OldSize(ctx)
+	case asset.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Asset field %s", name)
 }
 
-// RemoveTopicIDs removes the "topics" edge to the Topic entity by IDs.
-func (m *ExamMutation) RemoveTopicIDs(ids ...int) {
-	if m.removedtopics == nil {
-		m.removedtopics = make(map[int]struct{})
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *AssetMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case asset.FieldHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHash(v)
+		return nil
+	case asset.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case asset.FieldContentType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetContentType(v)
+		return nil
+	case asset.FieldSize:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSize(v)
+		return nil
+	case asset.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
 	}
-	for i := range ids {
-		delete(m.topics, ids[i])
-		m.removedtopics[ids[i]] = struct{}{}
+	return fmt.Errorf("unknown Asset field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *AssetMutation) AddedFields() []string {
+	var fields []string
+	if m.addsize != nil {
+		fields = append(fields, asset.FieldSize)
 	}
+	return fields
 }
 
-// RemovedTopics returns the removed IDs of the "topics" edge to the Topic entity.
-func (m *ExamMutation) RemovedTopicsIDs() (ids []int) {
-	for id := range m.removedtopics {
-		ids = append(ids, id)
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *AssetMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case asset.FieldSize:
+		return m.AddedSize()
 	}
-	return
+	return nil, false
 }
 
-// TopicsIDs returns the "topics" edge IDs in the mutation.
-func (m *ExamMutation) TopicsIDs() (ids []int) {
-	for id := range m.topics {
-		ids = append(ids, id)
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *AssetMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case asset.FieldSize:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSize(v)
+		return nil
 	}
-	return
+	return fmt.Errorf("unknown Asset numeric field %s", name)
 }
 
-// ResetTopics resets all changes to the "topics" edge.
-func (m *ExamMutation) ResetTopics() {
-	m.topics = nil
-	m.clearedtopics = false
-	m.removedtopics = nil
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *AssetMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *AssetMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *AssetMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Asset nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *AssetMutation) ResetField(name string) error {
+	switch name {
+	case asset.FieldHash:
+		m.ResetHash()
+		return nil
+	case asset.FieldName:
+		m.ResetName()
+		return nil
+	case asset.FieldContentType:
+		m.ResetContentType()
+		return nil
+	case asset.FieldSize:
+		m.ResetSize()
+		return nil
+	case asset.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Asset field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *AssetMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *AssetMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *AssetMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *AssetMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *AssetMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *AssetMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *AssetMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Asset unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *AssetMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Asset edge %s", name)
+}
+
+// AttemptMutation represents an operation that mutates the Attempt nodes in the graph.
+type AttemptMutation struct {
+	config
+	op               Op
+	typ              string
+	id               *int
+	status           *attempt.Status
+	locale           *string
+	started_at       *time.Time
+	expires_at       *time.Time
+	submitted_at     *time.Time
+	auto_submitted   *bool
+	edition_year     *int
+	addedition_year  *int
+	edition_round    *int
+	addedition_round *int
+	edition_category *string
+	clearedFields    map[string]struct{}
+	exam             *int
+	clearedexam      bool
+	user             *int
+	cleareduser      bool
+	answers          map[int]struct{}
+	removedanswers   map[int]struct{}
+	clearedanswers   bool
+	items            map[int]struct{}
+	removeditems     map[int]struct{}
+	cleareditems     bool
+	done             bool
+	oldValue         func(context.Context) (*Attempt, error)
+	predicates       []predicate.Attempt
+}
+
+var _ ent.Mutation = (*AttemptMutation)(nil)
+
+// attemptOption allows management of the mutation configuration using functional options.
+type attemptOption func(*AttemptMutation)
+
+// newAttemptMutation creates new mutation for the Attempt entity.
+func newAttemptMutation(c config, op Op, opts ...attemptOption) *AttemptMutation {
+	m := &AttemptMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeAttempt,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withAttemptID sets the ID field of the mutation.
+func withAttemptID(id int) attemptOption {
+	return func(m *AttemptMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Attempt
+		)
+		m.oldValue = func(ctx context.Context) (*Attempt, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Attempt.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withAttempt sets the old Attempt of the mutation.
+func withAttempt(node *Attempt) attemptOption {
+	return func(m *AttemptMutation) {
+		m.oldValue = func(context.Context) (*Attempt, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m AttemptMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m AttemptMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *AttemptMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *AttemptMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Attempt.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetStatus sets the "status" field.
+func (m *AttemptMutation) SetStatus(a attempt.Status) {
+	m.status = &a
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *AttemptMutation) Status() (r attempt.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the Attempt entity.
+// If the Attempt object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AttemptMutation) OldStatus(ctx context.Context) (v attempt.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *AttemptMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetLocale sets the "locale" field.
+func (m *AttemptMutation) SetLocale(s string) {
+	m.locale = &s
+}
+
+// Locale returns the value of the "locale" field in the mutation.
+func (m *AttemptMutation) Locale() (r string, exists bool) {
+	v := m.locale
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLocale returns the old "locale" field's value of the Attempt entity.
+// If the Attempt object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AttemptMutation) OldLocale(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLocale is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLocale requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLocale: %w", err)
+	}
+	return oldValue.Locale, nil
+}
+
+// ResetLocale resets all changes to the "locale" field.
+func (m *AttemptMutation) ResetLocale() {
+	m.locale = nil
+}
+
+// SetStartedAt sets the "started_at" field.
+func (m *AttemptMutation) SetStartedAt(t time.Time) {
+	m.started_at = &t
+}
+
+// StartedAt returns the value of the "started_at" field in the mutation.
+func (m *AttemptMutation) StartedAt() (r time.Time, exists bool) {
+	v := m.started_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStartedAt returns the old "started_at" field's value of the Attempt entity.
+// If the Attempt object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AttemptMutation) OldStartedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStartedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStartedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStartedAt: %w", err)
+	}
+	return oldValue.StartedAt, nil
+}
+
+// ResetStartedAt resets all changes to the "started_at" field.
+func (m *AttemptMutation) ResetStartedAt() {
+	m.started_at = nil
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (m *AttemptMutation) SetExpiresAt(t time.Time) {
+	m.expires_at = &t
+}
+
+// ExpiresAt returns the value of the "expires_at" field in the mutation.
+func (m *AttemptMutation) ExpiresAt() (r time.Time, exists bool) {
+	v := m.expires_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpiresAt returns the old "expires_at" field's value of the Attempt entity.
+// If the Attempt object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AttemptMutation) OldExpiresAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
+	}
+	return oldValue.ExpiresAt, nil
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (m *AttemptMutation) ClearExpiresAt() {
+	m.expires_at = nil
+	m.clearedFields[attempt.FieldExpiresAt] = struct{}{}
+}
+
+// ExpiresAtCleared returns if the "expires_at" field was cleared in this mutation.
+func (m *AttemptMutation) ExpiresAtCleared() bool {
+	_, ok := m.clearedFields[attempt.FieldExpiresAt]
+	return ok
+}
+
+// ResetExpiresAt resets all changes to the "expires_at" field.
+func (m *AttemptMutation) ResetExpiresAt() {
+	m.expires_at = nil
+	delete(m.clearedFields, attempt.FieldExpiresAt)
+}
+
+// SetSubmittedAt sets the "submitted_at" field.
+func (m *AttemptMutation) SetSubmittedAt(t time.Time) {
+	m.submitted_at = &t
+}
+
+// SubmittedAt returns the value of the "submitted_at" field in the mutation.
+func (m *AttemptMutation) SubmittedAt() (r time.Time, exists bool) {
+	v := m.submitted_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSubmittedAt returns the old "submitted_at" field's value of the Attempt entity.
+// If the Attempt object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AttemptMutation) OldSubmittedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSubmittedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSubmittedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSubmittedAt: %w", err)
+	}
+	return oldValue.SubmittedAt, nil
+}
+
+// ClearSubmittedAt clears the value of the "submitted_at" field.
+func (m *AttemptMutation) ClearSubmittedAt() {
+	m.submitted_at = nil
+	m.clearedFields[attempt.FieldSubmittedAt] = struct{}{}
+}
+
+// SubmittedAtCleared returns if the "submitted_at" field was cleared in this mutation.
+func (m *AttemptMutation) SubmittedAtCleared() bool {
+	_, ok := m.clearedFields[attempt.FieldSubmittedAt]
+	return ok
+}
+
+// ResetSubmittedAt resets all changes to the "submitted_at" field.
+func (m *AttemptMutation) ResetSubmittedAt() {
+	m.submitted_at = nil
+	delete(m.clearedFields, attempt.FieldSubmittedAt)
+}
+
+// SetAutoSubmitted sets the "auto_submitted" field.
+func (m *AttemptMutation) SetAutoSubmitted(b bool) {
+	m.auto_submitted = &b
+}
+
+// AutoSubmitted returns the value of the "auto_submitted" field in the mutation.
+func (m *AttemptMutation) AutoSubmitted() (r bool, exists bool) {
+	v := m.auto_submitted
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAutoSubmitted returns the old "auto_submitted" field's value of the Attempt entity.
+// If the Attempt object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AttemptMutation) OldAutoSubmitted(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAutoSubmitted is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAutoSubmitted requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAutoSubmitted: %w", err)
+	}
+	return oldValue.AutoSubmitted, nil
+}
+
+// ResetAutoSubmitted resets all changes to the "auto_submitted" field.
+func (m *AttemptMutation) ResetAutoSubmitted() {
+	m.auto_submitted = nil
+}
+
+// SetEditionYear sets the "edition_year" field.
+func (m *AttemptMutation) SetEditionYear(i int) {
+	m.edition_year = &i
+	m.addedition_year = nil
+}
+
+// EditionYear returns the value of the "edition_year" field in the mutation.
+func (m *AttemptMutation) EditionYear() (r int, exists bool) {
+	v := m.edition_year
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEditionYear returns the old "edition_year" field's value of the Attempt entity.
+// If the Attempt object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AttemptMutation) OldEditionYear(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEditionYear is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEditionYear requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEditionYear: %w", err)
+	}
+	return oldValue.EditionYear, nil
+}
+
+// AddEditionYear adds i to the "edition_year" field.
+func (m *AttemptMutation) AddEditionYear(i int) {
+	if m.addedition_year != nil {
+		*m.addedition_year += i
+	} else {
+		m.addedition_year = &i
+	}
+}
+
+// AddedEditionYear returns the value that was added to the "edition_year" field in this mutation.
+func (m *AttemptMutation) AddedEditionYear() (r int, exists bool) {
+	v := m.addedition_year
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearEditionYear clears the value of the "edition_year" field.
+func (m *AttemptMutation) ClearEditionYear() {
+	m.edition_year = nil
+	m.addedition_year = nil
+	m.clearedFields[attempt.FieldEditionYear] = struct{}{}
+}
+
+// EditionYearCleared returns if the "edition_year" field was cleared in this mutation.
+func (m *AttemptMutation) EditionYearCleared() bool {
+	_, ok := m.clearedFields[attempt.FieldEditionYear]
+	return ok
+}
+
+// ResetEditionYear resets all changes to the "edition_year" field.
+func (m *AttemptMutation) ResetEditionYear() {
+	m.edition_year = nil
+	m.addedition_year = nil
+	delete(m.clearedFields, attempt.FieldEditionYear)
+}
+
+// SetEditionRound sets the "edition_round" field.
+func (m *AttemptMutation) SetEditionRound(i int) {
+	m.edition_round = &i
+	m.addedition_round = nil
+}
+
+// EditionRound returns the value of the "edition_round" field in the mutation.
+func (m *AttemptMutation) EditionRound() (r int, exists bool) {
+	v := m.edition_round
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEditionRound returns the old "edition_round" field's value of the Attempt entity.
+// If the Attempt object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AttemptMutation) OldEditionRound(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEditionRound is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEditionRound requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEditionRound: %w", err)
+	}
+	return oldValue.EditionRound, nil
+}
+
+// AddEditionRound adds i to the "edition_round" field.
+func (m *AttemptMutation) AddEditionRound(i int) {
+	if m.addedition_round != nil {
+		*m.addedition_round += i
+	} else {
+		m.addedition_round = &i
+	}
+}
+
+// AddedEditionRound returns the value that was added to the "edition_round" field in this mutation.
+func (m *AttemptMutation) AddedEditionRound() (r int, exists bool) {
+	v := m.addedition_round
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearEditionRound clears the value of the "edition_round" field.
+func (m *AttemptMutation) ClearEditionRound() {
+	m.edition_round = nil
+	m.addedition_round = nil
+	m.clearedFields[attempt.FieldEditionRound] = struct{}{}
+}
+
+// EditionRoundCleared returns if the "edition_round" field was cleared in this mutation.
+func (m *AttemptMutation) EditionRoundCleared() bool {
+	_, ok := m.clearedFields[attempt.FieldEditionRound]
+	return ok
+}
+
+// ResetEditionRound resets all changes to the "edition_round" field.
+func (m *AttemptMutation) ResetEditionRound() {
+	m.edition_round = nil
+	m.addedition_round = nil
+	delete(m.clearedFields, attempt.FieldEditionRound)
+}
+
+// SetEditionCategory sets the "edition_category" field.
+func (m *AttemptMutation) SetEditionCategory(s string) {
+	m.edition_category = &s
+}
+
+// EditionCategory returns the value of the "edition_category" field in the mutation.
+func (m *AttemptMutation) EditionCategory() (r string, exists bool) {
+	v := m.edition_category
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEditionCategory returns the old "edition_category" field's value of the Attempt entity.
+// If the Attempt object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AttemptMutation) OldEditionCategory(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEditionCategory is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEditionCategory requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEditionCategory: %w", err)
+	}
+	return oldValue.EditionCategory, nil
+}
+
+// ClearEditionCategory clears the value of the "edition_category" field.
+func (m *AttemptMutation) ClearEditionCategory() {
+	m.edition_category = nil
+	m.clearedFields[attempt.FieldEditionCategory] = struct{}{}
+}
+
+// EditionCategoryCleared returns if the "edition_category" field was cleared in this mutation.
+func (m *AttemptMutation) EditionCategoryCleared() bool {
+	_, ok := m.clearedFields[attempt.FieldEditionCategory]
+	return ok
+}
+
+// ResetEditionCategory resets all changes to the "edition_category" field.
+func (m *AttemptMutation) ResetEditionCategory() {
+	m.edition_category = nil
+	delete(m.clearedFields, attempt.FieldEditionCategory)
+}
+
+// SetExamID sets the "exam_id" field.
+func (m *AttemptMutation) SetExamID(i int) {
+	m.exam = &i
+}
+
+// ExamID returns the value of the "exam_id" field in the mutation.
+func (m *AttemptMutation) ExamID() (r int, exists bool) {
+	v := m.exam
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExamID returns the old "exam_id" field's value of the Attempt entity.
+// If the Attempt object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AttemptMutation) OldExamID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExamID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExamID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExamID: %w", err)
+	}
+	return oldValue.ExamID, nil
+}
+
+// ResetExamID resets all changes to the "exam_id" field.
+func (m *AttemptMutation) ResetExamID() {
+	m.exam = nil
+}
+
+// SetUserID sets the "user_id" field.
+func (m *AttemptMutation) SetUserID(i int) {
+	m.user = &i
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *AttemptMutation) UserID() (r int, exists bool) {
+	v := m.user
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the Attempt entity.
+// If the Attempt object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AttemptMutation) OldUserID(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (m *AttemptMutation) ClearUserID() {
+	m.user = nil
+	m.clearedFields[attempt.FieldUserID] = struct{}{}
+}
+
+// UserIDCleared returns if the "user_id" field was cleared in this mutation.
+func (m *AttemptMutation) UserIDCleared() bool {
+	_, ok := m.clearedFields[attempt.FieldUserID]
+	return ok
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *AttemptMutation) ResetUserID() {
+	m.user = nil
+	delete(m.clearedFields, attempt.FieldUserID)
+}
+
+// ClearExam clears the "exam" edge to the Exam entity.
+func (m *AttemptMutation) ClearExam() {
+	m.clearedexam = true
+	m.clearedFields[attempt.FieldExamID] = struct{}{}
+}
+
+// ExamCleared reports if the "exam" edge to the Exam entity was cleared.
+func (m *AttemptMutation) ExamCleared() bool {
+	return m.clearedexam
+}
+
+// ExamIDs returns the "exam" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ExamID instead. It exists only for internal usage by the builders.
+func (m *AttemptMutation) ExamIDs() (ids []int) {
+	if id := m.exam; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetExam resets all changes to the "exam" edge.
+func (m *AttemptMutation) ResetExam() {
+	m.exam = nil
+	m.clearedexam = false
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *AttemptMutation) ClearUser() {
+	m.cleareduser = true
+	m.clearedFields[attempt.FieldUserID] = struct{}{}
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *AttemptMutation) UserCleared() bool {
+	return m.UserIDCleared() || m.cleareduser
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *AttemptMutation) UserIDs() (ids []int) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *AttemptMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// AddAnswerIDs adds the "answers" edge to the Answer entity by ids.
+func (m *AttemptMutation) AddAnswerIDs(ids ...int) {
+	if m.answers == nil {
+		m.answers = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.answers[ids[i]] = struct{}{}
+	}
+}
+
+// ClearAnswers clears the "answers" edge to the Answer entity.
+func (m *AttemptMutation) ClearAnswers() {
+	m.clearedanswers = true
+}
+
+// AnswersCleared reports if the "answers" edge to the Answer entity was cleared.
+func (m *AttemptMutation) AnswersCleared() bool {
+	return m.clearedanswers
+}
+
+// RemoveAnswerIDs removes the "answers" edge to the Answer entity by IDs.
+func (m *AttemptMutation) RemoveAnswerIDs(ids ...int) {
+	if m.removedanswers == nil {
+		m.removedanswers = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.answers, ids[i])
+		m.removedanswers[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedAnswers returns the removed IDs of the "answers" edge to the Answer entity.
+func (m *AttemptMutation) RemovedAnswersIDs() (ids []int) {
+	for id := range m.removedanswers {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// AnswersIDs returns the "answers" edge IDs in the mutation.
+func (m *AttemptMutation) AnswersIDs() (ids []int) {
+	for id := range m.answers {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetAnswers resets all changes to the "answers" edge.
+func (m *AttemptMutation) ResetAnswers() {
+	m.answers = nil
+	m.clearedanswers = false
+	m.removedanswers = nil
+}
+
+// AddItemIDs adds the "items" edge to the AttemptItem entity by ids.
+func (m *AttemptMutation) AddItemIDs(ids ...int) {
+	if m.items == nil {
+		m.items = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.items[ids[i]] = struct{}{}
+	}
+}
+
+// ClearItems clears the "items" edge to the AttemptItem entity.
+func (m *AttemptMutation) ClearItems() {
+	m.cleareditems = true
+}
+
+// ItemsCleared reports if the "items" edge to the AttemptItem entity was cleared.
+func (m *AttemptMutation) ItemsCleared() bool {
+	return m.cleareditems
+}
+
+// RemoveItemIDs removes the "items" edge to the AttemptItem entity by IDs.
+func (m *AttemptMutation) RemoveItemIDs(ids ...int) {
+	if m.removeditems == nil {
+		m.removeditems = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.items, ids[i])
+		m.removeditems[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedItems returns the removed IDs of the "items" edge to the AttemptItem entity.
+func (m *AttemptMutation) RemovedItemsIDs() (ids []int) {
+	for id := range m.removeditems {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ItemsIDs returns the "items" edge IDs in the mutation.
+func (m *AttemptMutation) ItemsIDs() (ids []int) {
+	for id := range m.items {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetItems resets all changes to the "items" edge.
+func (m *AttemptMutation) ResetItems() {
+	m.items = nil
+	m.cleareditems = false
+	m.removeditems = nil
+}
+
+// Where appends a list predicates to the AttemptMutation builder.
+func (m *AttemptMutation) Where(ps ...predicate.Attempt) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the AttemptMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *AttemptMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Attempt, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *AttemptMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *AttemptMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Attempt).
+func (m *AttemptMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *AttemptMutation) Fields() []string {
+	fields := make([]string, 0, 11)
+	if m.status != nil {
+		fields = append(fields, attempt.FieldStatus)
+	}
+	if m.locale != nil {
+		fields = append(fields, attempt.FieldLocale)
+	}
+	if m.started_at != nil {
+		fields = append(fields, attempt.FieldStartedAt)
+	}
+	if m.expires_at != nil {
+		fields = append(fields, attempt.FieldExpiresAt)
+	}
+	if m.submitted_at != nil {
+		fields = append(fields, attempt.FieldSubmittedAt)
+	}
+	if m.auto_submitted != nil {
+		fields = append(fields, attempt.FieldAutoSubmitted)
+	}
+	if m.edition_year != nil {
+		fields = append(fields, attempt.FieldEditionYear)
+	}
+	if m.edition_round != nil {
+		fields = append(fields, attempt.FieldEditionRound)
+	}
+	if m.edition_category != nil {
+		fields = append(fields, attempt.FieldEditionCategory)
+	}
+	if m.exam != nil {
+		fields = append(fields, attempt.FieldExamID)
+	}
+	if m.user != nil {
+		fields = append(fields, attempt.FieldUserID)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *AttemptMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case attempt.FieldStatus:
+		return m.Status()
+	case attempt.FieldLocale:
+		return m.Locale()
+	case attempt.FieldStartedAt:
+		return m.StartedAt()
+	case attempt.FieldExpiresAt:
+		return m.ExpiresAt()
+	case attempt.FieldSubmittedAt:
+		return m.SubmittedAt()
+	case attempt.FieldAutoSubmitted:
+		return m.AutoSubmitted()
+	case attempt.FieldEditionYear:
+		return m.EditionYear()
+	case attempt.FieldEditionRound:
+		return m.EditionRound()
+	case attempt.FieldEditionCategory:
+		return m.EditionCategory()
+	case attempt.FieldExamID:
+		return m.ExamID()
+	case attempt.FieldUserID:
+		return m.UserID()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *AttemptMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case attempt.FieldStatus:
+		return m.OldStatus(ctx)
+	case attempt.FieldLocale:
+		return m.OldLocale(ctx)
+	case attempt.FieldStartedAt:
+		return m.OldStartedAt(ctx)
+	case attempt.FieldExpiresAt:
+		return m.OldExpiresAt(ctx)
+	case attempt.FieldSubmittedAt:
+		return m.OldSubmittedAt(ctx)
+	case attempt.FieldAutoSubmitted:
+		return m.OldAutoSubmitted(ctx)
+	case attempt.FieldEditionYear:
+		return m.OldEditionYear(ctx)
+	case attempt.FieldEditionRound:
+		return m.OldEditionRound(ctx)
+	case attempt.FieldEditionCategory:
+		return m.OldEditionCategory(ctx)
+	case attempt.FieldExamID:
+		return m.OldExamID(ctx)
+	case attempt.FieldUserID:
+		return m.OldUserID(ctx)
+	}
+	return nil, fmt.Errorf("unknown Attempt field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *AttemptMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case attempt.FieldStatus:
+		v, ok := value.(attempt.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case attempt.FieldLocale:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLocale(v)
+		return nil
+	case attempt.FieldStartedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStartedAt(v)
+		return nil
+	case attempt.FieldExpiresAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpiresAt(v)
+		return nil
+	case attempt.FieldSubmittedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSubmittedAt(v)
+		return nil
+	case attempt.FieldAutoSubmitted:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAutoSubmitted(v)
+		return nil
+	case attempt.FieldEditionYear:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEditionYear(v)
+		return nil
+	case attempt.FieldEditionRound:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEditionRound(v)
+		return nil
+	case attempt.FieldEditionCategory:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEditionCategory(v)
+		return nil
+	case attempt.FieldExamID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExamID(v)
+		return nil
+	case attempt.FieldUserID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Attempt field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *AttemptMutation) AddedFields() []string {
+	var fields []string
+	if m.addedition_year != nil {
+		fields = append(fields, attempt.FieldEditionYear)
+	}
+	if m.addedition_round != nil {
+		fields = append(fields, attempt.FieldEditionRound)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *AttemptMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case attempt.FieldEditionYear:
+		return m.AddedEditionYear()
+	case attempt.FieldEditionRound:
+		return m.AddedEditionRound()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *AttemptMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case attempt.FieldEditionYear:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddEditionYear(v)
+		return nil
+	case attempt.FieldEditionRound:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddEditionRound(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Attempt numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *AttemptMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(attempt.FieldExpiresAt) {
+		fields = append(fields, attempt.FieldExpiresAt)
+	}
+	if m.FieldCleared(attempt.FieldSubmittedAt) {
+		fields = append(fields, attempt.FieldSubmittedAt)
+	}
+	if m.FieldCleared(attempt.FieldEditionYear) {
+		fields = append(fields, attempt.FieldEditionYear)
+	}
+	if m.FieldCleared(attempt.FieldEditionRound) {
+		fields = append(fields, attempt.FieldEditionRound)
+	}
+	if m.FieldCleared(attempt.FieldEditionCategory) {
+		fields = append(fields, attempt.FieldEditionCategory)
+	}
+	if m.FieldCleared(attempt.FieldUserID) {
+		fields = append(fields, attempt.FieldUserID)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *AttemptMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *AttemptMutation) ClearField(name string) error {
+	switch name {
+	case attempt.FieldExpiresAt:
+		m.ClearExpiresAt()
+		return nil
+	case attempt.FieldSubmittedAt:
+		m.ClearSubmittedAt()
+		return nil
+	case attempt.FieldEditionYear:
+		m.ClearEditionYear()
+		return nil
+	case attempt.FieldEditionRound:
+		m.ClearEditionRound()
+		return nil
+	case attempt.FieldEditionCategory:
+		m.ClearEditionCategory()
+		return nil
+	case attempt.FieldUserID:
+		m.ClearUserID()
+		return nil
+	}
+	return fmt.Errorf("unknown Attempt nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *AttemptMutation) ResetField(name string) error {
+	switch name {
+	case attempt.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case attempt.FieldLocale:
+		m.ResetLocale()
+		return nil
+	case attempt.FieldStartedAt:
+		m.ResetStartedAt()
+		return nil
+	case attempt.FieldExpiresAt:
+		m.ResetExpiresAt()
+		return nil
+	case attempt.FieldSubmittedAt:
+		m.ResetSubmittedAt()
+		return nil
+	case attempt.FieldAutoSubmitted:
+		m.ResetAutoSubmitted()
+		return nil
+	case attempt.FieldEditionYear:
+		m.ResetEditionYear()
+		return nil
+	case attempt.FieldEditionRound:
+		m.ResetEditionRound()
+		return nil
+	case attempt.FieldEditionCategory:
+		m.ResetEditionCategory()
+		return nil
+	case attempt.FieldExamID:
+		m.ResetExamID()
+		return nil
+	case attempt.FieldUserID:
+		m.ResetUserID()
+		return nil
+	}
+	return fmt.Errorf("unknown Attempt field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *AttemptMutation) AddedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.exam != nil {
+		edges = append(edges, attempt.EdgeExam)
+	}
+	if m.user != nil {
+		edges = append(edges, attempt.EdgeUser)
+	}
+	if m.answers != nil {
+		edges = append(edges, attempt.EdgeAnswers)
+	}
+	if m.items != nil {
+		edges = append(edges, attempt.EdgeItems)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *AttemptMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case attempt.EdgeExam:
+		if id := m.exam; id != nil {
+			return []ent.Value{*id}
+		}
+	case attempt.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	case attempt.EdgeAnswers:
+		ids := make([]ent.Value, 0, len(m.answers))
+		for id := range m.answers {
+			ids = append(ids, id)
+		}
+		return ids
+	case attempt.EdgeItems:
+		ids := make([]ent.Value, 0, len(m.items))
+		for id := range m.items {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *AttemptMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.removedanswers != nil {
+		edges = append(edges, attempt.EdgeAnswers)
+	}
+	if m.removeditems != nil {
+		edges = append(edges, attempt.EdgeItems)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *AttemptMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case attempt.EdgeAnswers:
+		ids := make([]ent.Value, 0, len(m.removedanswers))
+		for id := range m.removedanswers {
+			ids = append(ids, id)
+		}
+		return ids
+	case attempt.EdgeItems:
+		ids := make([]ent.Value, 0, len(m.removeditems))
+		for id := range m.removeditems {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *AttemptMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.clearedexam {
+		edges = append(edges, attempt.EdgeExam)
+	}
+	if m.cleareduser {
+		edges = append(edges, attempt.EdgeUser)
+	}
+	if m.clearedanswers {
+		edges = append(edges, attempt.EdgeAnswers)
+	}
+	if m.cleareditems {
+		edges = append(edges, attempt.EdgeItems)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *AttemptMutation) EdgeCleared(name string) bool {
+	switch name {
+	case attempt.EdgeExam:
+		return m.clearedexam
+	case attempt.EdgeUser:
+		return m.cleareduser
+	case attempt.EdgeAnswers:
+		return m.clearedanswers
+	case attempt.EdgeItems:
+		return m.cleareditems
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *AttemptMutation) ClearEdge(name string) error {
+	switch name {
+	case attempt.EdgeExam:
+		m.ClearExam()
+		return nil
+	case attempt.EdgeUser:
+		m.ClearUser()
+		return nil
+	}
+	return fmt.Errorf("unknown Attempt unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *AttemptMutation) ResetEdge(name string) error {
+	switch name {
+	case attempt.EdgeExam:
+		m.ResetExam()
+		return nil
+	case attempt.EdgeUser:
+		m.ResetUser()
+		return nil
+	case attempt.EdgeAnswers:
+		m.ResetAnswers()
+		return nil
+	case attempt.EdgeItems:
+		m.ResetItems()
+		return nil
+	}
+	return fmt.Errorf("unknown Attempt edge %s", name)
+}
+
+// AttemptItemMutation represents an operation that mutates the AttemptItem nodes in the graph.
+type AttemptItemMutation struct {
+	config
+	op                 Op
+	typ                string
+	id                 *int
+	choice_order       *[]int
+	appendchoice_order []int
+	clearedFields      map[string]struct{}
+	attempt            *int
+	clearedattempt     bool
+	problem            *int
+	clearedproblem     bool
+	done               bool
+	oldValue           func(context.Context) (*AttemptItem, error)
+	predicates         []predicate.AttemptItem
+}
+
+var _ ent.Mutation = (*AttemptItemMutation)(nil)
+
+// attemptitemOption allows management of the mutation configuration using functional options.
+type attemptitemOption func(*AttemptItemMutation)
+
+// newAttemptItemMutation creates new mutation for the AttemptItem entity.
+func newAttemptItemMutation(c config, op Op, opts ...attemptitemOption) *AttemptItemMutation {
+	m := &AttemptItemMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeAttemptItem,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withAttemptItemID sets the ID field of the mutation.
+func withAttemptItemID(id int) attemptitemOption {
+	return func(m *AttemptItemMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *AttemptItem
+		)
+		m.oldValue = func(ctx context.Context) (*AttemptItem, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().AttemptItem.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withAttemptItem sets the old AttemptItem of the mutation.
+func withAttemptItem(node *AttemptItem) attemptitemOption {
+	return func(m *AttemptItemMutation) {
+		m.oldValue = func(context.Context) (*AttemptItem, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m AttemptItemMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m AttemptItemMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *AttemptItemMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *AttemptItemMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().AttemptItem.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetChoiceOrder sets the "choice_order" field.
+func (m *AttemptItemMutation) SetChoiceOrder(i []int) {
+	m.choice_order = &i
+	m.appendchoice_order = nil
+}
+
+// ChoiceOrder returns the value of the "choice_order" field in the mutation.
+func (m *AttemptItemMutation) ChoiceOrder() (r []int, exists bool) {
+	v := m.choice_order
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldChoiceOrder returns the old "choice_order" field's value of the AttemptItem entity.
+// If the AttemptItem object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AttemptItemMutation) OldChoiceOrder(ctx context.Context) (v []int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldChoiceOrder is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldChoiceOrder requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldChoiceOrder: %w", err)
+	}
+	return oldValue.ChoiceOrder, nil
+}
+
+// AppendChoiceOrder adds i to the "choice_order" field.
+func (m *AttemptItemMutation) AppendChoiceOrder(i []int) {
+	m.appendchoice_order = append(m.appendchoice_order, i...)
+}
+
+// AppendedChoiceOrder returns the list of values that were appended to the "choice_order" field in this mutation.
+func (m *AttemptItemMutation) AppendedChoiceOrder() ([]int, bool) {
+	if len(m.appendchoice_order) == 0 {
+		return nil, false
+	}
+	return m.appendchoice_order, true
+}
+
+// ClearChoiceOrder clears the value of the "choice_order" field.
+func (m *AttemptItemMutation) ClearChoiceOrder() {
+	m.choice_order = nil
+	m.appendchoice_order = nil
+	m.clearedFields[attemptitem.FieldChoiceOrder] = struct{}{}
+}
+
+// ChoiceOrderCleared returns if the "choice_order" field was cleared in this mutation.
+func (m *AttemptItemMutation) ChoiceOrderCleared() bool {
+	_, ok := m.clearedFields[attemptitem.FieldChoiceOrder]
+	return ok
+}
+
+// ResetChoiceOrder resets all changes to the "choice_order" field.
+func (m *AttemptItemMutation) ResetChoiceOrder() {
+	m.choice_order = nil
+	m.appendchoice_order = nil
+	delete(m.clearedFields, attemptitem.FieldChoiceOrder)
+}
+
+// SetAttemptID sets the "attempt_id" field.
+func (m *AttemptItemMutation) SetAttemptID(i int) {
+	m.attempt = &i
+}
+
+// AttemptID returns the value of the "attempt_id" field in the mutation.
+func (m *AttemptItemMutation) AttemptID() (r int, exists bool) {
+	v := m.attempt
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAttemptID returns the old "attempt_id" field's value of the AttemptItem entity.
+// If the AttemptItem object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AttemptItemMutation) OldAttemptID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAttemptID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAttemptID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAttemptID: %w", err)
+	}
+	return oldValue.AttemptID, nil
+}
+
+// ResetAttemptID resets all changes to the "attempt_id" field.
+func (m *AttemptItemMutation) ResetAttemptID() {
+	m.attempt = nil
+}
+
+// SetProblemID sets the "problem_id" field.
+func (m *AttemptItemMutation) SetProblemID(i int) {
+	m.problem = &i
+}
+
+// ProblemID returns the value of the "problem_id" field in the mutation.
+func (m *AttemptItemMutation) ProblemID() (r int, exists bool) {
+	v := m.problem
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProblemID returns the old "problem_id" field's value of the AttemptItem entity.
+// If the AttemptItem object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AttemptItemMutation) OldProblemID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProblemID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProblemID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProblemID: %w", err)
+	}
+	return oldValue.ProblemID, nil
+}
+
+// ResetProblemID resets all changes to the "problem_id" field.
+func (m *AttemptItemMutation) ResetProblemID() {
+	m.problem = nil
+}
+
+// ClearAttempt clears the "attempt" edge to the Attempt entity.
+func (m *AttemptItemMutation) ClearAttempt() {
+	m.clearedattempt = true
+	m.clearedFields[attemptitem.FieldAttemptID] = struct{}{}
+}
+
+// AttemptCleared reports if the "attempt" edge to the Attempt entity was cleared.
+func (m *AttemptItemMutation) AttemptCleared() bool {
+	return m.clearedattempt
+}
+
+// AttemptIDs returns the "attempt" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// AttemptID instead. It exists only for internal usage by the builders.
+func (m *AttemptItemMutation) AttemptIDs() (ids []int) {
+	if id := m.attempt; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetAttempt resets all changes to the "attempt" edge.
+func (m *AttemptItemMutation) ResetAttempt() {
+	m.attempt = nil
+	m.clearedattempt = false
+}
+
+// ClearProblem clears the "problem" edge to the Problem entity.
+func (m *AttemptItemMutation) ClearProblem() {
+	m.clearedproblem = true
+	m.clearedFields[attemptitem.FieldProblemID] = struct{}{}
+}
+
+// ProblemCleared reports if the "problem" edge to the Problem entity was cleared.
+func (m *AttemptItemMutation) ProblemCleared() bool {
+	return m.clearedproblem
+}
+
+// ProblemIDs returns the "problem" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ProblemID instead. It exists only for internal usage by the builders.
+func (m *AttemptItemMutation) ProblemIDs() (ids []int) {
+	if id := m.problem; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetProblem resets all changes to the "problem" edge.
+func (m *AttemptItemMutation) ResetProblem() {
+	m.problem = nil
+	m.clearedproblem = false
+}
+
+// Where appends a list predicates to the AttemptItemMutation builder.
+func (m *AttemptItemMutation) Where(ps ...predicate.AttemptItem) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the AttemptItemMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *AttemptItemMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.AttemptItem, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *AttemptItemMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *AttemptItemMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (AttemptItem).
+func (m *AttemptItemMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *AttemptItemMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.choice_order != nil {
+		fields = append(fields, attemptitem.FieldChoiceOrder)
+	}
+	if m.attempt != nil {
+		fields = append(fields, attemptitem.FieldAttemptID)
+	}
+	if m.problem != nil {
+		fields = append(fields, attemptitem.FieldProblemID)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *AttemptItemMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case attemptitem.FieldChoiceOrder:
+		return m.ChoiceOrder()
+	case attemptitem.FieldAttemptID:
+		return m.AttemptID()
+	case attemptitem.FieldProblemID:
+		return m.ProblemID()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *AttemptItemMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case attemptitem.FieldChoiceOrder:
+		return m.OldChoiceOrder(ctx)
+	case attemptitem.FieldAttemptID:
+		return m.OldAttemptID(ctx)
+	case attemptitem.FieldProblemID:
+		return m.OldProblemID(ctx)
+	}
+	return nil, fmt.Errorf("unknown AttemptItem field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *AttemptItemMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case attemptitem.FieldChoiceOrder:
+		v, ok := value.([]int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetChoiceOrder(v)
+		return nil
+	case attemptitem.FieldAttemptID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAttemptID(v)
+		return nil
+	case attemptitem.FieldProblemID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProblemID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown AttemptItem field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *AttemptItemMutation) AddedFields() []string {
+	var fields []string
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *AttemptItemMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *AttemptItemMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown AttemptItem numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *AttemptItemMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(attemptitem.FieldChoiceOrder) {
+		fields = append(fields, attemptitem.FieldChoiceOrder)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *AttemptItemMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *AttemptItemMutation) ClearField(name string) error {
+	switch name {
+	case attemptitem.FieldChoiceOrder:
+		m.ClearChoiceOrder()
+		return nil
+	}
+	return fmt.Errorf("unknown AttemptItem nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *AttemptItemMutation) ResetField(name string) error {
+	switch name {
+	case attemptitem.FieldChoiceOrder:
+		m.ResetChoiceOrder()
+		return nil
+	case attemptitem.FieldAttemptID:
+		m.ResetAttemptID()
+		return nil
+	case attemptitem.FieldProblemID:
+		m.ResetProblemID()
+		return nil
+	}
+	return fmt.Errorf("unknown AttemptItem field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *AttemptItemMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.attempt != nil {
+		edges = append(edges, attemptitem.EdgeAttempt)
+	}
+	if m.problem != nil {
+		edges = append(edges, attemptitem.EdgeProblem)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *AttemptItemMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case attemptitem.EdgeAttempt:
+		if id := m.attempt; id != nil {
+			return []ent.Value{*id}
+		}
+	case attemptitem.EdgeProblem:
+		if id := m.problem; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *AttemptItemMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *AttemptItemMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *AttemptItemMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedattempt {
+		edges = append(edges, attemptitem.EdgeAttempt)
+	}
+	if m.clearedproblem {
+		edges = append(edges, attemptitem.EdgeProblem)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *AttemptItemMutation) EdgeCleared(name string) bool {
+	switch name {
+	case attemptitem.EdgeAttempt:
+		return m.clearedattempt
+	case attemptitem.EdgeProblem:
+		return m.clearedproblem
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *AttemptItemMutation) ClearEdge(name string) error {
+	switch name {
+	case attemptitem.EdgeAttempt:
+		m.ClearAttempt()
+		return nil
+	case attemptitem.EdgeProblem:
+		m.ClearProblem()
+		return nil
+	}
+	return fmt.Errorf("unknown AttemptItem unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *AttemptItemMutation) ResetEdge(name string) error {
+	switch name {
+	case attemptitem.EdgeAttempt:
+		m.ResetAttempt()
+		return nil
+	case attemptitem.EdgeProblem:
+		m.ResetProblem()
+		return nil
+	}
+	return fmt.Errorf("unknown AttemptItem edge %s", name)
+}
+
+// ChoiceMutation represents an operation that mutates the Choice nodes in the graph.
+type ChoiceMutation struct {
+	config
+	op                         Op
+	typ                        string
+	id                         *int
+	content                    *string
+	is_correct                 *bool
+	explanation                *string
+	seq                        *int
+	addseq                     *int
+	clearedFields              map[string]struct{}
+	problem_translation        *int
+	clearedproblem_translation bool
+	answers                    map[int]struct{}
+	removedanswers             map[int]struct{}
+	clearedanswers             bool
+	done                       bool
+	oldValue                   func(context.Context) (*Choice, error)
+	predicates                 []predicate.Choice
+}
+
+var _ ent.Mutation = (*ChoiceMutation)(nil)
+
+// choiceOption allows management of the mutation configuration using functional options.
+type choiceOption func(*ChoiceMutation)
+
+// newChoiceMutation creates new mutation for the Choice entity.
+func newChoiceMutation(c config, op Op, opts ...choiceOption) *ChoiceMutation {
+	m := &ChoiceMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeChoice,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withChoiceID sets the ID field of the mutation.
+func withChoiceID(id int) choiceOption {
+	return func(m *ChoiceMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Choice
+		)
+		m.oldValue = func(ctx context.Context) (*Choice, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Choice.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withChoice sets the old Choice of the mutation.
+func withChoice(node *Choice) choiceOption {
+	return func(m *ChoiceMutation) {
+		m.oldValue = func(context.Context) (*Choice, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ChoiceMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ChoiceMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ChoiceMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ChoiceMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Choice.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetContent sets the "content" field.
+func (m *ChoiceMutation) SetContent(s string) {
+	m.content = &s
+}
+
+// Content returns the value of the "content" field in the mutation.
+func (m *ChoiceMutation) Content() (r string, exists bool) {
+	v := m.content
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldContent returns the old "content" field's value of the Choice entity.
+// If the Choice object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChoiceMutation) OldContent(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldContent is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldContent requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldContent: %w", err)
+	}
+	return oldValue.Content, nil
+}
+
+// ResetContent resets all changes to the "content" field.
+func (m *ChoiceMutation) ResetContent() {
+	m.content = nil
+}
+
+// SetIsCorrect sets the "is_correct" field.
+func (m *ChoiceMutation) SetIsCorrect(b bool) {
+	m.is_correct = &b
+}
+
+// IsCorrect returns the value of the "is_correct" field in the mutation.
+func (m *ChoiceMutation) IsCorrect() (r bool, exists bool) {
+	v := m.is_correct
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsCorrect returns the old "is_correct" field's value of the Choice entity.
+// If the Choice object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChoiceMutation) OldIsCorrect(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsCorrect is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsCorrect requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsCorrect: %w", err)
+	}
+	return oldValue.IsCorrect, nil
+}
+
+// ResetIsCorrect resets all changes to the "is_correct" field.
+func (m *ChoiceMutation) ResetIsCorrect() {
+	m.is_correct = nil
+}
+
+// SetExplanation sets the "explanation" field.
+func (m *ChoiceMutation) SetExplanation(s string) {
+	m.explanation = &s
+}
+
+// Explanation returns the value of the "explanation" field in the mutation.
+func (m *ChoiceMutation) Explanation() (r string, exists bool) {
+	v := m.explanation
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExplanation returns the old "explanation" field's value of the Choice entity.
+// If the Choice object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChoiceMutation) OldExplanation(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExplanation is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExplanation requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExplanation: %w", err)
+	}
+	return oldValue.Explanation, nil
+}
+
+// ClearExplanation clears the value of the "explanation" field.
+func (m *ChoiceMutation) ClearExplanation() {
+	m.explanation = nil
+	m.clearedFields[choice.FieldExplanation] = struct{}{}
+}
+
+// ExplanationCleared returns if the "explanation" field was cleared in this mutation.
+func (m *ChoiceMutation) ExplanationCleared() bool {
+	_, ok := m.clearedFields[choice.FieldExplanation]
+	return ok
+}
+
+// ResetExplanation resets all changes to the "explanation" field.
+func (m *ChoiceMutation) ResetExplanation() {
+	m.explanation = nil
+	delete(m.clearedFields, choice.FieldExplanation)
+}
+
+// SetSeq sets the "seq" field.
+func (m *ChoiceMutation) SetSeq(i int) {
+	m.seq = &i
+	m.addseq = nil
+}
+
+// Seq returns the value of the "seq" field in the mutation.
+func (m *ChoiceMutation) Seq() (r int, exists bool) {
+	v := m.seq
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSeq returns the old "seq" field's value of the Choice entity.
+// If the Choice object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChoiceMutation) OldSeq(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSeq is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSeq requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSeq: %w", err)
+	}
+	return oldValue.Seq, nil
+}
+
+// AddSeq adds i to the "seq" field.
+func (m *ChoiceMutation) AddSeq(i int) {
+	if m.addseq != nil {
+		*m.addseq += i
+	} else {
+		m.addseq = &i
+	}
+}
+
+// AddedSeq returns the value that was added to the "seq" field in this mutation.
+func (m *ChoiceMutation) AddedSeq() (r int, exists bool) {
+	v := m.addseq
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetSeq resets all changes to the "seq" field.
+func (m *ChoiceMutation) ResetSeq() {
+	m.seq = nil
+	m.addseq = nil
+}
+
+// SetProblemTranslationID sets the "problem_translation_id" field.
+func (m *ChoiceMutation) SetProblemTranslationID(i int) {
+	m.problem_translation = &i
+}
+
+// ProblemTranslationID returns the value of the "problem_translation_id" field in the mutation.
+func (m *ChoiceMutation) ProblemTranslationID() (r int, exists bool) {
+	v := m.problem_translation
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProblemTranslationID returns the old "problem_translation_id" field's value of the Choice entity.
+// If the Choice object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChoiceMutation) OldProblemTranslationID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProblemTranslationID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProblemTranslationID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProblemTranslationID: %w", err)
+	}
+	return oldValue.ProblemTranslationID, nil
+}
+
+// ResetProblemTranslationID resets all changes to the "problem_translation_id" field.
+func (m *ChoiceMutation) ResetProblemTranslationID() {
+	m.problem_translation = nil
+}
+
+// ClearProblemTranslation clears the "problem_translation" edge to the ProblemTranslation entity.
+func (m *ChoiceMutation) ClearProblemTranslation() {
+	m.clearedproblem_translation = true
+	m.clearedFields[choice.FieldProblemTranslationID] = struct{}{}
+}
+
+// ProblemTranslationCleared reports if the "problem_translation" edge to the ProblemTranslation entity was cleared.
+func (m *ChoiceMutation) ProblemTranslationCleared() bool {
+	return m.clearedproblem_translation
+}
+
+// ProblemTranslationIDs returns the "problem_translation" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ProblemTranslationID instead. It exists only for internal usage by the builders.
+func (m *ChoiceMutation) ProblemTranslationIDs() (ids []int) {
+	if id := m.problem_translation; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetProblemTranslation resets all changes to the "problem_translation" edge.
+func (m *ChoiceMutation) ResetProblemTranslation() {
+	m.problem_translation = nil
+	m.clearedproblem_translation = false
+}
+
+// AddAnswerIDs adds the "answers" edge to the Answer entity by ids.
+func (m *ChoiceMutation) AddAnswerIDs(ids ...int) {
+	if m.answers == nil {
+		m.answers = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.answers[ids[i]] = struct{}{}
+	}
+}
+
+// ClearAnswers clears the "answers" edge to the Answer entity.
+func (m *ChoiceMutation) ClearAnswers() {
+	m.clearedanswers = true
+}
+
+// AnswersCleared reports if the "answers" edge to the Answer entity was cleared.
+func (m *ChoiceMutation) AnswersCleared() bool {
+	return m.clearedanswers
+}
+
+// RemoveAnswerIDs removes the "answers" edge to the Answer entity by IDs.
+func (m *ChoiceMutation) RemoveAnswerIDs(ids ...int) {
+	if m.removedanswers == nil {
+		m.removedanswers = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.answers, ids[i])
+		m.removedanswers[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedAnswers returns the removed IDs of the "answers" edge to the Answer entity.
+func (m *ChoiceMutation) RemovedAnswersIDs() (ids []int) {
+	for id := range m.removedanswers {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// AnswersIDs returns the "answers" edge IDs in the mutation.
+func (m *ChoiceMutation) AnswersIDs() (ids []int) {
+	for id := range m.answers {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetAnswers resets all changes to the "answers" edge.
+func (m *ChoiceMutation) ResetAnswers() {
+	m.answers = nil
+	m.clearedanswers = false
+	m.removedanswers = nil
+}
+
+// Where appends a list predicates to the ChoiceMutation builder.
+func (m *ChoiceMutation) Where(ps ...predicate.Choice) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ChoiceMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ChoiceMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Choice, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ChoiceMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ChoiceMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Choice).
+func (m *ChoiceMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ChoiceMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.content != nil {
+		fields = append(fields, choice.FieldContent)
+	}
+	if m.is_correct != nil {
+		fields = append(fields, choice.FieldIsCorrect)
+	}
+	if m.explanation != nil {
+		fields = append(fields, choice.FieldExplanation)
+	}
+	if m.seq != nil {
+		fields = append(fields, choice.FieldSeq)
+	}
+	if m.problem_translation != nil {
+		fields = append(fields, choice.FieldProblemTranslationID)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ChoiceMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case choice.FieldContent:
+		return m.Content()
+	case choice.FieldIsCorrect:
+		return m.IsCorrect()
+	case choice.FieldExplanation:
+		return m.Explanation()
+	case choice.FieldSeq:
+		return m.Seq()
+	case choice.FieldProblemTranslationID:
+		return m.ProblemTranslationID()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ChoiceMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case choice.FieldContent:
+		return m.OldContent(ctx)
+	case choice.FieldIsCorrect:
+		return m.OldIsCorrect(ctx)
+	case choice.FieldExplanation:
+		return m.OldExplanation(ctx)
+	case choice.FieldSeq:
+		return m.OldSeq(ctx)
+	case choice.FieldProblemTranslationID:
+		return m.OldProblemTranslationID(ctx)
+	}
+	return nil, fmt.Errorf("unknown Choice field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ChoiceMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case choice.FieldContent:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetContent(v)
+		return nil
+	case choice.FieldIsCorrect:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsCorrect(v)
+		return nil
+	case choice.FieldExplanation:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExplanation(v)
+		return nil
+	case choice.FieldSeq:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSeq(v)
+		return nil
+	case choice.FieldProblemTranslationID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProblemTranslationID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Choice field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ChoiceMutation) AddedFields() []string {
+	var fields []string
+	if m.addseq != nil {
+		fields = append(fields, choice.FieldSeq)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ChoiceMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case choice.FieldSeq:
+		return m.AddedSeq()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ChoiceMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case choice.FieldSeq:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSeq(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Choice numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ChoiceMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(choice.FieldExplanation) {
+		fields = append(fields, choice.FieldExplanation)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ChoiceMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ChoiceMutation) ClearField(name string) error {
+	switch name {
+	case choice.FieldExplanation:
+		m.ClearExplanation()
+		return nil
+	}
+	return fmt.Errorf("unknown Choice nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ChoiceMutation) ResetField(name string) error {
+	switch name {
+	case choice.FieldContent:
+		m.ResetContent()
+		return nil
+	case choice.FieldIsCorrect:
+		m.ResetIsCorrect()
+		return nil
+	case choice.FieldExplanation:
+		m.ResetExplanation()
+		return nil
+	case choice.FieldSeq:
+		m.ResetSeq()
+		return nil
+	case choice.FieldProblemTranslationID:
+		m.ResetProblemTranslationID()
+		return nil
+	}
+	return fmt.Errorf("unknown Choice field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ChoiceMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.problem_translation != nil {
+		edges = append(edges, choice.EdgeProblemTranslation)
+	}
+	if m.answers != nil {
+		edges = append(edges, choice.EdgeAnswers)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ChoiceMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case choice.EdgeProblemTranslation:
+		if id := m.problem_translation; id != nil {
+			return []ent.Value{*id}
+		}
+	case choice.EdgeAnswers:
+		ids := make([]ent.Value, 0, len(m.answers))
+		for id := range m.answers {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ChoiceMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removedanswers != nil {
+		edges = append(edges, choice.EdgeAnswers)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ChoiceMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case choice.EdgeAnswers:
+		ids := make([]ent.Value, 0, len(m.removedanswers))
+		for id := range m.removedanswers {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ChoiceMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedproblem_translation {
+		edges = append(edges, choice.EdgeProblemTranslation)
+	}
+	if m.clearedanswers {
+		edges = append(edges, choice.EdgeAnswers)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ChoiceMutation) EdgeCleared(name string) bool {
+	switch name {
+	case choice.EdgeProblemTranslation:
+		return m.clearedproblem_translation
+	case choice.EdgeAnswers:
+		return m.clearedanswers
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ChoiceMutation) ClearEdge(name string) error {
+	switch name {
+	case choice.EdgeProblemTranslation:
+		m.ClearProblemTranslation()
+		return nil
+	}
+	return fmt.Errorf("unknown Choice unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ChoiceMutation) ResetEdge(name string) error {
+	switch name {
+	case choice.EdgeProblemTranslation:
+		m.ResetProblemTranslation()
+		return nil
+	case choice.EdgeAnswers:
+		m.ResetAnswers()
+		return nil
+	}
+	return fmt.Errorf("unknown Choice edge %s", name)
+}
+
+// ExamMutation represents an operation that mutates the Exam nodes in the graph.
+type ExamMutation struct {
+	config
+	op                   Op
+	typ                  string
+	id                   *int
+	key                  *string
+	title                *string
+	description          *string
+	time_limit           *int
+	addtime_limit        *int
+	is_active            *bool
+	shuffle_choices      *bool
+	weight_by_difficulty *bool
+	partial_credit       *bool
+	negative_marking     *bool
+	clearedFields        map[string]struct{}
+	sections             map[int]struct{}
+	removedsections      map[int]struct{}
+	clearedsections      bool
+	topics               map[int]struct{}
+	removedtopics        map[int]struct{}
+	clearedtopics        bool
+	units                map[int]struct{}
+	removedunits         map[int]struct{}
+	clearedunits         bool
+	version_rules        map[int]struct{}
+	removedversion_rules map[int]struct{}
+	clearedversion_rules bool
+	attempts             map[int]struct{}
+	removedattempts      map[int]struct{}
+	clearedattempts      bool
+	done                 bool
+	oldValue             func(context.Context) (*Exam, error)
+	predicates           []predicate.Exam
+}
+
+var _ ent.Mutation = (*ExamMutation)(nil)
+
+// examOption allows management of the mutation configuration using functional options.
+type examOption func(*ExamMutation)
+
+// newExamMutation creates new mutation for the Exam entity.
+func newExamMutation(c config, op Op, opts ...examOption) *ExamMutation {
+	m := &ExamMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeExam,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withExamID sets the ID field of the mutation.
+func withExamID(id int) examOption {
+	return func(m *ExamMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Exam
+		)
+		m.oldValue = func(ctx context.Context) (*Exam, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Exam.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withExam sets the old Exam of the mutation.
+func withExam(node *Exam) examOption {
+	return func(m *ExamMutation) {
+		m.oldValue = func(context.Context) (*Exam, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ExamMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ExamMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ExamMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ExamMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Exam.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetKey sets the "key" field.
+func (m *ExamMutation) SetKey(s string) {
+	m.key = &s
+}
+
+// Key returns the value of the "key" field in the mutation.
+func (m *ExamMutation) Key() (r string, exists bool) {
+	v := m.key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKey returns the old "key" field's value of the Exam entity.
+// If the Exam object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExamMutation) OldKey(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKey: %w", err)
+	}
+	return oldValue.Key, nil
+}
+
+// ClearKey clears the value of the "key" field.
+func (m *ExamMutation) ClearKey() {
+	m.key = nil
+	m.clearedFields[exam.FieldKey] = struct{}{}
+}
+
+// KeyCleared returns if the "key" field was cleared in this mutation.
+func (m *ExamMutation) KeyCleared() bool {
+	_, ok := m.clearedFields[exam.FieldKey]
+	return ok
+}
+
+// ResetKey resets all changes to the "key" field.
+func (m *ExamMutation) ResetKey() {
+	m.key = nil
+	delete(m.clearedFields, exam.FieldKey)
+}
+
+// SetTitle sets the "title" field.
+func (m *ExamMutation) SetTitle(s string) {
+	m.title = &s
+}
+
+// Title returns the value of the "title" field in the mutation.
+func (m *ExamMutation) Title() (r string, exists bool) {
+	v := m.title
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTitle returns the old "title" field's value of the Exam entity.
+// If the Exam object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExamMutation) OldTitle(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTitle requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
+	}
+	return oldValue.Title, nil
+}
+
+// ResetTitle resets all changes to the "title" field.
+func (m *ExamMutation) ResetTitle() {
+	m.title = nil
+}
+
+// SetDescription sets the "description" field.
+func (m *ExamMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *ExamMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDescription returns the old "description" field's value of the Exam entity.
+// If the Exam object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExamMutation) OldDescription(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
+}
+
+// ClearDescription clears the value of the "description" field.
+func (m *ExamMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[exam.FieldDescription] = struct{}{}
+}
+
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *ExamMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[exam.FieldDescription]
+	return ok
+}
+
+// ResetDescription resets all changes to the "description" field.
+func (m *ExamMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, exam.FieldDescription)
+}
+
+// SetTimeLimit sets the "time_limit" field.
+func (m *ExamMutation) SetTimeLimit(i int) {
+	m.time_limit = &i
+	m.addtime_limit = nil
+}
+
+// TimeLimit returns the value of the "time_limit" field in the mutation.
+func (m *ExamMutation) TimeLimit() (r int, exists bool) {
+	v := m.time_limit
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTimeLimit returns the old "time_limit" field's value of the Exam entity.
+// If the Exam object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExamMutation) OldTimeLimit(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTimeLimit is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTimeLimit requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTimeLimit: %w", err)
+	}
+	return oldValue.TimeLimit, nil
+}
+
+// AddTimeLimit adds i to the "time_limit" field.
+func (m *ExamMutation) AddTimeLimit(i int) {
+	if m.addtime_limit != nil {
+		*m.addtime_limit += i
+	} else {
+		m.addtime_limit = &i
+	}
+}
+
+// AddedTimeLimit returns the value that was added to the "time_limit" field in this mutation.
+func (m *ExamMutation) AddedTimeLimit() (r int, exists bool) {
+	v := m.addtime_limit
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetTimeLimit resets all changes to the "time_limit" field.
+func (m *ExamMutation) ResetTimeLimit() {
+	m.time_limit = nil
+	m.addtime_limit = nil
+}
+
+// SetIsActive sets the "is_active" field.
+func (m *ExamMutation) SetIsActive(b bool) {
+	m.is_active = &b
+}
+
+// IsActive returns the value of the "is_active" field in the mutation.
+func (m *ExamMutation) IsActive() (r bool, exists bool) {
+	v := m.is_active
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsActive returns the old "is_active" field's value of the Exam entity.
+// If the Exam object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExamMutation) OldIsActive(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsActive is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsActive requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsActive: %w", err)
+	}
+	return oldValue.IsActive, nil
+}
+
+// ResetIsActive resets all changes to the "is_active" field.
+func (m *ExamMutation) ResetIsActive() {
+	m.is_active = nil
+}
+
+// SetShuffleChoices sets the "shuffle_choices" field.
+func (m *ExamMutation) SetShuffleChoices(b bool) {
+	m.shuffle_choices = &b
+}
+
+// ShuffleChoices returns the value of the "shuffle_choices" field in the mutation.
+func (m *ExamMutation) ShuffleChoices() (r bool, exists bool) {
+	v := m.shuffle_choices
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldShuffleChoices returns the old "shuffle_choices" field's value of the Exam entity.
+// If the Exam object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExamMutation) OldShuffleChoices(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldShuffleChoices is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldShuffleChoices requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldShuffleChoices: %w", err)
+	}
+	return oldValue.ShuffleChoices, nil
+}
+
+// ResetShuffleChoices resets all changes to the "shuffle_choices" field.
+func (m *ExamMutation) ResetShuffleChoices() {
+	m.shuffle_choices = nil
+}
+
+// SetWeightByDifficulty sets the "weight_by_difficulty" field.
+func (m *ExamMutation) SetWeightByDifficulty(b bool) {
+	m.weight_by_difficulty = &b
+}
+
+// WeightByDifficulty returns the value of the "weight_by_difficulty" field in the mutation.
+func (m *ExamMutation) WeightByDifficulty() (r bool, exists bool) {
+	v := m.weight_by_difficulty
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldWeightByDifficulty returns the old "weight_by_difficulty" field's value of the Exam entity.
+// If the Exam object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExamMutation) OldWeightByDifficulty(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWeightByDifficulty is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWeightByDifficulty requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWeightByDifficulty: %w", err)
+	}
+	return oldValue.WeightByDifficulty, nil
+}
+
+// ResetWeightByDifficulty resets all changes to the "weight_by_difficulty" field.
+func (m *ExamMutation) ResetWeightByDifficulty() {
+	m.weight_by_difficulty = nil
+}
+
+// SetPartialCredit sets the "partial_credit" field.
+func (m *ExamMutation) SetPartialCredit(b bool) {
+	m.partial_credit = &b
+}
+
+// PartialCredit returns the value of the "partial_credit" field in the mutation.
+func (m *ExamMutation) PartialCredit() (r bool, exists bool) {
+	v := m.partial_credit
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPartialCredit returns the old "partial_credit" field's value of the Exam entity.
+// If the Exam object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExamMutation) OldPartialCredit(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPartialCredit is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPartialCredit requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPartialCredit: %w", err)
+	}
+	return oldValue.PartialCredit, nil
+}
+
+// ResetPartialCredit resets all changes to the "partial_credit" field.
+func (m *ExamMutation) ResetPartialCredit() {
+	m.partial_credit = nil
+}
+
+// SetNegativeMarking sets the "negative_marking" field.
+func (m *ExamMutation) SetNegativeMarking(b bool) {
+	m.negative_marking = &b
+}
+
+// NegativeMarking returns the value of the "negative_marking" field in the mutation.
+func (m *ExamMutation) NegativeMarking() (r bool, exists bool) {
+	v := m.negative_marking
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNegativeMarking returns the old "negative_marking" field's value of the Exam entity.
+// If the Exam object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExamMutation) OldNegativeMarking(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNegativeMarking is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNegativeMarking requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNegativeMarking: %w", err)
+	}
+	return oldValue.NegativeMarking, nil
+}
+
+// ResetNegativeMarking resets all changes to the "negative_marking" field.
+func (m *ExamMutation) ResetNegativeMarking() {
+	m.negative_marking = nil
+}
+
+// AddSectionIDs adds the "sections" edge to the Section entity by ids.
+func (m *ExamMutation) AddSectionIDs(ids ...int) {
+	if m.sections == nil {
+		m.sections = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.sections[ids[i]] = struct{}{}
+	}
+}
+
+// ClearSections clears the "sections" edge to the Section entity.
+func (m *ExamMutation) ClearSections() {
+	m.clearedsections = true
+}
+
+// SectionsCleared reports if the "sections" edge to the Section entity was cleared.
+func (m *ExamMutation) SectionsCleared() bool {
+	return m.clearedsections
+}
+
+// RemoveSectionIDs removes the "sections" edge to the Section entity by IDs.
+func (m *ExamMutation) RemoveSectionIDs(ids ...int) {
+	if m.removedsections == nil {
+		m.removedsections = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.sections, ids[i])
+		m.removedsections[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedSections returns the removed IDs of the "sections" edge to the Section entity.
+func (m *ExamMutation) RemovedSectionsIDs() (ids []int) {
+	for id := range m.removedsections {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// SectionsIDs returns the "sections" edge IDs in the mutation.
+func (m *ExamMutation) SectionsIDs() (ids []int) {
+	for id := range m.sections {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetSections resets all changes to the "sections" edge.
+func (m *ExamMutation) ResetSections() {
+	m.sections = nil
+	m.clearedsections = false
+	m.removedsections = nil
+}
+
+// AddTopicIDs adds the "topics" edge to the Topic entity by ids.
+func (m *ExamMutation) AddTopicIDs(ids ...int) {
+	if m.topics == nil {
+		m.topics = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.topics[ids[i]] = struct{}{}
+	}
+}
+
+// ClearTopics clears the "topics" edge to the Topic entity.
+func (m *ExamMutation) ClearTopics() {
+	m.clearedtopics = true
+}
+
+// TopicsCleared reports if the "topics" edge to the Topic entity was cleared.
+func (m *ExamMutation) TopicsCleared() bool {
+	return m.clearedtopics
+}
+
+// RemoveTopicIDs removes the "topics" edge to the Topic entity by IDs.
+func (m *ExamMutation) RemoveTopicIDs(ids ...int) {
+	if m.removedtopics == nil {
+		m.removedtopics = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.topics, ids[i])
+		m.removedtopics[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedTopics returns the removed IDs of the "topics" edge to the Topic entity.
+func (m *ExamMutation) RemovedTopicsIDs() (ids []int) {
+	for id := range m.removedtopics {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// TopicsIDs returns the "topics" edge IDs in the mutation.
+func (m *ExamMutation) TopicsIDs() (ids []int) {
+	for id := range m.topics {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetTopics resets all changes to the "topics" edge.
+func (m *ExamMutation) ResetTopics() {
+	m.topics = nil
+	m.clearedtopics = false
+	m.removedtopics = nil
 }
 
 // AddUnitIDs adds the "units" edge to the Unit entity by ids.
@@ -1112,118 +4676,2952 @@ func (m *ExamMutation) AddUnitIDs(ids ...int) {
 	if m.units == nil {
 		m.units = make(map[int]struct{})
 	}
-	for i := range ids {
-		m.units[ids[i]] = struct{}{}
+	for i := range ids {
+		m.units[ids[i]] = struct{}{}
+	}
+}
+
+// ClearUnits clears the "units" edge to the Unit entity.
+func (m *ExamMutation) ClearUnits() {
+	m.clearedunits = true
+}
+
+// UnitsCleared reports if the "units" edge to the Unit entity was cleared.
+func (m *ExamMutation) UnitsCleared() bool {
+	return m.clearedunits
+}
+
+// RemoveUnitIDs removes the "units" edge to the Unit entity by IDs.
+func (m *ExamMutation) RemoveUnitIDs(ids ...int) {
+	if m.removedunits == nil {
+		m.removedunits = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.units, ids[i])
+		m.removedunits[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedUnits returns the removed IDs of the "units" edge to the Unit entity.
+func (m *ExamMutation) RemovedUnitsIDs() (ids []int) {
+	for id := range m.removedunits {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// UnitsIDs returns the "units" edge IDs in the mutation.
+func (m *ExamMutation) UnitsIDs() (ids []int) {
+	for id := range m.units {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetUnits resets all changes to the "units" edge.
+func (m *ExamMutation) ResetUnits() {
+	m.units = nil
+	m.clearedunits = false
+	m.removedunits = nil
+}
+
+// AddVersionRuleIDs adds the "version_rules" edge to the VersionRule entity by ids.
+func (m *ExamMutation) AddVersionRuleIDs(ids ...int) {
+	if m.version_rules == nil {
+		m.version_rules = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.version_rules[ids[i]] = struct{}{}
+	}
+}
+
+// ClearVersionRules clears the "version_rules" edge to the VersionRule entity.
+func (m *ExamMutation) ClearVersionRules() {
+	m.clearedversion_rules = true
+}
+
+// VersionRulesCleared reports if the "version_rules" edge to the VersionRule entity was cleared.
+func (m *ExamMutation) VersionRulesCleared() bool {
+	return m.clearedversion_rules
+}
+
+// RemoveVersionRuleIDs removes the "version_rules" edge to the VersionRule entity by IDs.
+func (m *ExamMutation) RemoveVersionRuleIDs(ids ...int) {
+	if m.removedversion_rules == nil {
+		m.removedversion_rules = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.version_rules, ids[i])
+		m.removedversion_rules[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedVersionRules returns the removed IDs of the "version_rules" edge to the VersionRule entity.
+func (m *ExamMutation) RemovedVersionRulesIDs() (ids []int) {
+	for id := range m.removedversion_rules {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// VersionRulesIDs returns the "version_rules" edge IDs in the mutation.
+func (m *ExamMutation) VersionRulesIDs() (ids []int) {
+	for id := range m.version_rules {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetVersionRules resets all changes to the "version_rules" edge.
+func (m *ExamMutation) ResetVersionRules() {
+	m.version_rules = nil
+	m.clearedversion_rules = false
+	m.removedversion_rules = nil
+}
+
+// AddAttemptIDs adds the "attempts" edge to the Attempt entity by ids.
+func (m *ExamMutation) AddAttemptIDs(ids ...int) {
+	if m.attempts == nil {
+		m.attempts = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.attempts[ids[i]] = struct{}{}
+	}
+}
+
+// ClearAttempts clears the "attempts" edge to the Attempt entity.
+func (m *ExamMutation) ClearAttempts() {
+	m.clearedattempts = true
+}
+
+// AttemptsCleared reports if the "attempts" edge to the Attempt entity was cleared.
+func (m *ExamMutation) AttemptsCleared() bool {
+	return m.clearedattempts
+}
+
+// RemoveAttemptIDs removes the "attempts" edge to the Attempt entity by IDs.
+func (m *ExamMutation) RemoveAttemptIDs(ids ...int) {
+	if m.removedattempts == nil {
+		m.removedattempts = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.attempts, ids[i])
+		m.removedattempts[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedAttempts returns the removed IDs of the "attempts" edge to the Attempt entity.
+func (m *ExamMutation) RemovedAttemptsIDs() (ids []int) {
+	for id := range m.removedattempts {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// AttemptsIDs returns the "attempts" edge IDs in the mutation.
+func (m *ExamMutation) AttemptsIDs() (ids []int) {
+	for id := range m.attempts {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetAttempts resets all changes to the "attempts" edge.
+func (m *ExamMutation) ResetAttempts() {
+	m.attempts = nil
+	m.clearedattempts = false
+	m.removedattempts = nil
+}
+
+// Where appends a list predicates to the ExamMutation builder.
+func (m *ExamMutation) Where(ps ...predicate.Exam) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ExamMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ExamMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Exam, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ExamMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ExamMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Exam).
+func (m *ExamMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ExamMutation) Fields() []string {
+	fields := make([]string, 0, 9)
+	if m.key != nil {
+		fields = append(fields, exam.FieldKey)
+	}
+	if m.title != nil {
+		fields = append(fields, exam.FieldTitle)
+	}
+	if m.description != nil {
+		fields = append(fields, exam.FieldDescription)
+	}
+	if m.time_limit != nil {
+		fields = append(fields, exam.FieldTimeLimit)
+	}
+	if m.is_active != nil {
+		fields = append(fields, exam.FieldIsActive)
+	}
+	if m.shuffle_choices != nil {
+		fields = append(fields, exam.FieldShuffleChoices)
+	}
+	if m.weight_by_difficulty != nil {
+		fields = append(fields, exam.FieldWeightByDifficulty)
+	}
+	if m.partial_credit != nil {
+		fields = append(fields, exam.FieldPartialCredit)
+	}
+	if m.negative_marking != nil {
+		fields = append(fields, exam.FieldNegativeMarking)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ExamMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case exam.FieldKey:
+		return m.Key()
+	case exam.FieldTitle:
+		return m.Title()
+	case exam.FieldDescription:
+		return m.Description()
+	case exam.FieldTimeLimit:
+		return m.TimeLimit()
+	case exam.FieldIsActive:
+		return m.IsActive()
+	case exam.FieldShuffleChoices:
+		return m.ShuffleChoices()
+	case exam.FieldWeightByDifficulty:
+		return m.WeightByDifficulty()
+	case exam.FieldPartialCredit:
+		return m.PartialCredit()
+	case exam.FieldNegativeMarking:
+		return m.NegativeMarking()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ExamMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case exam.FieldKey:
+		return m.OldKey(ctx)
+	case exam.FieldTitle:
+		return m.OldTitle(ctx)
+	case exam.FieldDescription:
+		return m.OldDescription(ctx)
+	case exam.FieldTimeLimit:
+		return m.OldTimeLimit(ctx)
+	case exam.FieldIsActive:
+		return m.OldIsActive(ctx)
+	case exam.FieldShuffleChoices:
+		return m.OldShuffleChoices(ctx)
+	case exam.FieldWeightByDifficulty:
+		return m.OldWeightByDifficulty(ctx)
+	case exam.FieldPartialCredit:
+		return m.OldPartialCredit(ctx)
+	case exam.FieldNegativeMarking:
+		return m.OldNegativeMarking(ctx)
+	}
+	return nil, fmt.Errorf("unknown Exam field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ExamMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case exam.FieldKey:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKey(v)
+		return nil
+	case exam.FieldTitle:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTitle(v)
+		return nil
+	case exam.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case exam.FieldTimeLimit:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTimeLimit(v)
+		return nil
+	case exam.FieldIsActive:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsActive(v)
+		return nil
+	case exam.FieldShuffleChoices:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetShuffleChoices(v)
+		return nil
+	case exam.FieldWeightByDifficulty:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWeightByDifficulty(v)
+		return nil
+	case exam.FieldPartialCredit:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPartialCredit(v)
+		return nil
+	case exam.FieldNegativeMarking:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNegativeMarking(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Exam field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ExamMutation) AddedFields() []string {
+	var fields []string
+	if m.addtime_limit != nil {
+		fields = append(fields, exam.FieldTimeLimit)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ExamMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case exam.FieldTimeLimit:
+		return m.AddedTimeLimit()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ExamMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case exam.FieldTimeLimit:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTimeLimit(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Exam numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ExamMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(exam.FieldKey) {
+		fields = append(fields, exam.FieldKey)
+	}
+	if m.FieldCleared(exam.FieldDescription) {
+		fields = append(fields, exam.FieldDescription)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ExamMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ExamMutation) ClearField(name string) error {
+	switch name {
+	case exam.FieldKey:
+		m.ClearKey()
+		return nil
+	case exam.FieldDescription:
+		m.ClearDescription()
+		return nil
+	}
+	return fmt.Errorf("unknown Exam nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ExamMutation) ResetField(name string) error {
+	switch name {
+	case exam.FieldKey:
+		m.ResetKey()
+		return nil
+	case exam.FieldTitle:
+		m.ResetTitle()
+		return nil
+	case exam.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case exam.FieldTimeLimit:
+		m.ResetTimeLimit()
+		return nil
+	case exam.FieldIsActive:
+		m.ResetIsActive()
+		return nil
+	case exam.FieldShuffleChoices:
+		m.ResetShuffleChoices()
+		return nil
+	case exam.FieldWeightByDifficulty:
+		m.ResetWeightByDifficulty()
+		return nil
+	case exam.FieldPartialCredit:
+		m.ResetPartialCredit()
+		return nil
+	case exam.FieldNegativeMarking:
+		m.ResetNegativeMarking()
+		return nil
+	}
+	return fmt.Errorf("unknown Exam field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ExamMutation) AddedEdges() []string {
+	edges := make([]string, 0, 5)
+	if m.sections != nil {
+		edges = append(edges, exam.EdgeSections)
+	}
+	if m.topics != nil {
+		edges = append(edges, exam.EdgeTopics)
+	}
+	if m.units != nil {
+		edges = append(edges, exam.EdgeUnits)
+	}
+	if m.version_rules != nil {
+		edges = append(edges, exam.EdgeVersionRules)
+	}
+	if m.attempts != nil {
+		edges = append(edges, exam.EdgeAttempts)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ExamMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case exam.EdgeSections:
+		ids := make([]ent.Value, 0, len(m.sections))
+		for id := range m.sections {
+			ids = append(ids, id)
+		}
+		return ids
+	case exam.EdgeTopics:
+		ids := make([]ent.Value, 0, len(m.topics))
+		for id := range m.topics {
+			ids = append(ids, id)
+		}
+		return ids
+	case exam.EdgeUnits:
+		ids := make([]ent.Value, 0, len(m.units))
+		for id := range m.units {
+			ids = append(ids, id)
+		}
+		return ids
+	case exam.EdgeVersionRules:
+		ids := make([]ent.Value, 0, len(m.version_rules))
+		for id := range m.version_rules {
+			ids = append(ids, id)
+		}
+		return ids
+	case exam.EdgeAttempts:
+		ids := make([]ent.Value, 0, len(m.attempts))
+		for id := range m.attempts {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ExamMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 5)
+	if m.removedsections != nil {
+		edges = append(edges, exam.EdgeSections)
+	}
+	if m.removedtopics != nil {
+		edges = append(edges, exam.EdgeTopics)
+	}
+	if m.removedunits != nil {
+		edges = append(edges, exam.EdgeUnits)
+	}
+	if m.removedversion_rules != nil {
+		edges = append(edges, exam.EdgeVersionRules)
+	}
+	if m.removedattempts != nil {
+		edges = append(edges, exam.EdgeAttempts)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ExamMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case exam.EdgeSections:
+		ids := make([]ent.Value, 0, len(m.removedsections))
+		for id := range m.removedsections {
+			ids = append(ids, id)
+		}
+		return ids
+	case exam.EdgeTopics:
+		ids := make([]ent.Value, 0, len(m.removedtopics))
+		for id := range m.removedtopics {
+			ids = append(ids, id)
+		}
+		return ids
+	case exam.EdgeUnits:
+		ids := make([]ent.Value, 0, len(m.removedunits))
+		for id := range m.removedunits {
+			ids = append(ids, id)
+		}
+		return ids
+	case exam.EdgeVersionRules:
+		ids := make([]ent.Value, 0, len(m.removedversion_rules))
+		for id := range m.removedversion_rules {
+			ids = append(ids, id)
+		}
+		return ids
+	case exam.EdgeAttempts:
+		ids := make([]ent.Value, 0, len(m.removedattempts))
+		for id := range m.removedattempts {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ExamMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 5)
+	if m.clearedsections {
+		edges = append(edges, exam.EdgeSections)
+	}
+	if m.clearedtopics {
+		edges = append(edges, exam.EdgeTopics)
+	}
+	if m.clearedunits {
+		edges = append(edges, exam.EdgeUnits)
+	}
+	if m.clearedversion_rules {
+		edges = append(edges, exam.EdgeVersionRules)
+	}
+	if m.clearedattempts {
+		edges = append(edges, exam.EdgeAttempts)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ExamMutation) EdgeCleared(name string) bool {
+	switch name {
+	case exam.EdgeSections:
+		return m.clearedsections
+	case exam.EdgeTopics:
+		return m.clearedtopics
+	case exam.EdgeUnits:
+		return m.clearedunits
+	case exam.EdgeVersionRules:
+		return m.clearedversion_rules
+	case exam.EdgeAttempts:
+		return m.clearedattempts
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ExamMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Exam unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ExamMutation) ResetEdge(name string) error {
+	switch name {
+	case exam.EdgeSections:
+		m.ResetSections()
+		return nil
+	case exam.EdgeTopics:
+		m.ResetTopics()
+		return nil
+	case exam.EdgeUnits:
+		m.ResetUnits()
+		return nil
+	case exam.EdgeVersionRules:
+		m.ResetVersionRules()
+		return nil
+	case exam.EdgeAttempts:
+		m.ResetAttempts()
+		return nil
+	}
+	return fmt.Errorf("unknown Exam edge %s", name)
+}
+
+// ProblemMutation represents an operation that mutates the Problem nodes in the graph.
+type ProblemMutation struct {
+	config
+	op                   Op
+	typ                  string
+	id                   *int
+	key                  *string
+	_type                *problem.Type
+	difficulty           *int
+	adddifficulty        *int
+	interaction          *problem.Interaction
+	tolerance            *float64
+	addtolerance         *float64
+	text_match           *problem.TextMatch
+	created_at           *time.Time
+	clearedFields        map[string]struct{}
+	unit                 *int
+	clearedunit          bool
+	versions             map[int]struct{}
+	removedversions      map[int]struct{}
+	clearedversions      bool
+	translations         map[int]struct{}
+	removedtranslations  map[int]struct{}
+	clearedtranslations  bool
+	answers              map[int]struct{}
+	removedanswers       map[int]struct{}
+	clearedanswers       bool
+	attempt_items        map[int]struct{}
+	removedattempt_items map[int]struct{}
+	clearedattempt_items bool
+	parent               *int
+	clearedparent        bool
+	children             map[int]struct{}
+	removedchildren      map[int]struct{}
+	clearedchildren      bool
+	done                 bool
+	oldValue             func(context.Context) (*Problem, error)
+	predicates           []predicate.Problem
+}
+
+var _ ent.Mutation = (*ProblemMutation)(nil)
+
+// problemOption allows management of the mutation configuration using functional options.
+type problemOption func(*ProblemMutation)
+
+// newProblemMutation creates new mutation for the Problem entity.
+func newProblemMutation(c config, op Op, opts ...problemOption) *ProblemMutation {
+	m := &ProblemMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeProblem,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withProblemID sets the ID field of the mutation.
+func withProblemID(id int) problemOption {
+	return func(m *ProblemMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Problem
+		)
+		m.oldValue = func(ctx context.Context) (*Problem, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Problem.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withProblem sets the old Problem of the mutation.
+func withProblem(node *Problem) problemOption {
+	return func(m *ProblemMutation) {
+		m.oldValue = func(context.Context) (*Problem, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ProblemMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ProblemMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ProblemMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ProblemMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Problem.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetKey sets the "key" field.
+func (m *ProblemMutation) SetKey(s string) {
+	m.key = &s
+}
+
+// Key returns the value of the "key" field in the mutation.
+func (m *ProblemMutation) Key() (r string, exists bool) {
+	v := m.key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKey returns the old "key" field's value of the Problem entity.
+// If the Problem object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProblemMutation) OldKey(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKey: %w", err)
+	}
+	return oldValue.Key, nil
+}
+
+// ClearKey clears the value of the "key" field.
+func (m *ProblemMutation) ClearKey() {
+	m.key = nil
+	m.clearedFields[problem.FieldKey] = struct{}{}
+}
+
+// KeyCleared returns if the "key" field was cleared in this mutation.
+func (m *ProblemMutation) KeyCleared() bool {
+	_, ok := m.clearedFields[problem.FieldKey]
+	return ok
+}
+
+// ResetKey resets all changes to the "key" field.
+func (m *ProblemMutation) ResetKey() {
+	m.key = nil
+	delete(m.clearedFields, problem.FieldKey)
+}
+
+// SetType sets the "type" field.
+func (m *ProblemMutation) SetType(pr problem.Type) {
+	m._type = &pr
+}
+
+// GetType returns the value of the "type" field in the mutation.
+func (m *ProblemMutation) GetType() (r problem.Type, exists bool) {
+	v := m._type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldType returns the old "type" field's value of the Problem entity.
+// If the Problem object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProblemMutation) OldType(ctx context.Context) (v problem.Type, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldType: %w", err)
+	}
+	return oldValue.Type, nil
+}
+
+// ResetType resets all changes to the "type" field.
+func (m *ProblemMutation) ResetType() {
+	m._type = nil
+}
+
+// SetDifficulty sets the "difficulty" field.
+func (m *ProblemMutation) SetDifficulty(i int) {
+	m.difficulty = &i
+	m.adddifficulty = nil
+}
+
+// Difficulty returns the value of the "difficulty" field in the mutation.
+func (m *ProblemMutation) Difficulty() (r int, exists bool) {
+	v := m.difficulty
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDifficulty returns the old "difficulty" field's value of the Problem entity.
+// If the Problem object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProblemMutation) OldDifficulty(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDifficulty is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDifficulty requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDifficulty: %w", err)
+	}
+	return oldValue.Difficulty, nil
+}
+
+// AddDifficulty adds i to the "difficulty" field.
+func (m *ProblemMutation) AddDifficulty(i int) {
+	if m.adddifficulty != nil {
+		*m.adddifficulty += i
+	} else {
+		m.adddifficulty = &i
+	}
+}
+
+// AddedDifficulty returns the value that was added to the "difficulty" field in this mutation.
+func (m *ProblemMutation) AddedDifficulty() (r int, exists bool) {
+	v := m.adddifficulty
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetDifficulty resets all changes to the "difficulty" field.
+func (m *ProblemMutation) ResetDifficulty() {
+	m.difficulty = nil
+	m.adddifficulty = nil
+}
+
+// SetInteraction sets the "interaction" field.
+func (m *ProblemMutation) SetInteraction(pr problem.Interaction) {
+	m.interaction = &pr
+}
+
+// Interaction returns the value of the "interaction" field in the mutation.
+func (m *ProblemMutation) Interaction() (r problem.Interaction, exists bool) {
+	v := m.interaction
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldInteraction returns the old "interaction" field's value of the Problem entity.
+// If the Problem object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProblemMutation) OldInteraction(ctx context.Context) (v problem.Interaction, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldInteraction is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldInteraction requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldInteraction: %w", err)
+	}
+	return oldValue.Interaction, nil
+}
+
+// ResetInteraction resets all changes to the "interaction" field.
+func (m *ProblemMutation) ResetInteraction() {
+	m.interaction = nil
+}
+
+// SetTolerance sets the "tolerance" field.
+func (m *ProblemMutation) SetTolerance(f float64) {
+	m.tolerance = &f
+	m.addtolerance = nil
+}
+
+// Tolerance returns the value of the "tolerance" field in the mutation.
+func (m *ProblemMutation) Tolerance() (r float64, exists bool) {
+	v := m.tolerance
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTolerance returns the old "tolerance" field's value of the Problem entity.
+// If the Problem object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProblemMutation) OldTolerance(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTolerance is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTolerance requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTolerance: %w", err)
+	}
+	return oldValue.Tolerance, nil
+}
+
+// AddTolerance adds f to the "tolerance" field.
+func (m *ProblemMutation) AddTolerance(f float64) {
+	if m.addtolerance != nil {
+		*m.addtolerance += f
+	} else {
+		m.addtolerance = &f
+	}
+}
+
+// AddedTolerance returns the value that was added to the "tolerance" field in this mutation.
+func (m *ProblemMutation) AddedTolerance() (r float64, exists bool) {
+	v := m.addtolerance
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetTolerance resets all changes to the "tolerance" field.
+func (m *ProblemMutation) ResetTolerance() {
+	m.tolerance = nil
+	m.addtolerance = nil
+}
+
+// SetTextMatch sets the "text_match" field.
+func (m *ProblemMutation) SetTextMatch(pm problem.TextMatch) {
+	m.text_match = &pm
+}
+
+// TextMatch returns the value of the "text_match" field in the mutation.
+func (m *ProblemMutation) TextMatch() (r problem.TextMatch, exists bool) {
+	v := m.text_match
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTextMatch returns the old "text_match" field's value of the Problem entity.
+// If the Problem object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProblemMutation) OldTextMatch(ctx context.Context) (v problem.TextMatch, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTextMatch is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTextMatch requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTextMatch: %w", err)
+	}
+	return oldValue.TextMatch, nil
+}
+
+// ResetTextMatch resets all changes to the "text_match" field.
+func (m *ProblemMutation) ResetTextMatch() {
+	m.text_match = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *ProblemMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ProblemMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Problem entity.
+// If the Problem object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProblemMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ProblemMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUnitID sets the "unit_id" field.
+func (m *ProblemMutation) SetUnitID(i int) {
+	m.unit = &i
+}
+
+// UnitID returns the value of the "unit_id" field in the mutation.
+func (m *ProblemMutation) UnitID() (r int, exists bool) {
+	v := m.unit
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUnitID returns the old "unit_id" field's value of the Problem entity.
+// If the Problem object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProblemMutation) OldUnitID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUnitID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUnitID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUnitID: %w", err)
+	}
+	return oldValue.UnitID, nil
+}
+
+// ResetUnitID resets all changes to the "unit_id" field.
+func (m *ProblemMutation) ResetUnitID() {
+	m.unit = nil
+}
+
+// SetParentID sets the "parent_id" field.
+func (m *ProblemMutation) SetParentID(i int) {
+	m.parent = &i
+}
+
+// ParentID returns the value of the "parent_id" field in the mutation.
+func (m *ProblemMutation) ParentID() (r int, exists bool) {
+	v := m.parent
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldParentID returns the old "parent_id" field's value of the Problem entity.
+// If the Problem object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProblemMutation) OldParentID(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldParentID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldParentID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldParentID: %w", err)
+	}
+	return oldValue.ParentID, nil
+}
+
+// ClearParentID clears the value of the "parent_id" field.
+func (m *ProblemMutation) ClearParentID() {
+	m.parent = nil
+	m.clearedFields[problem.FieldParentID] = struct{}{}
+}
+
+// ParentIDCleared returns if the "parent_id" field was cleared in this mutation.
+func (m *ProblemMutation) ParentIDCleared() bool {
+	_, ok := m.clearedFields[problem.FieldParentID]
+	return ok
+}
+
+// ResetParentID resets all changes to the "parent_id" field.
+func (m *ProblemMutation) ResetParentID() {
+	m.parent = nil
+	delete(m.clearedFields, problem.FieldParentID)
+}
+
+// ClearUnit clears the "unit" edge to the Unit entity.
+func (m *ProblemMutation) ClearUnit() {
+	m.clearedunit = true
+	m.clearedFields[problem.FieldUnitID] = struct{}{}
+}
+
+// UnitCleared reports if the "unit" edge to the Unit entity was cleared.
+func (m *ProblemMutation) UnitCleared() bool {
+	return m.clearedunit
+}
+
+// UnitIDs returns the "unit" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UnitID instead. It exists only for internal usage by the builders.
+func (m *ProblemMutation) UnitIDs() (ids []int) {
+	if id := m.unit; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUnit resets all changes to the "unit" edge.
+func (m *ProblemMutation) ResetUnit() {
+	m.unit = nil
+	m.clearedunit = false
+}
+
+// AddVersionIDs adds the "versions" edge to the VersionRule entity by ids.
+func (m *ProblemMutation) AddVersionIDs(ids ...int) {
+	if m.versions == nil {
+		m.versions = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.versions[ids[i]] = struct{}{}
+	}
+}
+
+// ClearVersions clears the "versions" edge to the VersionRule entity.
+func (m *ProblemMutation) ClearVersions() {
+	m.clearedversions = true
+}
+
+// VersionsCleared reports if the "versions" edge to the VersionRule entity was cleared.
+func (m *ProblemMutation) VersionsCleared() bool {
+	return m.clearedversions
+}
+
+// RemoveVersionIDs removes the "versions" edge to the VersionRule entity by IDs.
+func (m *ProblemMutation) RemoveVersionIDs(ids ...int) {
+	if m.removedversions == nil {
+		m.removedversions = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.versions, ids[i])
+		m.removedversions[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedVersions returns the removed IDs of the "versions" edge to the VersionRule entity.
+func (m *ProblemMutation) RemovedVersionsIDs() (ids []int) {
+	for id := range m.removedversions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// VersionsIDs returns the "versions" edge IDs in the mutation.
+func (m *ProblemMutation) VersionsIDs() (ids []int) {
+	for id := range m.versions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetVersions resets all changes to the "versions" edge.
+func (m *ProblemMutation) ResetVersions() {
+	m.versions = nil
+	m.clearedversions = false
+	m.removedversions = nil
+}
+
+// AddTranslationIDs adds the "translations" edge to the ProblemTranslation entity by ids.
+func (m *ProblemMutation) AddTranslationIDs(ids ...int) {
+	if m.translations == nil {
+		m.translations = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.translations[ids[i]] = struct{}{}
+	}
+}
+
+// ClearTranslations clears the "translations" edge to the ProblemTranslation entity.
+func (m *ProblemMutation) ClearTranslations() {
+	m.clearedtranslations = true
+}
+
+// TranslationsCleared reports if the "translations" edge to the ProblemTranslation entity was cleared.
+func (m *ProblemMutation) TranslationsCleared() bool {
+	return m.clearedtranslations
+}
+
+// RemoveTranslationIDs removes the "translations" edge to the ProblemTranslation entity by IDs.
+func (m *ProblemMutation) RemoveTranslationIDs(ids ...int) {
+	if m.removedtranslations == nil {
+		m.removedtranslations = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.translations, ids[i])
+		m.removedtranslations[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedTranslations returns the removed IDs of the "translations" edge to the ProblemTranslation entity.
+func (m *ProblemMutation) RemovedTranslationsIDs() (ids []int) {
+	for id := range m.removedtranslations {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// TranslationsIDs returns the "translations" edge IDs in the mutation.
+func (m *ProblemMutation) TranslationsIDs() (ids []int) {
+	for id := range m.translations {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetTranslations resets all changes to the "translations" edge.
+func (m *ProblemMutation) ResetTranslations() {
+	m.translations = nil
+	m.clearedtranslations = false
+	m.removedtranslations = nil
+}
+
+// AddAnswerIDs adds the "answers" edge to the Answer entity by ids.
+func (m *ProblemMutation) AddAnswerIDs(ids ...int) {
+	if m.answers == nil {
+		m.answers = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.answers[ids[i]] = struct{}{}
+	}
+}
+
+// ClearAnswers clears the "answers" edge to the Answer entity.
+func (m *ProblemMutation) ClearAnswers() {
+	m.clearedanswers = true
+}
+
+// AnswersCleared reports if the "answers" edge to the Answer entity was cleared.
+func (m *ProblemMutation) AnswersCleared() bool {
+	return m.clearedanswers
+}
+
+// RemoveAnswerIDs removes the "answers" edge to the Answer entity by IDs.
+func (m *ProblemMutation) RemoveAnswerIDs(ids ...int) {
+	if m.removedanswers == nil {
+		m.removedanswers = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.answers, ids[i])
+		m.removedanswers[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedAnswers returns the removed IDs of the "answers" edge to the Answer entity.
+func (m *ProblemMutation) RemovedAnswersIDs() (ids []int) {
+	for id := range m.removedanswers {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// AnswersIDs returns the "answers" edge IDs in the mutation.
+func (m *ProblemMutation) AnswersIDs() (ids []int) {
+	for id := range m.answers {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetAnswers resets all changes to the "answers" edge.
+func (m *ProblemMutation) ResetAnswers() {
+	m.answers = nil
+	m.clearedanswers = false
+	m.removedanswers = nil
+}
+
+// AddAttemptItemIDs adds the "attempt_items" edge to the AttemptItem entity by ids.
+func (m *ProblemMutation) AddAttemptItemIDs(ids ...int) {
+	if m.attempt_items == nil {
+		m.attempt_items = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.attempt_items[ids[i]] = struct{}{}
+	}
+}
+
+// ClearAttemptItems clears the "attempt_items" edge to the AttemptItem entity.
+func (m *ProblemMutation) ClearAttemptItems() {
+	m.clearedattempt_items = true
+}
+
+// AttemptItemsCleared reports if the "attempt_items" edge to the AttemptItem entity was cleared.
+func (m *ProblemMutation) AttemptItemsCleared() bool {
+	return m.clearedattempt_items
+}
+
+// RemoveAttemptItemIDs removes the "attempt_items" edge to the AttemptItem entity by IDs.
+func (m *ProblemMutation) RemoveAttemptItemIDs(ids ...int) {
+	if m.removedattempt_items == nil {
+		m.removedattempt_items = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.attempt_items, ids[i])
+		m.removedattempt_items[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedAttemptItems returns the removed IDs of the "attempt_items" edge to the AttemptItem entity.
+func (m *ProblemMutation) RemovedAttemptItemsIDs() (ids []int) {
+	for id := range m.removedattempt_items {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// AttemptItemsIDs returns the "attempt_items" edge IDs in the mutation.
+func (m *ProblemMutation) AttemptItemsIDs() (ids []int) {
+	for id := range m.attempt_items {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetAttemptItems resets all changes to the "attempt_items" edge.
+func (m *ProblemMutation) ResetAttemptItems() {
+	m.attempt_items = nil
+	m.clearedattempt_items = false
+	m.removedattempt_items = nil
+}
+
+// ClearParent clears the "parent" edge to the Problem entity.
+func (m *ProblemMutation) ClearParent() {
+	m.clearedparent = true
+	m.clearedFields[problem.FieldParentID] = struct{}{}
+}
+
+// ParentCleared reports if the "parent" edge to the Problem entity was cleared.
+func (m *ProblemMutation) ParentCleared() bool {
+	return m.ParentIDCleared() || m.clearedparent
+}
+
+// ParentIDs returns the "parent" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ParentID instead. It exists only for internal usage by the builders.
+func (m *ProblemMutation) ParentIDs() (ids []int) {
+	if id := m.parent; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetParent resets all changes to the "parent" edge.
+func (m *ProblemMutation) ResetParent() {
+	m.parent = nil
+	m.clearedparent = false
+}
+
+// AddChildIDs adds the "children" edge to the Problem entity by ids.
+func (m *ProblemMutation) AddChildIDs(ids ...int) {
+	if m.children == nil {
+		m.children = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.children[ids[i]] = struct{}{}
+	}
+}
+
+// ClearChildren clears the "children" edge to the Problem entity.
+func (m *ProblemMutation) ClearChildren() {
+	m.clearedchildren = true
+}
+
+// ChildrenCleared reports if the "children" edge to the Problem entity was cleared.
+func (m *ProblemMutation) ChildrenCleared() bool {
+	return m.clearedchildren
+}
+
+// RemoveChildIDs removes the "children" edge to the Problem entity by IDs.
+func (m *ProblemMutation) RemoveChildIDs(ids ...int) {
+	if m.removedchildren == nil {
+		m.removedchildren = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.children, ids[i])
+		m.removedchildren[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedChildren returns the removed IDs of the "children" edge to the Problem entity.
+func (m *ProblemMutation) RemovedChildrenIDs() (ids []int) {
+	for id := range m.removedchildren {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ChildrenIDs returns the "children" edge IDs in the mutation.
+func (m *ProblemMutation) ChildrenIDs() (ids []int) {
+	for id := range m.children {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetChildren resets all changes to the "children" edge.
+func (m *ProblemMutation) ResetChildren() {
+	m.children = nil
+	m.clearedchildren = false
+	m.removedchildren = nil
+}
+
+// Where appends a list predicates to the ProblemMutation builder.
+func (m *ProblemMutation) Where(ps ...predicate.Problem) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ProblemMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ProblemMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Problem, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ProblemMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ProblemMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Problem).
+func (m *ProblemMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ProblemMutation) Fields() []string {
+	fields := make([]string, 0, 9)
+	if m.key != nil {
+		fields = append(fields, problem.FieldKey)
+	}
+	if m._type != nil {
+		fields = append(fields, problem.FieldType)
+	}
+	if m.difficulty != nil {
+		fields = append(fields, problem.FieldDifficulty)
+	}
+	if m.interaction != nil {
+		fields = append(fields, problem.FieldInteraction)
+	}
+	if m.tolerance != nil {
+		fields = append(fields, problem.FieldTolerance)
+	}
+	if m.text_match != nil {
+		fields = append(fields, problem.FieldTextMatch)
+	}
+	if m.created_at != nil {
+		fields = append(fields, problem.FieldCreatedAt)
+	}
+	if m.unit != nil {
+		fields = append(fields, problem.FieldUnitID)
+	}
+	if m.parent != nil {
+		fields = append(fields, problem.FieldParentID)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ProblemMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case problem.FieldKey:
+		return m.Key()
+	case problem.FieldType:
+		return m.GetType()
+	case problem.FieldDifficulty:
+		return m.Difficulty()
+	case problem.FieldInteraction:
+		return m.Interaction()
+	case problem.FieldTolerance:
+		return m.Tolerance()
+	case problem.FieldTextMatch:
+		return m.TextMatch()
+	case problem.FieldCreatedAt:
+		return m.CreatedAt()
+	case problem.FieldUnitID:
+		return m.UnitID()
+	case problem.FieldParentID:
+		return m.ParentID()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ProblemMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case problem.FieldKey:
+		return m.OldKey(ctx)
+	case problem.FieldType:
+		return m.OldType(ctx)
+	case problem.FieldDifficulty:
+		return m.OldDifficulty(ctx)
+	case problem.FieldInteraction:
+		return m.OldInteraction(ctx)
+	case problem.FieldTolerance:
+		return m.OldTolerance(ctx)
+	case problem.FieldTextMatch:
+		return m.OldTextMatch(ctx)
+	case problem.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case problem.FieldUnitID:
+		return m.OldUnitID(ctx)
+	case problem.FieldParentID:
+		return m.OldParentID(ctx)
+	}
+	return nil, fmt.Errorf("unknown Problem field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ProblemMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case problem.FieldKey:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKey(v)
+		return nil
+	case problem.FieldType:
+		v, ok := value.(problem.Type)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetType(v)
+		return nil
+	case problem.FieldDifficulty:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDifficulty(v)
+		return nil
+	case problem.FieldInteraction:
+		v, ok := value.(problem.Interaction)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetInteraction(v)
+		return nil
+	case problem.FieldTolerance:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTolerance(v)
+		return nil
+	case problem.FieldTextMatch:
+		v, ok := value.(problem.TextMatch)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTextMatch(v)
+		return nil
+	case problem.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case problem.FieldUnitID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUnitID(v)
+		return nil
+	case problem.FieldParentID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetParentID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Problem field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ProblemMutation) AddedFields() []string {
+	var fields []string
+	if m.adddifficulty != nil {
+		fields = append(fields, problem.FieldDifficulty)
+	}
+	if m.addtolerance != nil {
+		fields = append(fields, problem.FieldTolerance)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ProblemMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case problem.FieldDifficulty:
+		return m.AddedDifficulty()
+	case problem.FieldTolerance:
+		return m.AddedTolerance()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ProblemMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case problem.FieldDifficulty:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDifficulty(v)
+		return nil
+	case problem.FieldTolerance:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTolerance(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Problem numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ProblemMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(problem.FieldKey) {
+		fields = append(fields, problem.FieldKey)
+	}
+	if m.FieldCleared(problem.FieldParentID) {
+		fields = append(fields, problem.FieldParentID)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ProblemMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ProblemMutation) ClearField(name string) error {
+	switch name {
+	case problem.FieldKey:
+		m.ClearKey()
+		return nil
+	case problem.FieldParentID:
+		m.ClearParentID()
+		return nil
+	}
+	return fmt.Errorf("unknown Problem nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ProblemMutation) ResetField(name string) error {
+	switch name {
+	case problem.FieldKey:
+		m.ResetKey()
+		return nil
+	case problem.FieldType:
+		m.ResetType()
+		return nil
+	case problem.FieldDifficulty:
+		m.ResetDifficulty()
+		return nil
+	case problem.FieldInteraction:
+		m.ResetInteraction()
+		return nil
+	case problem.FieldTolerance:
+		m.ResetTolerance()
+		return nil
+	case problem.FieldTextMatch:
+		m.ResetTextMatch()
+		return nil
+	case problem.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case problem.FieldUnitID:
+		m.ResetUnitID()
+		return nil
+	case problem.FieldParentID:
+		m.ResetParentID()
+		return nil
+	}
+	return fmt.Errorf("unknown Problem field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ProblemMutation) AddedEdges() []string {
+	edges := make([]string, 0, 7)
+	if m.unit != nil {
+		edges = append(edges, problem.EdgeUnit)
+	}
+	if m.versions != nil {
+		edges = append(edges, problem.EdgeVersions)
+	}
+	if m.translations != nil {
+		edges = append(edges, problem.EdgeTranslations)
+	}
+	if m.answers != nil {
+		edges = append(edges, problem.EdgeAnswers)
+	}
+	if m.attempt_items != nil {
+		edges = append(edges, problem.EdgeAttemptItems)
+	}
+	if m.parent != nil {
+		edges = append(edges, problem.EdgeParent)
+	}
+	if m.children != nil {
+		edges = append(edges, problem.EdgeChildren)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ProblemMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case problem.EdgeUnit:
+		if id := m.unit; id != nil {
+			return []ent.Value{*id}
+		}
+	case problem.EdgeVersions:
+		ids := make([]ent.Value, 0, len(m.versions))
+		for id := range m.versions {
+			ids = append(ids, id)
+		}
+		return ids
+	case problem.EdgeTranslations:
+		ids := make([]ent.Value, 0, len(m.translations))
+		for id := range m.translations {
+			ids = append(ids, id)
+		}
+		return ids
+	case problem.EdgeAnswers:
+		ids := make([]ent.Value, 0, len(m.answers))
+		for id := range m.answers {
+			ids = append(ids, id)
+		}
+		return ids
+	case problem.EdgeAttemptItems:
+		ids := make([]ent.Value, 0, len(m.attempt_items))
+		for id := range m.attempt_items {
+			ids = append(ids, id)
+		}
+		return ids
+	case problem.EdgeParent:
+		if id := m.parent; id != nil {
+			return []ent.Value{*id}
+		}
+	case problem.EdgeChildren:
+		ids := make([]ent.Value, 0, len(m.children))
+		for id := range m.children {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ProblemMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 7)
+	if m.removedversions != nil {
+		edges = append(edges, problem.EdgeVersions)
+	}
+	if m.removedtranslations != nil {
+		edges = append(edges, problem.EdgeTranslations)
+	}
+	if m.removedanswers != nil {
+		edges = append(edges, problem.EdgeAnswers)
+	}
+	if m.removedattempt_items != nil {
+		edges = append(edges, problem.EdgeAttemptItems)
+	}
+	if m.removedchildren != nil {
+		edges = append(edges, problem.EdgeChildren)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ProblemMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case problem.EdgeVersions:
+		ids := make([]ent.Value, 0, len(m.removedversions))
+		for id := range m.removedversions {
+			ids = append(ids, id)
+		}
+		return ids
+	case problem.EdgeTranslations:
+		ids := make([]ent.Value, 0, len(m.removedtranslations))
+		for id := range m.removedtranslations {
+			ids = append(ids, id)
+		}
+		return ids
+	case problem.EdgeAnswers:
+		ids := make([]ent.Value, 0, len(m.removedanswers))
+		for id := range m.removedanswers {
+			ids = append(ids, id)
+		}
+		return ids
+	case problem.EdgeAttemptItems:
+		ids := make([]ent.Value, 0, len(m.removedattempt_items))
+		for id := range m.removedattempt_items {
+			ids = append(ids, id)
+		}
+		return ids
+	case problem.EdgeChildren:
+		ids := make([]ent.Value, 0, len(m.removedchildren))
+		for id := range m.removedchildren {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ProblemMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 7)
+	if m.clearedunit {
+		edges = append(edges, problem.EdgeUnit)
+	}
+	if m.clearedversions {
+		edges = append(edges, problem.EdgeVersions)
+	}
+	if m.clearedtranslations {
+		edges = append(edges, problem.EdgeTranslations)
+	}
+	if m.clearedanswers {
+		edges = append(edges, problem.EdgeAnswers)
+	}
+	if m.clearedattempt_items {
+		edges = append(edges, problem.EdgeAttemptItems)
+	}
+	if m.clearedparent {
+		edges = append(edges, problem.EdgeParent)
+	}
+	if m.clearedchildren {
+		edges = append(edges, problem.EdgeChildren)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ProblemMutation) EdgeCleared(name string) bool {
+	switch name {
+	case problem.EdgeUnit:
+		return m.clearedunit
+	case problem.EdgeVersions:
+		return m.clearedversions
+	case problem.EdgeTranslations:
+		return m.clearedtranslations
+	case problem.EdgeAnswers:
+		return m.clearedanswers
+	case problem.EdgeAttemptItems:
+		return m.clearedattempt_items
+	case problem.EdgeParent:
+		return m.clearedparent
+	case problem.EdgeChildren:
+		return m.clearedchildren
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ProblemMutation) ClearEdge(name string) error {
+	switch name {
+	case problem.EdgeUnit:
+		m.ClearUnit()
+		return nil
+	case problem.EdgeParent:
+		m.ClearParent()
+		return nil
+	}
+	return fmt.Errorf("unknown Problem unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ProblemMutation) ResetEdge(name string) error {
+	switch name {
+	case problem.EdgeUnit:
+		m.ResetUnit()
+		return nil
+	case problem.EdgeVersions:
+		m.ResetVersions()
+		return nil
+	case problem.EdgeTranslations:
+		m.ResetTranslations()
+		return nil
+	case problem.EdgeAnswers:
+		m.ResetAnswers()
+		return nil
+	case problem.EdgeAttemptItems:
+		m.ResetAttemptItems()
+		return nil
+	case problem.EdgeParent:
+		m.ResetParent()
+		return nil
+	case problem.EdgeChildren:
+		m.ResetChildren()
+		return nil
+	}
+	return fmt.Errorf("unknown Problem edge %s", name)
+}
+
+// ProblemTranslationMutation represents an operation that mutates the ProblemTranslation nodes in the graph.
+type ProblemTranslationMutation struct {
+	config
+	op             Op
+	typ            string
+	id             *int
+	locale         *string
+	title          *string
+	content        *string
+	explanation    *string
+	clearedFields  map[string]struct{}
+	problem        *int
+	clearedproblem bool
+	choices        map[int]struct{}
+	removedchoices map[int]struct{}
+	clearedchoices bool
+	done           bool
+	oldValue       func(context.Context) (*ProblemTranslation, error)
+	predicates     []predicate.ProblemTranslation
+}
+
+var _ ent.Mutation = (*ProblemTranslationMutation)(nil)
+
+// problemtranslationOption allows management of the mutation configuration using functional options.
+type problemtranslationOption func(*ProblemTranslationMutation)
+
+// newProblemTranslationMutation creates new mutation for the ProblemTranslation entity.
+func newProblemTranslationMutation(c config, op Op, opts ...problemtranslationOption) *ProblemTranslationMutation {
+	m := &ProblemTranslationMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeProblemTranslation,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withProblemTranslationID sets the ID field of the mutation.
+func withProblemTranslationID(id int) problemtranslationOption {
+	return func(m *ProblemTranslationMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ProblemTranslation
+		)
+		m.oldValue = func(ctx context.Context) (*ProblemTranslation, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ProblemTranslation.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withProblemTranslation sets the old ProblemTranslation of the mutation.
+func withProblemTranslation(node *ProblemTranslation) problemtranslationOption {
+	return func(m *ProblemTranslationMutation) {
+		m.oldValue = func(context.Context) (*ProblemTranslation, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ProblemTranslationMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ProblemTranslationMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ProblemTranslationMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ProblemTranslationMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ProblemTranslation.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetLocale sets the "locale" field.
+func (m *ProblemTranslationMutation) SetLocale(s string) {
+	m.locale = &s
+}
+
+// Locale returns the value of the "locale" field in the mutation.
+func (m *ProblemTranslationMutation) Locale() (r string, exists bool) {
+	v := m.locale
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLocale returns the old "locale" field's value of the ProblemTranslation entity.
+// If the ProblemTranslation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProblemTranslationMutation) OldLocale(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLocale is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLocale requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLocale: %w", err)
+	}
+	return oldValue.Locale, nil
+}
+
+// ResetLocale resets all changes to the "locale" field.
+func (m *ProblemTranslationMutation) ResetLocale() {
+	m.locale = nil
+}
+
+// SetTitle sets the "title" field.
+func (m *ProblemTranslationMutation) SetTitle(s string) {
+	m.title = &s
+}
+
+// Title returns the value of the "title" field in the mutation.
+func (m *ProblemTranslationMutation) Title() (r string, exists bool) {
+	v := m.title
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTitle returns the old "title" field's value of the ProblemTranslation entity.
+// If the ProblemTranslation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProblemTranslationMutation) OldTitle(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTitle requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
+	}
+	return oldValue.Title, nil
+}
+
+// ResetTitle resets all changes to the "title" field.
+func (m *ProblemTranslationMutation) ResetTitle() {
+	m.title = nil
+}
+
+// SetContent sets the "content" field.
+func (m *ProblemTranslationMutation) SetContent(s string) {
+	m.content = &s
+}
+
+// Content returns the value of the "content" field in the mutation.
+func (m *ProblemTranslationMutation) Content() (r string, exists bool) {
+	v := m.content
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldContent returns the old "content" field's value of the ProblemTranslation entity.
+// If the ProblemTranslation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProblemTranslationMutation) OldContent(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldContent is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldContent requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldContent: %w", err)
+	}
+	return oldValue.Content, nil
+}
+
+// ResetContent resets all changes to the "content" field.
+func (m *ProblemTranslationMutation) ResetContent() {
+	m.content = nil
+}
+
+// SetExplanation sets the "explanation" field.
+func (m *ProblemTranslationMutation) SetExplanation(s string) {
+	m.explanation = &s
+}
+
+// Explanation returns the value of the "explanation" field in the mutation.
+func (m *ProblemTranslationMutation) Explanation() (r string, exists bool) {
+	v := m.explanation
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExplanation returns the old "explanation" field's value of the ProblemTranslation entity.
+// If the ProblemTranslation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProblemTranslationMutation) OldExplanation(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExplanation is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExplanation requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExplanation: %w", err)
+	}
+	return oldValue.Explanation, nil
+}
+
+// ClearExplanation clears the value of the "explanation" field.
+func (m *ProblemTranslationMutation) ClearExplanation() {
+	m.explanation = nil
+	m.clearedFields[problemtranslation.FieldExplanation] = struct{}{}
+}
+
+// ExplanationCleared returns if the "explanation" field was cleared in this mutation.
+func (m *ProblemTranslationMutation) ExplanationCleared() bool {
+	_, ok := m.clearedFields[problemtranslation.FieldExplanation]
+	return ok
+}
+
+// ResetExplanation resets all changes to the "explanation" field.
+func (m *ProblemTranslationMutation) ResetExplanation() {
+	m.explanation = nil
+	delete(m.clearedFields, problemtranslation.FieldExplanation)
+}
+
+// SetProblemID sets the "problem_id" field.
+func (m *ProblemTranslationMutation) SetProblemID(i int) {
+	m.problem = &i
+}
+
+// ProblemID returns the value of the "problem_id" field in the mutation.
+func (m *ProblemTranslationMutation) ProblemID() (r int, exists bool) {
+	v := m.problem
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProblemID returns the old "problem_id" field's value of the ProblemTranslation entity.
+// If the ProblemTranslation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProblemTranslationMutation) OldProblemID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProblemID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProblemID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProblemID: %w", err)
+	}
+	return oldValue.ProblemID, nil
+}
+
+// ResetProblemID resets all changes to the "problem_id" field.
+func (m *ProblemTranslationMutation) ResetProblemID() {
+	m.problem = nil
+}
+
+// ClearProblem clears the "problem" edge to the Problem entity.
+func (m *ProblemTranslationMutation) ClearProblem() {
+	m.clearedproblem = true
+	m.clearedFields[problemtranslation.FieldProblemID] = struct{}{}
+}
+
+// ProblemCleared reports if the "problem" edge to the Problem entity was cleared.
+func (m *ProblemTranslationMutation) ProblemCleared() bool {
+	return m.clearedproblem
+}
+
+// ProblemIDs returns the "problem" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ProblemID instead. It exists only for internal usage by the builders.
+func (m *ProblemTranslationMutation) ProblemIDs() (ids []int) {
+	if id := m.problem; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetProblem resets all changes to the "problem" edge.
+func (m *ProblemTranslationMutation) ResetProblem() {
+	m.problem = nil
+	m.clearedproblem = false
+}
+
+// AddChoiceIDs adds the "choices" edge to the Choice entity by ids.
+func (m *ProblemTranslationMutation) AddChoiceIDs(ids ...int) {
+	if m.choices == nil {
+		m.choices = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.choices[ids[i]] = struct{}{}
+	}
+}
+
+// ClearChoices clears the "choices" edge to the Choice entity.
+func (m *ProblemTranslationMutation) ClearChoices() {
+	m.clearedchoices = true
+}
+
+// ChoicesCleared reports if the "choices" edge to the Choice entity was cleared.
+func (m *ProblemTranslationMutation) ChoicesCleared() bool {
+	return m.clearedchoices
+}
+
+// RemoveChoiceIDs removes the "choices" edge to the Choice entity by IDs.
+func (m *ProblemTranslationMutation) RemoveChoiceIDs(ids ...int) {
+	if m.removedchoices == nil {
+		m.removedchoices = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.choices, ids[i])
+		m.removedchoices[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedChoices returns the removed IDs of the "choices" edge to the Choice entity.
+func (m *ProblemTranslationMutation) RemovedChoicesIDs() (ids []int) {
+	for id := range m.removedchoices {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ChoicesIDs returns the "choices" edge IDs in the mutation.
+func (m *ProblemTranslationMutation) ChoicesIDs() (ids []int) {
+	for id := range m.choices {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetChoices resets all changes to the "choices" edge.
+func (m *ProblemTranslationMutation) ResetChoices() {
+	m.choices = nil
+	m.clearedchoices = false
+	m.removedchoices = nil
+}
+
+// Where appends a list predicates to the ProblemTranslationMutation builder.
+func (m *ProblemTranslationMutation) Where(ps ...predicate.ProblemTranslation) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ProblemTranslationMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ProblemTranslationMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ProblemTranslation, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ProblemTranslationMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ProblemTranslationMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (ProblemTranslation).
+func (m *ProblemTranslationMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ProblemTranslationMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.locale != nil {
+		fields = append(fields, problemtranslation.FieldLocale)
+	}
+	if m.title != nil {
+		fields = append(fields, problemtranslation.FieldTitle)
+	}
+	if m.content != nil {
+		fields = append(fields, problemtranslation.FieldContent)
+	}
+	if m.explanation != nil {
+		fields = append(fields, problemtranslation.FieldExplanation)
+	}
+	if m.problem != nil {
+		fields = append(fields, problemtranslation.FieldProblemID)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ProblemTranslationMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case problemtranslation.FieldLocale:
+		return m.Locale()
+	case problemtranslation.FieldTitle:
+		return m.Title()
+	case problemtranslation.FieldContent:
+		return m.Content()
+	case problemtranslation.FieldExplanation:
+		return m.Explanation()
+	case problemtranslation.FieldProblemID:
+		return m.ProblemID()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ProblemTranslationMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case problemtranslation.FieldLocale:
+		return m.OldLocale(ctx)
+	case problemtranslation.FieldTitle:
+		return m.OldTitle(ctx)
+	case problemtranslation.FieldContent:
+		return m.OldContent(ctx)
+	case problemtranslation.FieldExplanation:
+		return m.OldExplanation(ctx)
+	case problemtranslation.FieldProblemID:
+		return m.OldProblemID(ctx)
+	}
+	return nil, fmt.Errorf("unknown ProblemTranslation field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ProblemTranslationMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case problemtranslation.FieldLocale:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLocale(v)
+		return nil
+	case problemtranslation.FieldTitle:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTitle(v)
+		return nil
+	case problemtranslation.FieldContent:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetContent(v)
+		return nil
+	case problemtranslation.FieldExplanation:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExplanation(v)
+		return nil
+	case problemtranslation.FieldProblemID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProblemID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ProblemTranslation field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ProblemTranslationMutation) AddedFields() []string {
+	var fields []string
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ProblemTranslationMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ProblemTranslationMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown ProblemTranslation numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ProblemTranslationMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(problemtranslation.FieldExplanation) {
+		fields = append(fields, problemtranslation.FieldExplanation)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ProblemTranslationMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ProblemTranslationMutation) ClearField(name string) error {
+	switch name {
+	case problemtranslation.FieldExplanation:
+		m.ClearExplanation()
+		return nil
+	}
+	return fmt.Errorf("unknown ProblemTranslation nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ProblemTranslationMutation) ResetField(name string) error {
+	switch name {
+	case problemtranslation.FieldLocale:
+		m.ResetLocale()
+		return nil
+	case problemtranslation.FieldTitle:
+		m.ResetTitle()
+		return nil
+	case problemtranslation.FieldContent:
+		m.ResetContent()
+		return nil
+	case problemtranslation.FieldExplanation:
+		m.ResetExplanation()
+		return nil
+	case problemtranslation.FieldProblemID:
+		m.ResetProblemID()
+		return nil
+	}
+	return fmt.Errorf("unknown ProblemTranslation field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ProblemTranslationMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.problem != nil {
+		edges = append(edges, problemtranslation.EdgeProblem)
 	}
+	if m.choices != nil {
+		edges = append(edges, problemtranslation.EdgeChoices)
+	}
+	return edges
 }
 
-// ClearUnits clears the "units" edge to the Unit entity.
-func (m *ExamMutation) ClearUnits() {
-	m.clearedunits = true
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ProblemTranslationMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case problemtranslation.EdgeProblem:
+		if id := m.problem; id != nil {
+			return []ent.Value{*id}
+		}
+	case problemtranslation.EdgeChoices:
+		ids := make([]ent.Value, 0, len(m.choices))
+		for id := range m.choices {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
 }
 
-// UnitsCleared reports if the "units" edge to the Unit entity was cleared.
-func (m *ExamMutation) UnitsCleared() bool {
-	return m.clearedunits
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ProblemTranslationMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removedchoices != nil {
+		edges = append(edges, problemtranslation.EdgeChoices)
+	}
+	return edges
 }
 
-// RemoveUnitIDs removes the "units" edge to the Unit entity by IDs.
-func (m *ExamMutation) RemoveUnitIDs(ids ...int) {
-	if m.removedunits == nil {
-		m.removedunits = make(map[int]struct{})
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ProblemTranslationMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case problemtranslation.EdgeChoices:
+		ids := make([]ent.Value, 0, len(m.removedchoices))
+		for id := range m.removedchoices {
+			ids = append(ids, id)
+		}
+		return ids
 	}
-	for i := range ids {
-		delete(m.units, ids[i])
-		m.removedunits[ids[i]] = struct{}{}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ProblemTranslationMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedproblem {
+		edges = append(edges, problemtranslation.EdgeProblem)
+	}
+	if m.clearedchoices {
+		edges = append(edges, problemtranslation.EdgeChoices)
 	}
+	return edges
 }
 
-// RemovedUnits returns the removed IDs of the "units" edge to the Unit entity.
-func (m *ExamMutation) RemovedUnitsIDs() (ids []int) {
-	for id := range m.removedunits {
-		ids = append(ids, id)
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ProblemTranslationMutation) EdgeCleared(name string) bool {
+	switch name {
+	case problemtranslation.EdgeProblem:
+		return m.clearedproblem
+	case problemtranslation.EdgeChoices:
+		return m.clearedchoices
 	}
-	return
+	return false
 }
 
-// UnitsIDs returns the "units" edge IDs in the mutation.
-func (m *ExamMutation) UnitsIDs() (ids []int) {
-	for id := range m.units {
-		ids = append(ids, id)
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ProblemTranslationMutation) ClearEdge(name string) error {
+	switch name {
+	case problemtranslation.EdgeProblem:
+		m.ClearProblem()
+		return nil
 	}
-	return
+	return fmt.Errorf("unknown ProblemTranslation unique edge %s", name)
 }
 
-// ResetUnits resets all changes to the "units" edge.
-func (m *ExamMutation) ResetUnits() {
-	m.units = nil
-	m.clearedunits = false
-	m.removedunits = nil
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ProblemTranslationMutation) ResetEdge(name string) error {
+	switch name {
+	case problemtranslation.EdgeProblem:
+		m.ResetProblem()
+		return nil
+	case problemtranslation.EdgeChoices:
+		m.ResetChoices()
+		return nil
+	}
+	return fmt.Errorf("unknown ProblemTranslation edge %s", name)
 }
 
-// AddVersionRuleIDs adds the "version_rules" edge to the VersionRule entity by ids.
-func (m *ExamMutation) AddVersionRuleIDs(ids ...int) {
-	if m.version_rules == nil {
-		m.version_rules = make(map[int]struct{})
+// RoleMutation represents an operation that mutates the Role nodes in the graph.
+type RoleMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	name          *string
+	clearedFields map[string]struct{}
+	users         map[int]struct{}
+	removedusers  map[int]struct{}
+	clearedusers  bool
+	done          bool
+	oldValue      func(context.Context) (*Role, error)
+	predicates    []predicate.Role
+}
+
+var _ ent.Mutation = (*RoleMutation)(nil)
+
+// roleOption allows management of the mutation configuration using functional options.
+type roleOption func(*RoleMutation)
+
+// newRoleMutation creates new mutation for the Role entity.
+func newRoleMutation(c config, op Op, opts ...roleOption) *RoleMutation {
+	m := &RoleMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeRole,
+		clearedFields: make(map[string]struct{}),
 	}
-	for i := range ids {
-		m.version_rules[ids[i]] = struct{}{}
+	for _, opt := range opts {
+		opt(m)
 	}
+	return m
 }
 
-// ClearVersionRules clears the "version_rules" edge to the VersionRule entity.
-func (m *ExamMutation) ClearVersionRules() {
-	m.clearedversion_rules = true
+// withRoleID sets the ID field of the mutation.
+func withRoleID(id int) roleOption {
+	return func(m *RoleMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Role
+		)
+		m.oldValue = func(ctx context.Context) (*Role, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Role.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
 }
 
-// VersionRulesCleared reports if the "version_rules" edge to the VersionRule entity was cleared.
-func (m *ExamMutation) VersionRulesCleared() bool {
-	return m.clearedversion_rules
+// withRole sets the old Role of the mutation.
+func withRole(node *Role) roleOption {
+	return func(m *RoleMutation) {
+		m.oldValue = func(context.Context) (*Role, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
 }
 
-// RemoveVersionRuleIDs removes the "version_rules" edge to the VersionRule entity by IDs.
-func (m *ExamMutation) RemoveVersionRuleIDs(ids ...int) {
-	if m.removedversion_rules == nil {
-		m.removedversion_rules = make(map[int]struct{})
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m RoleMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m RoleMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *RoleMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *RoleMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Role.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetName sets the "name" field.
+func (m *RoleMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *RoleMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Role entity.
+// If the Role object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoleMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *RoleMutation) ResetName() {
+	m.name = nil
+}
+
+// AddUserIDs adds the "users" edge to the User entity by ids.
+func (m *RoleMutation) AddUserIDs(ids ...int) {
+	if m.users == nil {
+		m.users = make(map[int]struct{})
 	}
 	for i := range ids {
-		delete(m.version_rules, ids[i])
-		m.removedversion_rules[ids[i]] = struct{}{}
+		m.users[ids[i]] = struct{}{}
+	}
+}
+
+// ClearUsers clears the "users" edge to the User entity.
+func (m *RoleMutation) ClearUsers() {
+	m.clearedusers = true
+}
+
+// UsersCleared reports if the "users" edge to the User entity was cleared.
+func (m *RoleMutation) UsersCleared() bool {
+	return m.clearedusers
+}
+
+// RemoveUserIDs removes the "users" edge to the User entity by IDs.
+func (m *RoleMutation) RemoveUserIDs(ids ...int) {
+	if m.removedusers == nil {
+		m.removedusers = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.users, ids[i])
+		m.removedusers[ids[i]] = struct{}{}
 	}
 }
 
-// RemovedVersionRules returns the removed IDs of the "version_rules" edge to the VersionRule entity.
-func (m *ExamMutation) RemovedVersionRulesIDs() (ids []int) {
-	for id := range m.removedversion_rules {
+// RemovedUsers returns the removed IDs of the "users" edge to the User entity.
+func (m *RoleMutation) RemovedUsersIDs() (ids []int) {
+	for id := range m.removedusers {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// VersionRulesIDs returns the "version_rules" edge IDs in the mutation.
-func (m *ExamMutation) VersionRulesIDs() (ids []int) {
-	for id := range m.version_rules {
+// UsersIDs returns the "users" edge IDs in the mutation.
+func (m *RoleMutation) UsersIDs() (ids []int) {
+	for id := range m.users {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetVersionRules resets all changes to the "version_rules" edge.
-func (m *ExamMutation) ResetVersionRules() {
-	m.version_rules = nil
-	m.clearedversion_rules = false
-	m.removedversion_rules = nil
+// ResetUsers resets all changes to the "users" edge.
+func (m *RoleMutation) ResetUsers() {
+	m.users = nil
+	m.clearedusers = false
+	m.removedusers = nil
 }
 
-// Where appends a list predicates to the ExamMutation builder.
-func (m *ExamMutation) Where(ps ...predicate.Exam) {
+// Where appends a list predicates to the RoleMutation builder.
+func (m *RoleMutation) Where(ps ...predicate.Role) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the ExamMutation builder. Using this method,
+// WhereP appends storage-level predicates to the RoleMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *ExamMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Exam, len(ps))
+func (m *RoleMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Role, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -1231,36 +7629,27 @@ func (m *ExamMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *ExamMutation) Op() Op {
+func (m *RoleMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *ExamMutation) SetOp(op Op) {
+func (m *RoleMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Exam).
-func (m *ExamMutation) Type() string {
+// Type returns the node type of this mutation (Role).
+func (m *RoleMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *ExamMutation) Fields() []string {
-	fields := make([]string, 0, 4)
-	if m.title != nil {
-		fields = append(fields, exam.FieldTitle)
-	}
-	if m.description != nil {
-		fields = append(fields, exam.FieldDescription)
-	}
-	if m.time_limit != nil {
-		fields = append(fields, exam.FieldTimeLimit)
-	}
-	if m.is_active != nil {
-		fields = append(fields, exam.FieldIsActive)
+func (m *RoleMutation) Fields() []string {
+	fields := make([]string, 0, 1)
+	if m.name != nil {
+		fields = append(fields, role.FieldName)
 	}
 	return fields
 }
@@ -1268,16 +7657,10 @@ func (m *ExamMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *ExamMutation) Field(name string) (ent.Value, bool) {
+func (m *RoleMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case exam.FieldTitle:
-		return m.Title()
-	case exam.FieldDescription:
-		return m.Description()
-	case exam.FieldTimeLimit:
-		return m.TimeLimit()
-	case exam.FieldIsActive:
-		return m.IsActive()
+	case role.FieldName:
+		return m.Name()
 	}
 	return nil, false
 }
@@ -1285,185 +7668,98 @@ func (m *ExamMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *ExamMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *RoleMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case exam.FieldTitle:
-		return m.OldTitle(ctx)
-	case exam.FieldDescription:
-		return m.OldDescription(ctx)
-	case exam.FieldTimeLimit:
-		return m.OldTimeLimit(ctx)
-	case exam.FieldIsActive:
-		return m.OldIsActive(ctx)
+	case role.FieldName:
+		return m.OldName(ctx)
 	}
-	return nil, fmt.Errorf("unknown Exam field %s", name)
+	return nil, fmt.Errorf("unknown Role field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ExamMutation) SetField(name string, value ent.Value) error {
+func (m *RoleMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case exam.FieldTitle:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetTitle(v)
-		return nil
-	case exam.FieldDescription:
+	case role.FieldName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDescription(v)
-		return nil
-	case exam.FieldTimeLimit:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetTimeLimit(v)
-		return nil
-	case exam.FieldIsActive:
-		v, ok := value.(bool)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetIsActive(v)
+		m.SetName(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Exam field %s", name)
+	return fmt.Errorf("unknown Role field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *ExamMutation) AddedFields() []string {
-	var fields []string
-	if m.addtime_limit != nil {
-		fields = append(fields, exam.FieldTimeLimit)
-	}
-	return fields
+func (m *RoleMutation) AddedFields() []string {
+	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *ExamMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	case exam.FieldTimeLimit:
-		return m.AddedTimeLimit()
-	}
+func (m *RoleMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ExamMutation) AddField(name string, value ent.Value) error {
+func (m *RoleMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case exam.FieldTimeLimit:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddTimeLimit(v)
-		return nil
 	}
-	return fmt.Errorf("unknown Exam numeric field %s", name)
+	return fmt.Errorf("unknown Role numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *ExamMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(exam.FieldDescription) {
-		fields = append(fields, exam.FieldDescription)
-	}
-	return fields
+func (m *RoleMutation) ClearedFields() []string {
+	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *ExamMutation) FieldCleared(name string) bool {
+func (m *RoleMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *ExamMutation) ClearField(name string) error {
-	switch name {
-	case exam.FieldDescription:
-		m.ClearDescription()
-		return nil
-	}
-	return fmt.Errorf("unknown Exam nullable field %s", name)
+func (m *RoleMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Role nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *ExamMutation) ResetField(name string) error {
+func (m *RoleMutation) ResetField(name string) error {
 	switch name {
-	case exam.FieldTitle:
-		m.ResetTitle()
-		return nil
-	case exam.FieldDescription:
-		m.ResetDescription()
-		return nil
-	case exam.FieldTimeLimit:
-		m.ResetTimeLimit()
-		return nil
-	case exam.FieldIsActive:
-		m.ResetIsActive()
+	case role.FieldName:
+		m.ResetName()
 		return nil
 	}
-	return fmt.Errorf("unknown Exam field %s", name)
+	return fmt.Errorf("unknown Role field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *ExamMutation) AddedEdges() []string {
-	edges := make([]string, 0, 4)
-	if m.sections != nil {
-		edges = append(edges, exam.EdgeSections)
-	}
-	if m.topics != nil {
-		edges = append(edges, exam.EdgeTopics)
-	}
-	if m.units != nil {
-		edges = append(edges, exam.EdgeUnits)
-	}
-	if m.version_rules != nil {
-		edges = append(edges, exam.EdgeVersionRules)
+func (m *RoleMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.users != nil {
+		edges = append(edges, role.EdgeUsers)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *ExamMutation) AddedIDs(name string) []ent.Value {
+func (m *RoleMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case exam.EdgeSections:
-		ids := make([]ent.Value, 0, len(m.sections))
-		for id := range m.sections {
-			ids = append(ids, id)
-		}
-		return ids
-	case exam.EdgeTopics:
-		ids := make([]ent.Value, 0, len(m.topics))
-		for id := range m.topics {
-			ids = append(ids, id)
-		}
-		return ids
-	case exam.EdgeUnits:
-		ids := make([]ent.Value, 0, len(m.units))
-		for id := range m.units {
-			ids = append(ids, id)
-		}
-		return ids
-	case exam.EdgeVersionRules:
-		ids := make([]ent.Value, 0, len(m.version_rules))
-		for id := range m.version_rules {
+	case role.EdgeUsers:
+		ids := make([]ent.Value, 0, len(m.users))
+		for id := range m.users {
 			ids = append(ids, id)
 		}
 		return ids
@@ -1472,48 +7768,21 @@ func (m *ExamMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *ExamMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 4)
-	if m.removedsections != nil {
-		edges = append(edges, exam.EdgeSections)
-	}
-	if m.removedtopics != nil {
-		edges = append(edges, exam.EdgeTopics)
-	}
-	if m.removedunits != nil {
-		edges = append(edges, exam.EdgeUnits)
-	}
-	if m.removedversion_rules != nil {
-		edges = append(edges, exam.EdgeVersionRules)
+func (m *RoleMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.removedusers != nil {
+		edges = append(edges, role.EdgeUsers)
 	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *ExamMutation) RemovedIDs(name string) []ent.Value {
+func (m *RoleMutation) RemovedIDs(name string) []ent.Value {
 	switch name {
-	case exam.EdgeSections:
-		ids := make([]ent.Value, 0, len(m.removedsections))
-		for id := range m.removedsections {
-			ids = append(ids, id)
-		}
-		return ids
-	case exam.EdgeTopics:
-		ids := make([]ent.Value, 0, len(m.removedtopics))
-		for id := range m.removedtopics {
-			ids = append(ids, id)
-		}
-		return ids
-	case exam.EdgeUnits:
-		ids := make([]ent.Value, 0, len(m.removedunits))
-		for id := range m.removedunits {
-			ids = append(ids, id)
-		}
-		return ids
-	case exam.EdgeVersionRules:
-		ids := make([]ent.Value, 0, len(m.removedversion_rules))
-		for id := range m.removedversion_rules {
+	case role.EdgeUsers:
+		ids := make([]ent.Value, 0, len(m.removedusers))
+		for id := range m.removedusers {
 			ids = append(ids, id)
 		}
 		return ids
@@ -1522,107 +7791,78 @@ func (m *ExamMutation) RemovedIDs(name string) []ent.Value {
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *ExamMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 4)
-	if m.clearedsections {
-		edges = append(edges, exam.EdgeSections)
-	}
-	if m.clearedtopics {
-		edges = append(edges, exam.EdgeTopics)
-	}
-	if m.clearedunits {
-		edges = append(edges, exam.EdgeUnits)
-	}
-	if m.clearedversion_rules {
-		edges = append(edges, exam.EdgeVersionRules)
+func (m *RoleMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedusers {
+		edges = append(edges, role.EdgeUsers)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *ExamMutation) EdgeCleared(name string) bool {
+func (m *RoleMutation) EdgeCleared(name string) bool {
 	switch name {
-	case exam.EdgeSections:
-		return m.clearedsections
-	case exam.EdgeTopics:
-		return m.clearedtopics
-	case exam.EdgeUnits:
-		return m.clearedunits
-	case exam.EdgeVersionRules:
-		return m.clearedversion_rules
+	case role.EdgeUsers:
+		return m.clearedusers
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *ExamMutation) ClearEdge(name string) error {
+func (m *RoleMutation) ClearEdge(name string) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown Exam unique edge %s", name)
+	return fmt.Errorf("unknown Role unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *ExamMutation) ResetEdge(name string) error {
+func (m *RoleMutation) ResetEdge(name string) error {
 	switch name {
-	case exam.EdgeSections:
-		m.ResetSections()
-		return nil
-	case exam.EdgeTopics:
-		m.ResetTopics()
-		return nil
-	case exam.EdgeUnits:
-		m.ResetUnits()
-		return nil
-	case exam.EdgeVersionRules:
-		m.ResetVersionRules()
+	case role.EdgeUsers:
+		m.ResetUsers()
 		return nil
 	}
-	return fmt.Errorf("unknown Exam edge %s", name)
+	return fmt.Errorf("unknown Role edge %s", name)
 }
 
-// ProblemMutation represents an operation that mutates the Problem nodes in the graph.
-type ProblemMutation struct {
+// SectionMutation represents an operation that mutates the Section nodes in the graph.
+type SectionMutation struct {
 	config
-	op                  Op
-	typ                 string
-	id                  *int
-	_type               *problem.Type
-	difficulty          *int
-	adddifficulty       *int
-	created_at          *time.Time
-	clearedFields       map[string]struct{}
-	unit                *int
-	clearedunit         bool
-	versions            map[int]struct{}
-	removedversions     map[int]struct{}
-	clearedversions     bool
-	translations        map[int]struct{}
-	removedtranslations map[int]struct{}
-	clearedtranslations bool
-	parent              *int
-	clearedparent       bool
-	children            map[int]struct{}
-	removedchildren     map[int]struct{}
-	clearedchildren     bool
-	done                bool
-	oldValue            func(context.Context) (*Problem, error)
-	predicates          []predicate.Problem
+	op            Op
+	typ           string
+	id            *int
+	key           *string
+	title         *string
+	seq           *int
+	addseq        *int
+	clearedFields map[string]struct{}
+	exam          *int
+	clearedexam   bool
+	topics        map[int]struct{}
+	removedtopics map[int]struct{}
+	clearedtopics bool
+	units         map[int]struct{}
+	removedunits  map[int]struct{}
+	clearedunits  bool
+	done          bool
+	oldValue      func(context.Context) (*Section, error)
+	predicates    []predicate.Section
 }
 
-var _ ent.Mutation = (*ProblemMutation)(nil)
+var _ ent.Mutation = (*SectionMutation)(nil)
 
-// problemOption allows management of the mutation configuration using functional options.
-type problemOption func(*ProblemMutation)
+// sectionOption allows management of the mutation configuration using functional options.
+type sectionOption func(*SectionMutation)
 
-// newProblemMutation creates new mutation for the Problem entity.
-func newProblemMutation(c config, op Op, opts ...problemOption) *ProblemMutation {
-	m := &ProblemMutation{
+// newSectionMutation creates new mutation for the Section entity.
+func newSectionMutation(c config, op Op, opts ...sectionOption) *SectionMutation {
+	m := &SectionMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeProblem,
+		typ:           TypeSection,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -1631,20 +7871,20 @@ func newProblemMutation(c config, op Op, opts ...problemOption) *ProblemMutation
 	return m
 }
 
-// withProblemID sets the ID field of the mutation.
-func withProblemID(id int) problemOption {
-	return func(m *ProblemMutation) {
+// withSectionID sets the ID field of the mutation.
+func withSectionID(id int) sectionOption {
+	return func(m *SectionMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Problem
+			value *Section
 		)
-		m.oldValue = func(ctx context.Context) (*Problem, error) {
+		m.oldValue = func(ctx context.Context) (*Section, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Problem.Get(ctx, id)
+					value, err = m.Client().Section.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -1653,10 +7893,10 @@ func withProblemID(id int) problemOption {
 	}
 }
 
-// withProblem sets the old Problem of the mutation.
-func withProblem(node *Problem) problemOption {
-	return func(m *ProblemMutation) {
-		m.oldValue = func(context.Context) (*Problem, error) {
+// withSection sets the old Section of the mutation.
+func withSection(node *Section) sectionOption {
+	return func(m *SectionMutation) {
+		m.oldValue = func(context.Context) (*Section, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -1665,7 +7905,7 @@ func withProblem(node *Problem) problemOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m ProblemMutation) Client() *Client {
+func (m SectionMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -1673,7 +7913,7 @@ func (m ProblemMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m ProblemMutation) Tx() (*Tx, error) {
+func (m SectionMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -1684,7 +7924,7 @@ func (m ProblemMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *ProblemMutation) ID() (id int, exists bool) {
+func (m *SectionMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -1695,7 +7935,7 @@ func (m *ProblemMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *ProblemMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *SectionMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -1704,450 +7944,333 @@ func (m *ProblemMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Problem.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Section.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetType sets the "type" field.
-func (m *ProblemMutation) SetType(pr problem.Type) {
-	m._type = &pr
-}
-
-// GetType returns the value of the "type" field in the mutation.
-func (m *ProblemMutation) GetType() (r problem.Type, exists bool) {
-	v := m._type
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldType returns the old "type" field's value of the Problem entity.
-// If the Problem object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProblemMutation) OldType(ctx context.Context) (v problem.Type, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldType is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldType requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldType: %w", err)
-	}
-	return oldValue.Type, nil
-}
-
-// ResetType resets all changes to the "type" field.
-func (m *ProblemMutation) ResetType() {
-	m._type = nil
-}
-
-// SetDifficulty sets the "difficulty" field.
-func (m *ProblemMutation) SetDifficulty(i int) {
-	m.difficulty = &i
-	m.adddifficulty = nil
+// SetKey sets the "key" field.
+func (m *SectionMutation) SetKey(s string) {
+	m.key = &s
 }
 
-// Difficulty returns the value of the "difficulty" field in the mutation.
-func (m *ProblemMutation) Difficulty() (r int, exists bool) {
-	v := m.difficulty
+// Key returns the value of the "key" field in the mutation.
+func (m *SectionMutation) Key() (r string, exists bool) {
+	v := m.key
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDifficulty returns the old "difficulty" field's value of the Problem entity.
-// If the Problem object wasn't provided to the builder, the object is fetched from the database.
+// OldKey returns the old "key" field's value of the Section entity.
+// If the Section object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProblemMutation) OldDifficulty(ctx context.Context) (v int, err error) {
+func (m *SectionMutation) OldKey(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDifficulty is only allowed on UpdateOne operations")
+		return v, errors.New("OldKey is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDifficulty requires an ID field in the mutation")
+		return v, errors.New("OldKey requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDifficulty: %w", err)
+		return v, fmt.Errorf("querying old value for OldKey: %w", err)
 	}
-	return oldValue.Difficulty, nil
+	return oldValue.Key, nil
 }
 
-// AddDifficulty adds i to the "difficulty" field.
-func (m *ProblemMutation) AddDifficulty(i int) {
-	if m.adddifficulty != nil {
-		*m.adddifficulty += i
-	} else {
-		m.adddifficulty = &i
-	}
+// ClearKey clears the value of the "key" field.
+func (m *SectionMutation) ClearKey() {
+	m.key = nil
+	m.clearedFields[section.FieldKey] = struct{}{}
 }
 
-// AddedDifficulty returns the value that was added to the "difficulty" field in this mutation.
-func (m *ProblemMutation) AddedDifficulty() (r int, exists bool) {
-	v := m.adddifficulty
-	if v == nil {
-		return
-	}
-	return *v, true
+// KeyCleared returns if the "key" field was cleared in this mutation.
+func (m *SectionMutation) KeyCleared() bool {
+	_, ok := m.clearedFields[section.FieldKey]
+	return ok
 }
 
-// ResetDifficulty resets all changes to the "difficulty" field.
-func (m *ProblemMutation) ResetDifficulty() {
-	m.difficulty = nil
-	m.adddifficulty = nil
+// ResetKey resets all changes to the "key" field.
+func (m *SectionMutation) ResetKey() {
+	m.key = nil
+	delete(m.clearedFields, section.FieldKey)
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *ProblemMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// SetTitle sets the "title" field.
+func (m *SectionMutation) SetTitle(s string) {
+	m.title = &s
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *ProblemMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// Title returns the value of the "title" field in the mutation.
+func (m *SectionMutation) Title() (r string, exists bool) {
+	v := m.title
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Problem entity.
-// If the Problem object wasn't provided to the builder, the object is fetched from the database.
+// OldTitle returns the old "title" field's value of the Section entity.
+// If the Section object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProblemMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *SectionMutation) OldTitle(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldTitle requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.Title, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *ProblemMutation) ResetCreatedAt() {
-	m.created_at = nil
+// ResetTitle resets all changes to the "title" field.
+func (m *SectionMutation) ResetTitle() {
+	m.title = nil
 }
 
-// SetUnitID sets the "unit_id" field.
-func (m *ProblemMutation) SetUnitID(i int) {
-	m.unit = &i
+// SetSeq sets the "seq" field.
+func (m *SectionMutation) SetSeq(i int) {
+	m.seq = &i
+	m.addseq = nil
 }
 
-// UnitID returns the value of the "unit_id" field in the mutation.
-func (m *ProblemMutation) UnitID() (r int, exists bool) {
-	v := m.unit
+// Seq returns the value of the "seq" field in the mutation.
+func (m *SectionMutation) Seq() (r int, exists bool) {
+	v := m.seq
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUnitID returns the old "unit_id" field's value of the Problem entity.
-// If the Problem object wasn't provided to the builder, the object is fetched from the database.
+// OldSeq returns the old "seq" field's value of the Section entity.
+// If the Section object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProblemMutation) OldUnitID(ctx context.Context) (v int, err error) {
+func (m *SectionMutation) OldSeq(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUnitID is only allowed on UpdateOne operations")
+		return v, errors.New("OldSeq is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUnitID requires an ID field in the mutation")
+		return v, errors.New("OldSeq requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUnitID: %w", err)
+		return v, fmt.Errorf("querying old value for OldSeq: %w", err)
 	}
-	return oldValue.UnitID, nil
-}
-
-// ResetUnitID resets all changes to the "unit_id" field.
-func (m *ProblemMutation) ResetUnitID() {
-	m.unit = nil
+	return oldValue.Seq, nil
 }
 
-// SetParentID sets the "parent_id" field.
-func (m *ProblemMutation) SetParentID(i int) {
-	m.parent = &i
+// AddSeq adds i to the "seq" field.
+func (m *SectionMutation) AddSeq(i int) {
+	if m.addseq != nil {
+		*m.addseq += i
+	} else {
+		m.addseq = &i
+	}
 }
 
-// ParentID returns the value of the "parent_id" field in the mutation.
-func (m *ProblemMutation) ParentID() (r int, exists bool) {
-	v := m.parent
+// AddedSeq returns the value that was added to the "seq" field in this mutation.
+func (m *SectionMutation) AddedSeq() (r int, exists bool) {
+	v := m.addseq
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldParentID returns the old "parent_id" field's value of the Problem entity.
-// If the Problem object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProblemMutation) OldParentID(ctx context.Context) (v *int, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldParentID is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldParentID requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldParentID: %w", err)
-	}
-	return oldValue.ParentID, nil
-}
-
-// ClearParentID clears the value of the "parent_id" field.
-func (m *ProblemMutation) ClearParentID() {
-	m.parent = nil
-	m.clearedFields[problem.FieldParentID] = struct{}{}
-}
-
-// ParentIDCleared returns if the "parent_id" field was cleared in this mutation.
-func (m *ProblemMutation) ParentIDCleared() bool {
-	_, ok := m.clearedFields[problem.FieldParentID]
-	return ok
-}
-
-// ResetParentID resets all changes to the "parent_id" field.
-func (m *ProblemMutation) ResetParentID() {
-	m.parent = nil
-	delete(m.clearedFields, problem.FieldParentID)
-}
-
-// ClearUnit clears the "unit" edge to the Unit entity.
-func (m *ProblemMutation) ClearUnit() {
-	m.clearedunit = true
-	m.clearedFields[problem.FieldUnitID] = struct{}{}
-}
-
-// UnitCleared reports if the "unit" edge to the Unit entity was cleared.
-func (m *ProblemMutation) UnitCleared() bool {
-	return m.clearedunit
+// ResetSeq resets all changes to the "seq" field.
+func (m *SectionMutation) ResetSeq() {
+	m.seq = nil
+	m.addseq = nil
 }
 
-// UnitIDs returns the "unit" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// UnitID instead. It exists only for internal usage by the builders.
-func (m *ProblemMutation) UnitIDs() (ids []int) {
-	if id := m.unit; id != nil {
-		ids = append(ids, *id)
-	}
-	return
+// SetExamID sets the "exam_id" field.
+func (m *SectionMutation) SetExamID(i int) {
+	m.exam = &i
 }
 
-// ResetUnit resets all changes to the "unit" edge.
-func (m *ProblemMutation) ResetUnit() {
-	m.unit = nil
-	m.clearedunit = false
+// ExamID returns the value of the "exam_id" field in the mutation.
+func (m *SectionMutation) ExamID() (r int, exists bool) {
+	v := m.exam
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// AddVersionIDs adds the "versions" edge to the VersionRule entity by ids.
-func (m *ProblemMutation) AddVersionIDs(ids ...int) {
-	if m.versions == nil {
-		m.versions = make(map[int]struct{})
+// OldExamID returns the old "exam_id" field's value of the Section entity.
+// If the Section object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SectionMutation) OldExamID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExamID is only allowed on UpdateOne operations")
 	}
-	for i := range ids {
-		m.versions[ids[i]] = struct{}{}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExamID requires an ID field in the mutation")
 	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExamID: %w", err)
+	}
+	return oldValue.ExamID, nil
 }
 
-// ClearVersions clears the "versions" edge to the VersionRule entity.
-func (m *ProblemMutation) ClearVersions() {
-	m.clearedversions = true
-}
-
-// VersionsCleared reports if the "versions" edge to the VersionRule entity was cleared.
-func (m *ProblemMutation) VersionsCleared() bool {
-	return m.clearedversions
+// ResetExamID resets all changes to the "exam_id" field.
+func (m *SectionMutation) ResetExamID() {
+	m.exam = nil
 }
 
-// RemoveVersionIDs removes the "versions" edge to the VersionRule entity by IDs.
-func (m *ProblemMutation) RemoveVersionIDs(ids ...int) {
-	if m.removedversions == nil {
-		m.removedversions = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.versions, ids[i])
-		m.removedversions[ids[i]] = struct{}{}
-	}
+// ClearExam clears the "exam" edge to the Exam entity.
+func (m *SectionMutation) ClearExam() {
+	m.clearedexam = true
+	m.clearedFields[section.FieldExamID] = struct{}{}
 }
 
-// RemovedVersions returns the removed IDs of the "versions" edge to the VersionRule entity.
-func (m *ProblemMutation) RemovedVersionsIDs() (ids []int) {
-	for id := range m.removedversions {
-		ids = append(ids, id)
-	}
-	return
+// ExamCleared reports if the "exam" edge to the Exam entity was cleared.
+func (m *SectionMutation) ExamCleared() bool {
+	return m.clearedexam
 }
 
-// VersionsIDs returns the "versions" edge IDs in the mutation.
-func (m *ProblemMutation) VersionsIDs() (ids []int) {
-	for id := range m.versions {
-		ids = append(ids, id)
+// ExamIDs returns the "exam" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ExamID instead. It exists only for internal usage by the builders.
+func (m *SectionMutation) ExamIDs() (ids []int) {
+	if id := m.exam; id != nil {
+		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetVersions resets all changes to the "versions" edge.
-func (m *ProblemMutation) ResetVersions() {
-	m.versions = nil
-	m.clearedversions = false
-	m.removedversions = nil
+// ResetExam resets all changes to the "exam" edge.
+func (m *SectionMutation) ResetExam() {
+	m.exam = nil
+	m.clearedexam = false
 }
 
-// AddTranslationIDs adds the "translations" edge to the ProblemTranslation entity by ids.
-func (m *ProblemMutation) AddTranslationIDs(ids ...int) {
-	if m.translations == nil {
-		m.translations = make(map[int]struct{})
+// AddTopicIDs adds the "topics" edge to the Topic entity by ids.
+func (m *SectionMutation) AddTopicIDs(ids ...int) {
+	if m.topics == nil {
+		m.topics = make(map[int]struct{})
 	}
 	for i := range ids {
-		m.translations[ids[i]] = struct{}{}
+		m.topics[ids[i]] = struct{}{}
 	}
 }
 
-// ClearTranslations clears the "translations" edge to the ProblemTranslation entity.
-func (m *ProblemMutation) ClearTranslations() {
-	m.clearedtranslations = true
+// ClearTopics clears the "topics" edge to the Topic entity.
+func (m *SectionMutation) ClearTopics() {
+	m.clearedtopics = true
 }
 
-// TranslationsCleared reports if the "translations" edge to the ProblemTranslation entity was cleared.
-func (m *ProblemMutation) TranslationsCleared() bool {
-	return m.clearedtranslations
+// TopicsCleared reports if the "topics" edge to the Topic entity was cleared.
+func (m *SectionMutation) TopicsCleared() bool {
+	return m.clearedtopics
 }
 
-// RemoveTranslationIDs removes the "translations" edge to the ProblemTranslation entity by IDs.
-func (m *ProblemMutation) RemoveTranslationIDs(ids ...int) {
-	if m.removedtranslations == nil {
-		m.removedtranslations = make(map[int]struct{})
+// RemoveTopicIDs removes the "topics" edge to the Topic entity by IDs.
+func (m *SectionMutation) RemoveTopicIDs(ids ...int) {
+	if m.removedtopics == nil {
+		m.removedtopics = make(map[int]struct{})
 	}
 	for i := range ids {
-		delete(m.translations, ids[i])
-		m.removedtranslations[ids[i]] = struct{}{}
+		delete(m.topics, ids[i])
+		m.removedtopics[ids[i]] = struct{}{}
 	}
 }
 
-// RemovedTranslations returns the removed IDs of the "translations" edge to the ProblemTranslation entity.
-func (m *ProblemMutation) RemovedTranslationsIDs() (ids []int) {
-	for id := range m.removedtranslations {
+// RemovedTopics returns the removed IDs of the "topics" edge to the Topic entity.
+func (m *SectionMutation) RemovedTopicsIDs() (ids []int) {
+	for id := range m.removedtopics {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// TranslationsIDs returns the "translations" edge IDs in the mutation.
-func (m *ProblemMutation) TranslationsIDs() (ids []int) {
-	for id := range m.translations {
+// TopicsIDs returns the "topics" edge IDs in the mutation.
+func (m *SectionMutation) TopicsIDs() (ids []int) {
+	for id := range m.topics {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetTranslations resets all changes to the "translations" edge.
-func (m *ProblemMutation) ResetTranslations() {
-	m.translations = nil
-	m.clearedtranslations = false
-	m.removedtranslations = nil
-}
-
-// ClearParent clears the "parent" edge to the Problem entity.
-func (m *ProblemMutation) ClearParent() {
-	m.clearedparent = true
-	m.clearedFields[problem.FieldParentID] = struct{}{}
-}
-
-// ParentCleared reports if the "parent" edge to the Problem entity was cleared.
-func (m *ProblemMutation) ParentCleared() bool {
-	return m.ParentIDCleared() || m.clearedparent
-}
-
-// ParentIDs returns the "parent" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// ParentID instead. It exists only for internal usage by the builders.
-func (m *ProblemMutation) ParentIDs() (ids []int) {
-	if id := m.parent; id != nil {
-		ids = append(ids, *id)
-	}
-	return
-}
-
-// ResetParent resets all changes to the "parent" edge.
-func (m *ProblemMutation) ResetParent() {
-	m.parent = nil
-	m.clearedparent = false
+// ResetTopics resets all changes to the "topics" edge.
+func (m *SectionMutation) ResetTopics() {
+	m.topics = nil
+	m.clearedtopics = false
+	m.removedtopics = nil
 }
 
-// AddChildIDs adds the "children" edge to the Problem entity by ids.
-func (m *ProblemMutation) AddChildIDs(ids ...int) {
-	if m.children == nil {
-		m.children = make(map[int]struct{})
+// AddUnitIDs adds the "units" edge to the Unit entity by ids.
+func (m *SectionMutation) AddUnitIDs(ids ...int) {
+	if m.units == nil {
+		m.units = make(map[int]struct{})
 	}
 	for i := range ids {
-		m.children[ids[i]] = struct{}{}
+		m.units[ids[i]] = struct{}{}
 	}
 }
 
-// ClearChildren clears the "children" edge to the Problem entity.
-func (m *ProblemMutation) ClearChildren() {
-	m.clearedchildren = true
+// ClearUnits clears the "units" edge to the Unit entity.
+func (m *SectionMutation) ClearUnits() {
+	m.clearedunits = true
 }
 
-// ChildrenCleared reports if the "children" edge to the Problem entity was cleared.
-func (m *ProblemMutation) ChildrenCleared() bool {
-	return m.clearedchildren
+// UnitsCleared reports if the "units" edge to the Unit entity was cleared.
+func (m *SectionMutation) UnitsCleared() bool {
+	return m.clearedunits
 }
 
-// RemoveChildIDs removes the "children" edge to the Problem entity by IDs.
-func (m *ProblemMutation) RemoveChildIDs(ids ...int) {
-	if m.removedchildren == nil {
-		m.removedchildren = make(map[int]struct{})
+// RemoveUnitIDs removes the "units" edge to the Unit entity by IDs.
+func (m *SectionMutation) RemoveUnitIDs(ids ...int) {
+	if m.removedunits == nil {
+		m.removedunits = make(map[int]struct{})
 	}
 	for i := range ids {
-		delete(m.children, ids[i])
-		m.removedchildren[ids[i]] = struct{}{}
+		delete(m.units, ids[i])
+		m.removedunits[ids[i]] = struct{}{}
 	}
 }
 
-// RemovedChildren returns the removed IDs of the "children" edge to the Problem entity.
-func (m *ProblemMutation) RemovedChildrenIDs() (ids []int) {
-	for id := range m.removedchildren {
+// RemovedUnits returns the removed IDs of the "units" edge to the Unit entity.
+func (m *SectionMutation) RemovedUnitsIDs() (ids []int) {
+	for id := range m.removedunits {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// ChildrenIDs returns the "children" edge IDs in the mutation.
-func (m *ProblemMutation) ChildrenIDs() (ids []int) {
-	for id := range m.children {
+// UnitsIDs returns the "units" edge IDs in the mutation.
+func (m *SectionMutation) UnitsIDs() (ids []int) {
+	for id := range m.units {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetChildren resets all changes to the "children" edge.
-func (m *ProblemMutation) ResetChildren() {
-	m.children = nil
-	m.clearedchildren = false
-	m.removedchildren = nil
+// ResetUnits resets all changes to the "units" edge.
+func (m *SectionMutation) ResetUnits() {
+	m.units = nil
+	m.clearedunits = false
+	m.removedunits = nil
 }
 
-// Where appends a list predicates to the ProblemMutation builder.
-func (m *ProblemMutation) Where(ps ...predicate.Problem) {
+// Where appends a list predicates to the SectionMutation builder.
+func (m *SectionMutation) Where(ps ...predicate.Section) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the ProblemMutation builder. Using this method,
+// WhereP appends storage-level predicates to the SectionMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *ProblemMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Problem, len(ps))
+func (m *SectionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Section, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -2155,39 +8278,36 @@ func (m *ProblemMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *ProblemMutation) Op() Op {
+func (m *SectionMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *ProblemMutation) SetOp(op Op) {
+func (m *SectionMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Problem).
-func (m *ProblemMutation) Type() string {
+// Type returns the node type of this mutation (Section).
+func (m *SectionMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *ProblemMutation) Fields() []string {
-	fields := make([]string, 0, 5)
-	if m._type != nil {
-		fields = append(fields, problem.FieldType)
-	}
-	if m.difficulty != nil {
-		fields = append(fields, problem.FieldDifficulty)
+func (m *SectionMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.key != nil {
+		fields = append(fields, section.FieldKey)
 	}
-	if m.created_at != nil {
-		fields = append(fields, problem.FieldCreatedAt)
+	if m.title != nil {
+		fields = append(fields, section.FieldTitle)
 	}
-	if m.unit != nil {
-		fields = append(fields, problem.FieldUnitID)
+	if m.seq != nil {
+		fields = append(fields, section.FieldSeq)
 	}
-	if m.parent != nil {
-		fields = append(fields, problem.FieldParentID)
+	if m.exam != nil {
+		fields = append(fields, section.FieldExamID)
 	}
 	return fields
 }
@@ -2195,18 +8315,16 @@ func (m *ProblemMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *ProblemMutation) Field(name string) (ent.Value, bool) {
+func (m *SectionMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case problem.FieldType:
-		return m.GetType()
-	case problem.FieldDifficulty:
-		return m.Difficulty()
-	case problem.FieldCreatedAt:
-		return m.CreatedAt()
-	case problem.FieldUnitID:
-		return m.UnitID()
-	case problem.FieldParentID:
-		return m.ParentID()
+	case section.FieldKey:
+		return m.Key()
+	case section.FieldTitle:
+		return m.Title()
+	case section.FieldSeq:
+		return m.Seq()
+	case section.FieldExamID:
+		return m.ExamID()
 	}
 	return nil, false
 }
@@ -2214,72 +8332,63 @@ func (m *ProblemMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *ProblemMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *SectionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case problem.FieldType:
-		return m.OldType(ctx)
-	case problem.FieldDifficulty:
-		return m.OldDifficulty(ctx)
-	case problem.FieldCreatedAt:
-		return m.OldCreatedAt(ctx)
-	case problem.FieldUnitID:
-		return m.OldUnitID(ctx)
-	case problem.FieldParentID:
-		return m.OldParentID(ctx)
+	case section.FieldKey:
+		return m.OldKey(ctx)
+	case section.FieldTitle:
+		return m.OldTitle(ctx)
+	case section.FieldSeq:
+		return m.OldSeq(ctx)
+	case section.FieldExamID:
+		return m.OldExamID(ctx)
 	}
-	return nil, fmt.Errorf("unknown Problem field %s", name)
+	return nil, fmt.Errorf("unknown Section field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ProblemMutation) SetField(name string, value ent.Value) error {
+func (m *SectionMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case problem.FieldType:
-		v, ok := value.(problem.Type)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetType(v)
-		return nil
-	case problem.FieldDifficulty:
-		v, ok := value.(int)
+	case section.FieldKey:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDifficulty(v)
+		m.SetKey(v)
 		return nil
-	case problem.FieldCreatedAt:
-		v, ok := value.(time.Time)
+	case section.FieldTitle:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCreatedAt(v)
+		m.SetTitle(v)
 		return nil
-	case problem.FieldUnitID:
+	case section.FieldSeq:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUnitID(v)
+		m.SetSeq(v)
 		return nil
-	case problem.FieldParentID:
+	case section.FieldExamID:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetParentID(v)
+		m.SetExamID(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Problem field %s", name)
+	return fmt.Errorf("unknown Section field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *ProblemMutation) AddedFields() []string {
+func (m *SectionMutation) AddedFields() []string {
 	var fields []string
-	if m.adddifficulty != nil {
-		fields = append(fields, problem.FieldDifficulty)
+	if m.addseq != nil {
+		fields = append(fields, section.FieldSeq)
 	}
 	return fields
 }
@@ -2287,10 +8396,10 @@ func (m *ProblemMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *ProblemMutation) AddedField(name string) (ent.Value, bool) {
+func (m *SectionMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case problem.FieldDifficulty:
-		return m.AddedDifficulty()
+	case section.FieldSeq:
+		return m.AddedSeq()
 	}
 	return nil, false
 }
@@ -2298,118 +8407,99 @@ func (m *ProblemMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ProblemMutation) AddField(name string, value ent.Value) error {
+func (m *SectionMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case problem.FieldDifficulty:
+	case section.FieldSeq:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddDifficulty(v)
+		m.AddSeq(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Problem numeric field %s", name)
+	return fmt.Errorf("unknown Section numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *ProblemMutation) ClearedFields() []string {
+func (m *SectionMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(problem.FieldParentID) {
-		fields = append(fields, problem.FieldParentID)
+	if m.FieldCleared(section.FieldKey) {
+		fields = append(fields, section.FieldKey)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *ProblemMutation) FieldCleared(name string) bool {
+func (m *SectionMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *ProblemMutation) ClearField(name string) error {
+func (m *SectionMutation) ClearField(name string) error {
 	switch name {
-	case problem.FieldParentID:
-		m.ClearParentID()
+	case section.FieldKey:
+		m.ClearKey()
 		return nil
 	}
-	return fmt.Errorf("unknown Problem nullable field %s", name)
+	return fmt.Errorf("unknown Section nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *ProblemMutation) ResetField(name string) error {
+func (m *SectionMutation) ResetField(name string) error {
 	switch name {
-	case problem.FieldType:
-		m.ResetType()
-		return nil
-	case problem.FieldDifficulty:
-		m.ResetDifficulty()
+	case section.FieldKey:
+		m.ResetKey()
 		return nil
-	case problem.FieldCreatedAt:
-		m.ResetCreatedAt()
+	case section.FieldTitle:
+		m.ResetTitle()
 		return nil
-	case problem.FieldUnitID:
-		m.ResetUnitID()
+	case section.FieldSeq:
+		m.ResetSeq()
 		return nil
-	case problem.FieldParentID:
-		m.ResetParentID()
+	case section.FieldExamID:
+		m.ResetExamID()
 		return nil
 	}
-	return fmt.Errorf("unknown Problem field %s", name)
+	return fmt.Errorf("unknown Section field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *ProblemMutation) AddedEdges() []string {
-	edges := make([]string, 0, 5)
-	if m.unit != nil {
-		edges = append(edges, problem.EdgeUnit)
-	}
-	if m.versions != nil {
-		edges = append(edges, problem.EdgeVersions)
-	}
-	if m.translations != nil {
-		edges = append(edges, problem.EdgeTranslations)
+func (m *SectionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.exam != nil {
+		edges = append(edges, section.EdgeExam)
 	}
-	if m.parent != nil {
-		edges = append(edges, problem.EdgeParent)
+	if m.topics != nil {
+		edges = append(edges, section.EdgeTopics)
 	}
-	if m.children != nil {
-		edges = append(edges, problem.EdgeChildren)
+	if m.units != nil {
+		edges = append(edges, section.EdgeUnits)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *ProblemMutation) AddedIDs(name string) []ent.Value {
+func (m *SectionMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case problem.EdgeUnit:
-		if id := m.unit; id != nil {
+	case section.EdgeExam:
+		if id := m.exam; id != nil {
 			return []ent.Value{*id}
 		}
-	case problem.EdgeVersions:
-		ids := make([]ent.Value, 0, len(m.versions))
-		for id := range m.versions {
-			ids = append(ids, id)
-		}
-		return ids
-	case problem.EdgeTranslations:
-		ids := make([]ent.Value, 0, len(m.translations))
-		for id := range m.translations {
+	case section.EdgeTopics:
+		ids := make([]ent.Value, 0, len(m.topics))
+		for id := range m.topics {
 			ids = append(ids, id)
 		}
 		return ids
-	case problem.EdgeParent:
-		if id := m.parent; id != nil {
-			return []ent.Value{*id}
-		}
-	case problem.EdgeChildren:
-		ids := make([]ent.Value, 0, len(m.children))
-		for id := range m.children {
+	case section.EdgeUnits:
+		ids := make([]ent.Value, 0, len(m.units))
+		for id := range m.units {
 			ids = append(ids, id)
 		}
 		return ids
@@ -2418,39 +8508,30 @@ func (m *ProblemMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *ProblemMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 5)
-	if m.removedversions != nil {
-		edges = append(edges, problem.EdgeVersions)
-	}
-	if m.removedtranslations != nil {
-		edges = append(edges, problem.EdgeTranslations)
+func (m *SectionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.removedtopics != nil {
+		edges = append(edges, section.EdgeTopics)
 	}
-	if m.removedchildren != nil {
-		edges = append(edges, problem.EdgeChildren)
+	if m.removedunits != nil {
+		edges = append(edges, section.EdgeUnits)
 	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *ProblemMutation) RemovedIDs(name string) []ent.Value {
+func (m *SectionMutation) RemovedIDs(name string) []ent.Value {
 	switch name {
-	case problem.EdgeVersions:
-		ids := make([]ent.Value, 0, len(m.removedversions))
-		for id := range m.removedversions {
-			ids = append(ids, id)
-		}
-		return ids
-	case problem.EdgeTranslations:
-		ids := make([]ent.Value, 0, len(m.removedtranslations))
-		for id := range m.removedtranslations {
+	case section.EdgeTopics:
+		ids := make([]ent.Value, 0, len(m.removedtopics))
+		for id := range m.removedtopics {
 			ids = append(ids, id)
 		}
 		return ids
-	case problem.EdgeChildren:
-		ids := make([]ent.Value, 0, len(m.removedchildren))
-		for id := range m.removedchildren {
+	case section.EdgeUnits:
+		ids := make([]ent.Value, 0, len(m.removedunits))
+		for id := range m.removedunits {
 			ids = append(ids, id)
 		}
 		return ids
@@ -2459,113 +8540,90 @@ func (m *ProblemMutation) RemovedIDs(name string) []ent.Value {
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *ProblemMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 5)
-	if m.clearedunit {
-		edges = append(edges, problem.EdgeUnit)
-	}
-	if m.clearedversions {
-		edges = append(edges, problem.EdgeVersions)
-	}
-	if m.clearedtranslations {
-		edges = append(edges, problem.EdgeTranslations)
+func (m *SectionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.clearedexam {
+		edges = append(edges, section.EdgeExam)
 	}
-	if m.clearedparent {
-		edges = append(edges, problem.EdgeParent)
+	if m.clearedtopics {
+		edges = append(edges, section.EdgeTopics)
 	}
-	if m.clearedchildren {
-		edges = append(edges, problem.EdgeChildren)
+	if m.clearedunits {
+		edges = append(edges, section.EdgeUnits)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *ProblemMutation) EdgeCleared(name string) bool {
+func (m *SectionMutation) EdgeCleared(name string) bool {
 	switch name {
-	case problem.EdgeUnit:
-		return m.clearedunit
-	case problem.EdgeVersions:
-		return m.clearedversions
-	case problem.EdgeTranslations:
-		return m.clearedtranslations
-	case problem.EdgeParent:
-		return m.clearedparent
-	case problem.EdgeChildren:
-		return m.clearedchildren
+	case section.EdgeExam:
+		return m.clearedexam
+	case section.EdgeTopics:
+		return m.clearedtopics
+	case section.EdgeUnits:
+		return m.clearedunits
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *ProblemMutation) ClearEdge(name string) error {
-	switch name {
-	case problem.EdgeUnit:
-		m.ClearUnit()
-		return nil
-	case problem.EdgeParent:
-		m.ClearParent()
+func (m *SectionMutation) ClearEdge(name string) error {
+	switch name {
+	case section.EdgeExam:
+		m.ClearExam()
 		return nil
 	}
-	return fmt.Errorf("unknown Problem unique edge %s", name)
+	return fmt.Errorf("unknown Section unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *ProblemMutation) ResetEdge(name string) error {
+func (m *SectionMutation) ResetEdge(name string) error {
 	switch name {
-	case problem.EdgeUnit:
-		m.ResetUnit()
-		return nil
-	case problem.EdgeVersions:
-		m.ResetVersions()
-		return nil
-	case problem.EdgeTranslations:
-		m.ResetTranslations()
+	case section.EdgeExam:
+		m.ResetExam()
 		return nil
-	case problem.EdgeParent:
-		m.ResetParent()
+	case section.EdgeTopics:
+		m.ResetTopics()
 		return nil
-	case problem.EdgeChildren:
-		m.ResetChildren()
+	case section.EdgeUnits:
+		m.ResetUnits()
 		return nil
 	}
-	return fmt.Errorf("unknown Problem edge %s", name)
+	return fmt.Errorf("unknown Section edge %s", name)
 }
 
-// ProblemTranslationMutation represents an operation that mutates the ProblemTranslation nodes in the graph.
-type ProblemTranslationMutation struct {
+// SessionMutation represents an operation that mutates the Session nodes in the graph.
+type SessionMutation struct {
 	config
-	op             Op
-	typ            string
-	id             *int
-	locale         *string
-	title          *string
-	content        *string
-	explanation    *string
-	clearedFields  map[string]struct{}
-	problem        *int
-	clearedproblem bool
-	choices        map[int]struct{}
-	removedchoices map[int]struct{}
-	clearedchoices bool
-	done           bool
-	oldValue       func(context.Context) (*ProblemTranslation, error)
-	predicates     []predicate.ProblemTranslation
+	op            Op
+	typ           string
+	id            *int
+	token_hash    *string
+	created_at    *time.Time
+	expires_at    *time.Time
+	clearedFields map[string]struct{}
+	user          *int
+	cleareduser   bool
+	done          bool
+	oldValue      func(context.Context) (*Session, error)
+	predicates    []predicate.Session
 }
 
-var _ ent.Mutation = (*ProblemTranslationMutation)(nil)
+var _ ent.Mutation = (*SessionMutation)(nil)
 
-// problemtranslationOption allows management of the mutation configuration using functional options.
-type problemtranslationOption func(*ProblemTranslationMutation)
+// sessionOption allows management of the mutation configuration using functional options.
+type sessionOption func(*SessionMutation)
 
-// newProblemTranslationMutation creates new mutation for the ProblemTranslation entity.
-func newProblemTranslationMutation(c config, op Op, opts ...problemtranslationOption) *ProblemTranslationMutation {
-	m := &ProblemTranslationMutation{
+// newSessionMutation creates new mutation for the Session entity.
+func newSessionMutation(c config, op Op, opts ...sessionOption) *SessionMutation {
+	m := &SessionMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeProblemTranslation,
+		typ:           TypeSession,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -2574,20 +8632,20 @@ func newProblemTranslationMutation(c config, op Op, opts ...problemtranslationOp
 	return m
 }
 
-// withProblemTranslationID sets the ID field of the mutation.
-func withProblemTranslationID(id int) problemtranslationOption {
-	return func(m *ProblemTranslationMutation) {
+// withSessionID sets the ID field of the mutation.
+func withSessionID(id int) sessionOption {
+	return func(m *SessionMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *ProblemTranslation
+			value *Session
 		)
-		m.oldValue = func(ctx context.Context) (*ProblemTranslation, error) {
+		m.oldValue = func(ctx context.Context) (*Session, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().ProblemTranslation.Get(ctx, id)
+					value, err = m.Client().Session.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -2596,10 +8654,10 @@ func withProblemTranslationID(id int) problemtranslationOption {
 	}
 }
 
-// withProblemTranslation sets the old ProblemTranslation of the mutation.
-func withProblemTranslation(node *ProblemTranslation) problemtranslationOption {
-	return func(m *ProblemTranslationMutation) {
-		m.oldValue = func(context.Context) (*ProblemTranslation, error) {
+// withSession sets the old Session of the mutation.
+func withSession(node *Session) sessionOption {
+	return func(m *SessionMutation) {
+		m.oldValue = func(context.Context) (*Session, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -2608,7 +8666,7 @@ func withProblemTranslation(node *ProblemTranslation) problemtranslationOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m ProblemTranslationMutation) Client() *Client {
+func (m SessionMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -2616,7 +8674,7 @@ func (m ProblemTranslationMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m ProblemTranslationMutation) Tx() (*Tx, error) {
+func (m SessionMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -2627,7 +8685,7 @@ func (m ProblemTranslationMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *ProblemTranslationMutation) ID() (id int, exists bool) {
+func (m *SessionMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -2638,7 +8696,7 @@ func (m *ProblemTranslationMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *ProblemTranslationMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *SessionMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -2647,295 +8705,192 @@ func (m *ProblemTranslationMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().ProblemTranslation.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Session.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetLocale sets the "locale" field.
-func (m *ProblemTranslationMutation) SetLocale(s string) {
-	m.locale = &s
-}
-
-// Locale returns the value of the "locale" field in the mutation.
-func (m *ProblemTranslationMutation) Locale() (r string, exists bool) {
-	v := m.locale
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldLocale returns the old "locale" field's value of the ProblemTranslation entity.
-// If the ProblemTranslation object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProblemTranslationMutation) OldLocale(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLocale is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLocale requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLocale: %w", err)
-	}
-	return oldValue.Locale, nil
-}
-
-// ResetLocale resets all changes to the "locale" field.
-func (m *ProblemTranslationMutation) ResetLocale() {
-	m.locale = nil
-}
-
-// SetTitle sets the "title" field.
-func (m *ProblemTranslationMutation) SetTitle(s string) {
-	m.title = &s
-}
-
-// Title returns the value of the "title" field in the mutation.
-func (m *ProblemTranslationMutation) Title() (r string, exists bool) {
-	v := m.title
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldTitle returns the old "title" field's value of the ProblemTranslation entity.
-// If the ProblemTranslation object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProblemTranslationMutation) OldTitle(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTitle requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
-	}
-	return oldValue.Title, nil
-}
-
-// ResetTitle resets all changes to the "title" field.
-func (m *ProblemTranslationMutation) ResetTitle() {
-	m.title = nil
-}
-
-// SetContent sets the "content" field.
-func (m *ProblemTranslationMutation) SetContent(s string) {
-	m.content = &s
-}
-
-// Content returns the value of the "content" field in the mutation.
-func (m *ProblemTranslationMutation) Content() (r string, exists bool) {
-	v := m.content
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldContent returns the old "content" field's value of the ProblemTranslation entity.
-// If the ProblemTranslation object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProblemTranslationMutation) OldContent(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldContent is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldContent requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldContent: %w", err)
-	}
-	return oldValue.Content, nil
-}
-
-// ResetContent resets all changes to the "content" field.
-func (m *ProblemTranslationMutation) ResetContent() {
-	m.content = nil
-}
-
-// SetExplanation sets the "explanation" field.
-func (m *ProblemTranslationMutation) SetExplanation(s string) {
-	m.explanation = &s
+// SetTokenHash sets the "token_hash" field.
+func (m *SessionMutation) SetTokenHash(s string) {
+	m.token_hash = &s
 }
 
-// Explanation returns the value of the "explanation" field in the mutation.
-func (m *ProblemTranslationMutation) Explanation() (r string, exists bool) {
-	v := m.explanation
+// TokenHash returns the value of the "token_hash" field in the mutation.
+func (m *SessionMutation) TokenHash() (r string, exists bool) {
+	v := m.token_hash
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldExplanation returns the old "explanation" field's value of the ProblemTranslation entity.
-// If the ProblemTranslation object wasn't provided to the builder, the object is fetched from the database.
+// OldTokenHash returns the old "token_hash" field's value of the Session entity.
+// If the Session object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProblemTranslationMutation) OldExplanation(ctx context.Context) (v string, err error) {
+func (m *SessionMutation) OldTokenHash(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldExplanation is only allowed on UpdateOne operations")
+		return v, errors.New("OldTokenHash is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldExplanation requires an ID field in the mutation")
+		return v, errors.New("OldTokenHash requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldExplanation: %w", err)
+		return v, fmt.Errorf("querying old value for OldTokenHash: %w", err)
 	}
-	return oldValue.Explanation, nil
-}
-
-// ClearExplanation clears the value of the "explanation" field.
-func (m *ProblemTranslationMutation) ClearExplanation() {
-	m.explanation = nil
-	m.clearedFields[problemtranslation.FieldExplanation] = struct{}{}
+	return oldValue.TokenHash, nil
 }
 
-// ExplanationCleared returns if the "explanation" field was cleared in this mutation.
-func (m *ProblemTranslationMutation) ExplanationCleared() bool {
-	_, ok := m.clearedFields[problemtranslation.FieldExplanation]
-	return ok
-}
-
-// ResetExplanation resets all changes to the "explanation" field.
-func (m *ProblemTranslationMutation) ResetExplanation() {
-	m.explanation = nil
-	delete(m.clearedFields, problemtranslation.FieldExplanation)
+// ResetTokenHash resets all changes to the "token_hash" field.
+func (m *SessionMutation) ResetTokenHash() {
+	m.token_hash = nil
 }
 
-// SetProblemID sets the "problem_id" field.
-func (m *ProblemTranslationMutation) SetProblemID(i int) {
-	m.problem = &i
+// SetCreatedAt sets the "created_at" field.
+func (m *SessionMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// ProblemID returns the value of the "problem_id" field in the mutation.
-func (m *ProblemTranslationMutation) ProblemID() (r int, exists bool) {
-	v := m.problem
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *SessionMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldProblemID returns the old "problem_id" field's value of the ProblemTranslation entity.
-// If the ProblemTranslation object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the Session entity.
+// If the Session object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProblemTranslationMutation) OldProblemID(ctx context.Context) (v int, err error) {
+func (m *SessionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldProblemID is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldProblemID requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldProblemID: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return oldValue.ProblemID, nil
-}
-
-// ResetProblemID resets all changes to the "problem_id" field.
-func (m *ProblemTranslationMutation) ResetProblemID() {
-	m.problem = nil
-}
-
-// ClearProblem clears the "problem" edge to the Problem entity.
-func (m *ProblemTranslationMutation) ClearProblem() {
-	m.clearedproblem = true
-	m.clearedFields[problemtranslation.FieldProblemID] = struct{}{}
+	return oldValue.CreatedAt, nil
 }
 
-// ProblemCleared reports if the "problem" edge to the Problem entity was cleared.
-func (m *ProblemTranslationMutation) ProblemCleared() bool {
-	return m.clearedproblem
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *SessionMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
-
-// ProblemIDs returns the "problem" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// ProblemID instead. It exists only for internal usage by the builders.
-func (m *ProblemTranslationMutation) ProblemIDs() (ids []int) {
-	if id := m.problem; id != nil {
-		ids = append(ids, *id)
-	}
-	return
+
+// SetExpiresAt sets the "expires_at" field.
+func (m *SessionMutation) SetExpiresAt(t time.Time) {
+	m.expires_at = &t
 }
 
-// ResetProblem resets all changes to the "problem" edge.
-func (m *ProblemTranslationMutation) ResetProblem() {
-	m.problem = nil
-	m.clearedproblem = false
+// ExpiresAt returns the value of the "expires_at" field in the mutation.
+func (m *SessionMutation) ExpiresAt() (r time.Time, exists bool) {
+	v := m.expires_at
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// AddChoiceIDs adds the "choices" edge to the Choice entity by ids.
-func (m *ProblemTranslationMutation) AddChoiceIDs(ids ...int) {
-	if m.choices == nil {
-		m.choices = make(map[int]struct{})
+// OldExpiresAt returns the old "expires_at" field's value of the Session entity.
+// If the Session object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SessionMutation) OldExpiresAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
 	}
-	for i := range ids {
-		m.choices[ids[i]] = struct{}{}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
 	}
+	return oldValue.ExpiresAt, nil
 }
 
-// ClearChoices clears the "choices" edge to the Choice entity.
-func (m *ProblemTranslationMutation) ClearChoices() {
-	m.clearedchoices = true
+// ResetExpiresAt resets all changes to the "expires_at" field.
+func (m *SessionMutation) ResetExpiresAt() {
+	m.expires_at = nil
 }
 
-// ChoicesCleared reports if the "choices" edge to the Choice entity was cleared.
-func (m *ProblemTranslationMutation) ChoicesCleared() bool {
-	return m.clearedchoices
+// SetUserID sets the "user_id" field.
+func (m *SessionMutation) SetUserID(i int) {
+	m.user = &i
 }
 
-// RemoveChoiceIDs removes the "choices" edge to the Choice entity by IDs.
-func (m *ProblemTranslationMutation) RemoveChoiceIDs(ids ...int) {
-	if m.removedchoices == nil {
-		m.removedchoices = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.choices, ids[i])
-		m.removedchoices[ids[i]] = struct{}{}
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *SessionMutation) UserID() (r int, exists bool) {
+	v := m.user
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// RemovedChoices returns the removed IDs of the "choices" edge to the Choice entity.
-func (m *ProblemTranslationMutation) RemovedChoicesIDs() (ids []int) {
-	for id := range m.removedchoices {
-		ids = append(ids, id)
+// OldUserID returns the old "user_id" field's value of the Session entity.
+// If the Session object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SessionMutation) OldUserID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
 }
 
-// ChoicesIDs returns the "choices" edge IDs in the mutation.
-func (m *ProblemTranslationMutation) ChoicesIDs() (ids []int) {
-	for id := range m.choices {
-		ids = append(ids, id)
+// ResetUserID resets all changes to the "user_id" field.
+func (m *SessionMutation) ResetUserID() {
+	m.user = nil
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *SessionMutation) ClearUser() {
+	m.cleareduser = true
+	m.clearedFields[session.FieldUserID] = struct{}{}
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *SessionMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *SessionMutation) UserIDs() (ids []int) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetChoices resets all changes to the "choices" edge.
-func (m *ProblemTranslationMutation) ResetChoices() {
-	m.choices = nil
-	m.clearedchoices = false
-	m.removedchoices = nil
+// ResetUser resets all changes to the "user" edge.
+func (m *SessionMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
 }
 
-// Where appends a list predicates to the ProblemTranslationMutation builder.
-func (m *ProblemTranslationMutation) Where(ps ...predicate.ProblemTranslation) {
+// Where appends a list predicates to the SessionMutation builder.
+func (m *SessionMutation) Where(ps ...predicate.Session) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the ProblemTranslationMutation builder. Using this method,
+// WhereP appends storage-level predicates to the SessionMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *ProblemTranslationMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.ProblemTranslation, len(ps))
+func (m *SessionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Session, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -2943,39 +8898,36 @@ func (m *ProblemTranslationMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *ProblemTranslationMutation) Op() Op {
+func (m *SessionMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *ProblemTranslationMutation) SetOp(op Op) {
+func (m *SessionMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (ProblemTranslation).
-func (m *ProblemTranslationMutation) Type() string {
+// Type returns the node type of this mutation (Session).
+func (m *SessionMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *ProblemTranslationMutation) Fields() []string {
-	fields := make([]string, 0, 5)
-	if m.locale != nil {
-		fields = append(fields, problemtranslation.FieldLocale)
-	}
-	if m.title != nil {
-		fields = append(fields, problemtranslation.FieldTitle)
+func (m *SessionMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.token_hash != nil {
+		fields = append(fields, session.FieldTokenHash)
 	}
-	if m.content != nil {
-		fields = append(fields, problemtranslation.FieldContent)
+	if m.created_at != nil {
+		fields = append(fields, session.FieldCreatedAt)
 	}
-	if m.explanation != nil {
-		fields = append(fields, problemtranslation.FieldExplanation)
+	if m.expires_at != nil {
+		fields = append(fields, session.FieldExpiresAt)
 	}
-	if m.problem != nil {
-		fields = append(fields, problemtranslation.FieldProblemID)
+	if m.user != nil {
+		fields = append(fields, session.FieldUserID)
 	}
 	return fields
 }
@@ -2983,18 +8935,16 @@ func (m *ProblemTranslationMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *ProblemTranslationMutation) Field(name string) (ent.Value, bool) {
+func (m *SessionMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case problemtranslation.FieldLocale:
-		return m.Locale()
-	case problemtranslation.FieldTitle:
-		return m.Title()
-	case problemtranslation.FieldContent:
-		return m.Content()
-	case problemtranslation.FieldExplanation:
-		return m.Explanation()
-	case problemtranslation.FieldProblemID:
-		return m.ProblemID()
+	case session.FieldTokenHash:
+		return m.TokenHash()
+	case session.FieldCreatedAt:
+		return m.CreatedAt()
+	case session.FieldExpiresAt:
+		return m.ExpiresAt()
+	case session.FieldUserID:
+		return m.UserID()
 	}
 	return nil, false
 }
@@ -3002,69 +8952,60 @@ func (m *ProblemTranslationMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *ProblemTranslationMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *SessionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case problemtranslation.FieldLocale:
-		return m.OldLocale(ctx)
-	case problemtranslation.FieldTitle:
-		return m.OldTitle(ctx)
-	case problemtranslation.FieldContent:
-		return m.OldContent(ctx)
-	case problemtranslation.FieldExplanation:
-		return m.OldExplanation(ctx)
-	case problemtranslation.FieldProblemID:
-		return m.OldProblemID(ctx)
+	case session.FieldTokenHash:
+		return m.OldTokenHash(ctx)
+	case session.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case session.FieldExpiresAt:
+		return m.OldExpiresAt(ctx)
+	case session.FieldUserID:
+		return m.OldUserID(ctx)
 	}
-	return nil, fmt.Errorf("unknown ProblemTranslation field %s", name)
+	return nil, fmt.Errorf("unknown Session field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ProblemTranslationMutation) SetField(name string, value ent.Value) error {
+func (m *SessionMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case problemtranslation.FieldLocale:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetLocale(v)
-		return nil
-	case problemtranslation.FieldTitle:
+	case session.FieldTokenHash:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTitle(v)
+		m.SetTokenHash(v)
 		return nil
-	case problemtranslation.FieldContent:
-		v, ok := value.(string)
+	case session.FieldCreatedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetContent(v)
+		m.SetCreatedAt(v)
 		return nil
-	case problemtranslation.FieldExplanation:
-		v, ok := value.(string)
+	case session.FieldExpiresAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetExplanation(v)
+		m.SetExpiresAt(v)
 		return nil
-	case problemtranslation.FieldProblemID:
+	case session.FieldUserID:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetProblemID(v)
+		m.SetUserID(v)
 		return nil
 	}
-	return fmt.Errorf("unknown ProblemTranslation field %s", name)
+	return fmt.Errorf("unknown Session field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *ProblemTranslationMutation) AddedFields() []string {
+func (m *SessionMutation) AddedFields() []string {
 	var fields []string
 	return fields
 }
@@ -3072,7 +9013,7 @@ func (m *ProblemTranslationMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *ProblemTranslationMutation) AddedField(name string) (ent.Value, bool) {
+func (m *SessionMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
 	}
 	return nil, false
@@ -3081,199 +9022,159 @@ func (m *ProblemTranslationMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ProblemTranslationMutation) AddField(name string, value ent.Value) error {
+func (m *SessionMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown ProblemTranslation numeric field %s", name)
+	return fmt.Errorf("unknown Session numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *ProblemTranslationMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(problemtranslation.FieldExplanation) {
-		fields = append(fields, problemtranslation.FieldExplanation)
-	}
-	return fields
+func (m *SessionMutation) ClearedFields() []string {
+	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *ProblemTranslationMutation) FieldCleared(name string) bool {
+func (m *SessionMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *ProblemTranslationMutation) ClearField(name string) error {
-	switch name {
-	case problemtranslation.FieldExplanation:
-		m.ClearExplanation()
-		return nil
-	}
-	return fmt.Errorf("unknown ProblemTranslation nullable field %s", name)
+func (m *SessionMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Session nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *ProblemTranslationMutation) ResetField(name string) error {
+func (m *SessionMutation) ResetField(name string) error {
 	switch name {
-	case problemtranslation.FieldLocale:
-		m.ResetLocale()
+	case session.FieldTokenHash:
+		m.ResetTokenHash()
 		return nil
-	case problemtranslation.FieldTitle:
-		m.ResetTitle()
-		return nil
-	case problemtranslation.FieldContent:
-		m.ResetContent()
+	case session.FieldCreatedAt:
+		m.ResetCreatedAt()
 		return nil
-	case problemtranslation.FieldExplanation:
-		m.ResetExplanation()
+	case session.FieldExpiresAt:
+		m.ResetExpiresAt()
 		return nil
-	case problemtranslation.FieldProblemID:
-		m.ResetProblemID()
+	case session.FieldUserID:
+		m.ResetUserID()
 		return nil
 	}
-	return fmt.Errorf("unknown ProblemTranslation field %s", name)
+	return fmt.Errorf("unknown Session field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *ProblemTranslationMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.problem != nil {
-		edges = append(edges, problemtranslation.EdgeProblem)
-	}
-	if m.choices != nil {
-		edges = append(edges, problemtranslation.EdgeChoices)
+func (m *SessionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.user != nil {
+		edges = append(edges, session.EdgeUser)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *ProblemTranslationMutation) AddedIDs(name string) []ent.Value {
+func (m *SessionMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case problemtranslation.EdgeProblem:
-		if id := m.problem; id != nil {
+	case session.EdgeUser:
+		if id := m.user; id != nil {
 			return []ent.Value{*id}
 		}
-	case problemtranslation.EdgeChoices:
-		ids := make([]ent.Value, 0, len(m.choices))
-		for id := range m.choices {
-			ids = append(ids, id)
-		}
-		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *ProblemTranslationMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.removedchoices != nil {
-		edges = append(edges, problemtranslation.EdgeChoices)
-	}
+func (m *SessionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *ProblemTranslationMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case problemtranslation.EdgeChoices:
-		ids := make([]ent.Value, 0, len(m.removedchoices))
-		for id := range m.removedchoices {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *SessionMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *ProblemTranslationMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.clearedproblem {
-		edges = append(edges, problemtranslation.EdgeProblem)
-	}
-	if m.clearedchoices {
-		edges = append(edges, problemtranslation.EdgeChoices)
+func (m *SessionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.cleareduser {
+		edges = append(edges, session.EdgeUser)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *ProblemTranslationMutation) EdgeCleared(name string) bool {
+func (m *SessionMutation) EdgeCleared(name string) bool {
 	switch name {
-	case problemtranslation.EdgeProblem:
-		return m.clearedproblem
-	case problemtranslation.EdgeChoices:
-		return m.clearedchoices
+	case session.EdgeUser:
+		return m.cleareduser
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *ProblemTranslationMutation) ClearEdge(name string) error {
-	switch name {
-	case problemtranslation.EdgeProblem:
-		m.ClearProblem()
-		return nil
-	}
-	return fmt.Errorf("unknown ProblemTranslation unique edge %s", name)
-}
-
-// ResetEdge resets all changes to the edge with the given name in this mutation.
-// It returns an error if the edge is not defined in the schema.
-func (m *ProblemTranslationMutation) ResetEdge(name string) error {
+func (m *SessionMutation) ClearEdge(name string) error {
 	switch name {
-	case problemtranslation.EdgeProblem:
-		m.ResetProblem()
-		return nil
-	case problemtranslation.EdgeChoices:
-		m.ResetChoices()
+	case session.EdgeUser:
+		m.ClearUser()
 		return nil
 	}
-	return fmt.Errorf("unknown ProblemTranslation edge %s", name)
+	return fmt.Errorf("unknown Session unique edge %s", name)
 }
-
-// SectionMutation represents an operation that mutates the Section nodes in the graph.
-type SectionMutation struct {
-	config
-	op            Op
-	typ           string
-	id            *int
-	title         *string
-	seq           *int
-	addseq        *int
-	clearedFields map[string]struct{}
-	exam          *int
-	clearedexam   bool
-	topics        map[int]struct{}
-	removedtopics map[int]struct{}
-	clearedtopics bool
-	units         map[int]struct{}
-	removedunits  map[int]struct{}
-	clearedunits  bool
-	done          bool
-	oldValue      func(context.Context) (*Section, error)
-	predicates    []predicate.Section
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *SessionMutation) ResetEdge(name string) error {
+	switch name {
+	case session.EdgeUser:
+		m.ResetUser()
+		return nil
+	}
+	return fmt.Errorf("unknown Session edge %s", name)
 }
 
-var _ ent.Mutation = (*SectionMutation)(nil)
+// TopicMutation represents an operation that mutates the Topic nodes in the graph.
+type TopicMutation struct {
+	config
+	op             Op
+	typ            string
+	id             *int
+	key            *string
+	title          *string
+	seq            *int
+	addseq         *int
+	clearedFields  map[string]struct{}
+	exam           *int
+	clearedexam    bool
+	section        *int
+	clearedsection bool
+	units          map[int]struct{}
+	removedunits   map[int]struct{}
+	clearedunits   bool
+	done           bool
+	oldValue       func(context.Context) (*Topic, error)
+	predicates     []predicate.Topic
+}
 
-// sectionOption allows management of the mutation configuration using functional options.
-type sectionOption func(*SectionMutation)
+var _ ent.Mutation = (*TopicMutation)(nil)
 
-// newSectionMutation creates new mutation for the Section entity.
-func newSectionMutation(c config, op Op, opts ...sectionOption) *SectionMutation {
-	m := &SectionMutation{
+// topicOption allows management of the mutation configuration using functional options.
+type topicOption func(*TopicMutation)
+
+// newTopicMutation creates new mutation for the Topic entity.
+func newTopicMutation(c config, op Op, opts ...topicOption) *TopicMutation {
+	m := &TopicMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeSection,
+		typ:           TypeTopic,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -3282,20 +9183,20 @@ func newSectionMutation(c config, op Op, opts ...sectionOption) *SectionMutation
 	return m
 }
 
-// withSectionID sets the ID field of the mutation.
-func withSectionID(id int) sectionOption {
-	return func(m *SectionMutation) {
+// withTopicID sets the ID field of the mutation.
+func withTopicID(id int) topicOption {
+	return func(m *TopicMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Section
+			value *Topic
 		)
-		m.oldValue = func(ctx context.Context) (*Section, error) {
+		m.oldValue = func(ctx context.Context) (*Topic, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Section.Get(ctx, id)
+					value, err = m.Client().Topic.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -3304,10 +9205,10 @@ func withSectionID(id int) sectionOption {
 	}
 }
 
-// withSection sets the old Section of the mutation.
-func withSection(node *Section) sectionOption {
-	return func(m *SectionMutation) {
-		m.oldValue = func(context.Context) (*Section, error) {
+// withTopic sets the old Topic of the mutation.
+func withTopic(node *Topic) topicOption {
+	return func(m *TopicMutation) {
+		m.oldValue = func(context.Context) (*Topic, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -3316,7 +9217,7 @@ func withSection(node *Section) sectionOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m SectionMutation) Client() *Client {
+func (m TopicMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -3324,7 +9225,7 @@ func (m SectionMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m SectionMutation) Tx() (*Tx, error) {
+func (m TopicMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -3335,7 +9236,7 @@ func (m SectionMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *SectionMutation) ID() (id int, exists bool) {
+func (m *TopicMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -3346,7 +9247,7 @@ func (m *SectionMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *SectionMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *TopicMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -3355,19 +9256,68 @@ func (m *SectionMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Section.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Topic.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
+// SetKey sets the "key" field.
+func (m *TopicMutation) SetKey(s string) {
+	m.key = &s
+}
+
+// Key returns the value of the "key" field in the mutation.
+func (m *TopicMutation) Key() (r string, exists bool) {
+	v := m.key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKey returns the old "key" field's value of the Topic entity.
+// If the Topic object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TopicMutation) OldKey(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKey: %w", err)
+	}
+	return oldValue.Key, nil
+}
+
+// ClearKey clears the value of the "key" field.
+func (m *TopicMutation) ClearKey() {
+	m.key = nil
+	m.clearedFields[topic.FieldKey] = struct{}{}
+}
+
+// KeyCleared returns if the "key" field was cleared in this mutation.
+func (m *TopicMutation) KeyCleared() bool {
+	_, ok := m.clearedFields[topic.FieldKey]
+	return ok
+}
+
+// ResetKey resets all changes to the "key" field.
+func (m *TopicMutation) ResetKey() {
+	m.key = nil
+	delete(m.clearedFields, topic.FieldKey)
+}
+
 // SetTitle sets the "title" field.
-func (m *SectionMutation) SetTitle(s string) {
+func (m *TopicMutation) SetTitle(s string) {
 	m.title = &s
 }
 
 // Title returns the value of the "title" field in the mutation.
-func (m *SectionMutation) Title() (r string, exists bool) {
+func (m *TopicMutation) Title() (r string, exists bool) {
 	v := m.title
 	if v == nil {
 		return
@@ -3375,10 +9325,10 @@ func (m *SectionMutation) Title() (r string, exists bool) {
 	return *v, true
 }
 
-// OldTitle returns the old "title" field's value of the Section entity.
-// If the Section object wasn't provided to the builder, the object is fetched from the database.
+// OldTitle returns the old "title" field's value of the Topic entity.
+// If the Topic object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SectionMutation) OldTitle(ctx context.Context) (v string, err error) {
+func (m *TopicMutation) OldTitle(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
 	}
@@ -3393,18 +9343,18 @@ func (m *SectionMutation) OldTitle(ctx context.Context) (v string, err error) {
 }
 
 // ResetTitle resets all changes to the "title" field.
-func (m *SectionMutation) ResetTitle() {
+func (m *TopicMutation) ResetTitle() {
 	m.title = nil
 }
 
 // SetSeq sets the "seq" field.
-func (m *SectionMutation) SetSeq(i int) {
+func (m *TopicMutation) SetSeq(i int) {
 	m.seq = &i
 	m.addseq = nil
 }
 
 // Seq returns the value of the "seq" field in the mutation.
-func (m *SectionMutation) Seq() (r int, exists bool) {
+func (m *TopicMutation) Seq() (r int, exists bool) {
 	v := m.seq
 	if v == nil {
 		return
@@ -3412,10 +9362,10 @@ func (m *SectionMutation) Seq() (r int, exists bool) {
 	return *v, true
 }
 
-// OldSeq returns the old "seq" field's value of the Section entity.
-// If the Section object wasn't provided to the builder, the object is fetched from the database.
+// OldSeq returns the old "seq" field's value of the Topic entity.
+// If the Topic object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SectionMutation) OldSeq(ctx context.Context) (v int, err error) {
+func (m *TopicMutation) OldSeq(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldSeq is only allowed on UpdateOne operations")
 	}
@@ -3430,7 +9380,7 @@ func (m *SectionMutation) OldSeq(ctx context.Context) (v int, err error) {
 }
 
 // AddSeq adds i to the "seq" field.
-func (m *SectionMutation) AddSeq(i int) {
+func (m *TopicMutation) AddSeq(i int) {
 	if m.addseq != nil {
 		*m.addseq += i
 	} else {
@@ -3439,7 +9389,7 @@ func (m *SectionMutation) AddSeq(i int) {
 }
 
 // AddedSeq returns the value that was added to the "seq" field in this mutation.
-func (m *SectionMutation) AddedSeq() (r int, exists bool) {
+func (m *TopicMutation) AddedSeq() (r int, exists bool) {
 	v := m.addseq
 	if v == nil {
 		return
@@ -3448,18 +9398,18 @@ func (m *SectionMutation) AddedSeq() (r int, exists bool) {
 }
 
 // ResetSeq resets all changes to the "seq" field.
-func (m *SectionMutation) ResetSeq() {
+func (m *TopicMutation) ResetSeq() {
 	m.seq = nil
 	m.addseq = nil
 }
 
 // SetExamID sets the "exam_id" field.
-func (m *SectionMutation) SetExamID(i int) {
+func (m *TopicMutation) SetExamID(i int) {
 	m.exam = &i
 }
 
 // ExamID returns the value of the "exam_id" field in the mutation.
-func (m *SectionMutation) ExamID() (r int, exists bool) {
+func (m *TopicMutation) ExamID() (r int, exists bool) {
 	v := m.exam
 	if v == nil {
 		return
@@ -3467,10 +9417,10 @@ func (m *SectionMutation) ExamID() (r int, exists bool) {
 	return *v, true
 }
 
-// OldExamID returns the old "exam_id" field's value of the Section entity.
-// If the Section object wasn't provided to the builder, the object is fetched from the database.
+// OldExamID returns the old "exam_id" field's value of the Topic entity.
+// If the Topic object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SectionMutation) OldExamID(ctx context.Context) (v int, err error) {
+func (m *TopicMutation) OldExamID(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldExamID is only allowed on UpdateOne operations")
 	}
@@ -3485,25 +9435,74 @@ func (m *SectionMutation) OldExamID(ctx context.Context) (v int, err error) {
 }
 
 // ResetExamID resets all changes to the "exam_id" field.
-func (m *SectionMutation) ResetExamID() {
+func (m *TopicMutation) ResetExamID() {
 	m.exam = nil
 }
 
+// SetSectionID sets the "section_id" field.
+func (m *TopicMutation) SetSectionID(i int) {
+	m.section = &i
+}
+
+// SectionID returns the value of the "section_id" field in the mutation.
+func (m *TopicMutation) SectionID() (r int, exists bool) {
+	v := m.section
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSectionID returns the old "section_id" field's value of the Topic entity.
+// If the Topic object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TopicMutation) OldSectionID(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSectionID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSectionID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSectionID: %w", err)
+	}
+	return oldValue.SectionID, nil
+}
+
+// ClearSectionID clears the value of the "section_id" field.
+func (m *TopicMutation) ClearSectionID() {
+	m.section = nil
+	m.clearedFields[topic.FieldSectionID] = struct{}{}
+}
+
+// SectionIDCleared returns if the "section_id" field was cleared in this mutation.
+func (m *TopicMutation) SectionIDCleared() bool {
+	_, ok := m.clearedFields[topic.FieldSectionID]
+	return ok
+}
+
+// ResetSectionID resets all changes to the "section_id" field.
+func (m *TopicMutation) ResetSectionID() {
+	m.section = nil
+	delete(m.clearedFields, topic.FieldSectionID)
+}
+
 // ClearExam clears the "exam" edge to the Exam entity.
-func (m *SectionMutation) ClearExam() {
+func (m *TopicMutation) ClearExam() {
 	m.clearedexam = true
-	m.clearedFields[section.FieldExamID] = struct{}{}
+	m.clearedFields[topic.FieldExamID] = struct{}{}
 }
 
 // ExamCleared reports if the "exam" edge to the Exam entity was cleared.
-func (m *SectionMutation) ExamCleared() bool {
+func (m *TopicMutation) ExamCleared() bool {
 	return m.clearedexam
 }
 
 // ExamIDs returns the "exam" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
 // ExamID instead. It exists only for internal usage by the builders.
-func (m *SectionMutation) ExamIDs() (ids []int) {
+func (m *TopicMutation) ExamIDs() (ids []int) {
 	if id := m.exam; id != nil {
 		ids = append(ids, *id)
 	}
@@ -3511,67 +9510,40 @@ func (m *SectionMutation) ExamIDs() (ids []int) {
 }
 
 // ResetExam resets all changes to the "exam" edge.
-func (m *SectionMutation) ResetExam() {
+func (m *TopicMutation) ResetExam() {
 	m.exam = nil
 	m.clearedexam = false
 }
 
-// AddTopicIDs adds the "topics" edge to the Topic entity by ids.
-func (m *SectionMutation) AddTopicIDs(ids ...int) {
-	if m.topics == nil {
-		m.topics = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.topics[ids[i]] = struct{}{}
-	}
-}
-
-// ClearTopics clears the "topics" edge to the Topic entity.
-func (m *SectionMutation) ClearTopics() {
-	m.clearedtopics = true
-}
-
-// TopicsCleared reports if the "topics" edge to the Topic entity was cleared.
-func (m *SectionMutation) TopicsCleared() bool {
-	return m.clearedtopics
-}
-
-// RemoveTopicIDs removes the "topics" edge to the Topic entity by IDs.
-func (m *SectionMutation) RemoveTopicIDs(ids ...int) {
-	if m.removedtopics == nil {
-		m.removedtopics = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.topics, ids[i])
-		m.removedtopics[ids[i]] = struct{}{}
-	}
+// ClearSection clears the "section" edge to the Section entity.
+func (m *TopicMutation) ClearSection() {
+	m.clearedsection = true
+	m.clearedFields[topic.FieldSectionID] = struct{}{}
 }
 
-// RemovedTopics returns the removed IDs of the "topics" edge to the Topic entity.
-func (m *SectionMutation) RemovedTopicsIDs() (ids []int) {
-	for id := range m.removedtopics {
-		ids = append(ids, id)
-	}
-	return
+// SectionCleared reports if the "section" edge to the Section entity was cleared.
+func (m *TopicMutation) SectionCleared() bool {
+	return m.SectionIDCleared() || m.clearedsection
 }
 
-// TopicsIDs returns the "topics" edge IDs in the mutation.
-func (m *SectionMutation) TopicsIDs() (ids []int) {
-	for id := range m.topics {
-		ids = append(ids, id)
+// SectionIDs returns the "section" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// SectionID instead. It exists only for internal usage by the builders.
+func (m *TopicMutation) SectionIDs() (ids []int) {
+	if id := m.section; id != nil {
+		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetTopics resets all changes to the "topics" edge.
-func (m *SectionMutation) ResetTopics() {
-	m.topics = nil
-	m.clearedtopics = false
-	m.removedtopics = nil
+// ResetSection resets all changes to the "section" edge.
+func (m *TopicMutation) ResetSection() {
+	m.section = nil
+	m.clearedsection = false
 }
 
 // AddUnitIDs adds the "units" edge to the Unit entity by ids.
-func (m *SectionMutation) AddUnitIDs(ids ...int) {
+func (m *TopicMutation) AddUnitIDs(ids ...int) {
 	if m.units == nil {
 		m.units = make(map[int]struct{})
 	}
@@ -3581,17 +9553,17 @@ func (m *SectionMutation) AddUnitIDs(ids ...int) {
 }
 
 // ClearUnits clears the "units" edge to the Unit entity.
-func (m *SectionMutation) ClearUnits() {
+func (m *TopicMutation) ClearUnits() {
 	m.clearedunits = true
 }
 
 // UnitsCleared reports if the "units" edge to the Unit entity was cleared.
-func (m *SectionMutation) UnitsCleared() bool {
+func (m *TopicMutation) UnitsCleared() bool {
 	return m.clearedunits
 }
 
 // RemoveUnitIDs removes the "units" edge to the Unit entity by IDs.
-func (m *SectionMutation) RemoveUnitIDs(ids ...int) {
+func (m *TopicMutation) RemoveUnitIDs(ids ...int) {
 	if m.removedunits == nil {
 		m.removedunits = make(map[int]struct{})
 	}
@@ -3602,7 +9574,7 @@ func (m *SectionMutation) RemoveUnitIDs(ids ...int) {
 }
 
 // RemovedUnits returns the removed IDs of the "units" edge to the Unit entity.
-func (m *SectionMutation) RemovedUnitsIDs() (ids []int) {
+func (m *TopicMutation) RemovedUnitsIDs() (ids []int) {
 	for id := range m.removedunits {
 		ids = append(ids, id)
 	}
@@ -3610,7 +9582,7 @@ func (m *SectionMutation) RemovedUnitsIDs() (ids []int) {
 }
 
 // UnitsIDs returns the "units" edge IDs in the mutation.
-func (m *SectionMutation) UnitsIDs() (ids []int) {
+func (m *TopicMutation) UnitsIDs() (ids []int) {
 	for id := range m.units {
 		ids = append(ids, id)
 	}
@@ -3618,21 +9590,21 @@ func (m *SectionMutation) UnitsIDs() (ids []int) {
 }
 
 // ResetUnits resets all changes to the "units" edge.
-func (m *SectionMutation) ResetUnits() {
+func (m *TopicMutation) ResetUnits() {
 	m.units = nil
 	m.clearedunits = false
 	m.removedunits = nil
 }
 
-// Where appends a list predicates to the SectionMutation builder.
-func (m *SectionMutation) Where(ps ...predicate.Section) {
+// Where appends a list predicates to the TopicMutation builder.
+func (m *TopicMutation) Where(ps ...predicate.Topic) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the SectionMutation builder. Using this method,
+// WhereP appends storage-level predicates to the TopicMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *SectionMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Section, len(ps))
+func (m *TopicMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Topic, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -3640,33 +9612,39 @@ func (m *SectionMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *SectionMutation) Op() Op {
+func (m *TopicMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *SectionMutation) SetOp(op Op) {
+func (m *TopicMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Section).
-func (m *SectionMutation) Type() string {
+// Type returns the node type of this mutation (Topic).
+func (m *TopicMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *SectionMutation) Fields() []string {
-	fields := make([]string, 0, 3)
+func (m *TopicMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.key != nil {
+		fields = append(fields, topic.FieldKey)
+	}
 	if m.title != nil {
-		fields = append(fields, section.FieldTitle)
+		fields = append(fields, topic.FieldTitle)
 	}
 	if m.seq != nil {
-		fields = append(fields, section.FieldSeq)
+		fields = append(fields, topic.FieldSeq)
 	}
 	if m.exam != nil {
-		fields = append(fields, section.FieldExamID)
+		fields = append(fields, topic.FieldExamID)
+	}
+	if m.section != nil {
+		fields = append(fields, topic.FieldSectionID)
 	}
 	return fields
 }
@@ -3674,14 +9652,18 @@ func (m *SectionMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *SectionMutation) Field(name string) (ent.Value, bool) {
+func (m *TopicMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case section.FieldTitle:
+	case topic.FieldKey:
+		return m.Key()
+	case topic.FieldTitle:
 		return m.Title()
-	case section.FieldSeq:
+	case topic.FieldSeq:
 		return m.Seq()
-	case section.FieldExamID:
+	case topic.FieldExamID:
 		return m.ExamID()
+	case topic.FieldSectionID:
+		return m.SectionID()
 	}
 	return nil, false
 }
@@ -3689,54 +9671,72 @@ func (m *SectionMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *SectionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *TopicMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case section.FieldTitle:
+	case topic.FieldKey:
+		return m.OldKey(ctx)
+	case topic.FieldTitle:
 		return m.OldTitle(ctx)
-	case section.FieldSeq:
+	case topic.FieldSeq:
 		return m.OldSeq(ctx)
-	case section.FieldExamID:
+	case topic.FieldExamID:
 		return m.OldExamID(ctx)
+	case topic.FieldSectionID:
+		return m.OldSectionID(ctx)
 	}
-	return nil, fmt.Errorf("unknown Section field %s", name)
+	return nil, fmt.Errorf("unknown Topic field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *SectionMutation) SetField(name string, value ent.Value) error {
+func (m *TopicMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case section.FieldTitle:
+	case topic.FieldKey:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKey(v)
+		return nil
+	case topic.FieldTitle:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetTitle(v)
 		return nil
-	case section.FieldSeq:
+	case topic.FieldSeq:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetSeq(v)
 		return nil
-	case section.FieldExamID:
+	case topic.FieldExamID:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetExamID(v)
 		return nil
+	case topic.FieldSectionID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSectionID(v)
+		return nil
 	}
-	return fmt.Errorf("unknown Section field %s", name)
+	return fmt.Errorf("unknown Topic field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *SectionMutation) AddedFields() []string {
+func (m *TopicMutation) AddedFields() []string {
 	var fields []string
 	if m.addseq != nil {
-		fields = append(fields, section.FieldSeq)
+		fields = append(fields, topic.FieldSeq)
 	}
 	return fields
 }
@@ -3744,9 +9744,9 @@ func (m *SectionMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *SectionMutation) AddedField(name string) (ent.Value, bool) {
+func (m *TopicMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case section.FieldSeq:
+	case topic.FieldSeq:
 		return m.AddedSeq()
 	}
 	return nil, false
@@ -3755,9 +9755,9 @@ func (m *SectionMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *SectionMutation) AddField(name string, value ent.Value) error {
+func (m *TopicMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case section.FieldSeq:
+	case topic.FieldSeq:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
@@ -3765,75 +9765,94 @@ func (m *SectionMutation) AddField(name string, value ent.Value) error {
 		m.AddSeq(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Section numeric field %s", name)
+	return fmt.Errorf("unknown Topic numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *SectionMutation) ClearedFields() []string {
-	return nil
+func (m *TopicMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(topic.FieldKey) {
+		fields = append(fields, topic.FieldKey)
+	}
+	if m.FieldCleared(topic.FieldSectionID) {
+		fields = append(fields, topic.FieldSectionID)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *SectionMutation) FieldCleared(name string) bool {
+func (m *TopicMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *SectionMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown Section nullable field %s", name)
+func (m *TopicMutation) ClearField(name string) error {
+	switch name {
+	case topic.FieldKey:
+		m.ClearKey()
+		return nil
+	case topic.FieldSectionID:
+		m.ClearSectionID()
+		return nil
+	}
+	return fmt.Errorf("unknown Topic nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *SectionMutation) ResetField(name string) error {
+func (m *TopicMutation) ResetField(name string) error {
 	switch name {
-	case section.FieldTitle:
+	case topic.FieldKey:
+		m.ResetKey()
+		return nil
+	case topic.FieldTitle:
 		m.ResetTitle()
 		return nil
-	case section.FieldSeq:
+	case topic.FieldSeq:
 		m.ResetSeq()
 		return nil
-	case section.FieldExamID:
+	case topic.FieldExamID:
 		m.ResetExamID()
 		return nil
+	case topic.FieldSectionID:
+		m.ResetSectionID()
+		return nil
 	}
-	return fmt.Errorf("unknown Section field %s", name)
+	return fmt.Errorf("unknown Topic field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *SectionMutation) AddedEdges() []string {
+func (m *TopicMutation) AddedEdges() []string {
 	edges := make([]string, 0, 3)
 	if m.exam != nil {
-		edges = append(edges, section.EdgeExam)
+		edges = append(edges, topic.EdgeExam)
 	}
-	if m.topics != nil {
-		edges = append(edges, section.EdgeTopics)
+	if m.section != nil {
+		edges = append(edges, topic.EdgeSection)
 	}
 	if m.units != nil {
-		edges = append(edges, section.EdgeUnits)
+		edges = append(edges, topic.EdgeUnits)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *SectionMutation) AddedIDs(name string) []ent.Value {
+func (m *TopicMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case section.EdgeExam:
+	case topic.EdgeExam:
 		if id := m.exam; id != nil {
 			return []ent.Value{*id}
 		}
-	case section.EdgeTopics:
-		ids := make([]ent.Value, 0, len(m.topics))
-		for id := range m.topics {
-			ids = append(ids, id)
+	case topic.EdgeSection:
+		if id := m.section; id != nil {
+			return []ent.Value{*id}
 		}
-		return ids
-	case section.EdgeUnits:
+	case topic.EdgeUnits:
 		ids := make([]ent.Value, 0, len(m.units))
 		for id := range m.units {
 			ids = append(ids, id)
@@ -3844,28 +9863,19 @@ func (m *SectionMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *SectionMutation) RemovedEdges() []string {
+func (m *TopicMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 3)
-	if m.removedtopics != nil {
-		edges = append(edges, section.EdgeTopics)
-	}
 	if m.removedunits != nil {
-		edges = append(edges, section.EdgeUnits)
+		edges = append(edges, topic.EdgeUnits)
 	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *SectionMutation) RemovedIDs(name string) []ent.Value {
+func (m *TopicMutation) RemovedIDs(name string) []ent.Value {
 	switch name {
-	case section.EdgeTopics:
-		ids := make([]ent.Value, 0, len(m.removedtopics))
-		for id := range m.removedtopics {
-			ids = append(ids, id)
-		}
-		return ids
-	case section.EdgeUnits:
+	case topic.EdgeUnits:
 		ids := make([]ent.Value, 0, len(m.removedunits))
 		for id := range m.removedunits {
 			ids = append(ids, id)
@@ -3876,29 +9886,29 @@ func (m *SectionMutation) RemovedIDs(name string) []ent.Value {
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *SectionMutation) ClearedEdges() []string {
+func (m *TopicMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 3)
 	if m.clearedexam {
-		edges = append(edges, section.EdgeExam)
+		edges = append(edges, topic.EdgeExam)
 	}
-	if m.clearedtopics {
-		edges = append(edges, section.EdgeTopics)
+	if m.clearedsection {
+		edges = append(edges, topic.EdgeSection)
 	}
 	if m.clearedunits {
-		edges = append(edges, section.EdgeUnits)
+		edges = append(edges, topic.EdgeUnits)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *SectionMutation) EdgeCleared(name string) bool {
+func (m *TopicMutation) EdgeCleared(name string) bool {
 	switch name {
-	case section.EdgeExam:
+	case topic.EdgeExam:
 		return m.clearedexam
-	case section.EdgeTopics:
-		return m.clearedtopics
-	case section.EdgeUnits:
+	case topic.EdgeSection:
+		return m.clearedsection
+	case topic.EdgeUnits:
 		return m.clearedunits
 	}
 	return false
@@ -3906,65 +9916,71 @@ func (m *SectionMutation) EdgeCleared(name string) bool {
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *SectionMutation) ClearEdge(name string) error {
+func (m *TopicMutation) ClearEdge(name string) error {
 	switch name {
-	case section.EdgeExam:
+	case topic.EdgeExam:
 		m.ClearExam()
 		return nil
+	case topic.EdgeSection:
+		m.ClearSection()
+		return nil
 	}
-	return fmt.Errorf("unknown Section unique edge %s", name)
+	return fmt.Errorf("unknown Topic unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *SectionMutation) ResetEdge(name string) error {
+func (m *TopicMutation) ResetEdge(name string) error {
 	switch name {
-	case section.EdgeExam:
+	case topic.EdgeExam:
 		m.ResetExam()
 		return nil
-	case section.EdgeTopics:
-		m.ResetTopics()
+	case topic.EdgeSection:
+		m.ResetSection()
 		return nil
-	case section.EdgeUnits:
+	case topic.EdgeUnits:
 		m.ResetUnits()
 		return nil
 	}
-	return fmt.Errorf("unknown Section edge %s", name)
+	return fmt.Errorf("unknown Topic edge %s", name)
 }
 
-// TopicMutation represents an operation that mutates the Topic nodes in the graph.
-type TopicMutation struct {
+// UnitMutation represents an operation that mutates the Unit nodes in the graph.
+type UnitMutation struct {
 	config
-	op             Op
-	typ            string
-	id             *int
-	title          *string
-	seq            *int
-	addseq         *int
-	clearedFields  map[string]struct{}
-	exam           *int
-	clearedexam    bool
-	section        *int
-	clearedsection bool
-	units          map[int]struct{}
-	removedunits   map[int]struct{}
-	clearedunits   bool
-	done           bool
-	oldValue       func(context.Context) (*Topic, error)
-	predicates     []predicate.Topic
+	op              Op
+	typ             string
+	id              *int
+	key             *string
+	title           *string
+	seq             *int
+	addseq          *int
+	clearedFields   map[string]struct{}
+	exam            *int
+	clearedexam     bool
+	section         *int
+	clearedsection  bool
+	topic           *int
+	clearedtopic    bool
+	problems        map[int]struct{}
+	removedproblems map[int]struct{}
+	clearedproblems bool
+	done            bool
+	oldValue        func(context.Context) (*Unit, error)
+	predicates      []predicate.Unit
 }
 
-var _ ent.Mutation = (*TopicMutation)(nil)
+var _ ent.Mutation = (*UnitMutation)(nil)
 
-// topicOption allows management of the mutation configuration using functional options.
-type topicOption func(*TopicMutation)
+// unitOption allows management of the mutation configuration using functional options.
+type unitOption func(*UnitMutation)
 
-// newTopicMutation creates new mutation for the Topic entity.
-func newTopicMutation(c config, op Op, opts ...topicOption) *TopicMutation {
-	m := &TopicMutation{
+// newUnitMutation creates new mutation for the Unit entity.
+func newUnitMutation(c config, op Op, opts ...unitOption) *UnitMutation {
+	m := &UnitMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeTopic,
+		typ:           TypeUnit,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -3973,20 +9989,20 @@ func newTopicMutation(c config, op Op, opts ...topicOption) *TopicMutation {
 	return m
 }
 
-// withTopicID sets the ID field of the mutation.
-func withTopicID(id int) topicOption {
-	return func(m *TopicMutation) {
+// withUnitID sets the ID field of the mutation.
+func withUnitID(id int) unitOption {
+	return func(m *UnitMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Topic
+			value *Unit
 		)
-		m.oldValue = func(ctx context.Context) (*Topic, error) {
+		m.oldValue = func(ctx context.Context) (*Unit, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Topic.Get(ctx, id)
+					value, err = m.Client().Unit.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -3995,10 +10011,10 @@ func withTopicID(id int) topicOption {
 	}
 }
 
-// withTopic sets the old Topic of the mutation.
-func withTopic(node *Topic) topicOption {
-	return func(m *TopicMutation) {
-		m.oldValue = func(context.Context) (*Topic, error) {
+// withUnit sets the old Unit of the mutation.
+func withUnit(node *Unit) unitOption {
+	return func(m *UnitMutation) {
+		m.oldValue = func(context.Context) (*Unit, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -4007,7 +10023,7 @@ func withTopic(node *Topic) topicOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m TopicMutation) Client() *Client {
+func (m UnitMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -4015,7 +10031,7 @@ func (m TopicMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m TopicMutation) Tx() (*Tx, error) {
+func (m UnitMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -4026,7 +10042,7 @@ func (m TopicMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *TopicMutation) ID() (id int, exists bool) {
+func (m *UnitMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -4037,7 +10053,7 @@ func (m *TopicMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *TopicMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *UnitMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -4046,19 +10062,68 @@ func (m *TopicMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Topic.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Unit.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
+// SetKey sets the "key" field.
+func (m *UnitMutation) SetKey(s string) {
+	m.key = &s
+}
+
+// Key returns the value of the "key" field in the mutation.
+func (m *UnitMutation) Key() (r string, exists bool) {
+	v := m.key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKey returns the old "key" field's value of the Unit entity.
+// If the Unit object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UnitMutation) OldKey(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKey: %w", err)
+	}
+	return oldValue.Key, nil
+}
+
+// ClearKey clears the value of the "key" field.
+func (m *UnitMutation) ClearKey() {
+	m.key = nil
+	m.clearedFields[unit.FieldKey] = struct{}{}
+}
+
+// KeyCleared returns if the "key" field was cleared in this mutation.
+func (m *UnitMutation) KeyCleared() bool {
+	_, ok := m.clearedFields[unit.FieldKey]
+	return ok
+}
+
+// ResetKey resets all changes to the "key" field.
+func (m *UnitMutation) ResetKey() {
+	m.key = nil
+	delete(m.clearedFields, unit.FieldKey)
+}
+
 // SetTitle sets the "title" field.
-func (m *TopicMutation) SetTitle(s string) {
+func (m *UnitMutation) SetTitle(s string) {
 	m.title = &s
 }
 
 // Title returns the value of the "title" field in the mutation.
-func (m *TopicMutation) Title() (r string, exists bool) {
+func (m *UnitMutation) Title() (r string, exists bool) {
 	v := m.title
 	if v == nil {
 		return
@@ -4066,10 +10131,10 @@ func (m *TopicMutation) Title() (r string, exists bool) {
 	return *v, true
 }
 
-// OldTitle returns the old "title" field's value of the Topic entity.
-// If the Topic object wasn't provided to the builder, the object is fetched from the database.
+// OldTitle returns the old "title" field's value of the Unit entity.
+// If the Unit object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TopicMutation) OldTitle(ctx context.Context) (v string, err error) {
+func (m *UnitMutation) OldTitle(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
 	}
@@ -4084,18 +10149,18 @@ func (m *TopicMutation) OldTitle(ctx context.Context) (v string, err error) {
 }
 
 // ResetTitle resets all changes to the "title" field.
-func (m *TopicMutation) ResetTitle() {
+func (m *UnitMutation) ResetTitle() {
 	m.title = nil
 }
 
 // SetSeq sets the "seq" field.
-func (m *TopicMutation) SetSeq(i int) {
+func (m *UnitMutation) SetSeq(i int) {
 	m.seq = &i
 	m.addseq = nil
 }
 
 // Seq returns the value of the "seq" field in the mutation.
-func (m *TopicMutation) Seq() (r int, exists bool) {
+func (m *UnitMutation) Seq() (r int, exists bool) {
 	v := m.seq
 	if v == nil {
 		return
@@ -4103,10 +10168,10 @@ func (m *TopicMutation) Seq() (r int, exists bool) {
 	return *v, true
 }
 
-// OldSeq returns the old "seq" field's value of the Topic entity.
-// If the Topic object wasn't provided to the builder, the object is fetched from the database.
+// OldSeq returns the old "seq" field's value of the Unit entity.
+// If the Unit object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TopicMutation) OldSeq(ctx context.Context) (v int, err error) {
+func (m *UnitMutation) OldSeq(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldSeq is only allowed on UpdateOne operations")
 	}
@@ -4121,7 +10186,7 @@ func (m *TopicMutation) OldSeq(ctx context.Context) (v int, err error) {
 }
 
 // AddSeq adds i to the "seq" field.
-func (m *TopicMutation) AddSeq(i int) {
+func (m *UnitMutation) AddSeq(i int) {
 	if m.addseq != nil {
 		*m.addseq += i
 	} else {
@@ -4130,7 +10195,7 @@ func (m *TopicMutation) AddSeq(i int) {
 }
 
 // AddedSeq returns the value that was added to the "seq" field in this mutation.
-func (m *TopicMutation) AddedSeq() (r int, exists bool) {
+func (m *UnitMutation) AddedSeq() (r int, exists bool) {
 	v := m.addseq
 	if v == nil {
 		return
@@ -4139,18 +10204,18 @@ func (m *TopicMutation) AddedSeq() (r int, exists bool) {
 }
 
 // ResetSeq resets all changes to the "seq" field.
-func (m *TopicMutation) ResetSeq() {
+func (m *UnitMutation) ResetSeq() {
 	m.seq = nil
 	m.addseq = nil
 }
 
 // SetExamID sets the "exam_id" field.
-func (m *TopicMutation) SetExamID(i int) {
+func (m *UnitMutation) SetExamID(i int) {
 	m.exam = &i
 }
 
 // ExamID returns the value of the "exam_id" field in the mutation.
-func (m *TopicMutation) ExamID() (r int, exists bool) {
+func (m *UnitMutation) ExamID() (r int, exists bool) {
 	v := m.exam
 	if v == nil {
 		return
@@ -4158,10 +10223,10 @@ func (m *TopicMutation) ExamID() (r int, exists bool) {
 	return *v, true
 }
 
-// OldExamID returns the old "exam_id" field's value of the Topic entity.
-// If the Topic object wasn't provided to the builder, the object is fetched from the database.
+// OldExamID returns the old "exam_id" field's value of the Unit entity.
+// If the Unit object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TopicMutation) OldExamID(ctx context.Context) (v int, err error) {
+func (m *UnitMutation) OldExamID(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldExamID is only allowed on UpdateOne operations")
 	}
@@ -4176,17 +10241,17 @@ func (m *TopicMutation) OldExamID(ctx context.Context) (v int, err error) {
 }
 
 // ResetExamID resets all changes to the "exam_id" field.
-func (m *TopicMutation) ResetExamID() {
+func (m *UnitMutation) ResetExamID() {
 	m.exam = nil
 }
 
 // SetSectionID sets the "section_id" field.
-func (m *TopicMutation) SetSectionID(i int) {
+func (m *UnitMutation) SetSectionID(i int) {
 	m.section = &i
 }
 
 // SectionID returns the value of the "section_id" field in the mutation.
-func (m *TopicMutation) SectionID() (r int, exists bool) {
+func (m *UnitMutation) SectionID() (r int, exists bool) {
 	v := m.section
 	if v == nil {
 		return
@@ -4194,10 +10259,10 @@ func (m *TopicMutation) SectionID() (r int, exists bool) {
 	return *v, true
 }
 
-// OldSectionID returns the old "section_id" field's value of the Topic entity.
-// If the Topic object wasn't provided to the builder, the object is fetched from the database.
+// OldSectionID returns the old "section_id" field's value of the Unit entity.
+// If the Unit object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TopicMutation) OldSectionID(ctx context.Context) (v *int, err error) {
+func (m *UnitMutation) OldSectionID(ctx context.Context) (v *int, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldSectionID is only allowed on UpdateOne operations")
 	}
@@ -4212,38 +10277,87 @@ func (m *TopicMutation) OldSectionID(ctx context.Context) (v *int, err error) {
 }
 
 // ClearSectionID clears the value of the "section_id" field.
-func (m *TopicMutation) ClearSectionID() {
+func (m *UnitMutation) ClearSectionID() {
 	m.section = nil
-	m.clearedFields[topic.FieldSectionID] = struct{}{}
+	m.clearedFields[unit.FieldSectionID] = struct{}{}
 }
 
 // SectionIDCleared returns if the "section_id" field was cleared in this mutation.
-func (m *TopicMutation) SectionIDCleared() bool {
-	_, ok := m.clearedFields[topic.FieldSectionID]
+func (m *UnitMutation) SectionIDCleared() bool {
+	_, ok := m.clearedFields[unit.FieldSectionID]
 	return ok
 }
 
 // ResetSectionID resets all changes to the "section_id" field.
-func (m *TopicMutation) ResetSectionID() {
+func (m *UnitMutation) ResetSectionID() {
 	m.section = nil
-	delete(m.clearedFields, topic.FieldSectionID)
+	delete(m.clearedFields, unit.FieldSectionID)
+}
+
+// SetTopicID sets the "topic_id" field.
+func (m *UnitMutation) SetTopicID(i int) {
+	m.topic = &i
+}
+
+// TopicID returns the value of the "topic_id" field in the mutation.
+func (m *UnitMutation) TopicID() (r int, exists bool) {
+	v := m.topic
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTopicID returns the old "topic_id" field's value of the Unit entity.
+// If the Unit object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UnitMutation) OldTopicID(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTopicID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTopicID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTopicID: %w", err)
+	}
+	return oldValue.TopicID, nil
+}
+
+// ClearTopicID clears the value of the "topic_id" field.
+func (m *UnitMutation) ClearTopicID() {
+	m.topic = nil
+	m.clearedFields[unit.FieldTopicID] = struct{}{}
+}
+
+// TopicIDCleared returns if the "topic_id" field was cleared in this mutation.
+func (m *UnitMutation) TopicIDCleared() bool {
+	_, ok := m.clearedFields[unit.FieldTopicID]
+	return ok
+}
+
+// ResetTopicID resets all changes to the "topic_id" field.
+func (m *UnitMutation) ResetTopicID() {
+	m.topic = nil
+	delete(m.clearedFields, unit.FieldTopicID)
 }
 
 // ClearExam clears the "exam" edge to the Exam entity.
-func (m *TopicMutation) ClearExam() {
+func (m *UnitMutation) ClearExam() {
 	m.clearedexam = true
-	m.clearedFields[topic.FieldExamID] = struct{}{}
+	m.clearedFields[unit.FieldExamID] = struct{}{}
 }
 
 // ExamCleared reports if the "exam" edge to the Exam entity was cleared.
-func (m *TopicMutation) ExamCleared() bool {
+func (m *UnitMutation) ExamCleared() bool {
 	return m.clearedexam
 }
 
 // ExamIDs returns the "exam" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
 // ExamID instead. It exists only for internal usage by the builders.
-func (m *TopicMutation) ExamIDs() (ids []int) {
+func (m *UnitMutation) ExamIDs() (ids []int) {
 	if id := m.exam; id != nil {
 		ids = append(ids, *id)
 	}
@@ -4251,26 +10365,26 @@ func (m *TopicMutation) ExamIDs() (ids []int) {
 }
 
 // ResetExam resets all changes to the "exam" edge.
-func (m *TopicMutation) ResetExam() {
+func (m *UnitMutation) ResetExam() {
 	m.exam = nil
 	m.clearedexam = false
 }
 
 // ClearSection clears the "section" edge to the Section entity.
-func (m *TopicMutation) ClearSection() {
+func (m *UnitMutation) ClearSection() {
 	m.clearedsection = true
-	m.clearedFields[topic.FieldSectionID] = struct{}{}
+	m.clearedFields[unit.FieldSectionID] = struct{}{}
 }
 
 // SectionCleared reports if the "section" edge to the Section entity was cleared.
-func (m *TopicMutation) SectionCleared() bool {
+func (m *UnitMutation) SectionCleared() bool {
 	return m.SectionIDCleared() || m.clearedsection
 }
 
 // SectionIDs returns the "section" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
 // SectionID instead. It exists only for internal usage by the builders.
-func (m *TopicMutation) SectionIDs() (ids []int) {
+func (m *UnitMutation) SectionIDs() (ids []int) {
 	if id := m.section; id != nil {
 		ids = append(ids, *id)
 	}
@@ -4278,74 +10392,101 @@ func (m *TopicMutation) SectionIDs() (ids []int) {
 }
 
 // ResetSection resets all changes to the "section" edge.
-func (m *TopicMutation) ResetSection() {
+func (m *UnitMutation) ResetSection() {
 	m.section = nil
 	m.clearedsection = false
 }
 
-// AddUnitIDs adds the "units" edge to the Unit entity by ids.
-func (m *TopicMutation) AddUnitIDs(ids ...int) {
-	if m.units == nil {
-		m.units = make(map[int]struct{})
+// ClearTopic clears the "topic" edge to the Topic entity.
+func (m *UnitMutation) ClearTopic() {
+	m.clearedtopic = true
+	m.clearedFields[unit.FieldTopicID] = struct{}{}
+}
+
+// TopicCleared reports if the "topic" edge to the Topic entity was cleared.
+func (m *UnitMutation) TopicCleared() bool {
+	return m.TopicIDCleared() || m.clearedtopic
+}
+
+// TopicIDs returns the "topic" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// TopicID instead. It exists only for internal usage by the builders.
+func (m *UnitMutation) TopicIDs() (ids []int) {
+	if id := m.topic; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetTopic resets all changes to the "topic" edge.
+func (m *UnitMutation) ResetTopic() {
+	m.topic = nil
+	m.clearedtopic = false
+}
+
+// AddProblemIDs adds the "problems" edge to the Problem entity by ids.
+func (m *UnitMutation) AddProblemIDs(ids ...int) {
+	if m.problems == nil {
+		m.problems = make(map[int]struct{})
 	}
 	for i := range ids {
-		m.units[ids[i]] = struct{}{}
+		m.problems[ids[i]] = struct{}{}
 	}
 }
 
-// ClearUnits clears the "units" edge to the Unit entity.
-func (m *TopicMutation) ClearUnits() {
-	m.clearedunits = true
+// ClearProblems clears the "problems" edge to the Problem entity.
+func (m *UnitMutation) ClearProblems() {
+	m.clearedproblems = true
 }
 
-// UnitsCleared reports if the "units" edge to the Unit entity was cleared.
-func (m *TopicMutation) UnitsCleared() bool {
-	return m.clearedunits
+// ProblemsCleared reports if the "problems" edge to the Problem entity was cleared.
+func (m *UnitMutation) ProblemsCleared() bool {
+	return m.clearedproblems
 }
 
-// RemoveUnitIDs removes the "units" edge to the Unit entity by IDs.
-func (m *TopicMutation) RemoveUnitIDs(ids ...int) {
-	if m.removedunits == nil {
-		m.removedunits = make(map[int]struct{})
+// RemoveProblemIDs removes the "problems" edge to the Problem entity by IDs.
+func (m *UnitMutation) RemoveProblemIDs(ids ...int) {
+	if m.removedproblems == nil {
+		m.removedproblems = make(map[int]struct{})
 	}
 	for i := range ids {
-		delete(m.units, ids[i])
-		m.removedunits[ids[i]] = struct{}{}
+		delete(m.problems, ids[i])
+		m.removedproblems[ids[i]] = struct{}{}
 	}
 }
 
-// RemovedUnits returns the removed IDs of the "units" edge to the Unit entity.
-func (m *TopicMutation) RemovedUnitsIDs() (ids []int) {
-	for id := range m.removedunits {
+// RemovedProblems returns the removed IDs of the "problems" edge to the Problem entity.
+func (m *UnitMutation) RemovedProblemsIDs() (ids []int) {
+	for id := range m.removedproblems {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// UnitsIDs returns the "units" edge IDs in the mutation.
-func (m *TopicMutation) UnitsIDs() (ids []int) {
-	for id := range m.units {
+// ProblemsIDs returns the "problems" edge IDs in the mutation.
+func (m *UnitMutation) ProblemsIDs() (ids []int) {
+	for id := range m.problems {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetUnits resets all changes to the "units" edge.
-func (m *TopicMutation) ResetUnits() {
-	m.units = nil
-	m.clearedunits = false
-	m.removedunits = nil
+// ResetProblems resets all changes to the "problems" edge.
+func (m *UnitMutation) ResetProblems() {
+	m.problems = nil
+	m.clearedproblems = false
+	m.removedproblems = nil
 }
 
-// Where appends a list predicates to the TopicMutation builder.
-func (m *TopicMutation) Where(ps ...predicate.Topic) {
+// Where appends a list predicates to the UnitMutation builder.
+func (m *UnitMutation) Where(ps ...predicate.Unit) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the TopicMutation builder. Using this method,
+// WhereP appends storage-level predicates to the UnitMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *TopicMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Topic, len(ps))
+func (m *UnitMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Unit, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -4353,36 +10494,42 @@ func (m *TopicMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *TopicMutation) Op() Op {
+func (m *UnitMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *TopicMutation) SetOp(op Op) {
+func (m *UnitMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Topic).
-func (m *TopicMutation) Type() string {
+// Type returns the node type of this mutation (Unit).
+func (m *UnitMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *TopicMutation) Fields() []string {
-	fields := make([]string, 0, 4)
+func (m *UnitMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.key != nil {
+		fields = append(fields, unit.FieldKey)
+	}
 	if m.title != nil {
-		fields = append(fields, topic.FieldTitle)
+		fields = append(fields, unit.FieldTitle)
 	}
 	if m.seq != nil {
-		fields = append(fields, topic.FieldSeq)
+		fields = append(fields, unit.FieldSeq)
 	}
 	if m.exam != nil {
-		fields = append(fields, topic.FieldExamID)
+		fields = append(fields, unit.FieldExamID)
 	}
 	if m.section != nil {
-		fields = append(fields, topic.FieldSectionID)
+		fields = append(fields, unit.FieldSectionID)
+	}
+	if m.topic != nil {
+		fields = append(fields, unit.FieldTopicID)
 	}
 	return fields
 }
@@ -4390,16 +10537,20 @@ func (m *TopicMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *TopicMutation) Field(name string) (ent.Value, bool) {
+func (m *UnitMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case topic.FieldTitle:
+	case unit.FieldKey:
+		return m.Key()
+	case unit.FieldTitle:
 		return m.Title()
-	case topic.FieldSeq:
+	case unit.FieldSeq:
 		return m.Seq()
-	case topic.FieldExamID:
+	case unit.FieldExamID:
 		return m.ExamID()
-	case topic.FieldSectionID:
+	case unit.FieldSectionID:
 		return m.SectionID()
+	case unit.FieldTopicID:
+		return m.TopicID()
 	}
 	return nil, false
 }
@@ -4407,63 +10558,81 @@ func (m *TopicMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *TopicMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *UnitMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case topic.FieldTitle:
+	case unit.FieldKey:
+		return m.OldKey(ctx)
+	case unit.FieldTitle:
 		return m.OldTitle(ctx)
-	case topic.FieldSeq:
+	case unit.FieldSeq:
 		return m.OldSeq(ctx)
-	case topic.FieldExamID:
+	case unit.FieldExamID:
 		return m.OldExamID(ctx)
-	case topic.FieldSectionID:
+	case unit.FieldSectionID:
 		return m.OldSectionID(ctx)
+	case unit.FieldTopicID:
+		return m.OldTopicID(ctx)
 	}
-	return nil, fmt.Errorf("unknown Topic field %s", name)
+	return nil, fmt.Errorf("unknown Unit field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *TopicMutation) SetField(name string, value ent.Value) error {
+func (m *UnitMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case topic.FieldTitle:
+	case unit.FieldKey:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKey(v)
+		return nil
+	case unit.FieldTitle:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetTitle(v)
 		return nil
-	case topic.FieldSeq:
+	case unit.FieldSeq:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetSeq(v)
 		return nil
-	case topic.FieldExamID:
+	case unit.FieldExamID:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetExamID(v)
 		return nil
-	case topic.FieldSectionID:
+	case unit.FieldSectionID:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetSectionID(v)
 		return nil
+	case unit.FieldTopicID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTopicID(v)
+		return nil
 	}
-	return fmt.Errorf("unknown Topic field %s", name)
+	return fmt.Errorf("unknown Unit field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *TopicMutation) AddedFields() []string {
+func (m *UnitMutation) AddedFields() []string {
 	var fields []string
 	if m.addseq != nil {
-		fields = append(fields, topic.FieldSeq)
+		fields = append(fields, unit.FieldSeq)
 	}
 	return fields
 }
@@ -4471,9 +10640,9 @@ func (m *TopicMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *TopicMutation) AddedField(name string) (ent.Value, bool) {
+func (m *UnitMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case topic.FieldSeq:
+	case unit.FieldSeq:
 		return m.AddedSeq()
 	}
 	return nil, false
@@ -4482,9 +10651,9 @@ func (m *TopicMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *TopicMutation) AddField(name string, value ent.Value) error {
+func (m *UnitMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case topic.FieldSeq:
+	case unit.FieldSeq:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
@@ -4492,87 +10661,112 @@ func (m *TopicMutation) AddField(name string, value ent.Value) error {
 		m.AddSeq(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Topic numeric field %s", name)
+	return fmt.Errorf("unknown Unit numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *TopicMutation) ClearedFields() []string {
+func (m *UnitMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(topic.FieldSectionID) {
-		fields = append(fields, topic.FieldSectionID)
+	if m.FieldCleared(unit.FieldKey) {
+		fields = append(fields, unit.FieldKey)
+	}
+	if m.FieldCleared(unit.FieldSectionID) {
+		fields = append(fields, unit.FieldSectionID)
+	}
+	if m.FieldCleared(unit.FieldTopicID) {
+		fields = append(fields, unit.FieldTopicID)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *TopicMutation) FieldCleared(name string) bool {
+func (m *UnitMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *TopicMutation) ClearField(name string) error {
+func (m *UnitMutation) ClearField(name string) error {
 	switch name {
-	case topic.FieldSectionID:
+	case unit.FieldKey:
+		m.ClearKey()
+		return nil
+	case unit.FieldSectionID:
 		m.ClearSectionID()
 		return nil
+	case unit.FieldTopicID:
+		m.ClearTopicID()
+		return nil
 	}
-	return fmt.Errorf("unknown Topic nullable field %s", name)
+	return fmt.Errorf("unknown Unit nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *TopicMutation) ResetField(name string) error {
+func (m *UnitMutation) ResetField(name string) error {
 	switch name {
-	case topic.FieldTitle:
+	case unit.FieldKey:
+		m.ResetKey()
+		return nil
+	case unit.FieldTitle:
 		m.ResetTitle()
 		return nil
-	case topic.FieldSeq:
+	case unit.FieldSeq:
 		m.ResetSeq()
 		return nil
-	case topic.FieldExamID:
+	case unit.FieldExamID:
 		m.ResetExamID()
 		return nil
-	case topic.FieldSectionID:
+	case unit.FieldSectionID:
 		m.ResetSectionID()
 		return nil
+	case unit.FieldTopicID:
+		m.ResetTopicID()
+		return nil
 	}
-	return fmt.Errorf("unknown Topic field %s", name)
+	return fmt.Errorf("unknown Unit field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *TopicMutation) AddedEdges() []string {
-	edges := make([]string, 0, 3)
+func (m *UnitMutation) AddedEdges() []string {
+	edges := make([]string, 0, 4)
 	if m.exam != nil {
-		edges = append(edges, topic.EdgeExam)
+		edges = append(edges, unit.EdgeExam)
 	}
 	if m.section != nil {
-		edges = append(edges, topic.EdgeSection)
+		edges = append(edges, unit.EdgeSection)
 	}
-	if m.units != nil {
-		edges = append(edges, topic.EdgeUnits)
+	if m.topic != nil {
+		edges = append(edges, unit.EdgeTopic)
+	}
+	if m.problems != nil {
+		edges = append(edges, unit.EdgeProblems)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *TopicMutation) AddedIDs(name string) []ent.Value {
+func (m *UnitMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case topic.EdgeExam:
+	case unit.EdgeExam:
 		if id := m.exam; id != nil {
 			return []ent.Value{*id}
 		}
-	case topic.EdgeSection:
+	case unit.EdgeSection:
 		if id := m.section; id != nil {
 			return []ent.Value{*id}
 		}
-	case topic.EdgeUnits:
-		ids := make([]ent.Value, 0, len(m.units))
-		for id := range m.units {
+	case unit.EdgeTopic:
+		if id := m.topic; id != nil {
+			return []ent.Value{*id}
+		}
+	case unit.EdgeProblems:
+		ids := make([]ent.Value, 0, len(m.problems))
+		for id := range m.problems {
 			ids = append(ids, id)
 		}
 		return ids
@@ -4581,21 +10775,21 @@ func (m *TopicMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *TopicMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.removedunits != nil {
-		edges = append(edges, topic.EdgeUnits)
+func (m *UnitMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.removedproblems != nil {
+		edges = append(edges, unit.EdgeProblems)
 	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *TopicMutation) RemovedIDs(name string) []ent.Value {
+func (m *UnitMutation) RemovedIDs(name string) []ent.Value {
 	switch name {
-	case topic.EdgeUnits:
-		ids := make([]ent.Value, 0, len(m.removedunits))
-		for id := range m.removedunits {
+	case unit.EdgeProblems:
+		ids := make([]ent.Value, 0, len(m.removedproblems))
+		for id := range m.removedproblems {
 			ids = append(ids, id)
 		}
 		return ids
@@ -4604,100 +10798,112 @@ func (m *TopicMutation) RemovedIDs(name string) []ent.Value {
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *TopicMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 3)
+func (m *UnitMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 4)
 	if m.clearedexam {
-		edges = append(edges, topic.EdgeExam)
+		edges = append(edges, unit.EdgeExam)
 	}
 	if m.clearedsection {
-		edges = append(edges, topic.EdgeSection)
+		edges = append(edges, unit.EdgeSection)
 	}
-	if m.clearedunits {
-		edges = append(edges, topic.EdgeUnits)
+	if m.clearedtopic {
+		edges = append(edges, unit.EdgeTopic)
+	}
+	if m.clearedproblems {
+		edges = append(edges, unit.EdgeProblems)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *TopicMutation) EdgeCleared(name string) bool {
+func (m *UnitMutation) EdgeCleared(name string) bool {
 	switch name {
-	case topic.EdgeExam:
+	case unit.EdgeExam:
 		return m.clearedexam
-	case topic.EdgeSection:
+	case unit.EdgeSection:
 		return m.clearedsection
-	case topic.EdgeUnits:
-		return m.clearedunits
+	case unit.EdgeTopic:
+		return m.clearedtopic
+	case unit.EdgeProblems:
+		return m.clearedproblems
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *TopicMutation) ClearEdge(name string) error {
+func (m *UnitMutation) ClearEdge(name string) error {
 	switch name {
-	case topic.EdgeExam:
+	case unit.EdgeExam:
 		m.ClearExam()
 		return nil
-	case topic.EdgeSection:
+	case unit.EdgeSection:
 		m.ClearSection()
 		return nil
+	case unit.EdgeTopic:
+		m.ClearTopic()
+		return nil
 	}
-	return fmt.Errorf("unknown Topic unique edge %s", name)
+	return fmt.Errorf("unknown Unit unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *TopicMutation) ResetEdge(name string) error {
+func (m *UnitMutation) ResetEdge(name string) error {
 	switch name {
-	case topic.EdgeExam:
+	case unit.EdgeExam:
 		m.ResetExam()
 		return nil
-	case topic.EdgeSection:
+	case unit.EdgeSection:
 		m.ResetSection()
 		return nil
-	case topic.EdgeUnits:
-		m.ResetUnits()
+	case unit.EdgeTopic:
+		m.ResetTopic()
+		return nil
+	case unit.EdgeProblems:
+		m.ResetProblems()
 		return nil
 	}
-	return fmt.Errorf("unknown Topic edge %s", name)
+	return fmt.Errorf("unknown Unit edge %s", name)
 }
 
-// UnitMutation represents an operation that mutates the Unit nodes in the graph.
-type UnitMutation struct {
+// UserMutation represents an operation that mutates the User nodes in the graph.
+type UserMutation struct {
 	config
 	op              Op
 	typ             string
 	id              *int
-	title           *string
-	seq             *int
-	addseq          *int
+	email           *string
+	name            *string
+	password_hash   *string
+	created_at      *time.Time
 	clearedFields   map[string]struct{}
-	exam            *int
-	clearedexam     bool
-	section         *int
-	clearedsection  bool
-	topic           *int
-	clearedtopic    bool
-	problems        map[int]struct{}
-	removedproblems map[int]struct{}
-	clearedproblems bool
+	roles           map[int]struct{}
+	removedroles    map[int]struct{}
+	clearedroles    bool
+	sessions        map[int]struct{}
+	removedsessions map[int]struct{}
+	clearedsessions bool
+	attempts        map[int]struct{}
+	removedattempts map[int]struct{}
+	clearedattempts bool
 	done            bool
-	oldValue        func(context.Context) (*Unit, error)
-	predicates      []predicate.Unit
+	oldValue        func(context.Context) (*User, error)
+	predicates      []predicate.User
 }
 
-var _ ent.Mutation = (*UnitMutation)(nil)
+var _ ent.Mutation = (*UserMutation)(nil)
 
-// unitOption allows management of the mutation configuration using functional options.
-type unitOption func(*UnitMutation)
+// userOption allows management of the mutation configuration using functional options.
+type userOption func(*UserMutation)
 
-// newUnitMutation creates new mutation for the Unit entity.
-func newUnitMutation(c config, op Op, opts ...unitOption) *UnitMutation {
-	m := &UnitMutation{
+// newUserMutation creates new mutation for the User entity.
+func newUserMutation(c config, op Op, opts ...userOption) *UserMutation {
+	m := &UserMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeUnit,
+		typ:           TypeUser,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -4706,20 +10912,20 @@ func newUnitMutation(c config, op Op, opts ...unitOption) *UnitMutation {
 	return m
 }
 
-// withUnitID sets the ID field of the mutation.
-func withUnitID(id int) unitOption {
-	return func(m *UnitMutation) {
+// withUserID sets the ID field of the mutation.
+func withUserID(id int) userOption {
+	return func(m *UserMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Unit
+			value *User
 		)
-		m.oldValue = func(ctx context.Context) (*Unit, error) {
+		m.oldValue = func(ctx context.Context) (*User, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Unit.Get(ctx, id)
+					value, err = m.Client().User.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -4728,10 +10934,10 @@ func withUnitID(id int) unitOption {
 	}
 }
 
-// withUnit sets the old Unit of the mutation.
-func withUnit(node *Unit) unitOption {
-	return func(m *UnitMutation) {
-		m.oldValue = func(context.Context) (*Unit, error) {
+// withUser sets the old User of the mutation.
+func withUser(node *User) userOption {
+	return func(m *UserMutation) {
+		m.oldValue = func(context.Context) (*User, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -4740,7 +10946,7 @@ func withUnit(node *Unit) unitOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m UnitMutation) Client() *Client {
+func (m UserMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -4748,7 +10954,7 @@ func (m UnitMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m UnitMutation) Tx() (*Tx, error) {
+func (m UserMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -4759,7 +10965,7 @@ func (m UnitMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *UnitMutation) ID() (id int, exists bool) {
+func (m *UserMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -4770,7 +10976,7 @@ func (m *UnitMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *UnitMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *UserMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -4779,382 +10985,340 @@ func (m *UnitMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Unit.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().User.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetTitle sets the "title" field.
-func (m *UnitMutation) SetTitle(s string) {
-	m.title = &s
+// SetEmail sets the "email" field.
+func (m *UserMutation) SetEmail(s string) {
+	m.email = &s
 }
 
-// Title returns the value of the "title" field in the mutation.
-func (m *UnitMutation) Title() (r string, exists bool) {
-	v := m.title
+// Email returns the value of the "email" field in the mutation.
+func (m *UserMutation) Email() (r string, exists bool) {
+	v := m.email
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTitle returns the old "title" field's value of the Unit entity.
-// If the Unit object wasn't provided to the builder, the object is fetched from the database.
+// OldEmail returns the old "email" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UnitMutation) OldTitle(ctx context.Context) (v string, err error) {
+func (m *UserMutation) OldEmail(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
+		return v, errors.New("OldEmail is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTitle requires an ID field in the mutation")
+		return v, errors.New("OldEmail requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
+		return v, fmt.Errorf("querying old value for OldEmail: %w", err)
 	}
-	return oldValue.Title, nil
+	return oldValue.Email, nil
 }
 
-// ResetTitle resets all changes to the "title" field.
-func (m *UnitMutation) ResetTitle() {
-	m.title = nil
+// ResetEmail resets all changes to the "email" field.
+func (m *UserMutation) ResetEmail() {
+	m.email = nil
 }
 
-// SetSeq sets the "seq" field.
-func (m *UnitMutation) SetSeq(i int) {
-	m.seq = &i
-	m.addseq = nil
+// SetName sets the "name" field.
+func (m *UserMutation) SetName(s string) {
+	m.name = &s
 }
 
-// Seq returns the value of the "seq" field in the mutation.
-func (m *UnitMutation) Seq() (r int, exists bool) {
-	v := m.seq
+// Name returns the value of the "name" field in the mutation.
+func (m *UserMutation) Name() (r string, exists bool) {
+	v := m.name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSeq returns the old "seq" field's value of the Unit entity.
-// If the Unit object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UnitMutation) OldSeq(ctx context.Context) (v int, err error) {
+func (m *UserMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSeq is only allowed on UpdateOne operations")
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSeq requires an ID field in the mutation")
+		return v, errors.New("OldName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSeq: %w", err)
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
-	return oldValue.Seq, nil
+	return oldValue.Name, nil
 }
 
-// AddSeq adds i to the "seq" field.
-func (m *UnitMutation) AddSeq(i int) {
-	if m.addseq != nil {
-		*m.addseq += i
-	} else {
-		m.addseq = &i
-	}
+// ClearName clears the value of the "name" field.
+func (m *UserMutation) ClearName() {
+	m.name = nil
+	m.clearedFields[user.FieldName] = struct{}{}
 }
 
-// AddedSeq returns the value that was added to the "seq" field in this mutation.
-func (m *UnitMutation) AddedSeq() (r int, exists bool) {
-	v := m.addseq
-	if v == nil {
-		return
-	}
-	return *v, true
+// NameCleared returns if the "name" field was cleared in this mutation.
+func (m *UserMutation) NameCleared() bool {
+	_, ok := m.clearedFields[user.FieldName]
+	return ok
 }
 
-// ResetSeq resets all changes to the "seq" field.
-func (m *UnitMutation) ResetSeq() {
-	m.seq = nil
-	m.addseq = nil
+// ResetName resets all changes to the "name" field.
+func (m *UserMutation) ResetName() {
+	m.name = nil
+	delete(m.clearedFields, user.FieldName)
 }
 
-// SetExamID sets the "exam_id" field.
-func (m *UnitMutation) SetExamID(i int) {
-	m.exam = &i
+// SetPasswordHash sets the "password_hash" field.
+func (m *UserMutation) SetPasswordHash(s string) {
+	m.password_hash = &s
 }
 
-// ExamID returns the value of the "exam_id" field in the mutation.
-func (m *UnitMutation) ExamID() (r int, exists bool) {
-	v := m.exam
+// PasswordHash returns the value of the "password_hash" field in the mutation.
+func (m *UserMutation) PasswordHash() (r string, exists bool) {
+	v := m.password_hash
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldExamID returns the old "exam_id" field's value of the Unit entity.
-// If the Unit object wasn't provided to the builder, the object is fetched from the database.
+// OldPasswordHash returns the old "password_hash" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UnitMutation) OldExamID(ctx context.Context) (v int, err error) {
+func (m *UserMutation) OldPasswordHash(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldExamID is only allowed on UpdateOne operations")
+		return v, errors.New("OldPasswordHash is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldExamID requires an ID field in the mutation")
+		return v, errors.New("OldPasswordHash requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldExamID: %w", err)
+		return v, fmt.Errorf("querying old value for OldPasswordHash: %w", err)
 	}
-	return oldValue.ExamID, nil
+	return oldValue.PasswordHash, nil
 }
 
-// ResetExamID resets all changes to the "exam_id" field.
-func (m *UnitMutation) ResetExamID() {
-	m.exam = nil
+// ResetPasswordHash resets all changes to the "password_hash" field.
+func (m *UserMutation) ResetPasswordHash() {
+	m.password_hash = nil
 }
 
-// SetSectionID sets the "section_id" field.
-func (m *UnitMutation) SetSectionID(i int) {
-	m.section = &i
+// SetCreatedAt sets the "created_at" field.
+func (m *UserMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// SectionID returns the value of the "section_id" field in the mutation.
-func (m *UnitMutation) SectionID() (r int, exists bool) {
-	v := m.section
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *UserMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSectionID returns the old "section_id" field's value of the Unit entity.
-// If the Unit object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UnitMutation) OldSectionID(ctx context.Context) (v *int, err error) {
+func (m *UserMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSectionID is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSectionID requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSectionID: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return oldValue.SectionID, nil
-}
-
-// ClearSectionID clears the value of the "section_id" field.
-func (m *UnitMutation) ClearSectionID() {
-	m.section = nil
-	m.clearedFields[unit.FieldSectionID] = struct{}{}
-}
-
-// SectionIDCleared returns if the "section_id" field was cleared in this mutation.
-func (m *UnitMutation) SectionIDCleared() bool {
-	_, ok := m.clearedFields[unit.FieldSectionID]
-	return ok
-}
-
-// ResetSectionID resets all changes to the "section_id" field.
-func (m *UnitMutation) ResetSectionID() {
-	m.section = nil
-	delete(m.clearedFields, unit.FieldSectionID)
-}
-
-// SetTopicID sets the "topic_id" field.
-func (m *UnitMutation) SetTopicID(i int) {
-	m.topic = &i
+	return oldValue.CreatedAt, nil
 }
 
-// TopicID returns the value of the "topic_id" field in the mutation.
-func (m *UnitMutation) TopicID() (r int, exists bool) {
-	v := m.topic
-	if v == nil {
-		return
-	}
-	return *v, true
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *UserMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// OldTopicID returns the old "topic_id" field's value of the Unit entity.
-// If the Unit object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UnitMutation) OldTopicID(ctx context.Context) (v *int, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTopicID is only allowed on UpdateOne operations")
+// AddRoleIDs adds the "roles" edge to the Role entity by ids.
+func (m *UserMutation) AddRoleIDs(ids ...int) {
+	if m.roles == nil {
+		m.roles = make(map[int]struct{})
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTopicID requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTopicID: %w", err)
+	for i := range ids {
+		m.roles[ids[i]] = struct{}{}
 	}
-	return oldValue.TopicID, nil
-}
-
-// ClearTopicID clears the value of the "topic_id" field.
-func (m *UnitMutation) ClearTopicID() {
-	m.topic = nil
-	m.clearedFields[unit.FieldTopicID] = struct{}{}
-}
-
-// TopicIDCleared returns if the "topic_id" field was cleared in this mutation.
-func (m *UnitMutation) TopicIDCleared() bool {
-	_, ok := m.clearedFields[unit.FieldTopicID]
-	return ok
 }
 
-// ResetTopicID resets all changes to the "topic_id" field.
-func (m *UnitMutation) ResetTopicID() {
-	m.topic = nil
-	delete(m.clearedFields, unit.FieldTopicID)
+// ClearRoles clears the "roles" edge to the Role entity.
+func (m *UserMutation) ClearRoles() {
+	m.clearedroles = true
 }
 
-// ClearExam clears the "exam" edge to the Exam entity.
-func (m *UnitMutation) ClearExam() {
-	m.clearedexam = true
-	m.clearedFields[unit.FieldExamID] = struct{}{}
+// RolesCleared reports if the "roles" edge to the Role entity was cleared.
+func (m *UserMutation) RolesCleared() bool {
+	return m.clearedroles
 }
 
-// ExamCleared reports if the "exam" edge to the Exam entity was cleared.
-func (m *UnitMutation) ExamCleared() bool {
-	return m.clearedexam
+// RemoveRoleIDs removes the "roles" edge to the Role entity by IDs.
+func (m *UserMutation) RemoveRoleIDs(ids ...int) {
+	if m.removedroles == nil {
+		m.removedroles = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.roles, ids[i])
+		m.removedroles[ids[i]] = struct{}{}
+	}
 }
 
-// ExamIDs returns the "exam" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// ExamID instead. It exists only for internal usage by the builders.
-func (m *UnitMutation) ExamIDs() (ids []int) {
-	if id := m.exam; id != nil {
-		ids = append(ids, *id)
+// RemovedRoles returns the removed IDs of the "roles" edge to the Role entity.
+func (m *UserMutation) RemovedRolesIDs() (ids []int) {
+	for id := range m.removedroles {
+		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetExam resets all changes to the "exam" edge.
-func (m *UnitMutation) ResetExam() {
-	m.exam = nil
-	m.clearedexam = false
-}
-
-// ClearSection clears the "section" edge to the Section entity.
-func (m *UnitMutation) ClearSection() {
-	m.clearedsection = true
-	m.clearedFields[unit.FieldSectionID] = struct{}{}
+// RolesIDs returns the "roles" edge IDs in the mutation.
+func (m *UserMutation) RolesIDs() (ids []int) {
+	for id := range m.roles {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// SectionCleared reports if the "section" edge to the Section entity was cleared.
-func (m *UnitMutation) SectionCleared() bool {
-	return m.SectionIDCleared() || m.clearedsection
+// ResetRoles resets all changes to the "roles" edge.
+func (m *UserMutation) ResetRoles() {
+	m.roles = nil
+	m.clearedroles = false
+	m.removedroles = nil
 }
 
-// SectionIDs returns the "section" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// SectionID instead. It exists only for internal usage by the builders.
-func (m *UnitMutation) SectionIDs() (ids []int) {
-	if id := m.section; id != nil {
-		ids = append(ids, *id)
+// AddSessionIDs adds the "sessions" edge to the Session entity by ids.
+func (m *UserMutation) AddSessionIDs(ids ...int) {
+	if m.sessions == nil {
+		m.sessions = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.sessions[ids[i]] = struct{}{}
 	}
-	return
 }
 
-// ResetSection resets all changes to the "section" edge.
-func (m *UnitMutation) ResetSection() {
-	m.section = nil
-	m.clearedsection = false
+// ClearSessions clears the "sessions" edge to the Session entity.
+func (m *UserMutation) ClearSessions() {
+	m.clearedsessions = true
 }
 
-// ClearTopic clears the "topic" edge to the Topic entity.
-func (m *UnitMutation) ClearTopic() {
-	m.clearedtopic = true
-	m.clearedFields[unit.FieldTopicID] = struct{}{}
+// SessionsCleared reports if the "sessions" edge to the Session entity was cleared.
+func (m *UserMutation) SessionsCleared() bool {
+	return m.clearedsessions
 }
 
-// TopicCleared reports if the "topic" edge to the Topic entity was cleared.
-func (m *UnitMutation) TopicCleared() bool {
-	return m.TopicIDCleared() || m.clearedtopic
+// RemoveSessionIDs removes the "sessions" edge to the Session entity by IDs.
+func (m *UserMutation) RemoveSessionIDs(ids ...int) {
+	if m.removedsessions == nil {
+		m.removedsessions = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.sessions, ids[i])
+		m.removedsessions[ids[i]] = struct{}{}
+	}
 }
 
-// TopicIDs returns the "topic" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// TopicID instead. It exists only for internal usage by the builders.
-func (m *UnitMutation) TopicIDs() (ids []int) {
-	if id := m.topic; id != nil {
-		ids = append(ids, *id)
+// RemovedSessions returns the removed IDs of the "sessions" edge to the Session entity.
+func (m *UserMutation) RemovedSessionsIDs() (ids []int) {
+	for id := range m.removedsessions {
+		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetTopic resets all changes to the "topic" edge.
-func (m *UnitMutation) ResetTopic() {
-	m.topic = nil
-	m.clearedtopic = false
+// SessionsIDs returns the "sessions" edge IDs in the mutation.
+func (m *UserMutation) SessionsIDs() (ids []int) {
+	for id := range m.sessions {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// AddProblemIDs adds the "problems" edge to the Problem entity by ids.
-func (m *UnitMutation) AddProblemIDs(ids ...int) {
-	if m.problems == nil {
-		m.problems = make(map[int]struct{})
+// ResetSessions resets all changes to the "sessions" edge.
+func (m *UserMutation) ResetSessions() {
+	m.sessions = nil
+	m.clearedsessions = false
+	m.removedsessions = nil
+}
+
+// AddAttemptIDs adds the "attempts" edge to the Attempt entity by ids.
+func (m *UserMutation) AddAttemptIDs(ids ...int) {
+	if m.attempts == nil {
+		m.attempts = make(map[int]struct{})
 	}
 	for i := range ids {
-		m.problems[ids[i]] = struct{}{}
+		m.attempts[ids[i]] = struct{}{}
 	}
 }
 
-// ClearProblems clears the "problems" edge to the Problem entity.
-func (m *UnitMutation) ClearProblems() {
-	m.clearedproblems = true
+// ClearAttempts clears the "attempts" edge to the Attempt entity.
+func (m *UserMutation) ClearAttempts() {
+	m.clearedattempts = true
 }
 
-// ProblemsCleared reports if the "problems" edge to the Problem entity was cleared.
-func (m *UnitMutation) ProblemsCleared() bool {
-	return m.clearedproblems
+// AttemptsCleared reports if the "attempts" edge to the Attempt entity was cleared.
+func (m *UserMutation) AttemptsCleared() bool {
+	return m.clearedattempts
 }
 
-// RemoveProblemIDs removes the "problems" edge to the Problem entity by IDs.
-func (m *UnitMutation) RemoveProblemIDs(ids ...int) {
-	if m.removedproblems == nil {
-		m.removedproblems = make(map[int]struct{})
+// RemoveAttemptIDs removes the "attempts" edge to the Attempt entity by IDs.
+func (m *UserMutation) RemoveAttemptIDs(ids ...int) {
+	if m.removedattempts == nil {
+		m.removedattempts = make(map[int]struct{})
 	}
 	for i := range ids {
-		delete(m.problems, ids[i])
-		m.removedproblems[ids[i]] = struct{}{}
+		delete(m.attempts, ids[i])
+		m.removedattempts[ids[i]] = struct{}{}
 	}
 }
 
-// RemovedProblems returns the removed IDs of the "problems" edge to the Problem entity.
-func (m *UnitMutation) RemovedProblemsIDs() (ids []int) {
-	for id := range m.removedproblems {
+// RemovedAttempts returns the removed IDs of the "attempts" edge to the Attempt entity.
+func (m *UserMutation) RemovedAttemptsIDs() (ids []int) {
+	for id := range m.removedattempts {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// ProblemsIDs returns the "problems" edge IDs in the mutation.
-func (m *UnitMutation) ProblemsIDs() (ids []int) {
-	for id := range m.problems {
+// AttemptsIDs returns the "attempts" edge IDs in the mutation.
+func (m *UserMutation) AttemptsIDs() (ids []int) {
+	for id := range m.attempts {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetProblems resets all changes to the "problems" edge.
-func (m *UnitMutation) ResetProblems() {
-	m.problems = nil
-	m.clearedproblems = false
-	m.removedproblems = nil
+// ResetAttempts resets all changes to the "attempts" edge.
+func (m *UserMutation) ResetAttempts() {
+	m.attempts = nil
+	m.clearedattempts = false
+	m.removedattempts = nil
 }
 
-// Where appends a list predicates to the UnitMutation builder.
-func (m *UnitMutation) Where(ps ...predicate.Unit) {
+// Where appends a list predicates to the UserMutation builder.
+func (m *UserMutation) Where(ps ...predicate.User) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the UnitMutation builder. Using this method,
+// WhereP appends storage-level predicates to the UserMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *UnitMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Unit, len(ps))
+func (m *UserMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.User, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -5162,39 +11326,36 @@ func (m *UnitMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *UnitMutation) Op() Op {
+func (m *UserMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *UnitMutation) SetOp(op Op) {
+func (m *UserMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Unit).
-func (m *UnitMutation) Type() string {
+// Type returns the node type of this mutation (User).
+func (m *UserMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *UnitMutation) Fields() []string {
-	fields := make([]string, 0, 5)
-	if m.title != nil {
-		fields = append(fields, unit.FieldTitle)
-	}
-	if m.seq != nil {
-		fields = append(fields, unit.FieldSeq)
+func (m *UserMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.email != nil {
+		fields = append(fields, user.FieldEmail)
 	}
-	if m.exam != nil {
-		fields = append(fields, unit.FieldExamID)
+	if m.name != nil {
+		fields = append(fields, user.FieldName)
 	}
-	if m.section != nil {
-		fields = append(fields, unit.FieldSectionID)
+	if m.password_hash != nil {
+		fields = append(fields, user.FieldPasswordHash)
 	}
-	if m.topic != nil {
-		fields = append(fields, unit.FieldTopicID)
+	if m.created_at != nil {
+		fields = append(fields, user.FieldCreatedAt)
 	}
 	return fields
 }
@@ -5202,18 +11363,16 @@ func (m *UnitMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *UnitMutation) Field(name string) (ent.Value, bool) {
+func (m *UserMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case unit.FieldTitle:
-		return m.Title()
-	case unit.FieldSeq:
-		return m.Seq()
-	case unit.FieldExamID:
-		return m.ExamID()
-	case unit.FieldSectionID:
-		return m.SectionID()
-	case unit.FieldTopicID:
-		return m.TopicID()
+	case user.FieldEmail:
+		return m.Email()
+	case user.FieldName:
+		return m.Name()
+	case user.FieldPasswordHash:
+		return m.PasswordHash()
+	case user.FieldCreatedAt:
+		return m.CreatedAt()
 	}
 	return nil, false
 }
@@ -5221,197 +11380,161 @@ func (m *UnitMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *UnitMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *UserMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case unit.FieldTitle:
-		return m.OldTitle(ctx)
-	case unit.FieldSeq:
-		return m.OldSeq(ctx)
-	case unit.FieldExamID:
-		return m.OldExamID(ctx)
-	case unit.FieldSectionID:
-		return m.OldSectionID(ctx)
-	case unit.FieldTopicID:
-		return m.OldTopicID(ctx)
+	case user.FieldEmail:
+		return m.OldEmail(ctx)
+	case user.FieldName:
+		return m.OldName(ctx)
+	case user.FieldPasswordHash:
+		return m.OldPasswordHash(ctx)
+	case user.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Unit field %s", name)
+	return nil, fmt.Errorf("unknown User field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *UnitMutation) SetField(name string, value ent.Value) error {
+func (m *UserMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case unit.FieldTitle:
+	case user.FieldEmail:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTitle(v)
-		return nil
-	case unit.FieldSeq:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetSeq(v)
+		m.SetEmail(v)
 		return nil
-	case unit.FieldExamID:
-		v, ok := value.(int)
+	case user.FieldName:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetExamID(v)
+		m.SetName(v)
 		return nil
-	case unit.FieldSectionID:
-		v, ok := value.(int)
+	case user.FieldPasswordHash:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSectionID(v)
+		m.SetPasswordHash(v)
 		return nil
-	case unit.FieldTopicID:
-		v, ok := value.(int)
+	case user.FieldCreatedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTopicID(v)
+		m.SetCreatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Unit field %s", name)
+	return fmt.Errorf("unknown User field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *UnitMutation) AddedFields() []string {
-	var fields []string
-	if m.addseq != nil {
-		fields = append(fields, unit.FieldSeq)
-	}
-	return fields
+func (m *UserMutation) AddedFields() []string {
+	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *UnitMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	case unit.FieldSeq:
-		return m.AddedSeq()
-	}
+func (m *UserMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *UnitMutation) AddField(name string, value ent.Value) error {
+func (m *UserMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case unit.FieldSeq:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddSeq(v)
-		return nil
 	}
-	return fmt.Errorf("unknown Unit numeric field %s", name)
+	return fmt.Errorf("unknown User numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *UnitMutation) ClearedFields() []string {
+func (m *UserMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(unit.FieldSectionID) {
-		fields = append(fields, unit.FieldSectionID)
-	}
-	if m.FieldCleared(unit.FieldTopicID) {
-		fields = append(fields, unit.FieldTopicID)
+	if m.FieldCleared(user.FieldName) {
+		fields = append(fields, user.FieldName)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *UnitMutation) FieldCleared(name string) bool {
+func (m *UserMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *UnitMutation) ClearField(name string) error {
+func (m *UserMutation) ClearField(name string) error {
 	switch name {
-	case unit.FieldSectionID:
-		m.ClearSectionID()
-		return nil
-	case unit.FieldTopicID:
-		m.ClearTopicID()
+	case user.FieldName:
+		m.ClearName()
 		return nil
 	}
-	return fmt.Errorf("unknown Unit nullable field %s", name)
+	return fmt.Errorf("unknown User nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *UnitMutation) ResetField(name string) error {
+func (m *UserMutation) ResetField(name string) error {
 	switch name {
-	case unit.FieldTitle:
-		m.ResetTitle()
-		return nil
-	case unit.FieldSeq:
-		m.ResetSeq()
+	case user.FieldEmail:
+		m.ResetEmail()
 		return nil
-	case unit.FieldExamID:
-		m.ResetExamID()
+	case user.FieldName:
+		m.ResetName()
 		return nil
-	case unit.FieldSectionID:
-		m.ResetSectionID()
+	case user.FieldPasswordHash:
+		m.ResetPasswordHash()
 		return nil
-	case unit.FieldTopicID:
-		m.ResetTopicID()
+	case user.FieldCreatedAt:
+		m.ResetCreatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Unit field %s", name)
+	return fmt.Errorf("unknown User field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *UnitMutation) AddedEdges() []string {
-	edges := make([]string, 0, 4)
-	if m.exam != nil {
-		edges = append(edges, unit.EdgeExam)
-	}
-	if m.section != nil {
-		edges = append(edges, unit.EdgeSection)
+func (m *UserMutation) AddedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.roles != nil {
+		edges = append(edges, user.EdgeRoles)
 	}
-	if m.topic != nil {
-		edges = append(edges, unit.EdgeTopic)
+	if m.sessions != nil {
+		edges = append(edges, user.EdgeSessions)
 	}
-	if m.problems != nil {
-		edges = append(edges, unit.EdgeProblems)
+	if m.attempts != nil {
+		edges = append(edges, user.EdgeAttempts)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *UnitMutation) AddedIDs(name string) []ent.Value {
+func (m *UserMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case unit.EdgeExam:
-		if id := m.exam; id != nil {
-			return []ent.Value{*id}
-		}
-	case unit.EdgeSection:
-		if id := m.section; id != nil {
-			return []ent.Value{*id}
+	case user.EdgeRoles:
+		ids := make([]ent.Value, 0, len(m.roles))
+		for id := range m.roles {
+			ids = append(ids, id)
 		}
-	case unit.EdgeTopic:
-		if id := m.topic; id != nil {
-			return []ent.Value{*id}
+		return ids
+	case user.EdgeSessions:
+		ids := make([]ent.Value, 0, len(m.sessions))
+		for id := range m.sessions {
+			ids = append(ids, id)
 		}
-	case unit.EdgeProblems:
-		ids := make([]ent.Value, 0, len(m.problems))
-		for id := range m.problems {
+		return ids
+	case user.EdgeAttempts:
+		ids := make([]ent.Value, 0, len(m.attempts))
+		for id := range m.attempts {
 			ids = append(ids, id)
 		}
 		return ids
@@ -5420,21 +11543,39 @@ func (m *UnitMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *UnitMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 4)
-	if m.removedproblems != nil {
-		edges = append(edges, unit.EdgeProblems)
+func (m *UserMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.removedroles != nil {
+		edges = append(edges, user.EdgeRoles)
+	}
+	if m.removedsessions != nil {
+		edges = append(edges, user.EdgeSessions)
+	}
+	if m.removedattempts != nil {
+		edges = append(edges, user.EdgeAttempts)
 	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *UnitMutation) RemovedIDs(name string) []ent.Value {
+func (m *UserMutation) RemovedIDs(name string) []ent.Value {
 	switch name {
-	case unit.EdgeProblems:
-		ids := make([]ent.Value, 0, len(m.removedproblems))
-		for id := range m.removedproblems {
+	case user.EdgeRoles:
+		ids := make([]ent.Value, 0, len(m.removedroles))
+		for id := range m.removedroles {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeSessions:
+		ids := make([]ent.Value, 0, len(m.removedsessions))
+		for id := range m.removedsessions {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeAttempts:
+		ids := make([]ent.Value, 0, len(m.removedattempts))
+		for id := range m.removedattempts {
 			ids = append(ids, id)
 		}
 		return ids
@@ -5443,74 +11584,57 @@ func (m *UnitMutation) RemovedIDs(name string) []ent.Value {
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *UnitMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 4)
-	if m.clearedexam {
-		edges = append(edges, unit.EdgeExam)
-	}
-	if m.clearedsection {
-		edges = append(edges, unit.EdgeSection)
+func (m *UserMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.clearedroles {
+		edges = append(edges, user.EdgeRoles)
 	}
-	if m.clearedtopic {
-		edges = append(edges, unit.EdgeTopic)
+	if m.clearedsessions {
+		edges = append(edges, user.EdgeSessions)
 	}
-	if m.clearedproblems {
-		edges = append(edges, unit.EdgeProblems)
+	if m.clearedattempts {
+		edges = append(edges, user.EdgeAttempts)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *UnitMutation) EdgeCleared(name string) bool {
+func (m *UserMutation) EdgeCleared(name string) bool {
 	switch name {
-	case unit.EdgeExam:
-		return m.clearedexam
-	case unit.EdgeSection:
-		return m.clearedsection
-	case unit.EdgeTopic:
-		return m.clearedtopic
-	case unit.EdgeProblems:
-		return m.clearedproblems
+	case user.EdgeRoles:
+		return m.clearedroles
+	case user.EdgeSessions:
+		return m.clearedsessions
+	case user.EdgeAttempts:
+		return m.clearedattempts
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *UnitMutation) ClearEdge(name string) error {
+func (m *UserMutation) ClearEdge(name string) error {
 	switch name {
-	case unit.EdgeExam:
-		m.ClearExam()
-		return nil
-	case unit.EdgeSection:
-		m.ClearSection()
-		return nil
-	case unit.EdgeTopic:
-		m.ClearTopic()
-		return nil
 	}
-	return fmt.Errorf("unknown Unit unique edge %s", name)
+	return fmt.Errorf("unknown User unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *UnitMutation) ResetEdge(name string) error {
+func (m *UserMutation) ResetEdge(name string) error {
 	switch name {
-	case unit.EdgeExam:
-		m.ResetExam()
+	case user.EdgeRoles:
+		m.ResetRoles()
 		return nil
-	case unit.EdgeSection:
-		m.ResetSection()
-		return nil
-	case unit.EdgeTopic:
-		m.ResetTopic()
+	case user.EdgeSessions:
+		m.ResetSessions()
 		return nil
-	case unit.EdgeProblems:
-		m.ResetProblems()
+	case user.EdgeAttempts:
+		m.ResetAttempts()
 		return nil
 	}
-	return fmt.Errorf("unknown Unit edge %s", name)
+	return fmt.Errorf("unknown User edge %s", name)
 }
 
 // VersionRuleMutation represents an operation that mutates the VersionRule nodes in the graph.
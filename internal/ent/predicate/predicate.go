@@ -6,6 +6,18 @@ import (
 	"entgo.io/ent/dialect/sql"
 )
 
+// Answer is the predicate function for answer builders.
+type Answer func(*sql.Selector)
+
+// Asset is the predicate function for asset builders.
+type Asset func(*sql.Selector)
+
+// Attempt is the predicate function for attempt builders.
+type Attempt func(*sql.Selector)
+
+// AttemptItem is the predicate function for attemptitem builders.
+type AttemptItem func(*sql.Selector)
+
 // Choice is the predicate function for choice builders.
 type Choice func(*sql.Selector)
 
@@ -18,14 +30,23 @@ type Problem func(*sql.Selector)
 // ProblemTranslation is the predicate function for problemtranslation builders.
 type ProblemTranslation func(*sql.Selector)
 
+// Role is the predicate function for role builders.
+type Role func(*sql.Selector)
+
 // Section is the predicate function for section builders.
 type Section func(*sql.Selector)
 
+// Session is the predicate function for session builders.
+type Session func(*sql.Selector)
+
 // Topic is the predicate function for topic builders.
 type Topic func(*sql.Selector)
 
 // Unit is the predicate function for unit builders.
 type Unit func(*sql.Selector)
 
+// User is the predicate function for user builders.
+type User func(*sql.Selector)
+
 // VersionRule is the predicate function for versionrule builders.
 type VersionRule func(*sql.Selector)
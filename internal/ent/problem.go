@@ -18,10 +18,18 @@ type Problem struct {
 	config `json:"-"`
 	// ID of the ent.
 	ID int `json:"id,omitempty"`
+	// Stable external key, unique within the exam
+	Key string `json:"key,omitempty"`
 	// Type holds the value of the "type" field.
 	Type problem.Type `json:"type,omitempty"`
 	// Difficulty holds the value of the "difficulty" field.
 	Difficulty int `json:"difficulty,omitempty"`
+	// How the problem is answered; decides what its choices mean
+	Interaction problem.Interaction `json:"interaction,omitempty"`
+	// Largest accepted distance of a NUMERIC answer from an accepted value
+	Tolerance float64 `json:"tolerance,omitempty"`
+	// How TEXT answers are compared with the accepted answers
+	TextMatch problem.TextMatch `json:"text_match,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// UnitID holds the value of the "unit_id" field.
@@ -42,13 +50,17 @@ type ProblemEdges struct {
 	Versions []*VersionRule `json:"versions,omitempty"`
 	// Translations holds the value of the translations edge.
 	Translations []*ProblemTranslation `json:"translations,omitempty"`
+	// Answers holds the value of the answers edge.
+	Answers []*Answer `json:"answers,omitempty"`
+	// AttemptItems holds the value of the attempt_items edge.
+	AttemptItems []*AttemptItem `json:"attempt_items,omitempty"`
 	// Parent holds the value of the parent edge.
 	Parent *Problem `json:"parent,omitempty"`
 	// Children holds the value of the children edge.
 	Children []*Problem `json:"children,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [5]bool
+	loadedTypes [7]bool
 }
 
 // UnitOrErr returns the Unit value or an error if the edge
@@ -80,12 +92,30 @@ func (e ProblemEdges) TranslationsOrErr() ([]*ProblemTranslation, error) {
 	return nil, &NotLoadedError{edge: "translations"}
 }
 
+// AnswersOrErr returns the Answers value or an error if the edge
+// was not loaded in eager-loading.
+func (e ProblemEdges) AnswersOrErr() ([]*Answer, error) {
+	if e.loadedTypes[3] {
+		return e.Answers, nil
+	}
+	return nil, &NotLoadedError{edge: "answers"}
+}
+
+// AttemptItemsOrErr returns the AttemptItems value or an error if the edge
+// was not loaded in eager-loading.
+func (e ProblemEdges) AttemptItemsOrErr() ([]*AttemptItem, error) {
+	if e.loadedTypes[4] {
+		return e.AttemptItems, nil
+	}
+	return nil, &NotLoadedError{edge: "attempt_items"}
+}
+
 // ParentOrErr returns the Parent value or an error if the edge
 // was not loaded in eager-loading, or loaded but was not found.
 func (e ProblemEdges) ParentOrErr() (*Problem, error) {
 	if e.Parent != nil {
 		return e.Parent, nil
-	} else if e.loadedTypes[3] {
+	} else if e.loadedTypes[5] {
 		return nil, &NotFoundError{label: problem.Label}
 	}
 	return nil, &NotLoadedError{edge: "parent"}
@@ -94,7 +124,7 @@ func (e ProblemEdges) ParentOrErr() (*Problem, error) {
 // ChildrenOrErr returns the Children value or an error if the edge
 // was not loaded in eager-loading.
 func (e ProblemEdges) ChildrenOrErr() ([]*Problem, error) {
-	if e.loadedTypes[4] {
+	if e.loadedTypes[6] {
 		return e.Children, nil
 	}
 	return nil, &NotLoadedError{edge: "children"}
@@ -105,9 +135,11 @@ func (*Problem) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case problem.FieldTolerance:
+			values[i] = new(sql.NullFloat64)
 		case problem.FieldID, problem.FieldDifficulty, problem.FieldUnitID, problem.FieldParentID:
 			values[i] = new(sql.NullInt64)
-		case problem.FieldType:
+		case problem.FieldKey, problem.FieldType, problem.FieldInteraction, problem.FieldTextMatch:
 			values[i] = new(sql.NullString)
 		case problem.FieldCreatedAt:
 			values[i] = new(sql.NullTime)
@@ -132,6 +164,12 @@ func (_m *Problem) assignValues(columns []string, values []any) error {
 				return fmt.Errorf("unexpected type %T for field id", value)
 			}
 			_m.ID = int(value.Int64)
+		case problem.FieldKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field key", values[i])
+			} else if value.Valid {
+				_m.Key = value.String
+			}
 		case problem.FieldType:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field type", values[i])
@@ -144,6 +182,24 @@ func (_m *Problem) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Difficulty = int(value.Int64)
 			}
+		case problem.FieldInteraction:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field interaction", values[i])
+			} else if value.Valid {
+				_m.Interaction = problem.Interaction(value.String)
+			}
+		case problem.FieldTolerance:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field tolerance", values[i])
+			} else if value.Valid {
+				_m.Tolerance = value.Float64
+			}
+		case problem.FieldTextMatch:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field text_match", values[i])
+			} else if value.Valid {
+				_m.TextMatch = problem.TextMatch(value.String)
+			}
 		case problem.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -191,6 +247,16 @@ func (_m *Problem) QueryTranslations() *ProblemTranslationQuery {
 	return NewProblemClient(_m.config).QueryTranslations(_m)
 }
 
+// QueryAnswers queries the "answers" edge of the Problem entity.
+func (_m *Problem) QueryAnswers() *AnswerQuery {
+	return NewProblemClient(_m.config).QueryAnswers(_m)
+}
+
+// QueryAttemptItems queries the "attempt_items" edge of the Problem entity.
+func (_m *Problem) QueryAttemptItems() *AttemptItemQuery {
+	return NewProblemClient(_m.config).QueryAttemptItems(_m)
+}
+
 // QueryParent queries the "parent" edge of the Problem entity.
 func (_m *Problem) QueryParent() *ProblemQuery {
 	return NewProblemClient(_m.config).QueryParent(_m)
@@ -224,12 +290,24 @@ func (_m *Problem) String() string {
 	var builder strings.Builder
 	builder.WriteString("Problem(")
 	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("key=")
+	builder.WriteString(_m.Key)
+	builder.WriteString(", ")
 	builder.WriteString("type=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Type))
 	builder.WriteString(", ")
 	builder.WriteString("difficulty=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Difficulty))
 	builder.WriteString(", ")
+	builder.WriteString("interaction=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Interaction))
+	builder.WriteString(", ")
+	builder.WriteString("tolerance=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Tolerance))
+	builder.WriteString(", ")
+	builder.WriteString("text_match=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TextMatch))
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
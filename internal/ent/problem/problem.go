@@ -14,10 +14,18 @@ const (
 	Label = "problem"
 	// FieldID holds the string denoting the id field in the database.
 	FieldID = "id"
+	// FieldKey holds the string denoting the key field in the database.
+	FieldKey = "key"
 	// FieldType holds the string denoting the type field in the database.
 	FieldType = "type"
 	// FieldDifficulty holds the string denoting the difficulty field in the database.
 	FieldDifficulty = "difficulty"
+	// FieldInteraction holds the string denoting the interaction field in the database.
+	FieldInteraction = "interaction"
+	// FieldTolerance holds the string denoting the tolerance field in the database.
+	FieldTolerance = "tolerance"
+	// FieldTextMatch holds the string denoting the text_match field in the database.
+	FieldTextMatch = "text_match"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// FieldUnitID holds the string denoting the unit_id field in the database.
@@ -30,6 +38,10 @@ const (
 	EdgeVersions = "versions"
 	// EdgeTranslations holds the string denoting the translations edge name in mutations.
 	EdgeTranslations = "translations"
+	// EdgeAnswers holds the string denoting the answers edge name in mutations.
+	EdgeAnswers = "answers"
+	// EdgeAttemptItems holds the string denoting the attempt_items edge name in mutations.
+	EdgeAttemptItems = "attempt_items"
 	// EdgeParent holds the string denoting the parent edge name in mutations.
 	EdgeParent = "parent"
 	// EdgeChildren holds the string denoting the children edge name in mutations.
@@ -57,6 +69,20 @@ const (
 	TranslationsInverseTable = "problem_translations"
 	// TranslationsColumn is the table column denoting the translations relation/edge.
 	TranslationsColumn = "problem_id"
+	// AnswersTable is the table that holds the answers relation/edge.
+	AnswersTable = "answers"
+	// AnswersInverseTable is the table name for the Answer entity.
+	// It exists in this package in order to avoid circular dependency with the "answer" package.
+	AnswersInverseTable = "answers"
+	// AnswersColumn is the table column denoting the answers relation/edge.
+	AnswersColumn = "problem_id"
+	// AttemptItemsTable is the table that holds the attempt_items relation/edge.
+	AttemptItemsTable = "attempt_items"
+	// AttemptItemsInverseTable is the table name for the AttemptItem entity.
+	// It exists in this package in order to avoid circular dependency with the "attemptitem" package.
+	AttemptItemsInverseTable = "attempt_items"
+	// AttemptItemsColumn is the table column denoting the attempt_items relation/edge.
+	AttemptItemsColumn = "problem_id"
 	// ParentTable is the table that holds the parent relation/edge.
 	ParentTable = "problems"
 	// ParentColumn is the table column denoting the parent relation/edge.
@@ -70,8 +96,12 @@ const (
 // Columns holds all SQL columns for problem fields.
 var Columns = []string{
 	FieldID,
+	FieldKey,
 	FieldType,
 	FieldDifficulty,
+	FieldInteraction,
+	FieldTolerance,
+	FieldTextMatch,
 	FieldCreatedAt,
 	FieldUnitID,
 	FieldParentID,
@@ -90,6 +120,8 @@ func ValidColumn(column string) bool {
 var (
 	// DefaultDifficulty holds the default value on creation for the "difficulty" field.
 	DefaultDifficulty int
+	// DefaultTolerance holds the default value on creation for the "tolerance" field.
+	DefaultTolerance float64
 )
 
 // Type defines the type for the "type" enum field.
@@ -118,6 +150,61 @@ func TypeValidator(_type Type) error {
 	}
 }
 
+// Interaction defines the type for the "interaction" enum field.
+type Interaction string
+
+// InteractionSINGLE is the default value of the Interaction enum.
+const DefaultInteraction = InteractionSINGLE
+
+// Interaction values.
+const (
+	InteractionSINGLE   Interaction = "SINGLE"
+	InteractionMULTIPLE Interaction = "MULTIPLE"
+	InteractionORDERING Interaction = "ORDERING"
+	InteractionNUMERIC  Interaction = "NUMERIC"
+	InteractionTEXT     Interaction = "TEXT"
+)
+
+func (i Interaction) String() string {
+	return string(i)
+}
+
+// InteractionValidator is a validator for the "interaction" field enum values. It is called by the builders before save.
+func InteractionValidator(i Interaction) error {
+	switch i {
+	case InteractionSINGLE, InteractionMULTIPLE, InteractionORDERING, InteractionNUMERIC, InteractionTEXT:
+		return nil
+	default:
+		return fmt.Errorf("problem: invalid enum value for interaction field: %q", i)
+	}
+}
+
+// TextMatch defines the type for the "text_match" enum field.
+type TextMatch string
+
+// TextMatchEXACT is the default value of the TextMatch enum.
+const DefaultTextMatch = TextMatchEXACT
+
+// TextMatch values.
+const (
+	TextMatchEXACT TextMatch = "EXACT"
+	TextMatchREGEX TextMatch = "REGEX"
+)
+
+func (tm TextMatch) String() string {
+	return string(tm)
+}
+
+// TextMatchValidator is a validator for the "text_match" field enum values. It is called by the builders before save.
+func TextMatchValidator(tm TextMatch) error {
+	switch tm {
+	case TextMatchEXACT, TextMatchREGEX:
+		return nil
+	default:
+		return fmt.Errorf("problem: invalid enum value for text_match field: %q", tm)
+	}
+}
+
 // OrderOption defines the ordering options for the Problem queries.
 type OrderOption func(*sql.Selector)
 
@@ -126,6 +213,11 @@ func ByID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldID, opts...).ToFunc()
 }
 
+// ByKey orders the results by the key field.
+func ByKey(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldKey, opts...).ToFunc()
+}
+
 // ByType orders the results by the type field.
 func ByType(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldType, opts...).ToFunc()
@@ -136,6 +228,21 @@ func ByDifficulty(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldDifficulty, opts...).ToFunc()
 }
 
+// ByInteraction orders the results by the interaction field.
+func ByInteraction(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldInteraction, opts...).ToFunc()
+}
+
+// ByTolerance orders the results by the tolerance field.
+func ByTolerance(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTolerance, opts...).ToFunc()
+}
+
+// ByTextMatch orders the results by the text_match field.
+func ByTextMatch(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTextMatch, opts...).ToFunc()
+}
+
 // ByCreatedAt orders the results by the created_at field.
 func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
@@ -186,6 +293,34 @@ func ByTranslations(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 	}
 }
 
+// ByAnswersCount orders the results by answers count.
+func ByAnswersCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newAnswersStep(), opts...)
+	}
+}
+
+// ByAnswers orders the results by answers terms.
+func ByAnswers(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAnswersStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByAttemptItemsCount orders the results by attempt_items count.
+func ByAttemptItemsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newAttemptItemsStep(), opts...)
+	}
+}
+
+// ByAttemptItems orders the results by attempt_items terms.
+func ByAttemptItems(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAttemptItemsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
 // ByParentField orders the results by parent field.
 func ByParentField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -227,6 +362,20 @@ func newTranslationsStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, TranslationsTable, TranslationsColumn),
 	)
 }
+func newAnswersStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(AnswersInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, AnswersTable, AnswersColumn),
+	)
+}
+func newAttemptItemsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(AttemptItemsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, AttemptItemsTable, AttemptItemsColumn),
+	)
+}
 func newParentStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
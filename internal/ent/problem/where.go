@@ -55,11 +55,21 @@ func IDLTE(id int) predicate.Problem {
 	return predicate.Problem(sql.FieldLTE(FieldID, id))
 }
 
+// Key applies equality check predicate on the "key" field. It's identical to KeyEQ.
+func Key(v string) predicate.Problem {
+	return predicate.Problem(sql.FieldEQ(FieldKey, v))
+}
+
 // Difficulty applies equality check predicate on the "difficulty" field. It's identical to DifficultyEQ.
 func Difficulty(v int) predicate.Problem {
 	return predicate.Problem(sql.FieldEQ(FieldDifficulty, v))
 }
 
+// Tolerance applies equality check predicate on the "tolerance" field. It's identical to ToleranceEQ.
+func Tolerance(v float64) predicate.Problem {
+	return predicate.Problem(sql.FieldEQ(FieldTolerance, v))
+}
+
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v time.Time) predicate.Problem {
 	return predicate.Problem(sql.FieldEQ(FieldCreatedAt, v))
@@ -75,6 +85,81 @@ func ParentID(v int) predicate.Problem {
 	return predicate.Problem(sql.FieldEQ(FieldParentID, v))
 }
 
+// KeyEQ applies the EQ predicate on the "key" field.
+func KeyEQ(v string) predicate.Problem {
+	return predicate.Problem(sql.FieldEQ(FieldKey, v))
+}
+
+// KeyNEQ applies the NEQ predicate on the "key" field.
+func KeyNEQ(v string) predicate.Problem {
+	return predicate.Problem(sql.FieldNEQ(FieldKey, v))
+}
+
+// KeyIn applies the In predicate on the "key" field.
+func KeyIn(vs ...string) predicate.Problem {
+	return predicate.Problem(sql.FieldIn(FieldKey, vs...))
+}
+
+// KeyNotIn applies the NotIn predicate on the "key" field.
+func KeyNotIn(vs ...string) predicate.Problem {
+	return predicate.Problem(sql.FieldNotIn(FieldKey, vs...))
+}
+
+// KeyGT applies the GT predicate on the "key" field.
+func KeyGT(v string) predicate.Problem {
+	return predicate.Problem(sql.FieldGT(FieldKey, v))
+}
+
+// KeyGTE applies the GTE predicate on the "key" field.
+func KeyGTE(v string) predicate.Problem {
+	return predicate.Problem(sql.FieldGTE(FieldKey, v))
+}
+
+// KeyLT applies the LT predicate on the "key" field.
+func KeyLT(v string) predicate.Problem {
+	return predicate.Problem(sql.FieldLT(FieldKey, v))
+}
+
+// KeyLTE applies the LTE predicate on the "key" field.
+func KeyLTE(v string) predicate.Problem {
+	return predicate.Problem(sql.FieldLTE(FieldKey, v))
+}
+
+// KeyContains applies the Contains predicate on the "key" field.
+func KeyContains(v string) predicate.Problem {
+	return predicate.Problem(sql.FieldContains(FieldKey, v))
+}
+
+// KeyHasPrefix applies the HasPrefix predicate on the "key" field.
+func KeyHasPrefix(v string) predicate.Problem {
+	return predicate.Problem(sql.FieldHasPrefix(FieldKey, v))
+}
+
+// KeyHasSuffix applies the HasSuffix predicate on the "key" field.
+func KeyHasSuffix(v string) predicate.Problem {
+	return predicate.Problem(sql.FieldHasSuffix(FieldKey, v))
+}
+
+// KeyIsNil applies the IsNil predicate on the "key" field.
+func KeyIsNil() predicate.Problem {
+	return predicate.Problem(sql.FieldIsNull(FieldKey))
+}
+
+// KeyNotNil applies the NotNil predicate on the "key" field.
+func KeyNotNil() predicate.Problem {
+	return predicate.Problem(sql.FieldNotNull(FieldKey))
+}
+
+// KeyEqualFold applies the EqualFold predicate on the "key" field.
+func KeyEqualFold(v string) predicate.Problem {
+	return predicate.Problem(sql.FieldEqualFold(FieldKey, v))
+}
+
+// KeyContainsFold applies the ContainsFold predicate on the "key" field.
+func KeyContainsFold(v string) predicate.Problem {
+	return predicate.Problem(sql.FieldContainsFold(FieldKey, v))
+}
+
 // TypeEQ applies the EQ predicate on the "type" field.
 func TypeEQ(v Type) predicate.Problem {
 	return predicate.Problem(sql.FieldEQ(FieldType, v))
@@ -135,6 +220,86 @@ func DifficultyLTE(v int) predicate.Problem {
 	return predicate.Problem(sql.FieldLTE(FieldDifficulty, v))
 }
 
+// InteractionEQ applies the EQ predicate on the "interaction" field.
+func InteractionEQ(v Interaction) predicate.Problem {
+	return predicate.Problem(sql.FieldEQ(FieldInteraction, v))
+}
+
+// InteractionNEQ applies the NEQ predicate on the "interaction" field.
+func InteractionNEQ(v Interaction) predicate.Problem {
+	return predicate.Problem(sql.FieldNEQ(FieldInteraction, v))
+}
+
+// InteractionIn applies the In predicate on the "interaction" field.
+func InteractionIn(vs ...Interaction) predicate.Problem {
+	return predicate.Problem(sql.FieldIn(FieldInteraction, vs...))
+}
+
+// InteractionNotIn applies the NotIn predicate on the "interaction" field.
+func InteractionNotIn(vs ...Interaction) predicate.Problem {
+	return predicate.Problem(sql.FieldNotIn(FieldInteraction, vs...))
+}
+
+// ToleranceEQ applies the EQ predicate on the "tolerance" field.
+func ToleranceEQ(v float64) predicate.Problem {
+	return predicate.Problem(sql.FieldEQ(FieldTolerance, v))
+}
+
+// ToleranceNEQ applies the NEQ predicate on the "tolerance" field.
+func ToleranceNEQ(v float64) predicate.Problem {
+	return predicate.Problem(sql.FieldNEQ(FieldTolerance, v))
+}
+
+// ToleranceIn applies the In predicate on the "tolerance" field.
+func ToleranceIn(vs ...float64) predicate.Problem {
+	return predicate.Problem(sql.FieldIn(FieldTolerance, vs...))
+}
+
+// ToleranceNotIn applies the NotIn predicate on the "tolerance" field.
+func ToleranceNotIn(vs ...float64) predicate.Problem {
+	return predicate.Problem(sql.FieldNotIn(FieldTolerance, vs...))
+}
+
+// ToleranceGT applies the GT predicate on the "tolerance" field.
+func ToleranceGT(v float64) predicate.Problem {
+	return predicate.Problem(sql.FieldGT(FieldTolerance, v))
+}
+
+// ToleranceGTE applies the GTE predicate on the "tolerance" field.
+func ToleranceGTE(v float64) predicate.Problem {
+	return predicate.Problem(sql.FieldGTE(FieldTolerance, v))
+}
+
+// ToleranceLT applies the LT predicate on the "tolerance" field.
+func ToleranceLT(v float64) predicate.Problem {
+	return predicate.Problem(sql.FieldLT(FieldTolerance, v))
+}
+
+// ToleranceLTE applies the LTE predicate on the "tolerance" field.
+func ToleranceLTE(v float64) predicate.Problem {
+	return predicate.Problem(sql.FieldLTE(FieldTolerance, v))
+}
+
+// TextMatchEQ applies the EQ predicate on the "text_match" field.
+func TextMatchEQ(v TextMatch) predicate.Problem {
+	return predicate.Problem(sql.FieldEQ(FieldTextMatch, v))
+}
+
+// TextMatchNEQ applies the NEQ predicate on the "text_match" field.
+func TextMatchNEQ(v TextMatch) predicate.Problem {
+	return predicate.Problem(sql.FieldNEQ(FieldTextMatch, v))
+}
+
+// TextMatchIn applies the In predicate on the "text_match" field.
+func TextMatchIn(vs ...TextMatch) predicate.Problem {
+	return predicate.Problem(sql.FieldIn(FieldTextMatch, vs...))
+}
+
+// TextMatchNotIn applies the NotIn predicate on the "text_match" field.
+func TextMatchNotIn(vs ...TextMatch) predicate.Problem {
+	return predicate.Problem(sql.FieldNotIn(FieldTextMatch, vs...))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.Problem {
 	return predicate.Problem(sql.FieldEQ(FieldCreatedAt, v))
@@ -294,6 +459,52 @@ func HasTranslationsWith(preds ...predicate.ProblemTranslation) predicate.Proble
 	})
 }
 
+// HasAnswers applies the HasEdge predicate on the "answers" edge.
+func HasAnswers() predicate.Problem {
+	return predicate.Problem(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, AnswersTable, AnswersColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAnswersWith applies the HasEdge predicate on the "answers" edge with a given conditions (other predicates).
+func HasAnswersWith(preds ...predicate.Answer) predicate.Problem {
+	return predicate.Problem(func(s *sql.Selector) {
+		step := newAnswersStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasAttemptItems applies the HasEdge predicate on the "attempt_items" edge.
+func HasAttemptItems() predicate.Problem {
+	return predicate.Problem(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, AttemptItemsTable, AttemptItemsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAttemptItemsWith applies the HasEdge predicate on the "attempt_items" edge with a given conditions (other predicates).
+func HasAttemptItemsWith(preds ...predicate.AttemptItem) predicate.Problem {
+	return predicate.Problem(func(s *sql.Selector) {
+		step := newAttemptItemsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // HasParent applies the HasEdge predicate on the "parent" edge.
 func HasParent() predicate.Problem {
 	return predicate.Problem(func(s *sql.Selector) {
@@ -5,6 +5,8 @@ package ent
 import (
 	"context"
 	"errors"
+	"examination/internal/ent/answer"
+	"examination/internal/ent/attemptitem"
 	"examination/internal/ent/problem"
 	"examination/internal/ent/problemtranslation"
 	"examination/internal/ent/unit"
@@ -23,6 +25,20 @@ type ProblemCreate struct {
 	hooks    []Hook
 }
 
+// SetKey sets the "key" field.
+func (_c *ProblemCreate) SetKey(v string) *ProblemCreate {
+	_c.mutation.SetKey(v)
+	return _c
+}
+
+// SetNillableKey sets the "key" field if the given value is not nil.
+func (_c *ProblemCreate) SetNillableKey(v *string) *ProblemCreate {
+	if v != nil {
+		_c.SetKey(*v)
+	}
+	return _c
+}
+
 // SetType sets the "type" field.
 func (_c *ProblemCreate) SetType(v problem.Type) *ProblemCreate {
 	_c.mutation.SetType(v)
@@ -51,6 +67,48 @@ func (_c *ProblemCreate) SetNillableDifficulty(v *int) *ProblemCreate {
 	return _c
 }
 
+// SetInteraction sets the "interaction" field.
+func (_c *ProblemCreate) SetInteraction(v problem.Interaction) *ProblemCreate {
+	_c.mutation.SetInteraction(v)
+	return _c
+}
+
+// SetNillableInteraction sets the "interaction" field if the given value is not nil.
+func (_c *ProblemCreate) SetNillableInteraction(v *problem.Interaction) *ProblemCreate {
+	if v != nil {
+		_c.SetInteraction(*v)
+	}
+	return _c
+}
+
+// SetTolerance sets the "tolerance" field.
+func (_c *ProblemCreate) SetTolerance(v float64) *ProblemCreate {
+	_c.mutation.SetTolerance(v)
+	return _c
+}
+
+// SetNillableTolerance sets the "tolerance" field if the given value is not nil.
+func (_c *ProblemCreate) SetNillableTolerance(v *float64) *ProblemCreate {
+	if v != nil {
+		_c.SetTolerance(*v)
+	}
+	return _c
+}
+
+// SetTextMatch sets the "text_match" field.
+func (_c *ProblemCreate) SetTextMatch(v problem.TextMatch) *ProblemCreate {
+	_c.mutation.SetTextMatch(v)
+	return _c
+}
+
+// SetNillableTextMatch sets the "text_match" field if the given value is not nil.
+func (_c *ProblemCreate) SetNillableTextMatch(v *problem.TextMatch) *ProblemCreate {
+	if v != nil {
+		_c.SetTextMatch(*v)
+	}
+	return _c
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_c *ProblemCreate) SetCreatedAt(v time.Time) *ProblemCreate {
 	_c.mutation.SetCreatedAt(v)
@@ -112,6 +170,36 @@ func (_c *ProblemCreate) AddTranslations(v ...*ProblemTranslation) *ProblemCreat
 	return _c.AddTranslationIDs(ids...)
 }
 
+// AddAnswerIDs adds the "answers" edge to the Answer entity by IDs.
+func (_c *ProblemCreate) AddAnswerIDs(ids ...int) *ProblemCreate {
+	_c.mutation.AddAnswerIDs(ids...)
+	return _c
+}
+
+// AddAnswers adds the "answers" edges to the Answer entity.
+func (_c *ProblemCreate) AddAnswers(v ...*Answer) *ProblemCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddAnswerIDs(ids...)
+}
+
+// AddAttemptItemIDs adds the "attempt_items" edge to the AttemptItem entity by IDs.
+func (_c *ProblemCreate) AddAttemptItemIDs(ids ...int) *ProblemCreate {
+	_c.mutation.AddAttemptItemIDs(ids...)
+	return _c
+}
+
+// AddAttemptItems adds the "attempt_items" edges to the AttemptItem entity.
+func (_c *ProblemCreate) AddAttemptItems(v ...*AttemptItem) *ProblemCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddAttemptItemIDs(ids...)
+}
+
 // SetParent sets the "parent" edge to the Problem entity.
 func (_c *ProblemCreate) SetParent(v *Problem) *ProblemCreate {
 	return _c.SetParentID(v.ID)
@@ -175,6 +263,18 @@ func (_c *ProblemCreate) defaults() {
 		v := problem.DefaultDifficulty
 		_c.mutation.SetDifficulty(v)
 	}
+	if _, ok := _c.mutation.Interaction(); !ok {
+		v := problem.DefaultInteraction
+		_c.mutation.SetInteraction(v)
+	}
+	if _, ok := _c.mutation.Tolerance(); !ok {
+		v := problem.DefaultTolerance
+		_c.mutation.SetTolerance(v)
+	}
+	if _, ok := _c.mutation.TextMatch(); !ok {
+		v := problem.DefaultTextMatch
+		_c.mutation.SetTextMatch(v)
+	}
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -190,6 +290,25 @@ func (_c *ProblemCreate) check() error {
 	if _, ok := _c.mutation.Difficulty(); !ok {
 		return &ValidationError{Name: "difficulty", err: errors.New(`ent: missing required field "Problem.difficulty"`)}
 	}
+	if _, ok := _c.mutation.Interaction(); !ok {
+		return &ValidationError{Name: "interaction", err: errors.New(`ent: missing required field "Problem.interaction"`)}
+	}
+	if v, ok := _c.mutation.Interaction(); ok {
+		if err := problem.InteractionValidator(v); err != nil {
+			return &ValidationError{Name: "interaction", err: fmt.Errorf(`ent: validator failed for field "Problem.interaction": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Tolerance(); !ok {
+		return &ValidationError{Name: "tolerance", err: errors.New(`ent: missing required field "Problem.tolerance"`)}
+	}
+	if _, ok := _c.mutation.TextMatch(); !ok {
+		return &ValidationError{Name: "text_match", err: errors.New(`ent: missing required field "Problem.text_match"`)}
+	}
+	if v, ok := _c.mutation.TextMatch(); ok {
+		if err := problem.TextMatchValidator(v); err != nil {
+			return &ValidationError{Name: "text_match", err: fmt.Errorf(`ent: validator failed for field "Problem.text_match": %w`, err)}
+		}
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Problem.created_at"`)}
 	}
@@ -225,6 +344,10 @@ func (_c *ProblemCreate) createSpec() (*Problem, *sqlgraph.CreateSpec) {
 		_node = &Problem{config: _c.config}
 		_spec = sqlgraph.NewCreateSpec(problem.Table, sqlgraph.NewFieldSpec(problem.FieldID, field.TypeInt))
 	)
+	if value, ok := _c.mutation.Key(); ok {
+		_spec.SetField(problem.FieldKey, field.TypeString, value)
+		_node.Key = value
+	}
 	if value, ok := _c.mutation.GetType(); ok {
 		_spec.SetField(problem.FieldType, field.TypeEnum, value)
 		_node.Type = value
@@ -233,6 +356,18 @@ func (_c *ProblemCreate) createSpec() (*Problem, *sqlgraph.CreateSpec) {
 		_spec.SetField(problem.FieldDifficulty, field.TypeInt, value)
 		_node.Difficulty = value
 	}
+	if value, ok := _c.mutation.Interaction(); ok {
+		_spec.SetField(problem.FieldInteraction, field.TypeEnum, value)
+		_node.Interaction = value
+	}
+	if value, ok := _c.mutation.Tolerance(); ok {
+		_spec.SetField(problem.FieldTolerance, field.TypeFloat64, value)
+		_node.Tolerance = value
+	}
+	if value, ok := _c.mutation.TextMatch(); ok {
+		_spec.SetField(problem.FieldTextMatch, field.TypeEnum, value)
+		_node.TextMatch = value
+	}
 	if value, ok := _c.mutation.CreatedAt(); ok {
 		_spec.SetField(problem.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
@@ -286,6 +421,38 @@ func (_c *ProblemCreate) createSpec() (*Problem, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.AnswersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   problem.AnswersTable,
+			Columns: []string{problem.AnswersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(answer.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.AttemptItemsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   problem.AttemptItemsTable,
+			Columns: []string{problem.AttemptItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attemptitem.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	if nodes := _c.mutation.ParentIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -5,6 +5,8 @@ package ent
 import (
 	"context"
 	"database/sql/driver"
+	"examination/internal/ent/answer"
+	"examination/internal/ent/attemptitem"
 	"examination/internal/ent/predicate"
 	"examination/internal/ent/problem"
 	"examination/internal/ent/problemtranslation"
@@ -29,6 +31,8 @@ type ProblemQuery struct {
 	withUnit         *UnitQuery
 	withVersions     *VersionRuleQuery
 	withTranslations *ProblemTranslationQuery
+	withAnswers      *AnswerQuery
+	withAttemptItems *AttemptItemQuery
 	withParent       *ProblemQuery
 	withChildren     *ProblemQuery
 	// intermediate query (i.e. traversal path).
@@ -133,6 +137,50 @@ func (_q *ProblemQuery) QueryTranslations() *ProblemTranslationQuery {
 	return query
 }
 
+// QueryAnswers chains the current query on the "answers" edge.
+func (_q *ProblemQuery) QueryAnswers() *AnswerQuery {
+	query := (&AnswerClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(problem.Table, problem.FieldID, selector),
+			sqlgraph.To(answer.Table, answer.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, problem.AnswersTable, problem.AnswersColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryAttemptItems chains the current query on the "attempt_items" edge.
+func (_q *ProblemQuery) QueryAttemptItems() *AttemptItemQuery {
+	query := (&AttemptItemClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(problem.Table, problem.FieldID, selector),
+			sqlgraph.To(attemptitem.Table, attemptitem.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, problem.AttemptItemsTable, problem.AttemptItemsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // QueryParent chains the current query on the "parent" edge.
 func (_q *ProblemQuery) QueryParent() *ProblemQuery {
 	query := (&ProblemClient{config: _q.config}).Query()
@@ -372,6 +420,8 @@ func (_q *ProblemQuery) Clone() *ProblemQuery {
 		withUnit:         _q.withUnit.Clone(),
 		withVersions:     _q.withVersions.Clone(),
 		withTranslations: _q.withTranslations.Clone(),
+		withAnswers:      _q.withAnswers.Clone(),
+		withAttemptItems: _q.withAttemptItems.Clone(),
 		withParent:       _q.withParent.Clone(),
 		withChildren:     _q.withChildren.Clone(),
 		// clone intermediate query.
@@ -413,6 +463,28 @@ func (_q *ProblemQuery) WithTranslations(opts ...func(*ProblemTranslationQuery))
 	return _q
 }
 
+// WithAnswers tells the query-builder to eager-load the nodes that are connected to
+// the "answers" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *ProblemQuery) WithAnswers(opts ...func(*AnswerQuery)) *ProblemQuery {
+	query := (&AnswerClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withAnswers = query
+	return _q
+}
+
+// WithAttemptItems tells the query-builder to eager-load the nodes that are connected to
+// the "attempt_items" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *ProblemQuery) WithAttemptItems(opts ...func(*AttemptItemQuery)) *ProblemQuery {
+	query := (&AttemptItemClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withAttemptItems = query
+	return _q
+}
+
 // WithParent tells the query-builder to eager-load the nodes that are connected to
 // the "parent" edge. The optional arguments are used to configure the query builder of the edge.
 func (_q *ProblemQuery) WithParent(opts ...func(*ProblemQuery)) *ProblemQuery {
@@ -441,12 +513,12 @@ func (_q *ProblemQuery) WithChildren(opts ...func(*ProblemQuery)) *ProblemQuery
 // Example:
 //
 //	var v []struct {
-//		Type problem.Type `json:"type,omitempty"`
+//		Key string `json:"key,omitempty"`
 //		Count int `json:"count,omitempty"`
 //	}
 //
 //	client.Problem.Query().
-//		GroupBy(problem.FieldType).
+//		GroupBy(problem.FieldKey).
 //		Aggregate(ent.Count()).
 //		Scan(ctx, &v)
 func (_q *ProblemQuery) GroupBy(field string, fields ...string) *ProblemGroupBy {
@@ -464,11 +536,11 @@ func (_q *ProblemQuery) GroupBy(field string, fields ...string) *ProblemGroupBy
 // Example:
 //
 //	var v []struct {
-//		Type problem.Type `json:"type,omitempty"`
+//		Key string `json:"key,omitempty"`
 //	}
 //
 //	client.Problem.Query().
-//		Select(problem.FieldType).
+//		Select(problem.FieldKey).
 //		Scan(ctx, &v)
 func (_q *ProblemQuery) Select(fields ...string) *ProblemSelect {
 	_q.ctx.Fields = append(_q.ctx.Fields, fields...)
@@ -513,10 +585,12 @@ func (_q *ProblemQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Prob
 	var (
 		nodes       = []*Problem{}
 		_spec       = _q.querySpec()
-		loadedTypes = [5]bool{
+		loadedTypes = [7]bool{
 			_q.withUnit != nil,
 			_q.withVersions != nil,
 			_q.withTranslations != nil,
+			_q.withAnswers != nil,
+			_q.withAttemptItems != nil,
 			_q.withParent != nil,
 			_q.withChildren != nil,
 		}
@@ -559,6 +633,20 @@ func (_q *ProblemQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Prob
 			return nil, err
 		}
 	}
+	if query := _q.withAnswers; query != nil {
+		if err := _q.loadAnswers(ctx, query, nodes,
+			func(n *Problem) { n.Edges.Answers = []*Answer{} },
+			func(n *Problem, e *Answer) { n.Edges.Answers = append(n.Edges.Answers, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withAttemptItems; query != nil {
+		if err := _q.loadAttemptItems(ctx, query, nodes,
+			func(n *Problem) { n.Edges.AttemptItems = []*AttemptItem{} },
+			func(n *Problem, e *AttemptItem) { n.Edges.AttemptItems = append(n.Edges.AttemptItems, e) }); err != nil {
+			return nil, err
+		}
+	}
 	if query := _q.withParent; query != nil {
 		if err := _q.loadParent(ctx, query, nodes, nil,
 			func(n *Problem, e *Problem) { n.Edges.Parent = e }); err != nil {
@@ -667,6 +755,66 @@ func (_q *ProblemQuery) loadTranslations(ctx context.Context, query *ProblemTran
 	}
 	return nil
 }
+func (_q *ProblemQuery) loadAnswers(ctx context.Context, query *AnswerQuery, nodes []*Problem, init func(*Problem), assign func(*Problem, *Answer)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Problem)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(answer.FieldProblemID)
+	}
+	query.Where(predicate.Answer(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(problem.AnswersColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.ProblemID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "problem_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *ProblemQuery) loadAttemptItems(ctx context.Context, query *AttemptItemQuery, nodes []*Problem, init func(*Problem), assign func(*Problem, *AttemptItem)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Problem)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(attemptitem.FieldProblemID)
+	}
+	query.Where(predicate.AttemptItem(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(problem.AttemptItemsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.ProblemID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "problem_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 func (_q *ProblemQuery) loadParent(ctx context.Context, query *ProblemQuery, nodes []*Problem, init func(*Problem), assign func(*Problem, *Problem)) error {
 	ids := make([]int, 0, len(nodes))
 	nodeids := make(map[int][]*Problem)
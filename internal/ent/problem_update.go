@@ -5,6 +5,8 @@ package ent
 import (
 	"context"
 	"errors"
+	"examination/internal/ent/answer"
+	"examination/internal/ent/attemptitem"
 	"examination/internal/ent/predicate"
 	"examination/internal/ent/problem"
 	"examination/internal/ent/problemtranslation"
@@ -31,6 +33,26 @@ func (_u *ProblemUpdate) Where(ps ...predicate.Problem) *ProblemUpdate {
 	return _u
 }
 
+// SetKey sets the "key" field.
+func (_u *ProblemUpdate) SetKey(v string) *ProblemUpdate {
+	_u.mutation.SetKey(v)
+	return _u
+}
+
+// SetNillableKey sets the "key" field if the given value is not nil.
+func (_u *ProblemUpdate) SetNillableKey(v *string) *ProblemUpdate {
+	if v != nil {
+		_u.SetKey(*v)
+	}
+	return _u
+}
+
+// ClearKey clears the value of the "key" field.
+func (_u *ProblemUpdate) ClearKey() *ProblemUpdate {
+	_u.mutation.ClearKey()
+	return _u
+}
+
 // SetType sets the "type" field.
 func (_u *ProblemUpdate) SetType(v problem.Type) *ProblemUpdate {
 	_u.mutation.SetType(v)
@@ -66,6 +88,55 @@ func (_u *ProblemUpdate) AddDifficulty(v int) *ProblemUpdate {
 	return _u
 }
 
+// SetInteraction sets the "interaction" field.
+func (_u *ProblemUpdate) SetInteraction(v problem.Interaction) *ProblemUpdate {
+	_u.mutation.SetInteraction(v)
+	return _u
+}
+
+// SetNillableInteraction sets the "interaction" field if the given value is not nil.
+func (_u *ProblemUpdate) SetNillableInteraction(v *problem.Interaction) *ProblemUpdate {
+	if v != nil {
+		_u.SetInteraction(*v)
+	}
+	return _u
+}
+
+// SetTolerance sets the "tolerance" field.
+func (_u *ProblemUpdate) SetTolerance(v float64) *ProblemUpdate {
+	_u.mutation.ResetTolerance()
+	_u.mutation.SetTolerance(v)
+	return _u
+}
+
+// SetNillableTolerance sets the "tolerance" field if the given value is not nil.
+func (_u *ProblemUpdate) SetNillableTolerance(v *float64) *ProblemUpdate {
+	if v != nil {
+		_u.SetTolerance(*v)
+	}
+	return _u
+}
+
+// AddTolerance adds value to the "tolerance" field.
+func (_u *ProblemUpdate) AddTolerance(v float64) *ProblemUpdate {
+	_u.mutation.AddTolerance(v)
+	return _u
+}
+
+// SetTextMatch sets the "text_match" field.
+func (_u *ProblemUpdate) SetTextMatch(v problem.TextMatch) *ProblemUpdate {
+	_u.mutation.SetTextMatch(v)
+	return _u
+}
+
+// SetNillableTextMatch sets the "text_match" field if the given value is not nil.
+func (_u *ProblemUpdate) SetNillableTextMatch(v *problem.TextMatch) *ProblemUpdate {
+	if v != nil {
+		_u.SetTextMatch(*v)
+	}
+	return _u
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_u *ProblemUpdate) SetCreatedAt(v time.Time) *ProblemUpdate {
 	_u.mutation.SetCreatedAt(v)
@@ -149,6 +220,36 @@ func (_u *ProblemUpdate) AddTranslations(v ...*ProblemTranslation) *ProblemUpdat
 	return _u.AddTranslationIDs(ids...)
 }
 
+// AddAnswerIDs adds the "answers" edge to the Answer entity by IDs.
+func (_u *ProblemUpdate) AddAnswerIDs(ids ...int) *ProblemUpdate {
+	_u.mutation.AddAnswerIDs(ids...)
+	return _u
+}
+
+// AddAnswers adds the "answers" edges to the Answer entity.
+func (_u *ProblemUpdate) AddAnswers(v ...*Answer) *ProblemUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddAnswerIDs(ids...)
+}
+
+// AddAttemptItemIDs adds the "attempt_items" edge to the AttemptItem entity by IDs.
+func (_u *ProblemUpdate) AddAttemptItemIDs(ids ...int) *ProblemUpdate {
+	_u.mutation.AddAttemptItemIDs(ids...)
+	return _u
+}
+
+// AddAttemptItems adds the "attempt_items" edges to the AttemptItem entity.
+func (_u *ProblemUpdate) AddAttemptItems(v ...*AttemptItem) *ProblemUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddAttemptItemIDs(ids...)
+}
+
 // SetParent sets the "parent" edge to the Problem entity.
 func (_u *ProblemUpdate) SetParent(v *Problem) *ProblemUpdate {
 	return _u.SetParentID(v.ID)
@@ -222,6 +323,48 @@ func (_u *ProblemUpdate) RemoveTranslations(v ...*ProblemTranslation) *ProblemUp
 	return _u.RemoveTranslationIDs(ids...)
 }
 
+// ClearAnswers clears all "answers" edges to the Answer entity.
+func (_u *ProblemUpdate) ClearAnswers() *ProblemUpdate {
+	_u.mutation.ClearAnswers()
+	return _u
+}
+
+// RemoveAnswerIDs removes the "answers" edge to Answer entities by IDs.
+func (_u *ProblemUpdate) RemoveAnswerIDs(ids ...int) *ProblemUpdate {
+	_u.mutation.RemoveAnswerIDs(ids...)
+	return _u
+}
+
+// RemoveAnswers removes "answers" edges to Answer entities.
+func (_u *ProblemUpdate) RemoveAnswers(v ...*Answer) *ProblemUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveAnswerIDs(ids...)
+}
+
+// ClearAttemptItems clears all "attempt_items" edges to the AttemptItem entity.
+func (_u *ProblemUpdate) ClearAttemptItems() *ProblemUpdate {
+	_u.mutation.ClearAttemptItems()
+	return _u
+}
+
+// RemoveAttemptItemIDs removes the "attempt_items" edge to AttemptItem entities by IDs.
+func (_u *ProblemUpdate) RemoveAttemptItemIDs(ids ...int) *ProblemUpdate {
+	_u.mutation.RemoveAttemptItemIDs(ids...)
+	return _u
+}
+
+// RemoveAttemptItems removes "attempt_items" edges to AttemptItem entities.
+func (_u *ProblemUpdate) RemoveAttemptItems(v ...*AttemptItem) *ProblemUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveAttemptItemIDs(ids...)
+}
+
 // ClearParent clears the "parent" edge to the Problem entity.
 func (_u *ProblemUpdate) ClearParent() *ProblemUpdate {
 	_u.mutation.ClearParent()
@@ -283,6 +426,16 @@ func (_u *ProblemUpdate) check() error {
 			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "Problem.type": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.Interaction(); ok {
+		if err := problem.InteractionValidator(v); err != nil {
+			return &ValidationError{Name: "interaction", err: fmt.Errorf(`ent: validator failed for field "Problem.interaction": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.TextMatch(); ok {
+		if err := problem.TextMatchValidator(v); err != nil {
+			return &ValidationError{Name: "text_match", err: fmt.Errorf(`ent: validator failed for field "Problem.text_match": %w`, err)}
+		}
+	}
 	if _u.mutation.UnitCleared() && len(_u.mutation.UnitIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "Problem.unit"`)
 	}
@@ -301,6 +454,12 @@ func (_u *ProblemUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 			}
 		}
 	}
+	if value, ok := _u.mutation.Key(); ok {
+		_spec.SetField(problem.FieldKey, field.TypeString, value)
+	}
+	if _u.mutation.KeyCleared() {
+		_spec.ClearField(problem.FieldKey, field.TypeString)
+	}
 	if value, ok := _u.mutation.GetType(); ok {
 		_spec.SetField(problem.FieldType, field.TypeEnum, value)
 	}
@@ -310,6 +469,18 @@ func (_u *ProblemUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.AddedDifficulty(); ok {
 		_spec.AddField(problem.FieldDifficulty, field.TypeInt, value)
 	}
+	if value, ok := _u.mutation.Interaction(); ok {
+		_spec.SetField(problem.FieldInteraction, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Tolerance(); ok {
+		_spec.SetField(problem.FieldTolerance, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedTolerance(); ok {
+		_spec.AddField(problem.FieldTolerance, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.TextMatch(); ok {
+		_spec.SetField(problem.FieldTextMatch, field.TypeEnum, value)
+	}
 	if value, ok := _u.mutation.CreatedAt(); ok {
 		_spec.SetField(problem.FieldCreatedAt, field.TypeTime, value)
 	}
@@ -432,6 +603,96 @@ func (_u *ProblemUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.AnswersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   problem.AnswersTable,
+			Columns: []string{problem.AnswersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(answer.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedAnswersIDs(); len(nodes) > 0 && !_u.mutation.AnswersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   problem.AnswersTable,
+			Columns: []string{problem.AnswersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(answer.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AnswersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   problem.AnswersTable,
+			Columns: []string{problem.AnswersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(answer.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.AttemptItemsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   problem.AttemptItemsTable,
+			Columns: []string{problem.AttemptItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attemptitem.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedAttemptItemsIDs(); len(nodes) > 0 && !_u.mutation.AttemptItemsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   problem.AttemptItemsTable,
+			Columns: []string{problem.AttemptItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attemptitem.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AttemptItemsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   problem.AttemptItemsTable,
+			Columns: []string{problem.AttemptItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attemptitem.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _u.mutation.ParentCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -526,6 +787,26 @@ type ProblemUpdateOne struct {
 	mutation *ProblemMutation
 }
 
+// SetKey sets the "key" field.
+func (_u *ProblemUpdateOne) SetKey(v string) *ProblemUpdateOne {
+	_u.mutation.SetKey(v)
+	return _u
+}
+
+// SetNillableKey sets the "key" field if the given value is not nil.
+func (_u *ProblemUpdateOne) SetNillableKey(v *string) *ProblemUpdateOne {
+	if v != nil {
+		_u.SetKey(*v)
+	}
+	return _u
+}
+
+// ClearKey clears the value of the "key" field.
+func (_u *ProblemUpdateOne) ClearKey() *ProblemUpdateOne {
+	_u.mutation.ClearKey()
+	return _u
+}
+
 // SetType sets the "type" field.
 func (_u *ProblemUpdateOne) SetType(v problem.Type) *ProblemUpdateOne {
 	_u.mutation.SetType(v)
@@ -561,6 +842,55 @@ func (_u *ProblemUpdateOne) AddDifficulty(v int) *ProblemUpdateOne {
 	return _u
 }
 
+// SetInteraction sets the "interaction" field.
+func (_u *ProblemUpdateOne) SetInteraction(v problem.Interaction) *ProblemUpdateOne {
+	_u.mutation.SetInteraction(v)
+	return _u
+}
+
+// SetNillableInteraction sets the "interaction" field if the given value is not nil.
+func (_u *ProblemUpdateOne) SetNillableInteraction(v *problem.Interaction) *ProblemUpdateOne {
+	if v != nil {
+		_u.SetInteraction(*v)
+	}
+	return _u
+}
+
+// SetTolerance sets the "tolerance" field.
+func (_u *ProblemUpdateOne) SetTolerance(v float64) *ProblemUpdateOne {
+	_u.mutation.ResetTolerance()
+	_u.mutation.SetTolerance(v)
+	return _u
+}
+
+// SetNillableTolerance sets the "tolerance" field if the given value is not nil.
+func (_u *ProblemUpdateOne) SetNillableTolerance(v *float64) *ProblemUpdateOne {
+	if v != nil {
+		_u.SetTolerance(*v)
+	}
+	return _u
+}
+
+// AddTolerance adds value to the "tolerance" field.
+func (_u *ProblemUpdateOne) AddTolerance(v float64) *ProblemUpdateOne {
+	_u.mutation.AddTolerance(v)
+	return _u
+}
+
+// SetTextMatch sets the "text_match" field.
+func (_u *ProblemUpdateOne) SetTextMatch(v problem.TextMatch) *ProblemUpdateOne {
+	_u.mutation.SetTextMatch(v)
+	return _u
+}
+
+// SetNillableTextMatch sets the "text_match" field if the given value is not nil.
+func (_u *ProblemUpdateOne) SetNillableTextMatch(v *problem.TextMatch) *ProblemUpdateOne {
+	if v != nil {
+		_u.SetTextMatch(*v)
+	}
+	return _u
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_u *ProblemUpdateOne) SetCreatedAt(v time.Time) *ProblemUpdateOne {
 	_u.mutation.SetCreatedAt(v)
@@ -644,6 +974,36 @@ func (_u *ProblemUpdateOne) AddTranslations(v ...*ProblemTranslation) *ProblemUp
 	return _u.AddTranslationIDs(ids...)
 }
 
+// AddAnswerIDs adds the "answers" edge to the Answer entity by IDs.
+func (_u *ProblemUpdateOne) AddAnswerIDs(ids ...int) *ProblemUpdateOne {
+	_u.mutation.AddAnswerIDs(ids...)
+	return _u
+}
+
+// AddAnswers adds the "answers" edges to the Answer entity.
+func (_u *ProblemUpdateOne) AddAnswers(v ...*Answer) *ProblemUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddAnswerIDs(ids...)
+}
+
+// AddAttemptItemIDs adds the "attempt_items" edge to the AttemptItem entity by IDs.
+func (_u *ProblemUpdateOne) AddAttemptItemIDs(ids ...int) *ProblemUpdateOne {
+	_u.mutation.AddAttemptItemIDs(ids...)
+	return _u
+}
+
+// AddAttemptItems adds the "attempt_items" edges to the AttemptItem entity.
+func (_u *ProblemUpdateOne) AddAttemptItems(v ...*AttemptItem) *ProblemUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddAttemptItemIDs(ids...)
+}
+
 // SetParent sets the "parent" edge to the Problem entity.
 func (_u *ProblemUpdateOne) SetParent(v *Problem) *ProblemUpdateOne {
 	return _u.SetParentID(v.ID)
@@ -717,6 +1077,48 @@ func (_u *ProblemUpdateOne) RemoveTranslations(v ...*ProblemTranslation) *Proble
 	return _u.RemoveTranslationIDs(ids...)
 }
 
+// ClearAnswers clears all "answers" edges to the Answer entity.
+func (_u *ProblemUpdateOne) ClearAnswers() *ProblemUpdateOne {
+	_u.mutation.ClearAnswers()
+	return _u
+}
+
+// RemoveAnswerIDs removes the "answers" edge to Answer entities by IDs.
+func (_u *ProblemUpdateOne) RemoveAnswerIDs(ids ...int) *ProblemUpdateOne {
+	_u.mutation.RemoveAnswerIDs(ids...)
+	return _u
+}
+
+// RemoveAnswers removes "answers" edges to Answer entities.
+func (_u *ProblemUpdateOne) RemoveAnswers(v ...*Answer) *ProblemUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveAnswerIDs(ids...)
+}
+
+// ClearAttemptItems clears all "attempt_items" edges to the AttemptItem entity.
+func (_u *ProblemUpdateOne) ClearAttemptItems() *ProblemUpdateOne {
+	_u.mutation.ClearAttemptItems()
+	return _u
+}
+
+// RemoveAttemptItemIDs removes the "attempt_items" edge to AttemptItem entities by IDs.
+func (_u *ProblemUpdateOne) RemoveAttemptItemIDs(ids ...int) *ProblemUpdateOne {
+	_u.mutation.RemoveAttemptItemIDs(ids...)
+	return _u
+}
+
+// RemoveAttemptItems removes "attempt_items" edges to AttemptItem entities.
+func (_u *ProblemUpdateOne) RemoveAttemptItems(v ...*AttemptItem) *ProblemUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveAttemptItemIDs(ids...)
+}
+
 // ClearParent clears the "parent" edge to the Problem entity.
 func (_u *ProblemUpdateOne) ClearParent() *ProblemUpdateOne {
 	_u.mutation.ClearParent()
@@ -791,6 +1193,16 @@ func (_u *ProblemUpdateOne) check() error {
 			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "Problem.type": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.Interaction(); ok {
+		if err := problem.InteractionValidator(v); err != nil {
+			return &ValidationError{Name: "interaction", err: fmt.Errorf(`ent: validator failed for field "Problem.interaction": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.TextMatch(); ok {
+		if err := problem.TextMatchValidator(v); err != nil {
+			return &ValidationError{Name: "text_match", err: fmt.Errorf(`ent: validator failed for field "Problem.text_match": %w`, err)}
+		}
+	}
 	if _u.mutation.UnitCleared() && len(_u.mutation.UnitIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "Problem.unit"`)
 	}
@@ -826,6 +1238,12 @@ func (_u *ProblemUpdateOne) sqlSave(ctx context.Context) (_node *Problem, err er
 			}
 		}
 	}
+	if value, ok := _u.mutation.Key(); ok {
+		_spec.SetField(problem.FieldKey, field.TypeString, value)
+	}
+	if _u.mutation.KeyCleared() {
+		_spec.ClearField(problem.FieldKey, field.TypeString)
+	}
 	if value, ok := _u.mutation.GetType(); ok {
 		_spec.SetField(problem.FieldType, field.TypeEnum, value)
 	}
@@ -835,6 +1253,18 @@ func (_u *ProblemUpdateOne) sqlSave(ctx context.Context) (_node *Problem, err er
 	if value, ok := _u.mutation.AddedDifficulty(); ok {
 		_spec.AddField(problem.FieldDifficulty, field.TypeInt, value)
 	}
+	if value, ok := _u.mutation.Interaction(); ok {
+		_spec.SetField(problem.FieldInteraction, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Tolerance(); ok {
+		_spec.SetField(problem.FieldTolerance, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedTolerance(); ok {
+		_spec.AddField(problem.FieldTolerance, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.TextMatch(); ok {
+		_spec.SetField(problem.FieldTextMatch, field.TypeEnum, value)
+	}
 	if value, ok := _u.mutation.CreatedAt(); ok {
 		_spec.SetField(problem.FieldCreatedAt, field.TypeTime, value)
 	}
@@ -957,6 +1387,96 @@ func (_u *ProblemUpdateOne) sqlSave(ctx context.Context) (_node *Problem, err er
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.AnswersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   problem.AnswersTable,
+			Columns: []string{problem.AnswersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(answer.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedAnswersIDs(); len(nodes) > 0 && !_u.mutation.AnswersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   problem.AnswersTable,
+			Columns: []string{problem.AnswersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(answer.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AnswersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   problem.AnswersTable,
+			Columns: []string{problem.AnswersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(answer.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.AttemptItemsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   problem.AttemptItemsTable,
+			Columns: []string{problem.AttemptItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attemptitem.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedAttemptItemsIDs(); len(nodes) > 0 && !_u.mutation.AttemptItemsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   problem.AttemptItemsTable,
+			Columns: []string{problem.AttemptItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attemptitem.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AttemptItemsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   problem.AttemptItemsTable,
+			Columns: []string{problem.AttemptItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attemptitem.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _u.mutation.ParentCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
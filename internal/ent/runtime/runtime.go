@@ -3,20 +3,45 @@
 package runtime
 
 import (
+	"examination/internal/ent/asset"
+	"examination/internal/ent/attempt"
 	"examination/internal/ent/choice"
 	"examination/internal/ent/exam"
 	"examination/internal/ent/problem"
 	"examination/internal/ent/problemtranslation"
+	"examination/internal/ent/role"
 	"examination/internal/ent/schema"
 	"examination/internal/ent/section"
+	"examination/internal/ent/session"
 	"examination/internal/ent/topic"
 	"examination/internal/ent/unit"
+	"examination/internal/ent/user"
 )
 
 // The init function reads all schema descriptors with runtime code
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	assetFields := schema.Asset{}.Fields()
+	_ = assetFields
+	// assetDescHash is the schema descriptor for hash field.
+	assetDescHash := assetFields[0].Descriptor()
+	// asset.HashValidator is a validator for the "hash" field. It is called by the builders before save.
+	asset.HashValidator = assetDescHash.Validators[0].(func(string) error)
+	// assetDescName is the schema descriptor for name field.
+	assetDescName := assetFields[1].Descriptor()
+	// asset.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	asset.NameValidator = assetDescName.Validators[0].(func(string) error)
+	// assetDescContentType is the schema descriptor for content_type field.
+	assetDescContentType := assetFields[2].Descriptor()
+	// asset.ContentTypeValidator is a validator for the "content_type" field. It is called by the builders before save.
+	asset.ContentTypeValidator = assetDescContentType.Validators[0].(func(string) error)
+	attemptFields := schema.Attempt{}.Fields()
+	_ = attemptFields
+	// attemptDescAutoSubmitted is the schema descriptor for auto_submitted field.
+	attemptDescAutoSubmitted := attemptFields[5].Descriptor()
+	// attempt.DefaultAutoSubmitted holds the default value on creation for the auto_submitted field.
+	attempt.DefaultAutoSubmitted = attemptDescAutoSubmitted.Default.(bool)
 	choiceFields := schema.Choice{}.Fields()
 	_ = choiceFields
 	// choiceDescContent is the schema descriptor for content field.
@@ -30,19 +55,39 @@ func init() {
 	examFields := schema.Exam{}.Fields()
 	_ = examFields
 	// examDescTitle is the schema descriptor for title field.
-	examDescTitle := examFields[0].Descriptor()
+	examDescTitle := examFields[1].Descriptor()
 	// exam.TitleValidator is a validator for the "title" field. It is called by the builders before save.
 	exam.TitleValidator = examDescTitle.Validators[0].(func(string) error)
 	// examDescIsActive is the schema descriptor for is_active field.
-	examDescIsActive := examFields[3].Descriptor()
+	examDescIsActive := examFields[4].Descriptor()
 	// exam.DefaultIsActive holds the default value on creation for the is_active field.
 	exam.DefaultIsActive = examDescIsActive.Default.(bool)
+	// examDescShuffleChoices is the schema descriptor for shuffle_choices field.
+	examDescShuffleChoices := examFields[5].Descriptor()
+	// exam.DefaultShuffleChoices holds the default value on creation for the shuffle_choices field.
+	exam.DefaultShuffleChoices = examDescShuffleChoices.Default.(bool)
+	// examDescWeightByDifficulty is the schema descriptor for weight_by_difficulty field.
+	examDescWeightByDifficulty := examFields[6].Descriptor()
+	// exam.DefaultWeightByDifficulty holds the default value on creation for the weight_by_difficulty field.
+	exam.DefaultWeightByDifficulty = examDescWeightByDifficulty.Default.(bool)
+	// examDescPartialCredit is the schema descriptor for partial_credit field.
+	examDescPartialCredit := examFields[7].Descriptor()
+	// exam.DefaultPartialCredit holds the default value on creation for the partial_credit field.
+	exam.DefaultPartialCredit = examDescPartialCredit.Default.(bool)
+	// examDescNegativeMarking is the schema descriptor for negative_marking field.
+	examDescNegativeMarking := examFields[8].Descriptor()
+	// exam.DefaultNegativeMarking holds the default value on creation for the negative_marking field.
+	exam.DefaultNegativeMarking = examDescNegativeMarking.Default.(bool)
 	problemFields := schema.Problem{}.Fields()
 	_ = problemFields
 	// problemDescDifficulty is the schema descriptor for difficulty field.
-	problemDescDifficulty := problemFields[1].Descriptor()
+	problemDescDifficulty := problemFields[2].Descriptor()
 	// problem.DefaultDifficulty holds the default value on creation for the difficulty field.
 	problem.DefaultDifficulty = problemDescDifficulty.Default.(int)
+	// problemDescTolerance is the schema descriptor for tolerance field.
+	problemDescTolerance := problemFields[4].Descriptor()
+	// problem.DefaultTolerance holds the default value on creation for the tolerance field.
+	problem.DefaultTolerance = problemDescTolerance.Default.(float64)
 	problemtranslationFields := schema.ProblemTranslation{}.Fields()
 	_ = problemtranslationFields
 	// problemtranslationDescLocale is the schema descriptor for locale field.
@@ -57,16 +102,28 @@ func init() {
 	problemtranslationDescContent := problemtranslationFields[2].Descriptor()
 	// problemtranslation.ContentValidator is a validator for the "content" field. It is called by the builders before save.
 	problemtranslation.ContentValidator = problemtranslationDescContent.Validators[0].(func(string) error)
+	roleFields := schema.Role{}.Fields()
+	_ = roleFields
+	// roleDescName is the schema descriptor for name field.
+	roleDescName := roleFields[0].Descriptor()
+	// role.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	role.NameValidator = roleDescName.Validators[0].(func(string) error)
 	sectionFields := schema.Section{}.Fields()
 	_ = sectionFields
 	// sectionDescTitle is the schema descriptor for title field.
-	sectionDescTitle := sectionFields[0].Descriptor()
+	sectionDescTitle := sectionFields[1].Descriptor()
 	// section.TitleValidator is a validator for the "title" field. It is called by the builders before save.
 	section.TitleValidator = sectionDescTitle.Validators[0].(func(string) error)
+	sessionFields := schema.Session{}.Fields()
+	_ = sessionFields
+	// sessionDescTokenHash is the schema descriptor for token_hash field.
+	sessionDescTokenHash := sessionFields[0].Descriptor()
+	// session.TokenHashValidator is a validator for the "token_hash" field. It is called by the builders before save.
+	session.TokenHashValidator = sessionDescTokenHash.Validators[0].(func(string) error)
 	topicFields := schema.Topic{}.Fields()
 	_ = topicFields
 	// topicDescTitle is the schema descriptor for title field.
-	topicDescTitle := topicFields[0].Descriptor()
+	topicDescTitle := topicFields[1].Descriptor()
 	// topic.TitleValidator is a validator for the "title" field. It is called by the builders before save.
 	topic.TitleValidator = topicDescTitle.Validators[0].(func(string) error)
 	unitHooks := schema.Unit{}.Hooks()
@@ -74,9 +131,15 @@ func init() {
 	unitFields := schema.Unit{}.Fields()
 	_ = unitFields
 	// unitDescTitle is the schema descriptor for title field.
-	unitDescTitle := unitFields[0].Descriptor()
+	unitDescTitle := unitFields[1].Descriptor()
 	// unit.TitleValidator is a validator for the "title" field. It is called by the builders before save.
 	unit.TitleValidator = unitDescTitle.Validators[0].(func(string) error)
+	userFields := schema.User{}.Fields()
+	_ = userFields
+	// userDescEmail is the schema descriptor for email field.
+	userDescEmail := userFields[0].Descriptor()
+	// user.EmailValidator is a validator for the "email" field. It is called by the builders before save.
+	user.EmailValidator = userDescEmail.Validators[0].(func(string) error)
 	versionruleFields := schema.VersionRule{}.Fields()
 	_ = versionruleFields
 }
@@ -0,0 +1,49 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Answer holds the schema definition for the Answer entity.
+type Answer struct {
+	ent.Schema
+}
+
+// Fields of the Answer.
+func (Answer) Fields() []ent.Field {
+	return []ent.Field{
+		field.Time("updated_at"),
+		field.Text("response").Optional().Comment("Typed answer of NUMERIC and TEXT problems"),
+		field.JSON("choice_order", []int{}).Optional().Comment("Choice seqs in the order given for an ORDERING problem"),
+		field.Int("attempt_id"),
+		field.Int("problem_id"),
+	}
+}
+
+// Edges of the Answer.
+func (Answer) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("attempt", Attempt.Type).
+			Ref("answers").
+			Field("attempt_id").
+			Unique().
+			Required(),
+		edge.From("problem", Problem.Type).
+			Ref("answers").
+			Field("problem_id").
+			Unique().
+			Required(),
+		// Selected choices, in the locale the problem was shown in
+		edge.To("choices", Choice.Type),
+	}
+}
+
+// Indexes of the Answer.
+func (Answer) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("attempt_id", "problem_id").Unique(),
+	}
+}
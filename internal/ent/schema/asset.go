@@ -0,0 +1,22 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+// Asset holds the schema definition for the Asset entity.
+type Asset struct {
+	ent.Schema
+}
+
+// Fields of the Asset.
+func (Asset) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("hash").NotEmpty().Unique().Immutable().Comment("SHA-256 of the bytes in hex; the storage key and the asset:HASH markdown reference"),
+		field.String("name").NotEmpty().Comment("File name it was first uploaded as"),
+		field.String("content_type").NotEmpty(),
+		field.Int64("size"),
+		field.Time("created_at"),
+	}
+}
@@ -0,0 +1,46 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// Attempt holds the schema definition for the Attempt entity.
+type Attempt struct {
+	ent.Schema
+}
+
+// Fields of the Attempt.
+func (Attempt) Fields() []ent.Field {
+	return []ent.Field{
+		field.Enum("status").Values("IN_PROGRESS", "SUBMITTED").Default("IN_PROGRESS"),
+		field.String("locale").Comment("Locale negotiated when the attempt started"),
+		field.Time("started_at"),
+		field.Time("expires_at").Optional().Nillable().Comment("Deadline derived from the exam time limit at start; nil means untimed"),
+		field.Time("submitted_at").Optional().Nillable(),
+		field.Bool("auto_submitted").Default(false).Comment("Submitted by the server after the deadline"),
+		field.Int("edition_year").Optional().Nillable().Comment("Edition the attempt was started for; nil means every problem"),
+		field.Int("edition_round").Optional(),
+		field.String("edition_category").Optional(),
+		field.Int("exam_id"),
+		field.Int("user_id").Optional().Nillable().Comment("Candidate taking the attempt"),
+	}
+}
+
+// Edges of the Attempt.
+func (Attempt) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("exam", Exam.Type).
+			Ref("attempts").
+			Field("exam_id").
+			Unique().
+			Required(),
+		edge.From("user", User.Type).
+			Ref("attempts").
+			Field("user_id").
+			Unique(),
+		edge.To("answers", Answer.Type),
+		edge.To("items", AttemptItem.Type),
+	}
+}
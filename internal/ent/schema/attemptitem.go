@@ -0,0 +1,47 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// AttemptItem holds the schema definition for the AttemptItem entity.
+// It records which problem of a unit's source-plus-variants family an
+// attempt was assembled with, so reloads and grading see the same exam.
+type AttemptItem struct {
+	ent.Schema
+}
+
+// Fields of the AttemptItem.
+func (AttemptItem) Fields() []ent.Field {
+	return []ent.Field{
+		field.JSON("choice_order", []int{}).Optional().Comment("Choice seqs in display order; empty keeps the authored order"),
+		field.Int("attempt_id"),
+		field.Int("problem_id"),
+	}
+}
+
+// Edges of the AttemptItem.
+func (AttemptItem) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("attempt", Attempt.Type).
+			Ref("items").
+			Field("attempt_id").
+			Unique().
+			Required(),
+		edge.From("problem", Problem.Type).
+			Ref("attempt_items").
+			Field("problem_id").
+			Unique().
+			Required(),
+	}
+}
+
+// Indexes of the AttemptItem.
+func (AttemptItem) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("attempt_id", "problem_id").Unique(),
+	}
+}
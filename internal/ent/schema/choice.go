@@ -30,5 +30,7 @@ func (Choice) Edges() []ent.Edge {
 			Field("problem_translation_id").
 			Unique().
 			Required(),
+		edge.From("answers", Answer.Type).
+			Ref("choices"),
 	}
 }
@@ -14,10 +14,15 @@ type Exam struct {
 // Fields of the Exam.
 func (Exam) Fields() []ent.Field {
 	return []ent.Field{
+		field.String("key").Optional().Unique().Comment("Stable external key used by content bundles"),
 		field.String("title").NotEmpty(),
 		field.Text("description").Optional(),
 		field.Int("time_limit").Comment("Time limit in minutes"),
 		field.Bool("is_active").Default(true),
+		field.Bool("shuffle_choices").Default(false).Comment("Shuffle choice order per attempt"),
+		field.Bool("weight_by_difficulty").Default(false).Comment("Weight each problem by its difficulty when scoring"),
+		field.Bool("partial_credit").Default(false).Comment("Award partial credit on problems with several parts"),
+		field.Bool("negative_marking").Default(false).Comment("Let wrong answers cost points"),
 	}
 }
 
@@ -28,5 +33,6 @@ func (Exam) Edges() []ent.Edge {
 		edge.To("topics", Topic.Type),
 		edge.To("units", Unit.Type),
 		edge.To("version_rules", VersionRule.Type),
+		edge.To("attempts", Attempt.Type),
 	}
 }
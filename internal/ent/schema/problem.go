@@ -14,8 +14,15 @@ type Problem struct {
 // Fields of the Problem.
 func (Problem) Fields() []ent.Field {
 	return []ent.Field{
+		field.String("key").Optional().Comment("Stable external key, unique within the exam"),
 		field.Enum("type").Values("SOURCE", "VARIANT").Default("SOURCE"),
 		field.Int("difficulty").Default(1),
+		field.Enum("interaction").
+			Values("SINGLE", "MULTIPLE", "ORDERING", "NUMERIC", "TEXT").
+			Default("SINGLE").
+			Comment("How the problem is answered; decides what its choices mean"),
+		field.Float("tolerance").Default(0).Comment("Largest accepted distance of a NUMERIC answer from an accepted value"),
+		field.Enum("text_match").Values("EXACT", "REGEX").Default("EXACT").Comment("How TEXT answers are compared with the accepted answers"),
 		field.Time("created_at"),
 		field.Int("unit_id"),
 		field.Int("parent_id").Optional().Nillable(),
@@ -32,6 +39,8 @@ func (Problem) Edges() []ent.Edge {
 			Required(),
 		edge.To("versions", VersionRule.Type),
 		edge.To("translations", ProblemTranslation.Type),
+		edge.To("answers", Answer.Type),
+		edge.To("attempt_items", AttemptItem.Type),
 		edge.To("children", Problem.Type).
 			From("parent").
 			Field("parent_id").
@@ -20,6 +20,13 @@ func TestSchema_Fields(t *testing.T) {
 		&schema.Problem{},
 		&schema.ProblemTranslation{},
 		&schema.Choice{},
+		&schema.Attempt{},
+		&schema.Answer{},
+		&schema.AttemptItem{},
+		&schema.User{},
+		&schema.Role{},
+		&schema.Session{},
+		&schema.Asset{},
 	}
 
 	for _, s := range schemas {
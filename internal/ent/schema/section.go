@@ -4,6 +4,7 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
 )
 
 // Section holds the schema definition for the Section entity.
@@ -14,6 +15,7 @@ type Section struct {
 // Fields of the Section.
 func (Section) Fields() []ent.Field {
 	return []ent.Field{
+		field.String("key").Optional().Comment("Stable external key, unique within the exam"),
 		field.String("title").NotEmpty(),
 		field.Int("seq").Comment("Sequence order"),
 		field.Int("exam_id"),
@@ -32,3 +34,10 @@ func (Section) Edges() []ent.Edge {
 		edge.To("units", Unit.Type),
 	}
 }
+
+// Indexes of the Section.
+func (Section) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("exam_id", "key").Unique(),
+	}
+}
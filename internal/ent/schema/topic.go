@@ -4,6 +4,7 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
 )
 
 // Topic holds the schema definition for the Topic entity.
@@ -14,6 +15,7 @@ type Topic struct {
 // Fields of the Topic.
 func (Topic) Fields() []ent.Field {
 	return []ent.Field{
+		field.String("key").Optional().Comment("Stable external key, unique within the exam"),
 		field.String("title").NotEmpty(),
 		field.Int("seq").Comment("Sequence order"),
 		field.Int("exam_id"),
@@ -36,3 +38,10 @@ func (Topic) Edges() []ent.Edge {
 		edge.To("units", Unit.Type),
 	}
 }
+
+// Indexes of the Topic.
+func (Topic) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("exam_id", "key").Unique(),
+	}
+}
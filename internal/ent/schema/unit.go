@@ -6,6 +6,7 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
 )
 
 // Unit holds the schema definition for the Unit entity.
@@ -16,6 +17,7 @@ type Unit struct {
 // Fields of the Unit.
 func (Unit) Fields() []ent.Field {
 	return []ent.Field{
+		field.String("key").Optional().Comment("Stable external key, unique within the exam"),
 		field.String("title").NotEmpty(),
 		field.Int("seq").Comment("Sequence order"),
 		field.Int("exam_id"),
@@ -67,3 +69,10 @@ func (Unit) Hooks() []ent.Hook {
 		},
 	}
 }
+
+// Indexes of the Unit.
+func (Unit) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("exam_id", "key").Unique(),
+	}
+}
@@ -0,0 +1,31 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// User holds the schema definition for the User entity.
+type User struct {
+	ent.Schema
+}
+
+// Fields of the User.
+func (User) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("email").NotEmpty().Unique().Comment("Login name, stored lower-cased"),
+		field.String("name").Optional(),
+		field.String("password_hash").Sensitive().Comment("PBKDF2 hash, see the auth service"),
+		field.Time("created_at"),
+	}
+}
+
+// Edges of the User.
+func (User) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("roles", Role.Type),
+		edge.To("sessions", Session.Type),
+		edge.To("attempts", Attempt.Type),
+	}
+}
@@ -17,6 +17,8 @@ type Section struct {
 	config `json:"-"`
 	// ID of the ent.
 	ID int `json:"id,omitempty"`
+	// Stable external key, unique within the exam
+	Key string `json:"key,omitempty"`
 	// Title holds the value of the "title" field.
 	Title string `json:"title,omitempty"`
 	// Sequence order
@@ -78,7 +80,7 @@ func (*Section) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case section.FieldID, section.FieldSeq, section.FieldExamID:
 			values[i] = new(sql.NullInt64)
-		case section.FieldTitle:
+		case section.FieldKey, section.FieldTitle:
 			values[i] = new(sql.NullString)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -101,6 +103,12 @@ func (_m *Section) assignValues(columns []string, values []any) error {
 				return fmt.Errorf("unexpected type %T for field id", value)
 			}
 			_m.ID = int(value.Int64)
+		case section.FieldKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field key", values[i])
+			} else if value.Valid {
+				_m.Key = value.String
+			}
 		case section.FieldTitle:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field title", values[i])
@@ -170,6 +178,9 @@ func (_m *Section) String() string {
 	var builder strings.Builder
 	builder.WriteString("Section(")
 	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("key=")
+	builder.WriteString(_m.Key)
+	builder.WriteString(", ")
 	builder.WriteString("title=")
 	builder.WriteString(_m.Title)
 	builder.WriteString(", ")
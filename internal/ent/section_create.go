@@ -22,6 +22,20 @@ type SectionCreate struct {
 	hooks    []Hook
 }
 
+// SetKey sets the "key" field.
+func (_c *SectionCreate) SetKey(v string) *SectionCreate {
+	_c.mutation.SetKey(v)
+	return _c
+}
+
+// SetNillableKey sets the "key" field if the given value is not nil.
+func (_c *SectionCreate) SetNillableKey(v *string) *SectionCreate {
+	if v != nil {
+		_c.SetKey(*v)
+	}
+	return _c
+}
+
 // SetTitle sets the "title" field.
 func (_c *SectionCreate) SetTitle(v string) *SectionCreate {
 	_c.mutation.SetTitle(v)
@@ -152,6 +166,10 @@ func (_c *SectionCreate) createSpec() (*Section, *sqlgraph.CreateSpec) {
 		_node = &Section{config: _c.config}
 		_spec = sqlgraph.NewCreateSpec(section.Table, sqlgraph.NewFieldSpec(section.FieldID, field.TypeInt))
 	)
+	if value, ok := _c.mutation.Key(); ok {
+		_spec.SetField(section.FieldKey, field.TypeString, value)
+		_node.Key = value
+	}
 	if value, ok := _c.mutation.Title(); ok {
 		_spec.SetField(section.FieldTitle, field.TypeString, value)
 		_node.Title = value
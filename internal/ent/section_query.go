@@ -371,12 +371,12 @@ func (_q *SectionQuery) WithUnits(opts ...func(*UnitQuery)) *SectionQuery {
 // Example:
 //
 //	var v []struct {
-//		Title string `json:"title,omitempty"`
+//		Key string `json:"key,omitempty"`
 //		Count int `json:"count,omitempty"`
 //	}
 //
 //	client.Section.Query().
-//		GroupBy(section.FieldTitle).
+//		GroupBy(section.FieldKey).
 //		Aggregate(ent.Count()).
 //		Scan(ctx, &v)
 func (_q *SectionQuery) GroupBy(field string, fields ...string) *SectionGroupBy {
@@ -394,11 +394,11 @@ func (_q *SectionQuery) GroupBy(field string, fields ...string) *SectionGroupBy
 // Example:
 //
 //	var v []struct {
-//		Title string `json:"title,omitempty"`
+//		Key string `json:"key,omitempty"`
 //	}
 //
 //	client.Section.Query().
-//		Select(section.FieldTitle).
+//		Select(section.FieldKey).
 //		Scan(ctx, &v)
 func (_q *SectionQuery) Select(fields ...string) *SectionSelect {
 	_q.ctx.Fields = append(_q.ctx.Fields, fields...)
@@ -30,6 +30,26 @@ func (_u *SectionUpdate) Where(ps ...predicate.Section) *SectionUpdate {
 	return _u
 }
 
+// SetKey sets the "key" field.
+func (_u *SectionUpdate) SetKey(v string) *SectionUpdate {
+	_u.mutation.SetKey(v)
+	return _u
+}
+
+// SetNillableKey sets the "key" field if the given value is not nil.
+func (_u *SectionUpdate) SetNillableKey(v *string) *SectionUpdate {
+	if v != nil {
+		_u.SetKey(*v)
+	}
+	return _u
+}
+
+// ClearKey clears the value of the "key" field.
+func (_u *SectionUpdate) ClearKey() *SectionUpdate {
+	_u.mutation.ClearKey()
+	return _u
+}
+
 // SetTitle sets the "title" field.
 func (_u *SectionUpdate) SetTitle(v string) *SectionUpdate {
 	_u.mutation.SetTitle(v)
@@ -219,6 +239,12 @@ func (_u *SectionUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 			}
 		}
 	}
+	if value, ok := _u.mutation.Key(); ok {
+		_spec.SetField(section.FieldKey, field.TypeString, value)
+	}
+	if _u.mutation.KeyCleared() {
+		_spec.ClearField(section.FieldKey, field.TypeString)
+	}
 	if value, ok := _u.mutation.Title(); ok {
 		_spec.SetField(section.FieldTitle, field.TypeString, value)
 	}
@@ -367,6 +393,26 @@ type SectionUpdateOne struct {
 	mutation *SectionMutation
 }
 
+// SetKey sets the "key" field.
+func (_u *SectionUpdateOne) SetKey(v string) *SectionUpdateOne {
+	_u.mutation.SetKey(v)
+	return _u
+}
+
+// SetNillableKey sets the "key" field if the given value is not nil.
+func (_u *SectionUpdateOne) SetNillableKey(v *string) *SectionUpdateOne {
+	if v != nil {
+		_u.SetKey(*v)
+	}
+	return _u
+}
+
+// ClearKey clears the value of the "key" field.
+func (_u *SectionUpdateOne) ClearKey() *SectionUpdateOne {
+	_u.mutation.ClearKey()
+	return _u
+}
+
 // SetTitle sets the "title" field.
 func (_u *SectionUpdateOne) SetTitle(v string) *SectionUpdateOne {
 	_u.mutation.SetTitle(v)
@@ -586,6 +632,12 @@ func (_u *SectionUpdateOne) sqlSave(ctx context.Context) (_node *Section, err er
 			}
 		}
 	}
+	if value, ok := _u.mutation.Key(); ok {
+		_spec.SetField(section.FieldKey, field.TypeString, value)
+	}
+	if _u.mutation.KeyCleared() {
+		_spec.ClearField(section.FieldKey, field.TypeString)
+	}
 	if value, ok := _u.mutation.Title(); ok {
 		_spec.SetField(section.FieldTitle, field.TypeString, value)
 	}
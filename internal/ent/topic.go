@@ -18,6 +18,8 @@ type Topic struct {
 	config `json:"-"`
 	// ID of the ent.
 	ID int `json:"id,omitempty"`
+	// Stable external key, unique within the exam
+	Key string `json:"key,omitempty"`
 	// Title holds the value of the "title" field.
 	Title string `json:"title,omitempty"`
 	// Sequence order
@@ -83,7 +85,7 @@ func (*Topic) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case topic.FieldID, topic.FieldSeq, topic.FieldExamID, topic.FieldSectionID:
 			values[i] = new(sql.NullInt64)
-		case topic.FieldTitle:
+		case topic.FieldKey, topic.FieldTitle:
 			values[i] = new(sql.NullString)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -106,6 +108,12 @@ func (_m *Topic) assignValues(columns []string, values []any) error {
 				return fmt.Errorf("unexpected type %T for field id", value)
 			}
 			_m.ID = int(value.Int64)
+		case topic.FieldKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field key", values[i])
+			} else if value.Valid {
+				_m.Key = value.String
+			}
 		case topic.FieldTitle:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field title", values[i])
@@ -182,6 +190,9 @@ func (_m *Topic) String() string {
 	var builder strings.Builder
 	builder.WriteString("Topic(")
 	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("key=")
+	builder.WriteString(_m.Key)
+	builder.WriteString(", ")
 	builder.WriteString("title=")
 	builder.WriteString(_m.Title)
 	builder.WriteString(", ")
@@ -12,6 +12,8 @@ const (
 	Label = "topic"
 	// FieldID holds the string denoting the id field in the database.
 	FieldID = "id"
+	// FieldKey holds the string denoting the key field in the database.
+	FieldKey = "key"
 	// FieldTitle holds the string denoting the title field in the database.
 	FieldTitle = "title"
 	// FieldSeq holds the string denoting the seq field in the database.
@@ -54,6 +56,7 @@ const (
 // Columns holds all SQL columns for topic fields.
 var Columns = []string{
 	FieldID,
+	FieldKey,
 	FieldTitle,
 	FieldSeq,
 	FieldExamID,
@@ -83,6 +86,11 @@ func ByID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldID, opts...).ToFunc()
 }
 
+// ByKey orders the results by the key field.
+func ByKey(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldKey, opts...).ToFunc()
+}
+
 // ByTitle orders the results by the title field.
 func ByTitle(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldTitle, opts...).ToFunc()
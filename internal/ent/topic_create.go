@@ -22,6 +22,20 @@ type TopicCreate struct {
 	hooks    []Hook
 }
 
+// SetKey sets the "key" field.
+func (_c *TopicCreate) SetKey(v string) *TopicCreate {
+	_c.mutation.SetKey(v)
+	return _c
+}
+
+// SetNillableKey sets the "key" field if the given value is not nil.
+func (_c *TopicCreate) SetNillableKey(v *string) *TopicCreate {
+	if v != nil {
+		_c.SetKey(*v)
+	}
+	return _c
+}
+
 // SetTitle sets the "title" field.
 func (_c *TopicCreate) SetTitle(v string) *TopicCreate {
 	_c.mutation.SetTitle(v)
@@ -156,6 +170,10 @@ func (_c *TopicCreate) createSpec() (*Topic, *sqlgraph.CreateSpec) {
 		_node = &Topic{config: _c.config}
 		_spec = sqlgraph.NewCreateSpec(topic.Table, sqlgraph.NewFieldSpec(topic.FieldID, field.TypeInt))
 	)
+	if value, ok := _c.mutation.Key(); ok {
+		_spec.SetField(topic.FieldKey, field.TypeString, value)
+		_node.Key = value
+	}
 	if value, ok := _c.mutation.Title(); ok {
 		_spec.SetField(topic.FieldTitle, field.TypeString, value)
 		_node.Title = value
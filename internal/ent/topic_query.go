@@ -371,12 +371,12 @@ func (_q *TopicQuery) WithUnits(opts ...func(*UnitQuery)) *TopicQuery {
 // Example:
 //
 //	var v []struct {
-//		Title string `json:"title,omitempty"`
+//		Key string `json:"key,omitempty"`
 //		Count int `json:"count,omitempty"`
 //	}
 //
 //	client.Topic.Query().
-//		GroupBy(topic.FieldTitle).
+//		GroupBy(topic.FieldKey).
 //		Aggregate(ent.Count()).
 //		Scan(ctx, &v)
 func (_q *TopicQuery) GroupBy(field string, fields ...string) *TopicGroupBy {
@@ -394,11 +394,11 @@ func (_q *TopicQuery) GroupBy(field string, fields ...string) *TopicGroupBy {
 // Example:
 //
 //	var v []struct {
-//		Title string `json:"title,omitempty"`
+//		Key string `json:"key,omitempty"`
 //	}
 //
 //	client.Topic.Query().
-//		Select(topic.FieldTitle).
+//		Select(topic.FieldKey).
 //		Scan(ctx, &v)
 func (_q *TopicQuery) Select(fields ...string) *TopicSelect {
 	_q.ctx.Fields = append(_q.ctx.Fields, fields...)
@@ -30,6 +30,26 @@ func (_u *TopicUpdate) Where(ps ...predicate.Topic) *TopicUpdate {
 	return _u
 }
 
+// SetKey sets the "key" field.
+func (_u *TopicUpdate) SetKey(v string) *TopicUpdate {
+	_u.mutation.SetKey(v)
+	return _u
+}
+
+// SetNillableKey sets the "key" field if the given value is not nil.
+func (_u *TopicUpdate) SetNillableKey(v *string) *TopicUpdate {
+	if v != nil {
+		_u.SetKey(*v)
+	}
+	return _u
+}
+
+// ClearKey clears the value of the "key" field.
+func (_u *TopicUpdate) ClearKey() *TopicUpdate {
+	_u.mutation.ClearKey()
+	return _u
+}
+
 // SetTitle sets the "title" field.
 func (_u *TopicUpdate) SetTitle(v string) *TopicUpdate {
 	_u.mutation.SetTitle(v)
@@ -214,6 +234,12 @@ func (_u *TopicUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 			}
 		}
 	}
+	if value, ok := _u.mutation.Key(); ok {
+		_spec.SetField(topic.FieldKey, field.TypeString, value)
+	}
+	if _u.mutation.KeyCleared() {
+		_spec.ClearField(topic.FieldKey, field.TypeString)
+	}
 	if value, ok := _u.mutation.Title(); ok {
 		_spec.SetField(topic.FieldTitle, field.TypeString, value)
 	}
@@ -346,6 +372,26 @@ type TopicUpdateOne struct {
 	mutation *TopicMutation
 }
 
+// SetKey sets the "key" field.
+func (_u *TopicUpdateOne) SetKey(v string) *TopicUpdateOne {
+	_u.mutation.SetKey(v)
+	return _u
+}
+
+// SetNillableKey sets the "key" field if the given value is not nil.
+func (_u *TopicUpdateOne) SetNillableKey(v *string) *TopicUpdateOne {
+	if v != nil {
+		_u.SetKey(*v)
+	}
+	return _u
+}
+
+// ClearKey clears the value of the "key" field.
+func (_u *TopicUpdateOne) ClearKey() *TopicUpdateOne {
+	_u.mutation.ClearKey()
+	return _u
+}
+
 // SetTitle sets the "title" field.
 func (_u *TopicUpdateOne) SetTitle(v string) *TopicUpdateOne {
 	_u.mutation.SetTitle(v)
@@ -560,6 +606,12 @@ func (_u *TopicUpdateOne) sqlSave(ctx context.Context) (_node *Topic, err error)
 			}
 		}
 	}
+	if value, ok := _u.mutation.Key(); ok {
+		_spec.SetField(topic.FieldKey, field.TypeString, value)
+	}
+	if _u.mutation.KeyCleared() {
+		_spec.ClearField(topic.FieldKey, field.TypeString)
+	}
 	if value, ok := _u.mutation.Title(); ok {
 		_spec.SetField(topic.FieldTitle, field.TypeString, value)
 	}
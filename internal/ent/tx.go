@@ -12,6 +12,14 @@ import (
 // Tx is a transactional client that is created by calling Client.Tx().
 type Tx struct {
 	config
+	// Answer is the client for interacting with the Answer builders.
+	Answer *AnswerClient
+	// Asset is the client for interacting with the Asset builders.
+	Asset *AssetClient
+	// Attempt is the client for interacting with the Attempt builders.
+	Attempt *AttemptClient
+	// AttemptItem is the client for interacting with the AttemptItem builders.
+	AttemptItem *AttemptItemClient
 	// Choice is the client for interacting with the Choice builders.
 	Choice *ChoiceClient
 	// Exam is the client for interacting with the Exam builders.
@@ -20,12 +28,18 @@ type Tx struct {
 	Problem *ProblemClient
 	// ProblemTranslation is the client for interacting with the ProblemTranslation builders.
 	ProblemTranslation *ProblemTranslationClient
+	// Role is the client for interacting with the Role builders.
+	Role *RoleClient
 	// Section is the client for interacting with the Section builders.
 	Section *SectionClient
+	// Session is the client for interacting with the Session builders.
+	Session *SessionClient
 	// Topic is the client for interacting with the Topic builders.
 	Topic *TopicClient
 	// Unit is the client for interacting with the Unit builders.
 	Unit *UnitClient
+	// User is the client for interacting with the User builders.
+	User *UserClient
 	// VersionRule is the client for interacting with the VersionRule builders.
 	VersionRule *VersionRuleClient
 
@@ -159,13 +173,20 @@ func (tx *Tx) Client() *Client {
 }
 
 func (tx *Tx) init() {
+	tx.Answer = NewAnswerClient(tx.config)
+	tx.Asset = NewAssetClient(tx.config)
+	tx.Attempt = NewAttemptClient(tx.config)
+	tx.AttemptItem = NewAttemptItemClient(tx.config)
 	tx.Choice = NewChoiceClient(tx.config)
 	tx.Exam = NewExamClient(tx.config)
 	tx.Problem = NewProblemClient(tx.config)
 	tx.ProblemTranslation = NewProblemTranslationClient(tx.config)
+	tx.Role = NewRoleClient(tx.config)
 	tx.Section = NewSectionClient(tx.config)
+	tx.Session = NewSessionClient(tx.config)
 	tx.Topic = NewTopicClient(tx.config)
 	tx.Unit = NewUnitClient(tx.config)
+	tx.User = NewUserClient(tx.config)
 	tx.VersionRule = NewVersionRuleClient(tx.config)
 }
 
@@ -176,7 +197,7 @@ func (tx *Tx) init() {
 // of them in order to commit or rollback the transaction.
 //
 // If a closed transaction is embedded in one of the generated entities, and the entity
-// applies a query, for example: Choice.QueryXXX(), the query will be executed
+// applies a query, for example: Answer.QueryXXX(), the query will be executed
 // through the driver which created this transaction.
 //
 // Note that txDriver is not goroutine safe.
@@ -23,6 +23,20 @@ type UnitCreate struct {
 	hooks    []Hook
 }
 
+// SetKey sets the "key" field.
+func (_c *UnitCreate) SetKey(v string) *UnitCreate {
+	_c.mutation.SetKey(v)
+	return _c
+}
+
+// SetNillableKey sets the "key" field if the given value is not nil.
+func (_c *UnitCreate) SetNillableKey(v *string) *UnitCreate {
+	if v != nil {
+		_c.SetKey(*v)
+	}
+	return _c
+}
+
 // SetTitle sets the "title" field.
 func (_c *UnitCreate) SetTitle(v string) *UnitCreate {
 	_c.mutation.SetTitle(v)
@@ -176,6 +190,10 @@ func (_c *UnitCreate) createSpec() (*Unit, *sqlgraph.CreateSpec) {
 		_node = &Unit{config: _c.config}
 		_spec = sqlgraph.NewCreateSpec(unit.Table, sqlgraph.NewFieldSpec(unit.FieldID, field.TypeInt))
 	)
+	if value, ok := _c.mutation.Key(); ok {
+		_spec.SetField(unit.FieldKey, field.TypeString, value)
+		_node.Key = value
+	}
 	if value, ok := _c.mutation.Title(); ok {
 		_spec.SetField(unit.FieldTitle, field.TypeString, value)
 		_node.Title = value
@@ -407,12 +407,12 @@ func (_q *UnitQuery) WithProblems(opts ...func(*ProblemQuery)) *UnitQuery {
 // Example:
 //
 //	var v []struct {
-//		Title string `json:"title,omitempty"`
+//		Key string `json:"key,omitempty"`
 //		Count int `json:"count,omitempty"`
 //	}
 //
 //	client.Unit.Query().
-//		GroupBy(unit.FieldTitle).
+//		GroupBy(unit.FieldKey).
 //		Aggregate(ent.Count()).
 //		Scan(ctx, &v)
 func (_q *UnitQuery) GroupBy(field string, fields ...string) *UnitGroupBy {
@@ -430,11 +430,11 @@ func (_q *UnitQuery) GroupBy(field string, fields ...string) *UnitGroupBy {
 // Example:
 //
 //	var v []struct {
-//		Title string `json:"title,omitempty"`
+//		Key string `json:"key,omitempty"`
 //	}
 //
 //	client.Unit.Query().
-//		Select(unit.FieldTitle).
+//		Select(unit.FieldKey).
 //		Scan(ctx, &v)
 func (_q *UnitQuery) Select(fields ...string) *UnitSelect {
 	_q.ctx.Fields = append(_q.ctx.Fields, fields...)
@@ -31,6 +31,26 @@ func (_u *UnitUpdate) Where(ps ...predicate.Unit) *UnitUpdate {
 	return _u
 }
 
+// SetKey sets the "key" field.
+func (_u *UnitUpdate) SetKey(v string) *UnitUpdate {
+	_u.mutation.SetKey(v)
+	return _u
+}
+
+// SetNillableKey sets the "key" field if the given value is not nil.
+func (_u *UnitUpdate) SetNillableKey(v *string) *UnitUpdate {
+	if v != nil {
+		_u.SetKey(*v)
+	}
+	return _u
+}
+
+// ClearKey clears the value of the "key" field.
+func (_u *UnitUpdate) ClearKey() *UnitUpdate {
+	_u.mutation.ClearKey()
+	return _u
+}
+
 // SetTitle sets the "title" field.
 func (_u *UnitUpdate) SetTitle(v string) *UnitUpdate {
 	_u.mutation.SetTitle(v)
@@ -246,6 +266,12 @@ func (_u *UnitUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 			}
 		}
 	}
+	if value, ok := _u.mutation.Key(); ok {
+		_spec.SetField(unit.FieldKey, field.TypeString, value)
+	}
+	if _u.mutation.KeyCleared() {
+		_spec.ClearField(unit.FieldKey, field.TypeString)
+	}
 	if value, ok := _u.mutation.Title(); ok {
 		_spec.SetField(unit.FieldTitle, field.TypeString, value)
 	}
@@ -407,6 +433,26 @@ type UnitUpdateOne struct {
 	mutation *UnitMutation
 }
 
+// SetKey sets the "key" field.
+func (_u *UnitUpdateOne) SetKey(v string) *UnitUpdateOne {
+	_u.mutation.SetKey(v)
+	return _u
+}
+
+// SetNillableKey sets the "key" field if the given value is not nil.
+func (_u *UnitUpdateOne) SetNillableKey(v *string) *UnitUpdateOne {
+	if v != nil {
+		_u.SetKey(*v)
+	}
+	return _u
+}
+
+// ClearKey clears the value of the "key" field.
+func (_u *UnitUpdateOne) ClearKey() *UnitUpdateOne {
+	_u.mutation.ClearKey()
+	return _u
+}
+
 // SetTitle sets the "title" field.
 func (_u *UnitUpdateOne) SetTitle(v string) *UnitUpdateOne {
 	_u.mutation.SetTitle(v)
@@ -652,6 +698,12 @@ func (_u *UnitUpdateOne) sqlSave(ctx context.Context) (_node *Unit, err error) {
 			}
 		}
 	}
+	if value, ok := _u.mutation.Key(); ok {
+		_spec.SetField(unit.FieldKey, field.TypeString, value)
+	}
+	if _u.mutation.KeyCleared() {
+		_spec.ClearField(unit.FieldKey, field.TypeString)
+	}
 	if value, ok := _u.mutation.Title(); ok {
 		_spec.SetField(unit.FieldTitle, field.TypeString, value)
 	}
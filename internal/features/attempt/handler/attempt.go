@@ -0,0 +1,322 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"examination/internal/ent"
+	"examination/internal/ent/attempt"
+	"examination/internal/ent/problem"
+	"examination/internal/features/attempt/scoring"
+	"examination/internal/features/attempt/service"
+	"examination/internal/features/attempt/ui"
+	authhandler "examination/internal/features/auth/handler"
+	"examination/internal/features/content/markdown"
+	contentservice "examination/internal/features/content/service"
+	"examination/internal/features/exam/edition"
+	"examination/internal/features/exam/i18n"
+	"examination/internal/features/exam/view"
+	"fmt"
+	"html/template"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+)
+
+type AttemptHandler struct {
+	client   *ent.Client
+	attempts *service.AttemptService
+	sequence *contentservice.SequenceLogic
+	versions *contentservice.VersionResolver
+}
+
+func NewAttemptHandler(client *ent.Client) *AttemptHandler {
+	return &AttemptHandler{
+		client:   client,
+		attempts: service.NewAttemptService(client),
+		sequence: contentservice.NewSequenceLogic(client),
+		versions: contentservice.NewVersionResolver(client),
+	}
+}
+
+// attemptPage is the template data of attempt.html.
+type attemptPage struct {
+	Attempt *ent.Attempt
+	Exam    *view.Exam
+	// Selected maps problem ID -> choice ID -> selected.
+	Selected map[int]map[int]bool
+	// Responses maps problem ID -> typed answer.
+	Responses map[int]string
+	// Submitted is true once the attempt can no longer be changed.
+	Submitted bool
+	// Expired is true when the deadline passed before the attempt was
+	// submitted; answers are locked but the candidate may still submit.
+	Expired bool
+	// Score is set once the attempt is submitted.
+	Score *scoring.Result
+}
+
+// Start opens a new attempt at the exam and redirects to it.
+func (h *AttemptHandler) Start(w http.ResponseWriter, r *http.Request) {
+	examID, err := strconv.Atoi(chi.URLParam(r, "examID"))
+	if err != nil || examID <= 0 {
+		http.Error(w, "Invalid exam ID", http.StatusBadRequest)
+		return
+	}
+
+	ed, err := edition.Parse(r)
+	if err != nil {
+		http.Error(w, "Invalid edition", http.StatusBadRequest)
+		return
+	}
+
+	var userID *int
+	if u := authhandler.UserFrom(r.Context()); u != nil {
+		userID = &u.ID
+	}
+	a, err := h.attempts.Start(r.Context(), examID, userID, i18n.Negotiate(r)[0], ed)
+	if err != nil {
+		switch {
+		case ent.IsNotFound(err):
+			http.Error(w, "Exam not found", http.StatusNotFound)
+		case errors.Is(err, service.ErrExamInactive):
+			http.Error(w, "Exam is not active", http.StatusForbidden)
+		default:
+			http.Error(w, "Failed to start attempt: "+err.Error(), http.StatusInternalServerError)
+		}
+		return
+	}
+
+	http.Redirect(w, r, fmt.Sprintf("/attempts/%d", a.ID), http.StatusSeeOther)
+}
+
+// Show renders the attempt with the candidate's current answers.
+func (h *AttemptHandler) Show(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	a, ok := h.loadAttempt(w, r)
+	if !ok {
+		return
+	}
+
+	// The locale picked at start comes first so the attempt stays in one language
+	prefs := append([]string{a.Locale}, i18n.Negotiate(r)...)
+	res, err := h.resolve(ctx, a)
+	if err != nil {
+		http.Error(w, "Failed to resolve edition: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	exam, err := view.Load(ctx, h.sequence, a.Edges.Exam, prefs, res, service.LayoutOf(a).Filter)
+	if err != nil {
+		http.Error(w, "Failed to load exam: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	applyOrders(exam, a)
+
+	tmpl, err := template.New("attempt.html").Funcs(markdown.Funcs).ParseFS(ui.FS, "attempt.html")
+	if err != nil {
+		http.Error(w, "Failed to parse embedded template: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	data := attemptPage{
+		Attempt:   a,
+		Exam:      exam,
+		Selected:  selectedChoices(a),
+		Responses: responses(a),
+		Submitted: a.Status == attempt.StatusSUBMITTED,
+		Expired:   a.Status == attempt.StatusIN_PROGRESS && service.Expired(a, time.Now()),
+	}
+	if data.Submitted {
+		if data.Score, err = h.score(ctx, a); err != nil {
+			http.Error(w, "Failed to score attempt: "+err.Error(), http.StatusInternalServerError)
+			return
+		}
+	}
+	if err := tmpl.Execute(w, data); err != nil {
+		http.Error(w, "Failed to render template: "+err.Error(), http.StatusInternalServerError)
+	}
+}
+
+// SaveAnswer stores the answer to a single problem: the choice_id values,
+// in order for ORDERING problems, or the typed response. It is called by
+// HTMX on every change and responds with a short status fragment.
+func (h *AttemptHandler) SaveAnswer(w http.ResponseWriter, r *http.Request) {
+	attemptID, ok := h.attemptID(w, r)
+	if !ok {
+		return
+	}
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, "Invalid form", http.StatusBadRequest)
+		return
+	}
+
+	problemID, err := strconv.Atoi(r.PostForm.Get("problem_id"))
+	if err != nil {
+		http.Error(w, "Invalid problem ID", http.StatusBadRequest)
+		return
+	}
+	var choiceIDs []int
+	for _, v := range r.PostForm["choice_id"] {
+		id, err := strconv.Atoi(v)
+		if err != nil {
+			http.Error(w, "Invalid choice ID", http.StatusBadRequest)
+			return
+		}
+		choiceIDs = append(choiceIDs, id)
+	}
+
+	if r.PostForm.Has("response") {
+		_, err = h.attempts.SaveResponse(r.Context(), attemptID, problemID, r.PostForm.Get("response"))
+	} else {
+		_, err = h.attempts.SaveAnswer(r.Context(), attemptID, problemID, choiceIDs)
+	}
+	if err != nil {
+		switch {
+		case ent.IsNotFound(err):
+			http.Error(w, "Attempt not found", http.StatusNotFound)
+		case errors.Is(err, service.ErrAttemptSubmitted):
+			http.Error(w, "This attempt was already submitted", http.StatusConflict)
+		case errors.Is(err, service.ErrAttemptExpired):
+			http.Error(w, "Time is up: answers can no longer be saved", http.StatusConflict)
+		case errors.Is(err, service.ErrInvalidAnswer):
+			http.Error(w, "Invalid answer", http.StatusBadRequest)
+		default:
+			http.Error(w, "Failed to save answer: "+err.Error(), http.StatusInternalServerError)
+		}
+		return
+	}
+
+	w.Write([]byte("Saved"))
+}
+
+// Submit closes the attempt and redirects back to it.
+func (h *AttemptHandler) Submit(w http.ResponseWriter, r *http.Request) {
+	attemptID, ok := h.attemptID(w, r)
+	if !ok {
+		return
+	}
+
+	if _, err := h.attempts.Submit(r.Context(), attemptID); err != nil {
+		switch {
+		case ent.IsNotFound(err):
+			http.Error(w, "Attempt not found", http.StatusNotFound)
+		case errors.Is(err, service.ErrAttemptSubmitted):
+			http.Error(w, "This attempt was already submitted", http.StatusConflict)
+		default:
+			http.Error(w, "Failed to submit attempt: "+err.Error(), http.StatusInternalServerError)
+		}
+		return
+	}
+
+	http.Redirect(w, r, fmt.Sprintf("/attempts/%d", attemptID), http.StatusSeeOther)
+}
+
+// attemptID resolves the {attemptID} URL parameter, writing the error
+// response itself when it is invalid. Candidates only see their own
+// attempts; others are reported as not found.
+func (h *AttemptHandler) attemptID(w http.ResponseWriter, r *http.Request) (int, bool) {
+	attemptID, err := strconv.Atoi(chi.URLParam(r, "attemptID"))
+	if err != nil || attemptID <= 0 {
+		http.Error(w, "Invalid attempt ID", http.StatusBadRequest)
+		return 0, false
+	}
+
+	u := authhandler.UserFrom(r.Context())
+	if u == nil {
+		http.Error(w, "Attempt not found", http.StatusNotFound)
+		return 0, false
+	}
+	owned, err := h.client.Attempt.Query().
+		Where(attempt.ID(attemptID), attempt.UserID(u.ID)).
+		Exist(r.Context())
+	if err != nil {
+		http.Error(w, "Failed to load attempt: "+err.Error(), http.StatusInternalServerError)
+		return 0, false
+	}
+	if !owned {
+		http.Error(w, "Attempt not found", http.StatusNotFound)
+		return 0, false
+	}
+	return attemptID, true
+}
+
+// loadAttempt resolves the {attemptID} URL parameter, writing the error
+// response itself when the attempt cannot be loaded.
+func (h *AttemptHandler) loadAttempt(w http.ResponseWriter, r *http.Request) (*ent.Attempt, bool) {
+	attemptID, ok := h.attemptID(w, r)
+	if !ok {
+		return nil, false
+	}
+
+	a, err := h.attempts.Get(r.Context(), attemptID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			http.Error(w, "Attempt not found", http.StatusNotFound)
+			return nil, false
+		}
+		http.Error(w, "Failed to load attempt: "+err.Error(), http.StatusInternalServerError)
+		return nil, false
+	}
+	return a, true
+}
+
+// resolve resolves the edition the attempt was started for; it returns nil
+// when the attempt includes every problem.
+func (h *AttemptHandler) resolve(ctx context.Context, a *ent.Attempt) (*contentservice.Resolution, error) {
+	ed := service.EditionOf(a)
+	if ed == nil {
+		return nil, nil
+	}
+	return h.versions.Resolve(ctx, a.ExamID, *ed)
+}
+
+// score grades a submitted attempt with the scoring policy of its exam.
+func (h *AttemptHandler) score(ctx context.Context, a *ent.Attempt) (*scoring.Result, error) {
+	return scoring.NewScorer(h.client, scoring.PolicyOf(a.Edges.Exam)).Score(ctx, a.ID)
+}
+
+// selectedChoices maps problem ID -> choice ID -> selected for the
+// attempt's answers.
+func selectedChoices(a *ent.Attempt) map[int]map[int]bool {
+	selected := make(map[int]map[int]bool)
+	for _, ans := range a.Edges.Answers {
+		selected[ans.ProblemID] = make(map[int]bool)
+		for _, c := range ans.Edges.Choices {
+			selected[ans.ProblemID][c.ID] = true
+		}
+	}
+	return selected
+}
+
+// responses maps problem ID -> typed answer for the attempt's answers.
+func responses(a *ent.Attempt) map[int]string {
+	out := make(map[int]string)
+	for _, ans := range a.Edges.Answers {
+		if ans.Response != "" {
+			out[ans.ProblemID] = ans.Response
+		}
+	}
+	return out
+}
+
+// applyOrders shows the choices of ORDERING problems in the order the
+// candidate last saved.
+func applyOrders(exam *view.Exam, a *ent.Attempt) {
+	orders := make(map[int][]int)
+	for _, ans := range a.Edges.Answers {
+		if len(ans.ChoiceOrder) > 0 {
+			orders[ans.ProblemID] = ans.ChoiceOrder
+		}
+	}
+	for _, s := range exam.Sections {
+		for _, u := range s.Units {
+			for _, p := range u.Problems {
+				if order, ok := orders[p.ID]; ok && p.Interaction == problem.InteractionORDERING {
+					service.SortChoices(p.Translation.Edges.Choices, order)
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,86 @@
+package handler
+
+import (
+	"examination/internal/ent"
+	"examination/internal/ent/attempt"
+	"examination/internal/features/attempt/scoring"
+	"examination/internal/features/attempt/service"
+	"examination/internal/features/attempt/ui"
+	"examination/internal/features/content/markdown"
+	"examination/internal/features/exam/i18n"
+	"examination/internal/features/exam/view"
+	"fmt"
+	"html/template"
+	"net/http"
+)
+
+// reviewPage is the template data of review.html.
+type reviewPage struct {
+	Attempt *ent.Attempt
+	Exam    *view.Exam
+	// Selected maps problem ID -> choice ID -> selected.
+	Selected map[int]map[int]bool
+	// Responses maps problem ID -> typed answer.
+	Responses map[int]string
+	// Results maps problem ID -> graded result.
+	Results map[int]scoring.ProblemResult
+	Score   *scoring.Result
+}
+
+// Review renders a submitted attempt with the correct choices and the
+// explanations, in the same order as the preview. Attempts that are still
+// in progress are redirected back to the attempt itself.
+func (h *AttemptHandler) Review(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	a, ok := h.loadAttempt(w, r)
+	if !ok {
+		return
+	}
+	if a.Status != attempt.StatusSUBMITTED {
+		http.Redirect(w, r, fmt.Sprintf("/attempts/%d", a.ID), http.StatusSeeOther)
+		return
+	}
+
+	// Review in the locale the attempt was taken in
+	prefs := append([]string{a.Locale}, i18n.Negotiate(r)...)
+	res, err := h.resolve(ctx, a)
+	if err != nil {
+		http.Error(w, "Failed to resolve edition: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	exam, err := view.Load(ctx, h.sequence, a.Edges.Exam, prefs, res, service.LayoutOf(a).Filter)
+	if err != nil {
+		http.Error(w, "Failed to load exam: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	applyOrders(exam, a)
+
+	score, err := h.score(ctx, a)
+	if err != nil {
+		http.Error(w, "Failed to score attempt: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	results := make(map[int]scoring.ProblemResult, len(score.Problems))
+	for _, pr := range score.Problems {
+		results[pr.Problem.ID] = pr
+	}
+
+	tmpl, err := template.New("review.html").Funcs(markdown.Funcs).ParseFS(ui.FS, "review.html")
+	if err != nil {
+		http.Error(w, "Failed to parse embedded template: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	data := reviewPage{
+		Attempt:   a,
+		Exam:      exam,
+		Selected:  selectedChoices(a),
+		Responses: responses(a),
+		Results:   results,
+		Score:     score,
+	}
+	if err := tmpl.Execute(w, data); err != nil {
+		http.Error(w, "Failed to render template: "+err.Error(), http.StatusInternalServerError)
+	}
+}
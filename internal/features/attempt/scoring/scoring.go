@@ -0,0 +1,334 @@
+// Package scoring grades submitted attempts against the answers their
+// problems accept: the correct choices, the choice order or the accepted
+// values, depending on the problem's interaction.
+package scoring
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"examination/internal/ent"
+	"examination/internal/ent/attempt"
+	"examination/internal/ent/problem"
+	"examination/internal/features/attempt/service"
+	contentservice "examination/internal/features/content/service"
+)
+
+// ErrNotSubmitted is returned when scoring an attempt that is still in progress.
+var ErrNotSubmitted = errors.New("attempt is not submitted")
+
+// Policy configures how answers are turned into points.
+//
+// A problem is worth its weight: 1, or Problem.difficulty when
+// WeightByDifficulty is set. A correct answer earns the full weight;
+// anything else earns nothing, except for problems with several parts to
+// get right, that is ORDERING problems and choice problems with more than
+// one correct choice:
+//   - PartialCredit awards weight * (correct selected - incorrect selected)
+//     / correct choices, or for ORDERING weight * choices in place / choices.
+//   - NegativeMarking lets a wrong answer cost points, down to -weight. Without
+//     PartialCredit a wrong answer scores -weight.
+//
+// Without NegativeMarking no problem scores below zero. Unanswered problems
+// always score zero.
+type Policy struct {
+	WeightByDifficulty bool
+	PartialCredit      bool
+	NegativeMarking    bool
+}
+
+// DefaultPolicy scores every problem all-or-nothing with equal weight.
+var DefaultPolicy = Policy{}
+
+// PolicyOf returns the scoring policy set on the exam.
+func PolicyOf(e *ent.Exam) Policy {
+	return Policy{
+		WeightByDifficulty: e.WeightByDifficulty,
+		PartialCredit:      e.PartialCredit,
+		NegativeMarking:    e.NegativeMarking,
+	}
+}
+
+// ProblemResult is the outcome of a single problem.
+type ProblemResult struct {
+	Problem *ent.Problem
+	// Number is the question number of the problem's unit.
+	Number int
+	// Section is nil for units placed directly under the exam.
+	Section  *ent.Section
+	Answered bool
+	// Correct is true when exactly the correct choices were selected, the
+	// choices were put in order, or the typed answer was accepted.
+	Correct bool
+	Points  float64
+	Max     float64
+}
+
+// SectionResult is the subtotal of the problems in a section, in exam order.
+type SectionResult struct {
+	// Section is nil for the units placed directly under the exam.
+	Section *ent.Section
+	Points  float64
+	Max     float64
+}
+
+// Result is the graded attempt.
+type Result struct {
+	Problems []ProblemResult
+	Sections []SectionResult
+	Points   float64
+	Max      float64
+}
+
+// Scorer grades attempts with a fixed policy.
+type Scorer struct {
+	client   *ent.Client
+	sequence *contentservice.SequenceLogic
+	policy   Policy
+}
+
+func NewScorer(client *ent.Client, policy Policy) *Scorer {
+	return &Scorer{
+		client:   client,
+		sequence: contentservice.NewSequenceLogic(client),
+		policy:   policy,
+	}
+}
+
+// Score grades a submitted attempt.
+func (s *Scorer) Score(ctx context.Context, attemptID int) (*Result, error) {
+	a, err := s.client.Attempt.Query().
+		Where(attempt.ID(attemptID)).
+		WithItems().
+		WithAnswers(func(aq *ent.AnswerQuery) {
+			aq.WithChoices()
+		}).
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if a.Status != attempt.StatusSUBMITTED {
+		return nil, ErrNotSubmitted
+	}
+
+	slots, err := s.sequence.Flatten(ctx, a.ExamID, func(pq *ent.ProblemQuery) {
+		pq.WithTranslations(func(tq *ent.ProblemTranslationQuery) {
+			tq.WithChoices()
+		})
+	})
+	if err != nil {
+		return nil, fmt.Errorf("loading exam %d: %w", a.ExamID, err)
+	}
+	if ed := service.EditionOf(a); ed != nil {
+		res, err := contentservice.NewVersionResolver(s.client).Resolve(ctx, a.ExamID, *ed)
+		if err != nil {
+			return nil, err
+		}
+		slots = res.Filter(slots)
+	}
+	slots = service.LayoutOf(a).Filter(slots)
+	return Grade(s.policy, slots, a.Edges.Answers), nil
+}
+
+// Grade scores answers against the problems of slots. The problems must have
+// their translations and choices loaded. Answers to problems outside slots
+// are ignored.
+func Grade(policy Policy, slots []contentservice.Slot, answers []*ent.Answer) *Result {
+	byProblem := make(map[int]*ent.Answer, len(answers))
+	for _, ans := range answers {
+		byProblem[ans.ProblemID] = ans
+	}
+
+	res := &Result{}
+	for _, slot := range slots {
+		// Consecutive slots of the same section share a subtotal
+		if n := len(res.Sections); n == 0 || res.Sections[n-1].Section != slot.Section {
+			res.Sections = append(res.Sections, SectionResult{Section: slot.Section})
+		}
+		sub := &res.Sections[len(res.Sections)-1]
+
+		for _, p := range slot.Unit.Edges.Problems {
+			pr := policy.grade(p, byProblem[p.ID])
+			pr.Number = slot.Number
+			pr.Section = slot.Section
+			res.Problems = append(res.Problems, pr)
+
+			sub.Points += pr.Points
+			sub.Max += pr.Max
+			res.Points += pr.Points
+			res.Max += pr.Max
+		}
+	}
+	return res
+}
+
+// outcome is an answer checked against its problem. Parts is the number of
+// parts there are to get right and partial the share earned by a wrong
+// answer under PartialCredit.
+type outcome struct {
+	answered, correct bool
+	parts             int
+	partial           float64
+}
+
+// grade scores one problem; ans is nil when it was not answered.
+func (p Policy) grade(prob *ent.Problem, ans *ent.Answer) ProblemResult {
+	weight := 1.0
+	if p.WeightByDifficulty && prob.Difficulty > 1 {
+		weight = float64(prob.Difficulty)
+	}
+	res := ProblemResult{Problem: prob, Max: weight}
+	if ans == nil {
+		return res
+	}
+
+	var o outcome
+	switch prob.Interaction {
+	case problem.InteractionORDERING:
+		o = checkOrder(prob, ans.ChoiceOrder)
+	case problem.InteractionNUMERIC, problem.InteractionTEXT:
+		o = checkResponse(prob, ans.Response)
+	default:
+		o = checkChoices(prob, ans.Edges.Choices)
+	}
+	if !o.answered {
+		return res
+	}
+	res.Answered = true
+
+	ratio := 0.0
+	switch {
+	case o.correct:
+		ratio = 1
+		res.Correct = true
+	case o.parts > 1 && p.PartialCredit:
+		ratio = o.partial
+	case o.parts > 1 && p.NegativeMarking:
+		ratio = -1
+	}
+	if p.NegativeMarking {
+		ratio = math.Max(ratio, -1)
+	} else {
+		ratio = math.Max(ratio, 0)
+	}
+	res.Points = ratio * weight
+	return res
+}
+
+// checkChoices checks selected choices. The correct choices are taken from
+// the translation the candidate answered in.
+func checkChoices(prob *ent.Problem, selected []*ent.Choice) outcome {
+	if len(selected) == 0 {
+		return outcome{}
+	}
+	var choices []*ent.Choice
+	for _, tr := range prob.Edges.Translations {
+		if tr.ID == selected[0].ProblemTranslationID {
+			choices = tr.Edges.Choices
+			break
+		}
+	}
+
+	isSelected := make(map[int]bool, len(selected))
+	for _, c := range selected {
+		isSelected[c.ID] = true
+	}
+	var correct, hits int
+	for _, c := range choices {
+		if c.IsCorrect {
+			correct++
+			if isSelected[c.ID] {
+				hits++
+			}
+		}
+	}
+	// Selections outside the translation's choices count as wrong
+	misses := len(selected) - hits
+
+	o := outcome{answered: true, parts: correct}
+	o.correct = correct > 0 && hits == correct && misses == 0
+	if correct > 0 {
+		o.partial = float64(hits-misses) / float64(correct)
+	}
+	return o
+}
+
+// checkOrder checks the choice seqs in the order the candidate gave them
+// against ascending seq order, which is the same in every translation.
+func checkOrder(prob *ent.Problem, order []int) outcome {
+	if len(order) == 0 {
+		return outcome{}
+	}
+	var want []int
+	for _, tr := range prob.Edges.Translations {
+		for _, c := range tr.Edges.Choices {
+			if !slices.Contains(want, c.Seq) {
+				want = append(want, c.Seq)
+			}
+		}
+	}
+	slices.Sort(want)
+
+	inPlace := 0
+	for i, seq := range order {
+		if i < len(want) && want[i] == seq {
+			inPlace++
+		}
+	}
+	o := outcome{answered: true, parts: len(want)}
+	o.correct = len(want) > 0 && inPlace == len(want) && len(order) == len(want)
+	if len(want) > 0 {
+		o.partial = float64(inPlace) / float64(len(want))
+	}
+	return o
+}
+
+// checkResponse checks a typed answer against the accepted answers of every
+// translation, so that it does not matter which one the candidate saw.
+func checkResponse(prob *ent.Problem, response string) outcome {
+	response = strings.TrimSpace(response)
+	if response == "" {
+		return outcome{}
+	}
+	o := outcome{answered: true, parts: 1}
+	for _, tr := range prob.Edges.Translations {
+		for _, c := range tr.Edges.Choices {
+			if Accepts(prob, c.Content, response) {
+				o.correct = true
+				return o
+			}
+		}
+	}
+	return o
+}
+
+// Accepts reports whether a NUMERIC or TEXT problem accepts the response
+// given the accepted answer. NUMERIC answers may be off by the problem's
+// tolerance; EXACT text ignores case and repeated spaces, and REGEX text
+// must match the whole response. Invalid accepted answers accept nothing.
+func Accepts(prob *ent.Problem, accepted, response string) bool {
+	response = strings.TrimSpace(response)
+	switch {
+	case prob.Interaction == problem.InteractionNUMERIC:
+		want, err := strconv.ParseFloat(strings.TrimSpace(accepted), 64)
+		if err != nil {
+			return false
+		}
+		got, err := strconv.ParseFloat(response, 64)
+		if err != nil {
+			return false
+		}
+		return math.Abs(got-want) <= prob.Tolerance
+	case prob.TextMatch == problem.TextMatchREGEX:
+		re, err := regexp.Compile(`^(?:` + accepted + `)$`)
+		return err == nil && re.MatchString(response)
+	default:
+		return strings.EqualFold(strings.Join(strings.Fields(accepted), " "), strings.Join(strings.Fields(response), " "))
+	}
+}
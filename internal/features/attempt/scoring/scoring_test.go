@@ -0,0 +1,229 @@
+package scoring_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"examination/internal/ent"
+	"examination/internal/ent/problem"
+	"examination/internal/features/attempt/scoring"
+	"examination/internal/features/attempt/service"
+	contentservice "examination/internal/features/content/service"
+	"examination/internal/testutil"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// seedProblem adds a unit with one problem to the section. Choices are
+// given as correctness flags; their IDs are returned in order.
+func seedProblem(t *testing.T, svc *contentservice.ContentService, examID int, sectionID *int, difficulty int, correct ...bool) (*ent.Problem, []int) {
+	ctx := context.Background()
+	u, err := svc.CreateUnit(ctx, contentservice.UnitInput{ExamID: examID, SectionID: sectionID, Title: "Unit"})
+	require.NoError(t, err)
+	p, err := svc.CreateProblem(ctx, contentservice.ProblemInput{UnitID: u.ID, Difficulty: difficulty})
+	require.NoError(t, err)
+
+	in := contentservice.ProblemTranslationInput{ProblemID: p.ID, Locale: "en", Title: "Q", Content: "Q"}
+	for i, c := range correct {
+		in.Choices = append(in.Choices, contentservice.ChoiceInput{Content: fmt.Sprint(i), IsCorrect: c, Seq: i + 1})
+	}
+	tr, err := svc.CreateProblemTranslation(ctx, in)
+	require.NoError(t, err)
+
+	var ids []int
+	for _, c := range tr.Edges.Choices {
+		ids = append(ids, c.ID)
+	}
+	return p, ids
+}
+
+func TestScorer_Policies(t *testing.T) {
+	ctx := context.Background()
+	client := testutil.NewClient(t)
+	content := contentservice.NewContentService(client)
+	attempts := service.NewAttemptService(client)
+
+	e, err := content.CreateExam(ctx, contentservice.ExamInput{Title: "Exam", TimeLimit: 30, IsActive: true})
+	require.NoError(t, err)
+	s1, err := content.CreateSection(ctx, contentservice.SectionInput{ExamID: e.ID, Title: "One"})
+	require.NoError(t, err)
+	s2, err := content.CreateSection(ctx, contentservice.SectionInput{ExamID: e.ID, Title: "Two"})
+	require.NoError(t, err)
+
+	single, singleChoices := seedProblem(t, content, e.ID, &s1.ID, 2, true, false)
+	multi, multiChoices := seedProblem(t, content, e.ID, &s2.ID, 3, true, true, false, false)
+	seedProblem(t, content, e.ID, &s2.ID, 1, true, false) // left unanswered
+
+	a, err := attempts.Start(ctx, e.ID, nil, "en", nil)
+	require.NoError(t, err)
+	_, err = scoring.NewScorer(client, scoring.DefaultPolicy).Score(ctx, a.ID)
+	assert.ErrorIs(t, err, scoring.ErrNotSubmitted)
+
+	// Single: correct. Multi: one correct and one incorrect choice.
+	_, err = attempts.SaveAnswer(ctx, a.ID, single.ID, singleChoices[:1])
+	require.NoError(t, err)
+	_, err = attempts.SaveAnswer(ctx, a.ID, multi.ID, []int{multiChoices[0], multiChoices[2]})
+	require.NoError(t, err)
+	_, err = attempts.Submit(ctx, a.ID)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name   string
+		policy scoring.Policy
+		multi  float64
+		total  float64
+		max    float64
+	}{
+		{"all or nothing", scoring.DefaultPolicy, 0, 1, 3},
+		{"weighted", scoring.Policy{WeightByDifficulty: true}, 0, 2, 6},
+		{"negative marking", scoring.Policy{NegativeMarking: true}, -1, 0, 3},
+		{"partial credit", scoring.Policy{PartialCredit: true}, 0, 1, 3},
+		{"weighted partial negative", scoring.Policy{WeightByDifficulty: true, PartialCredit: true, NegativeMarking: true}, 0, 2, 6},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := scoring.NewScorer(client, tt.policy).Score(ctx, a.ID)
+			require.NoError(t, err)
+			require.Len(t, res.Problems, 3)
+			assert.True(t, res.Problems[0].Correct)
+			assert.False(t, res.Problems[1].Correct)
+			assert.False(t, res.Problems[2].Answered)
+			assert.Equal(t, tt.multi, res.Problems[1].Points)
+			assert.Equal(t, tt.total, res.Points)
+			assert.Equal(t, tt.max, res.Max)
+
+			require.Len(t, res.Sections, 2)
+			assert.Equal(t, s1.ID, res.Sections[0].Section.ID)
+			assert.Equal(t, res.Problems[0].Points, res.Sections[0].Points)
+			assert.Equal(t, res.Problems[1].Points, res.Sections[1].Points)
+		})
+	}
+}
+
+func TestPolicyOf(t *testing.T) {
+	ctx := context.Background()
+	content := contentservice.NewContentService(testutil.NewClient(t))
+
+	e, err := content.CreateExam(ctx, contentservice.ExamInput{Title: "Exam", TimeLimit: 30})
+	require.NoError(t, err)
+	assert.Equal(t, scoring.DefaultPolicy, scoring.PolicyOf(e))
+
+	e, err = content.UpdateExam(ctx, e.ID, contentservice.ExamInput{Title: "Exam", TimeLimit: 30, WeightByDifficulty: true, NegativeMarking: true})
+	require.NoError(t, err)
+	assert.Equal(t, scoring.Policy{WeightByDifficulty: true, NegativeMarking: true}, scoring.PolicyOf(e))
+}
+
+func TestScorer_PartialCredit(t *testing.T) {
+	ctx := context.Background()
+	client := testutil.NewClient(t)
+	content := contentservice.NewContentService(client)
+	attempts := service.NewAttemptService(client)
+
+	e, err := content.CreateExam(ctx, contentservice.ExamInput{Title: "Exam", TimeLimit: 30, IsActive: true})
+	require.NoError(t, err)
+	p, choices := seedProblem(t, content, e.ID, nil, 1, true, true, false, false)
+
+	a, err := attempts.Start(ctx, e.ID, nil, "en", nil)
+	require.NoError(t, err)
+	_, err = attempts.SaveAnswer(ctx, a.ID, p.ID, choices[:1])
+	require.NoError(t, err)
+	_, err = attempts.Submit(ctx, a.ID)
+	require.NoError(t, err)
+
+	res, err := scoring.NewScorer(client, scoring.Policy{PartialCredit: true}).Score(ctx, a.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 0.5, res.Points, "one of two correct choices")
+	require.Len(t, res.Sections, 1)
+	assert.Nil(t, res.Sections[0].Section)
+}
+
+func TestAccepts(t *testing.T) {
+	numeric := &ent.Problem{Interaction: problem.InteractionNUMERIC, Tolerance: 0.05}
+	exact := &ent.Problem{Interaction: problem.InteractionTEXT, TextMatch: problem.TextMatchEXACT}
+	regex := &ent.Problem{Interaction: problem.InteractionTEXT, TextMatch: problem.TextMatchREGEX}
+
+	tests := []struct {
+		name     string
+		prob     *ent.Problem
+		accepted string
+		response string
+		want     bool
+	}{
+		{"numeric exact", numeric, "3.14", "3.14", true},
+		{"numeric within tolerance", numeric, "3.14", " 3.1 ", true},
+		{"numeric outside tolerance", numeric, "3.14", "3", false},
+		{"numeric not a number", numeric, "3.14", "pi", false},
+		{"exact ignores case and spaces", exact, "Bubble  sort", " bubble sort ", true},
+		{"exact differs", exact, "bubble sort", "merge sort", false},
+		{"regex matches", regex, "O\\(n ?log ?n\\)", "O(nlog n)", true},
+		{"regex matches whole response", regex, "log", "nlogn", false},
+		{"invalid regex accepts nothing", regex, "(", "(", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, scoring.Accepts(tt.prob, tt.accepted, tt.response))
+		})
+	}
+}
+
+func TestScorer_Interactions(t *testing.T) {
+	ctx := context.Background()
+	client := testutil.NewClient(t)
+	content := contentservice.NewContentService(client)
+	attempts := service.NewAttemptService(client)
+
+	e, err := content.CreateExam(ctx, contentservice.ExamInput{Title: "Exam", TimeLimit: 30, IsActive: true})
+	require.NoError(t, err)
+
+	// withInteraction adds a problem in a unit of its own whose choices are
+	// the given answers
+	withInteraction := func(in contentservice.ProblemInput, answers ...string) (*ent.Problem, []int) {
+		u, err := content.CreateUnit(ctx, contentservice.UnitInput{ExamID: e.ID, Title: "Unit"})
+		require.NoError(t, err)
+		in.UnitID, in.Difficulty = u.ID, 1
+		p, err := content.CreateProblem(ctx, in)
+		require.NoError(t, err)
+		tr := contentservice.ProblemTranslationInput{ProblemID: p.ID, Locale: "en", Title: "Q", Content: "Q"}
+		for i, a := range answers {
+			tr.Choices = append(tr.Choices, contentservice.ChoiceInput{Content: a, Seq: i + 1})
+		}
+		created, err := content.CreateProblemTranslation(ctx, tr)
+		require.NoError(t, err)
+		var ids []int
+		for _, c := range created.Edges.Choices {
+			ids = append(ids, c.ID)
+		}
+		return p, ids
+	}
+
+	ordering, items := withInteraction(contentservice.ProblemInput{Interaction: problem.InteractionORDERING}, "first", "second", "third", "fourth")
+	numeric, _ := withInteraction(contentservice.ProblemInput{Interaction: problem.InteractionNUMERIC, Tolerance: 0.5}, "42")
+	text, _ := withInteraction(contentservice.ProblemInput{Interaction: problem.InteractionTEXT, TextMatch: problem.TextMatchREGEX}, "colou?r")
+
+	a, err := attempts.Start(ctx, e.ID, nil, "en", nil)
+	require.NoError(t, err)
+
+	// Two of four items in place
+	_, err = attempts.SaveAnswer(ctx, a.ID, ordering.ID, []int{items[0], items[2], items[1], items[3]})
+	require.NoError(t, err)
+	_, err = attempts.SaveResponse(ctx, a.ID, numeric.ID, "42.4")
+	require.NoError(t, err)
+	_, err = attempts.SaveResponse(ctx, a.ID, text.ID, "colour")
+	require.NoError(t, err)
+	_, err = attempts.Submit(ctx, a.ID)
+	require.NoError(t, err)
+
+	res, err := scoring.NewScorer(client, scoring.DefaultPolicy).Score(ctx, a.ID)
+	require.NoError(t, err)
+	require.Len(t, res.Problems, 3)
+	assert.False(t, res.Problems[0].Correct)
+	assert.True(t, res.Problems[1].Correct)
+	assert.True(t, res.Problems[2].Correct)
+	assert.Equal(t, 2.0, res.Points)
+
+	res, err = scoring.NewScorer(client, scoring.Policy{PartialCredit: true}).Score(ctx, a.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 0.5, res.Problems[0].Points)
+}
@@ -0,0 +1,197 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"math/rand/v2"
+	"slices"
+
+	"examination/internal/ent"
+	"examination/internal/ent/problem"
+	contentservice "examination/internal/features/content/service"
+)
+
+// Layout is the exam an attempt was assembled with: one problem per
+// source-plus-variants family of each unit, and optionally a shuffled
+// choice order per problem. ORDERING problems are always shuffled, as
+// their authored order is the answer.
+type Layout struct {
+	// choiceOrder maps problem ID -> choice seqs in display order. A nil
+	// order keeps the authored order.
+	choiceOrder map[int][]int
+}
+
+// LayoutOf returns the layout of an attempt with its items loaded. It is nil
+// for attempts started before exams were assembled per attempt, which
+// include every problem.
+func LayoutOf(a *ent.Attempt) *Layout {
+	if len(a.Edges.Items) == 0 {
+		return nil
+	}
+	l := &Layout{choiceOrder: make(map[int][]int, len(a.Edges.Items))}
+	for _, it := range a.Edges.Items {
+		l.choiceOrder[it.ProblemID] = it.ChoiceOrder
+	}
+	return l
+}
+
+// Includes reports whether the problem is part of the attempt.
+// A nil Layout includes every problem.
+func (l *Layout) Includes(problemID int) bool {
+	if l == nil {
+		return true
+	}
+	_, ok := l.choiceOrder[problemID]
+	return ok
+}
+
+// Filter keeps the problems of the layout in the slots and puts the choices
+// of their translations in display order. Units left without problems are
+// dropped and the remaining units renumbered. A nil Layout returns the
+// slots unchanged.
+func (l *Layout) Filter(slots []contentservice.Slot) []contentservice.Slot {
+	if l == nil {
+		return slots
+	}
+	var kept []contentservice.Slot
+	for _, slot := range slots {
+		var problems []*ent.Problem
+		for _, p := range slot.Unit.Edges.Problems {
+			order, ok := l.choiceOrder[p.ID]
+			if !ok {
+				continue
+			}
+			if len(order) > 0 {
+				for _, t := range p.Edges.Translations {
+					SortChoices(t.Edges.Choices, order)
+				}
+			}
+			problems = append(problems, p)
+		}
+		if len(problems) == 0 {
+			continue
+		}
+		slot.Unit.Edges.Problems = problems
+		slot.Number = len(kept) + 1
+		kept = append(kept, slot)
+	}
+	return kept
+}
+
+// SortChoices orders choices by the position of their seq in order.
+// Choices whose seq is not in order go last, in seq order.
+func SortChoices(choices []*ent.Choice, order []int) {
+	pos := make(map[int]int, len(order))
+	for i, seq := range order {
+		pos[seq] = i
+	}
+	rank := func(c *ent.Choice) int {
+		if i, ok := pos[c.Seq]; ok {
+			return i
+		}
+		return len(order) + c.Seq
+	}
+	slices.SortStableFunc(choices, func(a, b *ent.Choice) int {
+		return rank(a) - rank(b)
+	})
+}
+
+// assemble picks the attempt's problems and persists them as attempt items.
+// The picks are seeded from the attempt ID, so candidates sitting side by
+// side get different exams while the same attempt always assembles the same.
+func assemble(ctx context.Context, tx *ent.Tx, a *ent.Attempt, e *ent.Exam) error {
+	slots, err := contentservice.NewSequenceLogic(tx.Client()).Flatten(ctx, e.ID, func(pq *ent.ProblemQuery) {
+		pq.WithTranslations(func(ptq *ent.ProblemTranslationQuery) {
+			ptq.WithChoices()
+		})
+	})
+	if err != nil {
+		return fmt.Errorf("loading exam %d: %w", e.ID, err)
+	}
+	if ed := EditionOf(a); ed != nil {
+		res, err := contentservice.NewVersionResolver(tx.Client()).Resolve(ctx, e.ID, *ed)
+		if err != nil {
+			return err
+		}
+		slots = res.Filter(slots)
+	}
+
+	rng := rand.New(rand.NewPCG(uint64(a.ID), uint64(e.ID)))
+	var builders []*ent.AttemptItemCreate
+	for _, slot := range slots {
+		for _, family := range families(slot.Unit.Edges.Problems) {
+			p := family[rng.IntN(len(family))]
+			item := tx.AttemptItem.Create().
+				SetAttemptID(a.ID).
+				SetProblemID(p.ID)
+			if e.ShuffleChoices || p.Interaction == problem.InteractionORDERING {
+				order := choiceSeqs(p)
+				rng.Shuffle(len(order), func(i, j int) {
+					order[i], order[j] = order[j], order[i]
+				})
+				// An ORDERING problem must not start out solved
+				if p.Interaction == problem.InteractionORDERING && slices.IsSorted(order) && len(order) > 1 {
+					order = append(order[1:], order[0])
+				}
+				item.SetChoiceOrder(order)
+			}
+			builders = append(builders, item)
+		}
+	}
+	if _, err := tx.AttemptItem.CreateBulk(builders...).Save(ctx); err != nil {
+		return fmt.Errorf("creating attempt items: %w", err)
+	}
+	return nil
+}
+
+// families groups the problems of a unit by their SOURCE problem. A variant
+// whose parent is not in the unit starts a family of its own. Families are
+// ordered by root ID and their members by ID.
+func families(problems []*ent.Problem) [][]*ent.Problem {
+	byID := make(map[int]*ent.Problem, len(problems))
+	for _, p := range problems {
+		byID[p.ID] = p
+	}
+	root := func(p *ent.Problem) int {
+		seen := map[int]bool{}
+		for p.ParentID != nil && byID[*p.ParentID] != nil && !seen[p.ID] {
+			seen[p.ID] = true
+			p = byID[*p.ParentID]
+		}
+		return p.ID
+	}
+
+	groups := make(map[int][]*ent.Problem)
+	for _, p := range problems {
+		r := root(p)
+		groups[r] = append(groups[r], p)
+	}
+	roots := make([]int, 0, len(groups))
+	for r := range groups {
+		roots = append(roots, r)
+	}
+	slices.Sort(roots)
+
+	out := make([][]*ent.Problem, 0, len(roots))
+	for _, r := range roots {
+		members := groups[r]
+		slices.SortFunc(members, func(a, b *ent.Problem) int { return a.ID - b.ID })
+		out = append(out, members)
+	}
+	return out
+}
+
+// choiceSeqs returns the distinct choice seqs across the problem's
+// translations in ascending order.
+func choiceSeqs(p *ent.Problem) []int {
+	var seqs []int
+	for _, t := range p.Edges.Translations {
+		for _, c := range t.Edges.Choices {
+			if !slices.Contains(seqs, c.Seq) {
+				seqs = append(seqs, c.Seq)
+			}
+		}
+	}
+	slices.Sort(seqs)
+	return seqs
+}
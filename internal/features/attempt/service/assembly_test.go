@@ -0,0 +1,95 @@
+package service_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"examination/internal/ent"
+	"examination/internal/ent/attemptitem"
+	"examination/internal/features/attempt/service"
+	contentservice "examination/internal/features/content/service"
+	"examination/internal/testutil"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAttemptService_AssemblesOneProblemPerFamily(t *testing.T) {
+	ctx := context.Background()
+	client := testutil.NewClient(t)
+	svc := service.NewAttemptService(client)
+	content := contentservice.NewContentService(client)
+
+	e, tr := seedExam(t, client)
+	v1, err := content.CloneVariant(ctx, tr.ProblemID)
+	require.NoError(t, err)
+	v2, err := content.CloneVariant(ctx, tr.ProblemID)
+	require.NoError(t, err)
+	family := []int{tr.ProblemID, v1.ID, v2.ID}
+
+	picked := make(map[int]bool)
+	for range 12 {
+		a, err := svc.Start(ctx, e.ID, nil, "en", nil)
+		require.NoError(t, err)
+
+		items := client.AttemptItem.Query().Where(attemptitem.AttemptID(a.ID)).AllX(ctx)
+		require.Len(t, items, 1, "one problem per family")
+		assert.Contains(t, family, items[0].ProblemID)
+		assert.Empty(t, items[0].ChoiceOrder, "choices are not shuffled by default")
+		picked[items[0].ProblemID] = true
+
+		// Only the picked problem can be answered
+		for _, id := range family {
+			_, err := svc.SaveAnswer(ctx, a.ID, id, nil)
+			if id == items[0].ProblemID {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, service.ErrInvalidAnswer)
+			}
+		}
+
+		got, err := svc.Get(ctx, a.ID)
+		require.NoError(t, err)
+		layout := service.LayoutOf(got)
+		assert.True(t, layout.Includes(items[0].ProblemID))
+	}
+	assert.Greater(t, len(picked), 1, "candidates get different variants")
+}
+
+func TestAttemptService_ShufflesChoices(t *testing.T) {
+	ctx := context.Background()
+	client := testutil.NewClient(t)
+	svc := service.NewAttemptService(client)
+	seq := contentservice.NewSequenceLogic(client)
+
+	e, tr := seedExam(t, client)
+	client.Exam.UpdateOneID(e.ID).SetShuffleChoices(true).ExecX(ctx)
+	for i := 3; i <= 6; i++ {
+		client.Choice.Create().SetProblemTranslationID(tr.ID).SetContent("X").SetSeq(i).ExecX(ctx)
+	}
+
+	orders := make(map[string]bool)
+	for range 8 {
+		a, err := svc.Start(ctx, e.ID, nil, "en", nil)
+		require.NoError(t, err)
+		item := client.AttemptItem.Query().Where(attemptitem.AttemptID(a.ID)).OnlyX(ctx)
+		assert.ElementsMatch(t, []int{1, 2, 3, 4, 5, 6}, item.ChoiceOrder)
+		orders[fmt.Sprint(item.ChoiceOrder)] = true
+
+		// The layout puts the choices in the stored order
+		got, err := svc.Get(ctx, a.ID)
+		require.NoError(t, err)
+		slots, err := seq.Flatten(ctx, e.ID, func(pq *ent.ProblemQuery) {
+			pq.WithTranslations(func(ptq *ent.ProblemTranslationQuery) { ptq.WithChoices() })
+		})
+		require.NoError(t, err)
+		slots = service.LayoutOf(got).Filter(slots)
+		var shown []int
+		for _, c := range slots[0].Unit.Edges.Problems[0].Edges.Translations[0].Edges.Choices {
+			shown = append(shown, c.Seq)
+		}
+		assert.Equal(t, item.ChoiceOrder, shown)
+	}
+	assert.Greater(t, len(orders), 1, "candidates get different choice orders")
+}
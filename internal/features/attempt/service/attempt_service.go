@@ -0,0 +1,311 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"examination/internal/dbtx"
+	"examination/internal/ent"
+	"examination/internal/ent/answer"
+	"examination/internal/ent/attempt"
+	"examination/internal/ent/choice"
+	"examination/internal/ent/problem"
+	"examination/internal/ent/problemtranslation"
+	"examination/internal/ent/unit"
+	contentservice "examination/internal/features/content/service"
+)
+
+var (
+	// ErrExamInactive is returned when starting an attempt on an inactive exam.
+	ErrExamInactive = errors.New("exam is not active")
+	// ErrAttemptSubmitted is returned when changing an attempt that was already submitted.
+	ErrAttemptSubmitted = errors.New("attempt is already submitted")
+	// ErrAttemptExpired is returned when saving an answer after the deadline.
+	ErrAttemptExpired = errors.New("attempt time limit has expired")
+	// ErrInvalidAnswer is returned when the problem is not part of the attempt's
+	// exam, a choice does not belong to the problem, or the answer is of the
+	// wrong kind for the problem's interaction.
+	ErrInvalidAnswer = errors.New("invalid answer")
+)
+
+// AttemptService manages candidate attempts at an exam: starting them,
+// saving answers as the candidate goes, and submitting them.
+type AttemptService struct {
+	client *ent.Client
+	now    func() time.Time
+}
+
+func NewAttemptService(client *ent.Client) *AttemptService {
+	return &AttemptService{client: client, now: time.Now}
+}
+
+// Start opens a new attempt at an active exam for the candidate with the
+// user ID, nil for none. The locale is the one the candidate negotiated
+// and is used to render the attempt later on.
+// The deadline is fixed at start from the exam's time limit, so later
+// edits of the exam do not move it. A nil edition includes every problem.
+// The attempt is assembled at start: one problem per source-plus-variants
+// family of each unit, see assemble.
+func (s *AttemptService) Start(ctx context.Context, examID int, userID *int, locale string, ed *contentservice.Edition) (*ent.Attempt, error) {
+	e, err := s.client.Exam.Get(ctx, examID)
+	if err != nil {
+		return nil, err
+	}
+	if !e.IsActive {
+		return nil, ErrExamInactive
+	}
+
+	var created *ent.Attempt
+	err = dbtx.WithTx(ctx, s.client, func(tx *ent.Tx) error {
+		now := s.now()
+		create := tx.Attempt.Create().
+			SetExamID(examID).
+			SetNillableUserID(userID).
+			SetLocale(locale).
+			SetStartedAt(now)
+		if e.TimeLimit > 0 {
+			create.SetExpiresAt(now.Add(time.Duration(e.TimeLimit) * time.Minute))
+		}
+		if ed != nil {
+			create.SetEditionYear(ed.Year).
+				SetEditionRound(ed.Round).
+				SetEditionCategory(ed.Category)
+		}
+		var err error
+		created, err = create.Save(ctx)
+		if err != nil {
+			return fmt.Errorf("creating attempt: %w", err)
+		}
+		return assemble(ctx, tx, created, e)
+	})
+	return created, err
+}
+
+// Get returns the attempt with its exam, items and answers (with selected choices).
+func (s *AttemptService) Get(ctx context.Context, id int) (*ent.Attempt, error) {
+	return s.client.Attempt.Query().
+		Where(attempt.ID(id)).
+		WithExam().
+		WithItems().
+		WithAnswers(func(aq *ent.AnswerQuery) {
+			aq.WithChoices()
+		}).
+		Only(ctx)
+}
+
+// SaveAnswer replaces the candidate's answer to a choice problem with the
+// given choices. For an ORDERING problem the choices are taken in the order
+// given, which is kept. An empty selection clears the answer.
+func (s *AttemptService) SaveAnswer(ctx context.Context, attemptID, problemID int, choiceIDs []int) (*ent.Answer, error) {
+	var saved *ent.Answer
+	err := dbtx.WithTx(ctx, s.client, func(tx *ent.Tx) error {
+		p, err := s.answerable(ctx, tx, attemptID, problemID)
+		if err != nil {
+			return err
+		}
+		if typed(p) && len(choiceIDs) > 0 {
+			return fmt.Errorf("%w: problem %d takes a typed answer", ErrInvalidAnswer, problemID)
+		}
+		var order []int
+		if len(choiceIDs) > 0 {
+			choices, err := tx.Choice.Query().
+				Where(choice.IDIn(choiceIDs...), choice.HasProblemTranslationWith(problemtranslation.ProblemID(problemID))).
+				All(ctx)
+			if err != nil {
+				return fmt.Errorf("querying choices: %w", err)
+			}
+			if len(choices) != len(choiceIDs) {
+				return fmt.Errorf("%w: choices %v do not belong to problem %d", ErrInvalidAnswer, choiceIDs, problemID)
+			}
+			if p.Interaction == problem.InteractionORDERING {
+				seqs := make(map[int]int, len(choices))
+				for _, c := range choices {
+					seqs[c.ID] = c.Seq
+				}
+				for _, id := range choiceIDs {
+					order = append(order, seqs[id])
+				}
+			}
+		}
+		saved, err = s.upsertAnswer(ctx, tx, attemptID, problemID, choiceIDs, order, "")
+		return err
+	})
+	return saved, err
+}
+
+// SaveResponse replaces the candidate's answer to a NUMERIC or TEXT problem
+// with the typed response. An empty response clears the answer.
+func (s *AttemptService) SaveResponse(ctx context.Context, attemptID, problemID int, response string) (*ent.Answer, error) {
+	var saved *ent.Answer
+	err := dbtx.WithTx(ctx, s.client, func(tx *ent.Tx) error {
+		p, err := s.answerable(ctx, tx, attemptID, problemID)
+		if err != nil {
+			return err
+		}
+		if !typed(p) {
+			return fmt.Errorf("%w: problem %d takes choices", ErrInvalidAnswer, problemID)
+		}
+		saved, err = s.upsertAnswer(ctx, tx, attemptID, problemID, nil, nil, strings.TrimSpace(response))
+		return err
+	})
+	return saved, err
+}
+
+// answerable returns the problem when the attempt can still be answered and
+// the problem is part of it.
+func (s *AttemptService) answerable(ctx context.Context, tx *ent.Tx, attemptID, problemID int) (*ent.Problem, error) {
+	a, err := tx.Attempt.Get(ctx, attemptID)
+	if err != nil {
+		return nil, err
+	}
+	if a.Status != attempt.StatusIN_PROGRESS {
+		return nil, ErrAttemptSubmitted
+	}
+	if Expired(a, s.now()) {
+		return nil, ErrAttemptExpired
+	}
+
+	// The problem must be part of the exam, its edition and the assembly
+	p, err := tx.Problem.Query().
+		Where(problem.ID(problemID), problem.HasUnitWith(unit.ExamID(a.ExamID))).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, fmt.Errorf("%w: problem %d is not part of exam %d", ErrInvalidAnswer, problemID, a.ExamID)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("querying problem: %w", err)
+	}
+	if ed := EditionOf(a); ed != nil {
+		res, err := contentservice.NewVersionResolver(tx.Client()).Resolve(ctx, a.ExamID, *ed)
+		if err != nil {
+			return nil, err
+		}
+		if !res.Includes(problemID) {
+			return nil, fmt.Errorf("%w: problem %d is not part of edition %s", ErrInvalidAnswer, problemID, ed)
+		}
+	}
+	items, err := a.QueryItems().All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("querying attempt items: %w", err)
+	}
+	a.Edges.Items = items
+	if !LayoutOf(a).Includes(problemID) {
+		return nil, fmt.Errorf("%w: problem %d was not assembled into attempt %d", ErrInvalidAnswer, problemID, attemptID)
+	}
+	return p, nil
+}
+
+// upsertAnswer creates or replaces the answer of the attempt to the problem.
+func (s *AttemptService) upsertAnswer(ctx context.Context, tx *ent.Tx, attemptID, problemID int, choiceIDs, order []int, response string) (*ent.Answer, error) {
+	existing, err := tx.Answer.Query().
+		Where(answer.AttemptID(attemptID), answer.ProblemID(problemID)).
+		Only(ctx)
+	var saved *ent.Answer
+	switch {
+	case ent.IsNotFound(err):
+		create := tx.Answer.Create().
+			SetAttemptID(attemptID).
+			SetProblemID(problemID).
+			SetUpdatedAt(s.now()).
+			SetResponse(response).
+			AddChoiceIDs(choiceIDs...)
+		if len(order) > 0 {
+			create.SetChoiceOrder(order)
+		}
+		saved, err = create.Save(ctx)
+	case err == nil:
+		update := existing.Update().
+			SetUpdatedAt(s.now()).
+			SetResponse(response).
+			ClearChoices().
+			AddChoiceIDs(choiceIDs...)
+		if len(order) > 0 {
+			update.SetChoiceOrder(order)
+		} else {
+			update.ClearChoiceOrder()
+		}
+		saved, err = update.Save(ctx)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("saving answer: %w", err)
+	}
+	return saved, nil
+}
+
+// typed reports whether the problem is answered by typing rather than by
+// choosing.
+func typed(p *ent.Problem) bool {
+	return p.Interaction == problem.InteractionNUMERIC || p.Interaction == problem.InteractionTEXT
+}
+
+// Submit closes the attempt. Answers can no longer be changed afterwards.
+// Submitting after the deadline is allowed; only the answers saved in time count.
+func (s *AttemptService) Submit(ctx context.Context, id int) (*ent.Attempt, error) {
+	var submitted *ent.Attempt
+	err := dbtx.WithTx(ctx, s.client, func(tx *ent.Tx) error {
+		a, err := tx.Attempt.Get(ctx, id)
+		if err != nil {
+			return err
+		}
+		if a.Status != attempt.StatusIN_PROGRESS {
+			return ErrAttemptSubmitted
+		}
+		submitted, err = a.Update().
+			SetStatus(attempt.StatusSUBMITTED).
+			SetSubmittedAt(s.now()).
+			Save(ctx)
+		if err != nil {
+			return fmt.Errorf("submitting attempt %d: %w", id, err)
+		}
+		return nil
+	})
+	return submitted, err
+}
+
+// SubmitExpired auto-submits every in-progress attempt whose deadline has
+// passed and returns how many were submitted. Each attempt is submitted on
+// its own so that one failure does not hold back the others.
+func (s *AttemptService) SubmitExpired(ctx context.Context) (int, error) {
+	now := s.now()
+	ids, err := s.client.Attempt.Query().
+		Where(attempt.StatusEQ(attempt.StatusIN_PROGRESS), attempt.ExpiresAtLTE(now)).
+		IDs(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("querying expired attempts: %w", err)
+	}
+
+	submitted := 0
+	var errs []error
+	for _, id := range ids {
+		// Conditional update: a candidate may submit concurrently
+		n, err := s.client.Attempt.Update().
+			Where(attempt.ID(id), attempt.StatusEQ(attempt.StatusIN_PROGRESS)).
+			SetStatus(attempt.StatusSUBMITTED).
+			SetSubmittedAt(now).
+			SetAutoSubmitted(true).
+			Save(ctx)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("auto-submitting attempt %d: %w", id, err))
+			continue
+		}
+		submitted += n
+	}
+	return submitted, errors.Join(errs...)
+}
+
+// EditionOf returns the edition the attempt was started for, or nil when it
+// includes every problem.
+func EditionOf(a *ent.Attempt) *contentservice.Edition {
+	if a.EditionYear == nil {
+		return nil
+	}
+	return &contentservice.Edition{Year: *a.EditionYear, Round: a.EditionRound, Category: a.EditionCategory}
+}
+
+// Expired reports whether the attempt's deadline has passed at now.
+func Expired(a *ent.Attempt, now time.Time) bool {
+	return a.ExpiresAt != nil && !now.Before(*a.ExpiresAt)
+}
@@ -0,0 +1,207 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"examination/internal/ent"
+	"examination/internal/ent/attempt"
+	"examination/internal/ent/attemptitem"
+	"examination/internal/ent/problem"
+	"examination/internal/ent/versionrule"
+	"examination/internal/features/attempt/service"
+	contentservice "examination/internal/features/content/service"
+	"examination/internal/testutil"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// seedExam creates an active exam with a single two-choice problem and
+// returns the exam and the problem's translation.
+func seedExam(t *testing.T, client *ent.Client) (*ent.Exam, *ent.ProblemTranslation) {
+	ctx := context.Background()
+	svc := contentservice.NewContentService(client)
+
+	e, err := svc.CreateExam(ctx, contentservice.ExamInput{Title: "Exam", TimeLimit: 30, IsActive: true})
+	require.NoError(t, err)
+	u, err := svc.CreateUnit(ctx, contentservice.UnitInput{ExamID: e.ID, Title: "Unit"})
+	require.NoError(t, err)
+	p, err := svc.CreateProblem(ctx, contentservice.ProblemInput{UnitID: u.ID, Difficulty: 1})
+	require.NoError(t, err)
+	tr, err := svc.CreateProblemTranslation(ctx, contentservice.ProblemTranslationInput{
+		ProblemID: p.ID,
+		Locale:    "en",
+		Title:     "Question",
+		Content:   "Pick one.",
+		Choices: []contentservice.ChoiceInput{
+			{Content: "A", IsCorrect: true, Seq: 1},
+			{Content: "B", Seq: 2},
+		},
+	})
+	require.NoError(t, err)
+	return e, tr
+}
+
+func TestAttemptService_SaveAndSubmit(t *testing.T) {
+	ctx := context.Background()
+	client := testutil.NewClient(t)
+	svc := service.NewAttemptService(client)
+	e, tr := seedExam(t, client)
+
+	a, err := svc.Start(ctx, e.ID, nil, "en", nil)
+	require.NoError(t, err)
+	assert.Equal(t, attempt.StatusIN_PROGRESS, a.Status)
+
+	choices := tr.Edges.Choices
+	_, err = svc.SaveAnswer(ctx, a.ID, tr.ProblemID, []int{choices[0].ID})
+	require.NoError(t, err)
+	_, err = svc.SaveAnswer(ctx, a.ID, tr.ProblemID, []int{choices[1].ID})
+	require.NoError(t, err)
+
+	got, err := svc.Get(ctx, a.ID)
+	require.NoError(t, err)
+	require.Len(t, got.Edges.Answers, 1, "answers are upserted per problem")
+	require.Len(t, got.Edges.Answers[0].Edges.Choices, 1)
+	assert.Equal(t, choices[1].ID, got.Edges.Answers[0].Edges.Choices[0].ID)
+
+	submitted, err := svc.Submit(ctx, a.ID)
+	require.NoError(t, err)
+	assert.Equal(t, attempt.StatusSUBMITTED, submitted.Status)
+	assert.NotNil(t, submitted.SubmittedAt)
+
+	_, err = svc.SaveAnswer(ctx, a.ID, tr.ProblemID, []int{choices[0].ID})
+	assert.ErrorIs(t, err, service.ErrAttemptSubmitted)
+	_, err = svc.Submit(ctx, a.ID)
+	assert.ErrorIs(t, err, service.ErrAttemptSubmitted)
+}
+
+func TestAttemptService_RejectsInvalidAnswers(t *testing.T) {
+	ctx := context.Background()
+	client := testutil.NewClient(t)
+	svc := service.NewAttemptService(client)
+	e, tr := seedExam(t, client)
+	_, other := seedExam(t, client)
+
+	a, err := svc.Start(ctx, e.ID, nil, "en", nil)
+	require.NoError(t, err)
+
+	_, err = svc.SaveAnswer(ctx, a.ID, other.ProblemID, nil)
+	assert.ErrorIs(t, err, service.ErrInvalidAnswer, "problem of another exam")
+
+	_, err = svc.SaveAnswer(ctx, a.ID, tr.ProblemID, []int{other.Edges.Choices[0].ID})
+	assert.ErrorIs(t, err, service.ErrInvalidAnswer, "choice of another problem")
+}
+
+func TestAttemptService_AnswerKinds(t *testing.T) {
+	ctx := context.Background()
+	client := testutil.NewClient(t)
+	svc := service.NewAttemptService(client)
+	e, tr := seedExam(t, client)
+	choices := tr.Edges.Choices
+
+	a, err := svc.Start(ctx, e.ID, nil, "en", nil)
+	require.NoError(t, err)
+	_, err = svc.SaveResponse(ctx, a.ID, tr.ProblemID, "A")
+	assert.ErrorIs(t, err, service.ErrInvalidAnswer, "typed answer to a choice problem")
+
+	// ORDERING problems start shuffled and keep the order given
+	client.Problem.UpdateOneID(tr.ProblemID).SetInteraction(problem.InteractionORDERING).ExecX(ctx)
+	a, err = svc.Start(ctx, e.ID, nil, "en", nil)
+	require.NoError(t, err)
+	item := client.AttemptItem.Query().Where(attemptitem.AttemptID(a.ID)).OnlyX(ctx)
+	assert.Equal(t, []int{2, 1}, item.ChoiceOrder, "an ordering problem does not start solved")
+	saved, err := svc.SaveAnswer(ctx, a.ID, tr.ProblemID, []int{choices[1].ID, choices[0].ID})
+	require.NoError(t, err)
+	assert.Equal(t, []int{2, 1}, saved.ChoiceOrder)
+
+	// TEXT problems take a trimmed response and no choices
+	client.Problem.UpdateOneID(tr.ProblemID).SetInteraction(problem.InteractionTEXT).ExecX(ctx)
+	a, err = svc.Start(ctx, e.ID, nil, "en", nil)
+	require.NoError(t, err)
+	_, err = svc.SaveAnswer(ctx, a.ID, tr.ProblemID, []int{choices[0].ID})
+	assert.ErrorIs(t, err, service.ErrInvalidAnswer, "choices for a typed problem")
+	saved, err = svc.SaveResponse(ctx, a.ID, tr.ProblemID, "  B ")
+	require.NoError(t, err)
+	assert.Equal(t, "B", saved.Response)
+}
+
+func TestAttemptService_StartRequiresActiveExam(t *testing.T) {
+	ctx := context.Background()
+	client := testutil.NewClient(t)
+	svc := service.NewAttemptService(client)
+	e, _ := seedExam(t, client)
+	client.Exam.UpdateOneID(e.ID).SetIsActive(false).ExecX(ctx)
+
+	_, err := svc.Start(ctx, e.ID, nil, "en", nil)
+	assert.ErrorIs(t, err, service.ErrExamInactive)
+}
+
+func TestAttemptService_TimeLimit(t *testing.T) {
+	ctx := context.Background()
+	client := testutil.NewClient(t)
+	svc := service.NewAttemptService(client)
+	e, tr := seedExam(t, client)
+
+	a, err := svc.Start(ctx, e.ID, nil, "en", nil)
+	require.NoError(t, err)
+	require.NotNil(t, a.ExpiresAt)
+	assert.Equal(t, 30*time.Minute, a.ExpiresAt.Sub(a.StartedAt))
+
+	fresh, err := svc.Start(ctx, e.ID, nil, "en", nil)
+	require.NoError(t, err)
+
+	// Move the deadline into the past
+	client.Attempt.UpdateOneID(a.ID).SetExpiresAt(time.Now().Add(-time.Minute)).ExecX(ctx)
+
+	_, err = svc.SaveAnswer(ctx, a.ID, tr.ProblemID, []int{tr.Edges.Choices[0].ID})
+	assert.ErrorIs(t, err, service.ErrAttemptExpired)
+
+	n, err := svc.SubmitExpired(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, n)
+
+	got := client.Attempt.GetX(ctx, a.ID)
+	assert.Equal(t, attempt.StatusSUBMITTED, got.Status)
+	assert.True(t, got.AutoSubmitted)
+	assert.NotNil(t, got.SubmittedAt)
+	assert.Equal(t, attempt.StatusIN_PROGRESS, client.Attempt.GetX(ctx, fresh.ID).Status)
+
+	n, err = svc.SubmitExpired(ctx)
+	require.NoError(t, err)
+	assert.Zero(t, n, "already submitted attempts are skipped")
+}
+
+func TestAttemptService_UntimedExam(t *testing.T) {
+	ctx := context.Background()
+	client := testutil.NewClient(t)
+	svc := service.NewAttemptService(client)
+	e, _ := seedExam(t, client)
+	client.Exam.UpdateOneID(e.ID).SetTimeLimit(0).ExecX(ctx)
+
+	a, err := svc.Start(ctx, e.ID, nil, "en", nil)
+	require.NoError(t, err)
+	assert.Nil(t, a.ExpiresAt)
+}
+
+func TestAttemptService_Edition(t *testing.T) {
+	ctx := context.Background()
+	client := testutil.NewClient(t)
+	svc := service.NewAttemptService(client)
+	e, tr := seedExam(t, client)
+	client.VersionRule.Create().SetExamID(e.ID).SetProblemID(tr.ProblemID).
+		SetYear(2025).SetOperator(versionrule.OperatorGreaterEqual).SaveX(ctx)
+
+	a, err := svc.Start(ctx, e.ID, nil, "en", &contentservice.Edition{Year: 2024, Round: 2})
+	require.NoError(t, err)
+	assert.Equal(t, &contentservice.Edition{Year: 2024, Round: 2}, service.EditionOf(a))
+
+	_, err = svc.SaveAnswer(ctx, a.ID, tr.ProblemID, []int{tr.Edges.Choices[0].ID})
+	assert.ErrorIs(t, err, service.ErrInvalidAnswer, "problem is not part of the edition")
+
+	a, err = svc.Start(ctx, e.ID, nil, "en", &contentservice.Edition{Year: 2025})
+	require.NoError(t, err)
+	_, err = svc.SaveAnswer(ctx, a.ID, tr.ProblemID, []int{tr.Edges.Choices[0].ID})
+	assert.NoError(t, err)
+}
@@ -0,0 +1,45 @@
+package service
+
+import (
+	"context"
+	"log"
+	"time"
+)
+
+// Sweeper periodically auto-submits attempts whose deadline has passed.
+type Sweeper struct {
+	attempts *AttemptService
+	interval time.Duration
+}
+
+func NewSweeper(attempts *AttemptService, interval time.Duration) *Sweeper {
+	return &Sweeper{attempts: attempts, interval: interval}
+}
+
+// Run sweeps every interval until ctx is cancelled. A sweep that is already
+// running when ctx is cancelled is allowed to finish, so callers waiting on
+// Run during shutdown never lose an in-flight auto-submit.
+func (s *Sweeper) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			log.Println("Sweeper: stopped.")
+			return
+		case <-ticker.C:
+			s.sweep(context.WithoutCancel(ctx))
+		}
+	}
+}
+
+func (s *Sweeper) sweep(ctx context.Context) {
+	n, err := s.attempts.SubmitExpired(ctx)
+	if err != nil {
+		log.Printf("Sweeper: %v", err)
+	}
+	if n > 0 {
+		log.Printf("Sweeper: auto-submitted %d expired attempt(s).", n)
+	}
+}
@@ -0,0 +1,6 @@
+package ui
+
+import "embed"
+
+//go:embed *.html
+var FS embed.FS
@@ -0,0 +1,125 @@
+package handler
+
+import (
+	"errors"
+	"examination/internal/ent"
+	"examination/internal/features/auth/service"
+	"examination/internal/features/auth/ui"
+	"html/template"
+	"log"
+	"net/http"
+	"strings"
+)
+
+// sessionCookie holds the session token.
+const sessionCookie = "session"
+
+// loginPage is the template data of login.html.
+type loginPage struct {
+	Email string
+	// Next is the path to return to after logging in.
+	Next  string
+	Error string
+}
+
+// AuthHandler logs users in and out and provides the middleware that
+// restricts routes by role.
+type AuthHandler struct {
+	client *ent.Client
+	auth   *service.AuthService
+}
+
+func NewAuthHandler(client *ent.Client) *AuthHandler {
+	return &AuthHandler{client: client, auth: service.NewAuthService(client)}
+}
+
+// LoginForm renders the login form.
+func (h *AuthHandler) LoginForm(w http.ResponseWriter, r *http.Request) {
+	h.render(w, http.StatusOK, loginPage{Next: safeNext(r.URL.Query().Get("next"))})
+}
+
+// Login checks the credentials, sets the session cookie and returns to
+// the page asked for.
+func (h *AuthHandler) Login(w http.ResponseWriter, r *http.Request) {
+	page := loginPage{
+		Email: strings.TrimSpace(r.PostFormValue("email")),
+		Next:  safeNext(r.PostFormValue("next")),
+	}
+	u, err := h.auth.Authenticate(r.Context(), page.Email, r.PostFormValue("password"))
+	if err != nil {
+		if errors.Is(err, service.ErrInvalidCredentials) {
+			page.Error = "Invalid email or password."
+			h.render(w, http.StatusUnauthorized, page)
+			return
+		}
+		http.Error(w, "Failed to log in: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	token, expires, err := h.auth.StartSession(r.Context(), u.ID)
+	if err != nil {
+		http.Error(w, "Failed to log in: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	http.SetCookie(w, &http.Cookie{
+		Name:     sessionCookie,
+		Value:    token,
+		Path:     "/",
+		Expires:  expires,
+		HttpOnly: true,
+		Secure:   secure(r),
+		SameSite: http.SameSiteLaxMode,
+	})
+	http.Redirect(w, r, page.Next, http.StatusSeeOther)
+}
+
+// Logout ends the session and returns to the login page.
+func (h *AuthHandler) Logout(w http.ResponseWriter, r *http.Request) {
+	if c, err := r.Cookie(sessionCookie); err == nil {
+		if err := h.auth.EndSession(r.Context(), c.Value); err != nil {
+			log.Printf("ending session: %v", err)
+		}
+	}
+	clearSessionCookie(w, r)
+	http.Redirect(w, r, "/login", http.StatusSeeOther)
+}
+
+func (h *AuthHandler) render(w http.ResponseWriter, status int, data loginPage) {
+	tmpl, err := template.ParseFS(ui.FS, "login.html")
+	if err != nil {
+		http.Error(w, "Failed to parse embedded template: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.WriteHeader(status)
+	if err := tmpl.Execute(w, data); err != nil {
+		log.Printf("rendering login: %v", err)
+	}
+}
+
+func clearSessionCookie(w http.ResponseWriter, r *http.Request) {
+	http.SetCookie(w, &http.Cookie{
+		Name:     sessionCookie,
+		Value:    "",
+		Path:     "/",
+		MaxAge:   -1,
+		HttpOnly: true,
+		Secure:   secure(r),
+		SameSite: http.SameSiteLaxMode,
+	})
+}
+
+// secure reports whether the request reached the server, or the proxy in
+// front of it, over HTTPS.
+func secure(r *http.Request) bool {
+	return r.TLS != nil || r.Header.Get("X-Forwarded-Proto") == "https"
+}
+
+// safeNext returns the path to return to after logging in. Anything but a
+// local path, which could send the user to another site, becomes "/".
+func safeNext(next string) string {
+	if !strings.HasPrefix(next, "/") || strings.HasPrefix(next, "//") || strings.HasPrefix(next, "/\\") {
+		return "/"
+	}
+	return next
+}
@@ -0,0 +1,55 @@
+package handler_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"examination/internal/features/auth/handler"
+	"examination/internal/features/auth/service"
+	"examination/internal/testutil"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuthHandler_LoginReturnsToLocalPathsOnly(t *testing.T) {
+	client := testutil.NewClient(t)
+	_, err := service.NewAuthService(client).SaveUser(context.Background(), service.UserInput{
+		Email: "a@example.com", Name: "A", Password: "password1", Roles: []string{service.RoleCandidate},
+	})
+	require.NoError(t, err)
+	h := handler.NewAuthHandler(client)
+
+	cases := []struct {
+		next, want string
+	}{
+		{"/exams/1?x=y", "/exams/1?x=y"},
+		{"", "/"},
+		{"//evil.example", "/"},
+		{"/\\evil.example", "/"},
+		{"https://evil.example/", "/"},
+		{"javascript:alert(1)", "/"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.next, func(t *testing.T) {
+			form := url.Values{"email": {"a@example.com"}, "password": {"password1"}, "next": {tc.next}}
+			req := httptest.NewRequest(http.MethodPost, "/login", strings.NewReader(form.Encode()))
+			req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+			rec := httptest.NewRecorder()
+			h.Login(rec, req)
+
+			assert.Equal(t, http.StatusSeeOther, rec.Code)
+			assert.Equal(t, tc.want, rec.Header().Get("Location"))
+		})
+	}
+
+	// The form carries the same check into its hidden field
+	rec := httptest.NewRecorder()
+	h.LoginForm(rec, httptest.NewRequest(http.MethodGet, "/login?next="+url.QueryEscape("//evil.example"), nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.NotContains(t, rec.Body.String(), "evil.example")
+}
@@ -0,0 +1,110 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"examination/internal/ent"
+	"examination/internal/features/auth/service"
+	"log"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+)
+
+type userKey struct{}
+
+// UserFrom returns the logged-in user, with its roles, or nil for an
+// anonymous request.
+func UserFrom(ctx context.Context) *ent.User {
+	u, _ := ctx.Value(userKey{}).(*ent.User)
+	return u
+}
+
+// Session attaches the user of the session cookie to the request context.
+// A stale cookie is cleared and the request continues anonymously.
+func (h *AuthHandler) Session(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		c, err := r.Cookie(sessionCookie)
+		if err != nil {
+			next.ServeHTTP(w, r)
+			return
+		}
+		u, err := h.auth.SessionUser(r.Context(), c.Value)
+		if err != nil {
+			if !errors.Is(err, service.ErrInvalidSession) {
+				log.Printf("loading session: %v", err)
+			}
+			clearSessionCookie(w, r)
+			next.ServeHTTP(w, r)
+			return
+		}
+		next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), userKey{}, u)))
+	})
+}
+
+// RequireRole lets through users holding any of the roles. Anonymous
+// requests are sent to the login page, other users get 403.
+func RequireRole(roles ...string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			u := UserFrom(r.Context())
+			switch {
+			case u == nil:
+				toLogin(w, r)
+			case !service.HasRole(u, roles...):
+				http.Error(w, "Forbidden", http.StatusForbidden)
+			default:
+				next.ServeHTTP(w, r)
+			}
+		})
+	}
+}
+
+// RequireStaffForInactive restricts routes of an inactive exam, identified
+// by the {examID} URL parameter, to staff. Active exams are public.
+func (h *AuthHandler) RequireStaffForInactive(next http.Handler) http.Handler {
+	staffOnly := RequireRole(service.StaffRoles...)(next)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		examID, err := strconv.Atoi(chi.URLParam(r, "examID"))
+		if err != nil || examID <= 0 {
+			http.Error(w, "Invalid exam ID", http.StatusBadRequest)
+			return
+		}
+		e, err := h.client.Exam.Get(r.Context(), examID)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				http.Error(w, "Exam not found", http.StatusNotFound)
+				return
+			}
+			http.Error(w, "Failed to load exam: "+err.Error(), http.StatusInternalServerError)
+			return
+		}
+		if !e.IsActive {
+			staffOnly.ServeHTTP(w, r)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// toLogin sends the request to the login page, which returns to the page
+// asked for. HTMX requests get a full page load; requests other than GET
+// return to the referring page.
+func toLogin(w http.ResponseWriter, r *http.Request) {
+	back := r.URL.RequestURI()
+	if r.Method != http.MethodGet {
+		back = "/"
+		if ref, err := url.Parse(r.Referer()); err == nil && ref.Host == r.Host {
+			back = ref.RequestURI()
+		}
+	}
+	loginURL := "/login?next=" + url.QueryEscape(back)
+	if r.Header.Get("HX-Request") != "" {
+		w.Header().Set("HX-Redirect", loginURL)
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+	http.Redirect(w, r, loginURL, http.StatusSeeOther)
+}
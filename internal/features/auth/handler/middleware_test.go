@@ -0,0 +1,109 @@
+package handler_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"examination/internal/ent"
+	"examination/internal/features/auth/handler"
+	"examination/internal/features/auth/service"
+	"examination/internal/testutil"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// sessions returns a session token for a new user holding role.
+func sessions(t *testing.T, client *ent.Client) func(role string) string {
+	auth := service.NewAuthService(client)
+	return func(role string) string {
+		ctx := context.Background()
+		u, err := auth.SaveUser(ctx, service.UserInput{Email: role + "@example.com", Name: role, Password: "password1", Roles: []string{role}})
+		require.NoError(t, err)
+		token, _, err := auth.StartSession(ctx, u.ID)
+		require.NoError(t, err)
+		return token
+	}
+}
+
+var ok = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusOK)
+})
+
+func serve(h http.Handler, req *http.Request, token string) *httptest.ResponseRecorder {
+	if token != "" {
+		req.AddCookie(&http.Cookie{Name: "session", Value: token})
+	}
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestRequireRole(t *testing.T) {
+	client := testutil.NewClient(t)
+	session := sessions(t, client)
+	staff := handler.NewAuthHandler(client).Session(handler.RequireRole(service.StaffRoles...)(ok))
+
+	t.Run("anonymous", func(t *testing.T) {
+		rec := serve(staff, httptest.NewRequest(http.MethodGet, "/admin/exams/1?tab=x", nil), "")
+		assert.Equal(t, http.StatusSeeOther, rec.Code)
+		assert.Equal(t, "/login?next=%2Fadmin%2Fexams%2F1%3Ftab%3Dx", rec.Header().Get("Location"))
+	})
+	t.Run("anonymous htmx", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPut, "http://example.com/admin/exams/1", nil)
+		req.Header.Set("HX-Request", "true")
+		req.Header.Set("Referer", "http://example.com/admin/exams/1")
+		rec := serve(staff, req, "")
+		assert.Equal(t, http.StatusUnauthorized, rec.Code)
+		assert.Equal(t, "/login?next=%2Fadmin%2Fexams%2F1", rec.Header().Get("HX-Redirect"))
+		assert.Empty(t, rec.Header().Get("Location"))
+	})
+	t.Run("foreign referer", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPost, "http://example.com/admin/exams", nil)
+		req.Header.Set("Referer", "http://evil.example/phish")
+		rec := serve(staff, req, "")
+		assert.Equal(t, "/login?next=%2F", rec.Header().Get("Location"))
+	})
+	t.Run("wrong role", func(t *testing.T) {
+		rec := serve(staff, httptest.NewRequest(http.MethodGet, "/admin", nil), session(service.RoleCandidate))
+		assert.Equal(t, http.StatusForbidden, rec.Code)
+	})
+	t.Run("stale session", func(t *testing.T) {
+		rec := serve(staff, httptest.NewRequest(http.MethodGet, "/admin", nil), "stale")
+		assert.Equal(t, http.StatusSeeOther, rec.Code)
+	})
+	for _, role := range service.StaffRoles {
+		t.Run(role, func(t *testing.T) {
+			rec := serve(staff, httptest.NewRequest(http.MethodGet, "/admin", nil), session(role))
+			assert.Equal(t, http.StatusOK, rec.Code)
+		})
+	}
+}
+
+func TestRequireStaffForInactive(t *testing.T) {
+	ctx := context.Background()
+	client := testutil.NewClient(t)
+	session := sessions(t, client)
+	active := client.Exam.Create().SetTitle("Open").SetTimeLimit(30).SaveX(ctx)
+	inactive := client.Exam.Create().SetTitle("Draft").SetTimeLimit(30).SetIsActive(false).SaveX(ctx)
+
+	h := handler.NewAuthHandler(client)
+	r := chi.NewRouter()
+	r.Use(h.Session)
+	r.With(h.RequireStaffForInactive).Get("/exams/{examID}/preview", ok)
+	preview := func(id int) *http.Request {
+		return httptest.NewRequest(http.MethodGet, "/exams/"+strconv.Itoa(id)+"/preview", nil)
+	}
+
+	candidate := session(service.RoleCandidate)
+	assert.Equal(t, http.StatusOK, serve(r, preview(active.ID), "").Code)
+	assert.Equal(t, http.StatusOK, serve(r, preview(active.ID), candidate).Code)
+	assert.Equal(t, http.StatusSeeOther, serve(r, preview(inactive.ID), "").Code)
+	assert.Equal(t, http.StatusForbidden, serve(r, preview(inactive.ID), candidate).Code)
+	assert.Equal(t, http.StatusOK, serve(r, preview(inactive.ID), session(service.RoleAuthor)).Code)
+	assert.Equal(t, http.StatusNotFound, serve(r, preview(inactive.ID+1), "").Code)
+}
@@ -0,0 +1,272 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"examination/internal/dbtx"
+	"examination/internal/ent"
+	"examination/internal/ent/role"
+	"examination/internal/ent/session"
+	"examination/internal/ent/user"
+)
+
+// Roles a user can hold. Authors and admins are staff: they write content
+// and see inactive exams. Only candidates take attempts; a user may hold
+// several roles.
+const (
+	RoleAdmin     = "admin"
+	RoleAuthor    = "author"
+	RoleCandidate = "candidate"
+)
+
+// Roles lists every role.
+var Roles = []string{RoleAdmin, RoleAuthor, RoleCandidate}
+
+// StaffRoles lists the roles allowed to author content.
+var StaffRoles = []string{RoleAdmin, RoleAuthor}
+
+// SessionTTL is how long a login lasts.
+const SessionTTL = 7 * 24 * time.Hour
+
+var (
+	// ErrInvalidCredentials is returned when the email or password is wrong.
+	// Which one is not told.
+	ErrInvalidCredentials = errors.New("invalid email or password")
+	// ErrInvalidSession is returned for an unknown or expired session token.
+	ErrInvalidSession = errors.New("invalid or expired session")
+	// ErrInvalidUser is returned when saving a user with an invalid email,
+	// a short password or an unknown role.
+	ErrInvalidUser = errors.New("invalid user")
+)
+
+// dummyHash is checked against when the email is unknown, so that a login
+// takes as long whether or not the user exists.
+var dummyHash = sync.OnceValue(func() string {
+	hash, _ := HashPassword("not a password")
+	return hash
+})
+
+// AuthService manages users, their roles and login sessions. Sessions are
+// identified by a random token kept in a cookie; only its SHA-256 is
+// stored.
+type AuthService struct {
+	client *ent.Client
+	now    func() time.Time
+}
+
+func NewAuthService(client *ent.Client) *AuthService {
+	return &AuthService{client: client, now: time.Now}
+}
+
+// UserInput holds the writable fields of a User. Emails are matched
+// case-insensitively.
+type UserInput struct {
+	Email    string
+	Name     string
+	Password string
+	Roles    []string
+}
+
+// SaveUser creates the user with the email or, when one exists, replaces
+// its name, password and roles and ends its sessions. Roles are created on
+// first use.
+func (s *AuthService) SaveUser(ctx context.Context, in UserInput) (*ent.User, error) {
+	email := normalizeEmail(in.Email)
+	if !strings.Contains(email, "@") {
+		return nil, fmt.Errorf("%w: email %q", ErrInvalidUser, in.Email)
+	}
+	if len(in.Password) < minPasswordBytes {
+		return nil, fmt.Errorf("%w: password must be at least %d characters", ErrInvalidUser, minPasswordBytes)
+	}
+	for _, r := range in.Roles {
+		if !slices.Contains(Roles, r) {
+			return nil, fmt.Errorf("%w: unknown role %q", ErrInvalidUser, r)
+		}
+	}
+	hash, err := HashPassword(in.Password)
+	if err != nil {
+		return nil, err
+	}
+
+	var saved *ent.User
+	err = dbtx.WithTx(ctx, s.client, func(tx *ent.Tx) error {
+		roleIDs, err := ensureRoles(ctx, tx, in.Roles)
+		if err != nil {
+			return err
+		}
+		existing, err := tx.User.Query().Where(user.Email(email)).Only(ctx)
+		switch {
+		case ent.IsNotFound(err):
+			saved, err = tx.User.Create().
+				SetEmail(email).
+				SetName(in.Name).
+				SetPasswordHash(hash).
+				SetCreatedAt(s.now()).
+				AddRoleIDs(roleIDs...).
+				Save(ctx)
+			if err != nil {
+				return fmt.Errorf("creating user: %w", err)
+			}
+		case err != nil:
+			return fmt.Errorf("querying user: %w", err)
+		default:
+			saved, err = existing.Update().
+				SetName(in.Name).
+				SetPasswordHash(hash).
+				ClearRoles().
+				AddRoleIDs(roleIDs...).
+				Save(ctx)
+			if err != nil {
+				return fmt.Errorf("updating user %d: %w", existing.ID, err)
+			}
+			if _, err := tx.Session.Delete().Where(session.UserID(existing.ID)).Exec(ctx); err != nil {
+				return fmt.Errorf("deleting sessions: %w", err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return s.getUser(ctx, saved.ID)
+}
+
+// ensureRoles returns the IDs of the named roles, creating missing ones.
+func ensureRoles(ctx context.Context, tx *ent.Tx, names []string) ([]int, error) {
+	ids := make([]int, 0, len(names))
+	for _, name := range names {
+		r, err := tx.Role.Query().Where(role.Name(name)).Only(ctx)
+		if ent.IsNotFound(err) {
+			r, err = tx.Role.Create().SetName(name).Save(ctx)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("saving role %q: %w", name, err)
+		}
+		ids = append(ids, r.ID)
+	}
+	return ids, nil
+}
+
+func (s *AuthService) getUser(ctx context.Context, id int) (*ent.User, error) {
+	return s.client.User.Query().
+		Where(user.ID(id)).
+		WithRoles(func(rq *ent.RoleQuery) {
+			rq.Order(ent.Asc(role.FieldName))
+		}).
+		Only(ctx)
+}
+
+// Authenticate returns the user with the email and password, with its
+// roles, or ErrInvalidCredentials.
+func (s *AuthService) Authenticate(ctx context.Context, email, password string) (*ent.User, error) {
+	u, err := s.client.User.Query().Where(user.Email(normalizeEmail(email))).Only(ctx)
+	if ent.IsNotFound(err) {
+		CheckPassword(dummyHash(), password)
+		return nil, ErrInvalidCredentials
+	}
+	if err != nil {
+		return nil, fmt.Errorf("querying user: %w", err)
+	}
+	if !CheckPassword(u.PasswordHash, password) {
+		return nil, ErrInvalidCredentials
+	}
+	return s.getUser(ctx, u.ID)
+}
+
+// StartSession logs the user in and returns the session token with its
+// expiry. Expired sessions of the user are removed.
+func (s *AuthService) StartSession(ctx context.Context, userID int) (string, time.Time, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", time.Time{}, fmt.Errorf("generating session token: %w", err)
+	}
+	token := base64.RawURLEncoding.EncodeToString(b)
+	now := s.now()
+	expires := now.Add(SessionTTL)
+
+	err := dbtx.WithTx(ctx, s.client, func(tx *ent.Tx) error {
+		if _, err := tx.Session.Delete().
+			Where(session.UserID(userID), session.ExpiresAtLTE(now)).
+			Exec(ctx); err != nil {
+			return fmt.Errorf("deleting expired sessions: %w", err)
+		}
+		if _, err := tx.Session.Create().
+			SetTokenHash(hashToken(token)).
+			SetUserID(userID).
+			SetCreatedAt(now).
+			SetExpiresAt(expires).
+			Save(ctx); err != nil {
+			return fmt.Errorf("creating session: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	return token, expires, nil
+}
+
+// SessionUser returns the user logged in with the token, with its roles,
+// or ErrInvalidSession.
+func (s *AuthService) SessionUser(ctx context.Context, token string) (*ent.User, error) {
+	if token == "" {
+		return nil, ErrInvalidSession
+	}
+	sess, err := s.client.Session.Query().
+		Where(session.TokenHash(hashToken(token))).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, ErrInvalidSession
+	}
+	if err != nil {
+		return nil, fmt.Errorf("querying session: %w", err)
+	}
+	if !s.now().Before(sess.ExpiresAt) {
+		return nil, ErrInvalidSession
+	}
+	return s.getUser(ctx, sess.UserID)
+}
+
+// EndSession logs out the session with the token. Unknown tokens are
+// ignored.
+func (s *AuthService) EndSession(ctx context.Context, token string) error {
+	if _, err := s.client.Session.Delete().
+		Where(session.TokenHash(hashToken(token))).
+		Exec(ctx); err != nil {
+		return fmt.Errorf("deleting session: %w", err)
+	}
+	return nil
+}
+
+// HasRole reports whether the user, loaded with its roles, holds any of
+// the roles.
+func HasRole(u *ent.User, roles ...string) bool {
+	if u == nil {
+		return false
+	}
+	for _, r := range u.Edges.Roles {
+		if slices.Contains(roles, r.Name) {
+			return true
+		}
+	}
+	return false
+}
+
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+func normalizeEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
@@ -0,0 +1,111 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"examination/internal/ent"
+	"examination/internal/features/auth/service"
+	"examination/internal/testutil"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func roleNames(u *ent.User) []string {
+	var names []string
+	for _, r := range u.Edges.Roles {
+		names = append(names, r.Name)
+	}
+	return names
+}
+
+func TestPassword(t *testing.T) {
+	hash, err := service.HashPassword("correct horse")
+	require.NoError(t, err)
+	assert.True(t, service.CheckPassword(hash, "correct horse"))
+	assert.False(t, service.CheckPassword(hash, "correct horse "))
+	assert.False(t, service.CheckPassword("plain", "plain"))
+
+	other, err := service.HashPassword("correct horse")
+	require.NoError(t, err)
+	assert.NotEqual(t, hash, other, "salts should differ")
+}
+
+func TestAuthService_SaveUser(t *testing.T) {
+	ctx := context.Background()
+	client := testutil.NewClient(t)
+	svc := service.NewAuthService(client)
+
+	for name, in := range map[string]service.UserInput{
+		"bad email":    {Email: "admin", Password: "password1"},
+		"short":        {Email: "a@example.com", Password: "short"},
+		"unknown role": {Email: "a@example.com", Password: "password1", Roles: []string{"root"}},
+	} {
+		_, err := svc.SaveUser(ctx, in)
+		assert.ErrorIs(t, err, service.ErrInvalidUser, name)
+	}
+
+	u, err := svc.SaveUser(ctx, service.UserInput{
+		Email: " Author@Example.com", Password: "password1", Roles: []string{service.RoleAuthor, service.RoleCandidate},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "author@example.com", u.Email)
+	assert.Equal(t, []string{"author", "candidate"}, roleNames(u))
+	assert.True(t, service.HasRole(u, service.StaffRoles...))
+
+	// Saving again resets the password and roles and logs the user out
+	token, _, err := svc.StartSession(ctx, u.ID)
+	require.NoError(t, err)
+	again, err := svc.SaveUser(ctx, service.UserInput{Email: "author@example.com", Password: "password2", Roles: []string{service.RoleCandidate}})
+	require.NoError(t, err)
+	assert.Equal(t, u.ID, again.ID)
+	assert.Equal(t, []string{"candidate"}, roleNames(again))
+	assert.False(t, service.HasRole(again, service.StaffRoles...))
+	_, err = svc.SessionUser(ctx, token)
+	assert.ErrorIs(t, err, service.ErrInvalidSession)
+	assert.Equal(t, 2, client.Role.Query().CountX(ctx))
+}
+
+func TestAuthService_Sessions(t *testing.T) {
+	ctx := context.Background()
+	client := testutil.NewClient(t)
+	svc := service.NewAuthService(client)
+
+	u, err := svc.SaveUser(ctx, service.UserInput{Email: "c@example.com", Password: "password1", Roles: []string{service.RoleCandidate}})
+	require.NoError(t, err)
+
+	_, err = svc.Authenticate(ctx, "c@example.com", "wrong")
+	assert.ErrorIs(t, err, service.ErrInvalidCredentials)
+	_, err = svc.Authenticate(ctx, "nobody@example.com", "password1")
+	assert.ErrorIs(t, err, service.ErrInvalidCredentials)
+	got, err := svc.Authenticate(ctx, "C@example.com", "password1")
+	require.NoError(t, err)
+	assert.Equal(t, u.ID, got.ID)
+
+	token, expires, err := svc.StartSession(ctx, u.ID)
+	require.NoError(t, err)
+	assert.WithinDuration(t, time.Now().Add(service.SessionTTL), expires, time.Minute)
+	sessionUser, err := svc.SessionUser(ctx, token)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"candidate"}, roleNames(sessionUser))
+	_, err = svc.SessionUser(ctx, token+"x")
+	assert.ErrorIs(t, err, service.ErrInvalidSession)
+
+	// The token itself is not stored
+	stored := client.Session.Query().OnlyX(ctx)
+	assert.NotEqual(t, token, stored.TokenHash)
+
+	// Expired sessions are refused
+	stored.Update().SetExpiresAt(time.Now().Add(-time.Minute)).ExecX(ctx)
+	_, err = svc.SessionUser(ctx, token)
+	assert.ErrorIs(t, err, service.ErrInvalidSession)
+
+	token, _, err = svc.StartSession(ctx, u.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 1, client.Session.Query().CountX(ctx), "expired sessions are removed on login")
+	require.NoError(t, svc.EndSession(ctx, token))
+	_, err = svc.SessionUser(ctx, token)
+	assert.ErrorIs(t, err, service.ErrInvalidSession)
+}
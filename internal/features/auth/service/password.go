@@ -0,0 +1,61 @@
+package service
+
+import (
+	"crypto/pbkdf2"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Password hashes are stored as "pbkdf2-sha256$<iterations>$<salt>$<key>"
+// with the salt and key in unpadded base64, so the iteration count can be
+// raised later without invalidating existing hashes.
+const (
+	hashScheme       = "pbkdf2-sha256"
+	hashIterations   = 600_000
+	hashSaltLength   = 16
+	hashKeyLength    = 32
+	minPasswordBytes = 8
+)
+
+var b64 = base64.RawStdEncoding
+
+// HashPassword returns the hash of the password with a random salt.
+func HashPassword(password string) (string, error) {
+	salt := make([]byte, hashSaltLength)
+	if _, err := rand.Read(salt); err != nil {
+		return "", fmt.Errorf("generating salt: %w", err)
+	}
+	key, err := pbkdf2.Key(sha256.New, password, salt, hashIterations, hashKeyLength)
+	if err != nil {
+		return "", fmt.Errorf("hashing password: %w", err)
+	}
+	return fmt.Sprintf("%s$%d$%s$%s", hashScheme, hashIterations, b64.EncodeToString(salt), b64.EncodeToString(key)), nil
+}
+
+// CheckPassword reports whether the password matches the hash. A malformed
+// hash matches no password.
+func CheckPassword(hash, password string) bool {
+	parts := strings.Split(hash, "$")
+	if len(parts) != 4 || parts[0] != hashScheme {
+		return false
+	}
+	iterations, err := strconv.Atoi(parts[1])
+	if err != nil || iterations <= 0 {
+		return false
+	}
+	salt, err1 := b64.DecodeString(parts[2])
+	want, err2 := b64.DecodeString(parts[3])
+	if err1 != nil || err2 != nil || len(want) == 0 {
+		return false
+	}
+	got, err := pbkdf2.Key(sha256.New, password, salt, iterations, len(want))
+	if err != nil {
+		return false
+	}
+	return subtle.ConstantTimeCompare(got, want) == 1
+}
@@ -0,0 +1,88 @@
+// Package assetstore keeps the bytes of the images and attachments that
+// problems reference. Keys are content hashes, so a stored object never
+// changes and the same file uploaded twice is stored once.
+//
+// Disk is the only backend; Storage is small enough for an S3-compatible
+// bucket to implement with a PUT and a GET per object.
+package assetstore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+)
+
+// ErrNotFound is returned by Open for a key that was never stored.
+var ErrNotFound = errors.New("asset not found")
+
+// Storage keeps objects by key. Put of a key that is already stored
+// leaves it as it is.
+type Storage interface {
+	Put(ctx context.Context, key string, data []byte) error
+	Open(ctx context.Context, key string) (io.ReadCloser, error)
+}
+
+// key is a hex SHA-256, which keeps paths built from it inside the store.
+var key = regexp.MustCompile(`^[0-9a-f]{64}$`)
+
+// Disk stores objects as files under a directory, in subdirectories named
+// by the first two characters of the key.
+type Disk struct {
+	dir string
+}
+
+func NewDisk(dir string) *Disk {
+	return &Disk{dir: dir}
+}
+
+func (d *Disk) path(k string) (string, error) {
+	if !key.MatchString(k) {
+		return "", fmt.Errorf("invalid asset key %q", k)
+	}
+	return filepath.Join(d.dir, k[:2], k), nil
+}
+
+// Put writes data through a temporary file, so that a reader never sees
+// a partly written object.
+func (d *Disk) Put(ctx context.Context, k string, data []byte) error {
+	path, err := d.path(k)
+	if err != nil {
+		return err
+	}
+	if _, err := os.Stat(path); err == nil {
+		return nil
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(path), k+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
+func (d *Disk) Open(ctx context.Context, k string) (io.ReadCloser, error) {
+	path, err := d.path(k)
+	if err != nil {
+		return nil, err
+	}
+	f, err := os.Open(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, ErrNotFound
+	}
+	return f, err
+}
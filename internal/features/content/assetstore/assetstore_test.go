@@ -0,0 +1,37 @@
+package assetstore_test
+
+import (
+	"context"
+	"io"
+	"strings"
+	"testing"
+
+	"examination/internal/features/content/assetstore"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDisk(t *testing.T) {
+	ctx := context.Background()
+	d := assetstore.NewDisk(t.TempDir())
+	k := strings.Repeat("ab", 32)
+
+	_, err := d.Open(ctx, k)
+	assert.ErrorIs(t, err, assetstore.ErrNotFound)
+
+	require.NoError(t, d.Put(ctx, k, []byte("first")))
+	// A stored key is never overwritten
+	require.NoError(t, d.Put(ctx, k, []byte("second")))
+
+	r, err := d.Open(ctx, k)
+	require.NoError(t, err)
+	defer r.Close()
+	data, err := io.ReadAll(r)
+	require.NoError(t, err)
+	assert.Equal(t, "first", string(data))
+
+	assert.Error(t, d.Put(ctx, "../escape", []byte("x")))
+	_, err = d.Open(ctx, "../"+k)
+	assert.Error(t, err)
+}
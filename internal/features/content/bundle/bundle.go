@@ -0,0 +1,195 @@
+// Package bundle defines the file format exams are exported to and imported
+// from, so content can be authored in files and reviewed in git. The format
+// is documented in docs/content-bundle.md.
+package bundle
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Version is the bundle format version written on export.
+const Version = 1
+
+// Exam is the root of a bundle. Keys identify entities across imports:
+// the exam key is global, every other key is unique within the exam.
+type Exam struct {
+	Version            int           `json:"version" yaml:"version"`
+	Key                string        `json:"key" yaml:"key"`
+	Title              string        `json:"title" yaml:"title"`
+	Description        string        `json:"description,omitempty" yaml:"description,omitempty"`
+	TimeLimit          int           `json:"time_limit" yaml:"time_limit"`
+	IsActive           bool          `json:"is_active" yaml:"is_active"`
+	ShuffleChoices     bool          `json:"shuffle_choices,omitempty" yaml:"shuffle_choices,omitempty"`
+	WeightByDifficulty bool          `json:"weight_by_difficulty,omitempty" yaml:"weight_by_difficulty,omitempty"`
+	PartialCredit      bool          `json:"partial_credit,omitempty" yaml:"partial_credit,omitempty"`
+	NegativeMarking    bool          `json:"negative_marking,omitempty" yaml:"negative_marking,omitempty"`
+	Sections           []Section     `json:"sections,omitempty" yaml:"sections,omitempty"`
+	Topics             []Topic       `json:"topics,omitempty" yaml:"topics,omitempty"`
+	Units              []Unit        `json:"units,omitempty" yaml:"units,omitempty"`
+	VersionRules       []VersionRule `json:"version_rules,omitempty" yaml:"version_rules,omitempty"`
+	Assets             []Asset       `json:"assets,omitempty" yaml:"assets,omitempty"`
+}
+
+// Section holds topics and direct units. Seq is the 1-based position among
+// the exam's children (sections, section-less topics and exam-level units);
+// 0 places it after the entries that set one, in file order.
+type Section struct {
+	Key    string  `json:"key" yaml:"key"`
+	Title  string  `json:"title" yaml:"title"`
+	Seq    int     `json:"seq,omitempty" yaml:"seq,omitempty"`
+	Topics []Topic `json:"topics,omitempty" yaml:"topics,omitempty"`
+	Units  []Unit  `json:"units,omitempty" yaml:"units,omitempty"`
+}
+
+// Topic groups units. Seq is the position within its section or the exam.
+type Topic struct {
+	Key   string `json:"key" yaml:"key"`
+	Title string `json:"title" yaml:"title"`
+	Seq   int    `json:"seq,omitempty" yaml:"seq,omitempty"`
+	Units []Unit `json:"units,omitempty" yaml:"units,omitempty"`
+}
+
+// Unit is a question slot. Seq is the position within its container.
+type Unit struct {
+	Key      string    `json:"key" yaml:"key"`
+	Title    string    `json:"title" yaml:"title"`
+	Seq      int       `json:"seq,omitempty" yaml:"seq,omitempty"`
+	Problems []Problem `json:"problems,omitempty" yaml:"problems,omitempty"`
+}
+
+// Problem is a question with its translations. Type is SOURCE (the
+// default) or VARIANT; Parent is the key of the problem a variant derives from.
+// Interaction is SINGLE (the default), MULTIPLE, ORDERING, NUMERIC or TEXT;
+// Tolerance applies to NUMERIC problems and TextMatch, EXACT (the default)
+// or REGEX, to TEXT problems.
+type Problem struct {
+	Key          string        `json:"key" yaml:"key"`
+	Type         string        `json:"type,omitempty" yaml:"type,omitempty"`
+	Difficulty   int           `json:"difficulty" yaml:"difficulty"`
+	Interaction  string        `json:"interaction,omitempty" yaml:"interaction,omitempty"`
+	Tolerance    float64       `json:"tolerance,omitempty" yaml:"tolerance,omitempty"`
+	TextMatch    string        `json:"text_match,omitempty" yaml:"text_match,omitempty"`
+	Parent       string        `json:"parent,omitempty" yaml:"parent,omitempty"`
+	Translations []Translation `json:"translations" yaml:"translations"`
+}
+
+// Translation is a problem in one locale. Choices are listed in display
+// order, which for ORDERING problems is the correct order; for NUMERIC and
+// TEXT problems they hold the accepted answers.
+type Translation struct {
+	Locale      string   `json:"locale" yaml:"locale"`
+	Title       string   `json:"title" yaml:"title"`
+	Content     string   `json:"content" yaml:"content"`
+	Explanation string   `json:"explanation,omitempty" yaml:"explanation,omitempty"`
+	Choices     []Choice `json:"choices" yaml:"choices"`
+}
+
+type Choice struct {
+	Content     string `json:"content" yaml:"content"`
+	Correct     bool   `json:"correct,omitempty" yaml:"correct,omitempty"`
+	Explanation string `json:"explanation,omitempty" yaml:"explanation,omitempty"`
+}
+
+// Asset is an image or attachment that content references as asset:HASH,
+// where Hash is the hex SHA-256 of the file. Data is the file in base64; it
+// may be left out for assets the server already has. A markdown folder
+// keeps the file in assets/ instead.
+type Asset struct {
+	Hash string `json:"hash" yaml:"hash"`
+	Name string `json:"name" yaml:"name"`
+	Data string `json:"data,omitempty" yaml:"data,omitempty"`
+}
+
+// Bytes decodes Data.
+func (a Asset) Bytes() ([]byte, error) {
+	return base64.StdEncoding.DecodeString(a.Data)
+}
+
+// VersionRule restricts the exam, or one problem when Problem (a problem
+// key) is set, to editions. Status defaults to ACTIVE.
+type VersionRule struct {
+	Year     *int    `json:"year,omitempty" yaml:"year,omitempty"`
+	Round    *int    `json:"round,omitempty" yaml:"round,omitempty"`
+	Category *string `json:"category,omitempty" yaml:"category,omitempty"`
+	Operator string  `json:"operator" yaml:"operator"`
+	Status   string  `json:"status,omitempty" yaml:"status,omitempty"`
+	Problem  string  `json:"problem,omitempty" yaml:"problem,omitempty"`
+}
+
+// Format is a bundle encoding.
+type Format string
+
+const (
+	JSON Format = "json"
+	YAML Format = "yaml"
+	// Markdown is a folder rather than a stream, see DecodeDir and EncodeDir.
+	Markdown Format = "markdown"
+)
+
+// ErrUnknownFormat is returned for file extensions other than .json, .yaml and .yml.
+var ErrUnknownFormat = errors.New("unknown bundle format")
+
+// FormatOf picks the format from a file name's extension. A path without
+// one is a markdown folder.
+func FormatOf(path string) (Format, error) {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".json":
+		return JSON, nil
+	case ".yaml", ".yml":
+		return YAML, nil
+	case "":
+		return Markdown, nil
+	}
+	return "", fmt.Errorf("%w: %q", ErrUnknownFormat, path)
+}
+
+// Decode reads a bundle and validates it.
+func Decode(r io.Reader, f Format) (*Exam, error) {
+	var e Exam
+	switch f {
+	case JSON:
+		dec := json.NewDecoder(r)
+		dec.DisallowUnknownFields()
+		if err := dec.Decode(&e); err != nil {
+			return nil, fmt.Errorf("decoding bundle: %w", err)
+		}
+	case YAML:
+		dec := yaml.NewDecoder(r)
+		dec.KnownFields(true)
+		if err := dec.Decode(&e); err != nil {
+			return nil, fmt.Errorf("decoding bundle: %w", err)
+		}
+	default:
+		return nil, fmt.Errorf("%w: %q", ErrUnknownFormat, f)
+	}
+	if err := e.Validate(); err != nil {
+		return nil, err
+	}
+	return &e, nil
+}
+
+// Encode writes the bundle.
+func Encode(w io.Writer, e *Exam, f Format) error {
+	switch f {
+	case JSON:
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(e)
+	case YAML:
+		enc := yaml.NewEncoder(w)
+		enc.SetIndent(2)
+		if err := enc.Encode(e); err != nil {
+			return err
+		}
+		return enc.Close()
+	}
+	return fmt.Errorf("%w: %q", ErrUnknownFormat, f)
+}
@@ -0,0 +1,124 @@
+package bundle_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"examination/internal/features/content/bundle"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const sample = `
+version: 1
+key: sdd
+title: SDD Mock Exam
+time_limit: 60
+is_active: true
+sections:
+  - key: basics
+    title: Basics
+    topics:
+      - key: modeling
+        title: Modeling
+        units:
+          - key: q1
+            title: Question 1
+            problems:
+              - key: q1-a
+                difficulty: 2
+                translations:
+                  - locale: en
+                    title: Entities
+                    content: Which one is an entity?
+                    choices:
+                      - content: Customer
+                        correct: true
+                      - content: Blue
+              - key: q1-b
+                type: VARIANT
+                parent: q1-a
+                difficulty: 3
+                translations: []
+version_rules:
+  - year: 2025
+    operator: GreaterEqual
+    problem: q1-b
+assets:
+  - hash: 2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824
+    name: hello.txt
+    data: aGVsbG8=
+`
+
+func TestDecode_RoundTripsAcrossFormats(t *testing.T) {
+	e, err := bundle.Decode(strings.NewReader(sample), bundle.YAML)
+	require.NoError(t, err)
+	require.Len(t, e.Sections, 1)
+	p := e.Sections[0].Topics[0].Units[0].Problems
+	require.Len(t, p, 2)
+	assert.True(t, p[0].Translations[0].Choices[0].Correct)
+	assert.Equal(t, "q1-a", p[1].Parent)
+	assert.Equal(t, 2025, *e.VersionRules[0].Year)
+	data, err := e.Assets[0].Bytes()
+	require.NoError(t, err)
+	assert.Equal(t, "hello", string(data))
+
+	for _, f := range []bundle.Format{bundle.JSON, bundle.YAML} {
+		var buf bytes.Buffer
+		require.NoError(t, bundle.Encode(&buf, e, f))
+		back, err := bundle.Decode(&buf, f)
+		require.NoError(t, err, f)
+		assert.Equal(t, e, back, f)
+	}
+}
+
+func TestDecode_RejectsUnknownFields(t *testing.T) {
+	_, err := bundle.Decode(strings.NewReader(`{"key": "k", "title": "T", "colour": "red"}`), bundle.JSON)
+	assert.Error(t, err)
+	_, err = bundle.Decode(strings.NewReader("key: k\ntitle: T\ncolour: red\n"), bundle.YAML)
+	assert.Error(t, err)
+}
+
+func TestValidate(t *testing.T) {
+	e := &bundle.Exam{
+		Key:   "k",
+		Title: "Exam",
+		Units: []bundle.Unit{
+			{Key: "u", Title: "Unit", Problems: []bundle.Problem{
+				{Key: "p", Parent: "missing", Translations: []bundle.Translation{{Locale: "en"}, {Locale: "en"}}},
+				{Key: "n", Interaction: "ESSAY", Tolerance: -1, Translations: []bundle.Translation{{Locale: "en", Content: "$x^$"}}},
+			}},
+			{Key: "u", Title: "Again"},
+		},
+		VersionRules: []bundle.VersionRule{{Operator: "Bigger", Problem: "p"}},
+		Assets: []bundle.Asset{
+			{Hash: "ABC", Name: "a.png"},
+			{Hash: "2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824", Name: "b.png", Data: "aGVsbG8h"},
+		},
+	}
+	err := e.Validate()
+	require.ErrorIs(t, err, bundle.ErrInvalid)
+	for _, want := range []string{
+		`units[0].problems[0].parent: unknown problem key "missing"`,
+		`units[0].problems[0].translations[1].locale: duplicate locale "en"`,
+		`units[0].problems[1].interaction: must be one of`,
+		`units[0].problems[1].tolerance: must not be negative`,
+		`units[0].problems[1].translations[0].content: line 1: ^ needs a superscript in "x^"`,
+		`units[1].key: duplicate unit key "u"`,
+		`version_rules[0].operator`,
+		`assets[0].hash: must be a lower-case hex SHA-256`,
+		`assets[1].data: does not match the hash`,
+	} {
+		assert.Contains(t, err.Error(), want)
+	}
+}
+
+func TestFormatOf(t *testing.T) {
+	f, err := bundle.FormatOf("exams/sdd.YML")
+	require.NoError(t, err)
+	assert.Equal(t, bundle.YAML, f)
+	_, err = bundle.FormatOf("sdd.toml")
+	assert.ErrorIs(t, err, bundle.ErrUnknownFormat)
+}
@@ -0,0 +1,543 @@
+package bundle
+
+import (
+	"bytes"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"slices"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// A markdown folder is the bundle split into files that read well in a
+// diff: the exam structure in exam.yaml, one file per problem and locale
+// in problems/<unit key>/<problem key>.<locale>.md and the file of each
+// asset in assets/<hash><extension of its name>.
+//
+// A problem file starts with YAML front matter holding the problem fields
+// and the translation title, followed by the question as markdown, the
+// choices as a checklist and the explanation:
+//
+//	---
+//	key: cap
+//	locale: en
+//	title: CAP Theorem
+//	difficulty: 2
+//	---
+//
+//	Which properties cannot all be guaranteed?
+//
+//	- [x] Consistency & Availability
+//	  > Explanation of the choice.
+//	- [ ] Reliability & Scalability
+//
+//	Explanation of the problem.
+const (
+	structureFile = "exam.yaml"
+	problemsDir   = "problems"
+	assetsDir     = "assets"
+)
+
+// problemMeta is the front matter of a problem file. The problem fields
+// are repeated in every locale file of a problem and must agree.
+type problemMeta struct {
+	Key          string        `yaml:"key"`
+	Locale       string        `yaml:"locale"`
+	Title        string        `yaml:"title"`
+	Type         string        `yaml:"type,omitempty"`
+	Difficulty   int           `yaml:"difficulty"`
+	Interaction  string        `yaml:"interaction,omitempty"`
+	Tolerance    float64       `yaml:"tolerance,omitempty"`
+	TextMatch    string        `yaml:"text_match,omitempty"`
+	Parent       string        `yaml:"parent,omitempty"`
+	VersionRules []VersionRule `yaml:"version_rules,omitempty"`
+}
+
+var (
+	checklistItem = regexp.MustCompile(`^- \[([ xX])\](?: (.*))?$`)
+	assetExt      = regexp.MustCompile(`^\.[a-z0-9]{1,8}$`)
+)
+
+// assetFile is the path of an asset's file in a markdown folder.
+func assetFile(a Asset) string {
+	ext := strings.ToLower(path.Ext(a.Name))
+	if !assetExt.MatchString(ext) {
+		ext = ""
+	}
+	return path.Join(assetsDir, a.Hash+ext)
+}
+
+// DecodeDir reads a markdown folder and validates it. Problem version
+// rules follow the exam's own rules, in problem order.
+func DecodeDir(fsys fs.FS) (*Exam, error) {
+	f, err := fsys.Open(structureFile)
+	if err != nil {
+		return nil, fmt.Errorf("opening %s: %w", structureFile, err)
+	}
+	defer f.Close()
+	e, err := Decode(f, YAML)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", structureFile, err)
+	}
+
+	units := make(map[string]*Unit)
+	for _, u := range e.units() {
+		if len(u.Problems) > 0 {
+			return nil, fmt.Errorf("%w: %s: unit %q lists problems; they belong in %s/", ErrInvalid, structureFile, u.Key, problemsDir)
+		}
+		units[u.Key] = u
+	}
+
+	dirs, err := fs.ReadDir(fsys, problemsDir)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return nil, fmt.Errorf("reading %s: %w", problemsDir, err)
+	}
+	var errs []error
+	for _, d := range dirs {
+		if !d.IsDir() {
+			continue
+		}
+		dir := path.Join(problemsDir, d.Name())
+		u, ok := units[d.Name()]
+		if !ok {
+			errs = append(errs, fmt.Errorf("%w: %s: no unit with key %q in %s", ErrInvalid, dir, d.Name(), structureFile))
+			continue
+		}
+		files, err := fs.Glob(fsys, path.Join(dir, "*.md"))
+		if err != nil {
+			return nil, err
+		}
+		metas := make(map[string]problemMeta)
+		for _, name := range files {
+			meta, t, err := readProblemFile(fsys, name)
+			if err != nil {
+				errs = append(errs, err)
+				continue
+			}
+			i := slices.IndexFunc(u.Problems, func(p Problem) bool { return p.Key == meta.Key })
+			if i < 0 {
+				u.Problems = append(u.Problems, Problem{
+					Key:         meta.Key,
+					Type:        meta.Type,
+					Difficulty:  meta.Difficulty,
+					Interaction: meta.Interaction,
+					Tolerance:   meta.Tolerance,
+					TextMatch:   meta.TextMatch,
+					Parent:      meta.Parent,
+				})
+				i = len(u.Problems) - 1
+				metas[meta.Key] = meta
+			} else if !sameProblem(metas[meta.Key], meta) {
+				errs = append(errs, fmt.Errorf("%w: %s: problem fields differ from the other locales of %q", ErrInvalid, name, meta.Key))
+			}
+			u.Problems[i].Translations = append(u.Problems[i].Translations, t)
+		}
+		for _, p := range u.Problems {
+			for _, r := range metas[p.Key].VersionRules {
+				r.Problem = p.Key
+				e.VersionRules = append(e.VersionRules, r)
+			}
+		}
+	}
+	// An asset without a file is one the server is expected to have
+	for i := range e.Assets {
+		a := &e.Assets[i]
+		if a.Data != "" {
+			continue
+		}
+		data, err := fs.ReadFile(fsys, assetFile(*a))
+		if err != nil && !errors.Is(err, fs.ErrNotExist) {
+			errs = append(errs, fmt.Errorf("reading %s: %w", assetFile(*a), err))
+			continue
+		}
+		if len(data) > 0 {
+			a.Data = base64.StdEncoding.EncodeToString(data)
+		}
+	}
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+	if err := e.Validate(); err != nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+func sameProblem(a, b problemMeta) bool {
+	a.Locale, a.Title = "", ""
+	b.Locale, b.Title = "", ""
+	return reflect.DeepEqual(a, b)
+}
+
+func readProblemFile(fsys fs.FS, name string) (problemMeta, Translation, error) {
+	var meta problemMeta
+	raw, err := fs.ReadFile(fsys, name)
+	if err != nil {
+		return meta, Translation{}, err
+	}
+	text := strings.ReplaceAll(string(raw), "\r\n", "\n")
+
+	front, body, ok := splitFrontMatter(text)
+	if !ok {
+		return meta, Translation{}, fmt.Errorf("%w: %s: missing front matter", ErrInvalid, name)
+	}
+	dec := yaml.NewDecoder(strings.NewReader(front))
+	dec.KnownFields(true)
+	if err := dec.Decode(&meta); err != nil {
+		return meta, Translation{}, fmt.Errorf("%w: %s: front matter: %v", ErrInvalid, name, err)
+	}
+	if meta.Key == "" {
+		// The key defaults to the file name up to the locale
+		meta.Key, _, _ = strings.Cut(path.Base(name), ".")
+	}
+	if meta.Locale == "" {
+		return meta, Translation{}, fmt.Errorf("%w: %s: locale is required", ErrInvalid, name)
+	}
+	for _, r := range meta.VersionRules {
+		if r.Problem != "" && r.Problem != meta.Key {
+			return meta, Translation{}, fmt.Errorf("%w: %s: version rules apply to the problem of the file", ErrInvalid, name)
+		}
+	}
+
+	t := Translation{Locale: meta.Locale, Title: meta.Title}
+	t.Content, t.Choices, t.Explanation = parseBody(body)
+	return meta, t, nil
+}
+
+func splitFrontMatter(text string) (front, body string, ok bool) {
+	rest, ok := strings.CutPrefix(text, "---\n")
+	if !ok {
+		return "", "", false
+	}
+	if strings.HasPrefix(rest, "---\n") {
+		return "", rest[len("---\n"):], true
+	}
+	front, body, ok = strings.Cut(rest, "\n---\n")
+	if !ok {
+		front, ok = strings.CutSuffix(rest, "\n---")
+	}
+	return front, body, ok
+}
+
+// parseBody splits a problem body at its first checklist outside fenced
+// code: the text before is the content, the checklist the choices and the
+// text after the explanation.
+func parseBody(body string) (content string, choices []Choice, explanation string) {
+	lines := strings.Split(body, "\n")
+	start := findChecklist(lines)
+	if start < 0 {
+		return trimBlankLines(body), []Choice{}, ""
+	}
+
+	choices = []Choice{}
+	var (
+		contentLines, explLines []string
+		inExpl                  bool
+	)
+	flush := func() {
+		if len(choices) > 0 {
+			c := &choices[len(choices)-1]
+			c.Content = trimBlankLines(strings.Join(contentLines, "\n"))
+			c.Explanation = trimBlankLines(strings.Join(explLines, "\n"))
+		}
+	}
+	end := len(lines)
+	for i := start; i < len(lines); i++ {
+		line := lines[i]
+		if m := checklistItem.FindStringSubmatch(line); m != nil {
+			flush()
+			choices = append(choices, Choice{Correct: m[1] != " "})
+			contentLines, explLines, inExpl = []string{m[2]}, nil, false
+			continue
+		}
+		if line == "" {
+			// A blank line belongs to the checklist if the checklist goes on after it
+			j := i + 1
+			for j < len(lines) && strings.TrimSpace(lines[j]) == "" {
+				j++
+			}
+			if j == len(lines) || !(checklistItem.MatchString(lines[j]) || strings.HasPrefix(lines[j], "  ")) {
+				end = i
+				break
+			}
+		} else if !strings.HasPrefix(line, "  ") {
+			end = i
+			break
+		}
+		line = strings.TrimPrefix(line, "  ")
+		if quoted, ok := strings.CutPrefix(line, ">"); ok {
+			inExpl = true
+			line = strings.TrimPrefix(quoted, " ")
+		}
+		if inExpl {
+			explLines = append(explLines, line)
+		} else {
+			contentLines = append(contentLines, line)
+		}
+	}
+	flush()
+
+	content = trimBlankLines(strings.Join(lines[:start], "\n"))
+	explanation = trimBlankLines(strings.Join(lines[end:], "\n"))
+	return content, choices, explanation
+}
+
+// findChecklist returns the index of the first checklist item outside
+// fenced code, or -1.
+func findChecklist(lines []string) int {
+	fence := ""
+	for i, line := range lines {
+		trimmed := strings.TrimLeft(line, " ")
+		if fence != "" {
+			if strings.HasPrefix(trimmed, fence) {
+				fence = ""
+			}
+			continue
+		}
+		if strings.HasPrefix(trimmed, "```") || strings.HasPrefix(trimmed, "~~~") {
+			fence = trimmed[:3]
+			continue
+		}
+		if checklistItem.MatchString(line) {
+			return i
+		}
+	}
+	return -1
+}
+
+// trimBlankLines drops leading and trailing blank lines, keeping the
+// indentation of the first line.
+func trimBlankLines(s string) string {
+	lines := strings.Split(s, "\n")
+	for len(lines) > 0 && strings.TrimSpace(lines[0]) == "" {
+		lines = lines[1:]
+	}
+	for len(lines) > 0 && strings.TrimSpace(lines[len(lines)-1]) == "" {
+		lines = lines[:len(lines)-1]
+	}
+	return strings.Join(lines, "\n")
+}
+
+// EncodeDir writes the bundle as a markdown folder into dir. The problems
+// and assets directories are rewritten from scratch so that deleted
+// problems do not linger; exam.yaml is overwritten.
+func EncodeDir(dir string, e *Exam) error {
+	if err := e.Validate(); err != nil {
+		return err
+	}
+
+	structure := *e
+	structure.VersionRules = nil
+	for _, r := range e.VersionRules {
+		if r.Problem == "" {
+			structure.VersionRules = append(structure.VersionRules, r)
+		}
+	}
+	structure.Sections = slices.Clone(e.Sections)
+	for i := range structure.Sections {
+		s := &structure.Sections[i]
+		s.Topics = withoutProblems(s.Topics)
+		s.Units = unitsWithoutProblems(s.Units)
+	}
+	structure.Topics = withoutProblems(e.Topics)
+	structure.Units = unitsWithoutProblems(e.Units)
+
+	files := make(map[string][]byte)
+	var errs []error
+	structure.Assets = slices.Clone(e.Assets)
+	for i := range structure.Assets {
+		a := &structure.Assets[i]
+		if a.Data == "" {
+			continue
+		}
+		data, err := a.Bytes()
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%w: asset %s: %v", ErrInvalid, a.Hash, err))
+			continue
+		}
+		files[assetFile(*a)] = data
+		a.Data = ""
+	}
+	for _, u := range e.units() {
+		for _, p := range u.Problems {
+			if len(p.Translations) == 0 {
+				errs = append(errs, fmt.Errorf("%w: problem %q has no translations to write", ErrInvalid, p.Key))
+			}
+			for _, t := range p.Translations {
+				name := path.Join(problemsDir, u.Key, p.Key+"."+t.Locale+".md")
+				if !validName(u.Key) || !validName(p.Key+"."+t.Locale) {
+					errs = append(errs, fmt.Errorf("%w: %s: keys and locales must be plain file names", ErrInvalid, name))
+					continue
+				}
+				data, err := problemFile(e, p, t)
+				if err != nil {
+					errs = append(errs, fmt.Errorf("%s: %w", name, err))
+					continue
+				}
+				files[name] = data
+			}
+		}
+	}
+	if len(errs) > 0 {
+		return errors.Join(errs...)
+	}
+
+	var buf bytes.Buffer
+	if err := Encode(&buf, &structure, YAML); err != nil {
+		return err
+	}
+	for _, sub := range []string{problemsDir, assetsDir} {
+		if err := os.RemoveAll(filepath.Join(dir, sub)); err != nil {
+			return err
+		}
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+	if err := os.WriteFile(filepath.Join(dir, structureFile), buf.Bytes(), 0o644); err != nil {
+		return err
+	}
+	for name, data := range files {
+		full := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(full), 0o755); err != nil {
+			return err
+		}
+		if err := os.WriteFile(full, data, 0o644); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validName(name string) bool {
+	return fs.ValidPath(name) && !strings.ContainsAny(name, `/\`) && name != "."
+}
+
+func problemFile(e *Exam, p Problem, t Translation) ([]byte, error) {
+	if findChecklist(strings.Split(t.Content, "\n")) >= 0 {
+		return nil, fmt.Errorf("%w: content contains a checklist, which would be read as the choices", ErrInvalid)
+	}
+	meta := problemMeta{
+		Key:         p.Key,
+		Locale:      t.Locale,
+		Title:       t.Title,
+		Type:        p.Type,
+		Difficulty:  p.Difficulty,
+		Interaction: p.Interaction,
+		Tolerance:   p.Tolerance,
+		TextMatch:   p.TextMatch,
+		Parent:      p.Parent,
+	}
+	for _, r := range e.VersionRules {
+		if r.Problem == p.Key {
+			r.Problem = ""
+			meta.VersionRules = append(meta.VersionRules, r)
+		}
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString("---\n")
+	enc := yaml.NewEncoder(&buf)
+	enc.SetIndent(2)
+	if err := enc.Encode(meta); err != nil {
+		return nil, err
+	}
+	if err := enc.Close(); err != nil {
+		return nil, err
+	}
+	buf.WriteString("---\n")
+	// Surrounding blank lines are not preserved by the reader
+	if content := trimBlankLines(t.Content); content != "" {
+		buf.WriteString("\n" + content + "\n")
+	}
+	if len(t.Choices) > 0 {
+		buf.WriteString("\n")
+	}
+	for _, c := range t.Choices {
+		mark := " "
+		if c.Correct {
+			mark = "x"
+		}
+		first, rest, _ := strings.Cut(c.Content, "\n")
+		if strings.Contains("\n"+rest, "\n>") {
+			return nil, fmt.Errorf("%w: a choice line starts with '>', which would be read as its explanation", ErrInvalid)
+		}
+		buf.WriteString(strings.TrimRight("- ["+mark+"] "+first, " ") + "\n")
+		if rest != "" {
+			for _, line := range strings.Split(rest, "\n") {
+				buf.WriteString(strings.TrimRight("  "+line, " ") + "\n")
+			}
+		}
+		if c.Explanation != "" {
+			for _, line := range strings.Split(c.Explanation, "\n") {
+				buf.WriteString(strings.TrimRight("  > "+line, " ") + "\n")
+			}
+		}
+	}
+	if explanation := trimBlankLines(t.Explanation); explanation != "" {
+		buf.WriteString("\n" + explanation + "\n")
+	}
+	return buf.Bytes(), nil
+}
+
+// units returns every unit of the exam in file order.
+func (e *Exam) units() []*Unit {
+	var out []*Unit
+	add := func(us []Unit) {
+		for i := range us {
+			out = append(out, &us[i])
+		}
+	}
+	for i := range e.Sections {
+		for j := range e.Sections[i].Topics {
+			add(e.Sections[i].Topics[j].Units)
+		}
+		add(e.Sections[i].Units)
+	}
+	for i := range e.Topics {
+		add(e.Topics[i].Units)
+	}
+	add(e.Units)
+	return out
+}
+
+// Texts returns the markdown of every translation and choice, in file
+// order.
+func (e *Exam) Texts() []string {
+	var out []string
+	for _, u := range e.units() {
+		for _, p := range u.Problems {
+			for _, t := range p.Translations {
+				out = append(out, t.Content, t.Explanation)
+				for _, c := range t.Choices {
+					out = append(out, c.Content, c.Explanation)
+				}
+			}
+		}
+	}
+	return out
+}
+
+func withoutProblems(topics []Topic) []Topic {
+	out := slices.Clone(topics)
+	for i := range out {
+		out[i].Units = unitsWithoutProblems(out[i].Units)
+	}
+	return out
+}
+
+func unitsWithoutProblems(units []Unit) []Unit {
+	out := slices.Clone(units)
+	for i := range out {
+		out[i].Problems = nil
+	}
+	return out
+}
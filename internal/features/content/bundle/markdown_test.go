@@ -0,0 +1,163 @@
+package bundle_test
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	"examination/internal/features/content/bundle"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const structure = `
+key: go
+title: Go Basics
+time_limit: 30
+units:
+  - key: goroutines
+    title: Goroutines
+    seq: 1
+`
+
+const goroutineEN = "---\n" + `key: wg
+locale: en
+title: WaitGroup
+difficulty: 2
+version_rules:
+  - year: 2025
+    operator: GreaterEqual
+---
+
+What does this print?
+
+` + "```go\n// - [ ] not a choice\nwg.Wait()\n```" + `
+
+- [x] Nothing until every goroutine is done
+  > Wait blocks until the counter is zero.
+- [ ] It panics
+  when the counter is negative
+
+See the ` + "`sync`" + ` package documentation.
+`
+
+func TestDecodeDir(t *testing.T) {
+	fsys := fstest.MapFS{
+		"exam.yaml":                     {Data: []byte(structure)},
+		"problems/goroutines/wg.en.md":  {Data: []byte(goroutineEN)},
+		"problems/goroutines/wg.ko.md":  {Data: []byte("---\nlocale: ko\ntitle: WaitGroup\ndifficulty: 2\nversion_rules:\n  - year: 2025\n    operator: GreaterEqual\n---\n무엇이 출력됩니까?\n\n- [X] 없음\n- [ ] 패닉\n")},
+		"problems/goroutines/notes.txt": {Data: []byte("ignored")},
+	}
+	e, err := bundle.DecodeDir(fsys)
+	require.NoError(t, err)
+
+	require.Len(t, e.Units, 1)
+	require.Len(t, e.Units[0].Problems, 1)
+	p := e.Units[0].Problems[0]
+	assert.Equal(t, "wg", p.Key)
+	assert.Equal(t, 2, p.Difficulty)
+	require.Len(t, p.Translations, 2)
+
+	en := p.Translations[0]
+	assert.Equal(t, "en", en.Locale)
+	assert.Equal(t, "What does this print?\n\n```go\n// - [ ] not a choice\nwg.Wait()\n```", en.Content)
+	assert.Equal(t, []bundle.Choice{
+		{Content: "Nothing until every goroutine is done", Correct: true, Explanation: "Wait blocks until the counter is zero."},
+		{Content: "It panics\nwhen the counter is negative"},
+	}, en.Choices)
+	assert.Equal(t, "See the `sync` package documentation.", en.Explanation)
+
+	ko := p.Translations[1]
+	assert.Equal(t, "ko", ko.Locale)
+	assert.True(t, ko.Choices[0].Correct)
+
+	require.Len(t, e.VersionRules, 1)
+	assert.Equal(t, "wg", e.VersionRules[0].Problem)
+}
+
+func TestDecodeDir_Errors(t *testing.T) {
+	tests := map[string]fstest.MapFS{
+		"unknown unit": {
+			"exam.yaml":              {Data: []byte(structure)},
+			"problems/channels/c.md": {Data: []byte("---\nlocale: en\ntitle: C\n---\n- [x] A\n")},
+		},
+		"missing front matter": {
+			"exam.yaml":                    {Data: []byte(structure)},
+			"problems/goroutines/wg.en.md": {Data: []byte("What does this print?\n")},
+		},
+		"locales disagree": {
+			"exam.yaml":                    {Data: []byte(structure)},
+			"problems/goroutines/wg.en.md": {Data: []byte("---\nlocale: en\ntitle: W\ndifficulty: 1\n---\n- [x] A\n")},
+			"problems/goroutines/wg.ko.md": {Data: []byte("---\nlocale: ko\ntitle: W\ndifficulty: 3\n---\n- [x] A\n")},
+		},
+	}
+	for name, fsys := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := bundle.DecodeDir(fsys)
+			assert.ErrorIs(t, err, bundle.ErrInvalid)
+		})
+	}
+}
+
+func TestEncodeDir_RoundTrip(t *testing.T) {
+	fsys := fstest.MapFS{
+		"exam.yaml":                    {Data: []byte(structure)},
+		"problems/goroutines/wg.en.md": {Data: []byte(goroutineEN)},
+	}
+	e, err := bundle.DecodeDir(fsys)
+	require.NoError(t, err)
+
+	dir := t.TempDir()
+	// Files of problems that no longer exist are removed
+	stale := filepath.Join(dir, "problems", "goroutines", "old.en.md")
+	require.NoError(t, os.MkdirAll(filepath.Dir(stale), 0o755))
+	require.NoError(t, os.WriteFile(stale, []byte("stale"), 0o644))
+
+	require.NoError(t, bundle.EncodeDir(dir, e))
+	assert.NoFileExists(t, stale)
+	written, err := os.ReadFile(filepath.Join(dir, "problems", "goroutines", "wg.en.md"))
+	require.NoError(t, err)
+	assert.Equal(t, goroutineEN, string(written))
+
+	back, err := bundle.DecodeDir(os.DirFS(dir))
+	require.NoError(t, err)
+	assert.Equal(t, e, back)
+}
+
+func TestEncodeDir_Assets(t *testing.T) {
+	hello := "2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824"
+	known := strings.Repeat("0a", 32)
+	e := &bundle.Exam{Key: "k", Title: "T", Assets: []bundle.Asset{
+		{Hash: hello, Name: "Hello.TXT", Data: "aGVsbG8="},
+		// Left for the server to have
+		{Hash: known, Name: "known.png"},
+	}}
+
+	dir := t.TempDir()
+	require.NoError(t, bundle.EncodeDir(dir, e))
+	written, err := os.ReadFile(filepath.Join(dir, "assets", hello+".txt"))
+	require.NoError(t, err)
+	assert.Equal(t, "hello", string(written))
+	structure, err := os.ReadFile(filepath.Join(dir, "exam.yaml"))
+	require.NoError(t, err)
+	assert.NotContains(t, string(structure), "data:")
+
+	back, err := bundle.DecodeDir(os.DirFS(dir))
+	require.NoError(t, err)
+	assert.Equal(t, e, back)
+}
+
+func TestEncodeDir_RejectsAmbiguousContent(t *testing.T) {
+	e := &bundle.Exam{Key: "k", Title: "T", Units: []bundle.Unit{{Key: "u", Title: "U", Problems: []bundle.Problem{{
+		Key: "p",
+		Translations: []bundle.Translation{{
+			Locale:  "en",
+			Content: "Tick the boxes:\n- [ ] one",
+			Choices: []bundle.Choice{{Content: "A", Correct: true}},
+		}},
+	}}}}}
+	assert.ErrorIs(t, bundle.EncodeDir(t.TempDir(), e), bundle.ErrInvalid)
+}
@@ -0,0 +1,217 @@
+package bundle
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+
+	"examination/internal/features/content/latex"
+)
+
+// ErrInvalid is returned, joined once per problem found, for bundles that
+// violate the format.
+var ErrInvalid = errors.New("invalid bundle")
+
+var (
+	problemTypes = []string{"", "SOURCE", "VARIANT"}
+	interactions = []string{"", "SINGLE", "MULTIPLE", "ORDERING", "NUMERIC", "TEXT"}
+	textMatches  = []string{"", "EXACT", "REGEX"}
+	operators    = []string{"Greater", "GreaterEqual", "Less", "LessEqual", "Equal", "NotEqual"}
+	ruleStatuses = []string{"", "ACTIVE", "DEPRECATED"}
+	assetHash    = regexp.MustCompile(`^[0-9a-f]{64}$`)
+)
+
+// Validate checks the structure of the bundle: required fields, unique keys
+// and references between problems and rules. Content rules such as "every
+// translation has a correct choice" are checked on import.
+func (e *Exam) Validate() error {
+	v := &validator{keys: make(map[string]map[string]bool)}
+
+	if e.Version > Version {
+		v.fail("version", "format version %d is newer than the supported %d", e.Version, Version)
+	}
+	v.required("key", e.Key)
+	v.required("title", e.Title)
+	if e.TimeLimit < 0 {
+		v.fail("time_limit", "must not be negative")
+	}
+
+	for i, s := range e.Sections {
+		path := fmt.Sprintf("sections[%d]", i)
+		v.entry(path, "section", s.Key, s.Title)
+		v.topics(path, s.Topics)
+		v.units(path, s.Units)
+	}
+	v.topics("", e.Topics)
+	v.units("", e.Units)
+
+	// References need every problem key collected first
+	for _, ref := range v.parents {
+		if !v.keys["problem"][ref.key] {
+			v.fail(ref.path+".parent", "unknown problem key %q", ref.key)
+		}
+	}
+	for i, r := range e.VersionRules {
+		path := fmt.Sprintf("version_rules[%d]", i)
+		if !slices.Contains(operators, r.Operator) {
+			v.fail(path+".operator", "must be one of %v", operators)
+		}
+		if !slices.Contains(ruleStatuses, r.Status) {
+			v.fail(path+".status", "must be ACTIVE or DEPRECATED")
+		}
+		if r.Problem != "" && !v.keys["problem"][r.Problem] {
+			v.fail(path+".problem", "unknown problem key %q", r.Problem)
+		}
+	}
+	hashes := make(map[string]bool)
+	for i, a := range e.Assets {
+		v.asset(fmt.Sprintf("assets[%d]", i), a, hashes)
+	}
+	return errors.Join(v.errs...)
+}
+
+type validator struct {
+	// keys maps kind -> key -> seen.
+	keys map[string]map[string]bool
+	// parents are the parent references of problems, checked last.
+	parents []reference
+	errs    []error
+}
+
+type reference struct {
+	path, key string
+}
+
+func (v *validator) fail(path, format string, args ...any) {
+	v.errs = append(v.errs, fmt.Errorf("%w: %s: %s", ErrInvalid, path, fmt.Sprintf(format, args...)))
+}
+
+func (v *validator) required(path, value string) {
+	if value == "" {
+		v.fail(path, "is required")
+	}
+}
+
+// entry checks the key and title of an entity and records the key.
+func (v *validator) entry(path, kind, key, title string) {
+	v.required(path+".title", title)
+	v.key(path, kind, key)
+}
+
+// key checks that the key is set and unique for its kind, and records it.
+func (v *validator) key(path, kind, key string) {
+	v.required(path+".key", key)
+	if key == "" {
+		return
+	}
+	if v.keys[kind] == nil {
+		v.keys[kind] = make(map[string]bool)
+	}
+	if v.keys[kind][key] {
+		v.fail(path+".key", "duplicate %s key %q", kind, key)
+	}
+	v.keys[kind][key] = true
+}
+
+func (v *validator) topics(parent string, topics []Topic) {
+	for i, t := range topics {
+		path := join(parent, fmt.Sprintf("topics[%d]", i))
+		v.entry(path, "topic", t.Key, t.Title)
+		v.units(path, t.Units)
+	}
+}
+
+func (v *validator) units(parent string, units []Unit) {
+	for i, u := range units {
+		path := join(parent, fmt.Sprintf("units[%d]", i))
+		v.entry(path, "unit", u.Key, u.Title)
+		for j, p := range u.Problems {
+			v.problem(fmt.Sprintf("%s.problems[%d]", path, j), p)
+		}
+	}
+}
+
+func (v *validator) problem(path string, p Problem) {
+	v.key(path, "problem", p.Key)
+	if !slices.Contains(problemTypes, p.Type) {
+		v.fail(path+".type", "must be SOURCE or VARIANT")
+	}
+	if !slices.Contains(interactions, p.Interaction) {
+		v.fail(path+".interaction", "must be one of %v", interactions[1:])
+	}
+	if p.Tolerance < 0 {
+		v.fail(path+".tolerance", "must not be negative")
+	}
+	if !slices.Contains(textMatches, p.TextMatch) {
+		v.fail(path+".text_match", "must be EXACT or REGEX")
+	}
+	if p.Parent != "" {
+		if p.Parent == p.Key {
+			v.fail(path+".parent", "a problem cannot be its own parent")
+		} else {
+			v.parents = append(v.parents, reference{path: path, key: p.Parent})
+		}
+	}
+
+	locales := make(map[string]bool)
+	for i, t := range p.Translations {
+		tpath := fmt.Sprintf("%s.translations[%d]", path, i)
+		v.required(tpath+".locale", t.Locale)
+		if locales[t.Locale] {
+			v.fail(tpath+".locale", "duplicate locale %q", t.Locale)
+		}
+		locales[t.Locale] = true
+
+		v.math(tpath+".content", t.Content)
+		v.math(tpath+".explanation", t.Explanation)
+		typed := p.Interaction == "NUMERIC" || p.Interaction == "TEXT"
+		for j, c := range t.Choices {
+			cpath := fmt.Sprintf("%s.choices[%d]", tpath, j)
+			if !typed {
+				v.math(cpath+".content", c.Content)
+			}
+			v.math(cpath+".explanation", c.Explanation)
+		}
+	}
+}
+
+// asset checks the hash of an asset against its data, when it has any.
+func (v *validator) asset(path string, a Asset, hashes map[string]bool) {
+	v.required(path+".name", a.Name)
+	if !assetHash.MatchString(a.Hash) {
+		v.fail(path+".hash", "must be a lower-case hex SHA-256")
+		return
+	}
+	if hashes[a.Hash] {
+		v.fail(path+".hash", "duplicate asset %s", a.Hash)
+	}
+	hashes[a.Hash] = true
+	if a.Data == "" {
+		return
+	}
+	data, err := a.Bytes()
+	if err != nil {
+		v.fail(path+".data", "must be base64")
+		return
+	}
+	if sum := sha256.Sum256(data); hex.EncodeToString(sum[:]) != a.Hash {
+		v.fail(path+".data", "does not match the hash")
+	}
+}
+
+// math fails path when its markdown holds malformed LaTeX.
+func (v *validator) math(path, markdown string) {
+	if err := latex.Validate(markdown); err != nil {
+		v.fail(path, "%v", err)
+	}
+}
+
+func join(parent, child string) string {
+	if parent == "" {
+		return child
+	}
+	return parent + "." + child
+}
@@ -0,0 +1,594 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"examination/internal/ent"
+	"examination/internal/ent/problem"
+	"examination/internal/ent/unit"
+	"examination/internal/features/content/service"
+	"examination/internal/features/content/ui"
+	"fmt"
+	"html/template"
+	"log"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+)
+
+// AdminHandler serves the authoring screens under /admin. Pages are full
+// documents; edits are HTMX requests answered with the fragment to swap
+// in. A rejected edit is answered with 422 and the submitted form, showing
+// the error in place.
+type AdminHandler struct {
+	client   *ent.Client
+	content  *service.ContentService
+	sequence *service.SequenceLogic
+}
+
+func NewAdminHandler(client *ent.Client) *AdminHandler {
+	return &AdminHandler{
+		client:   client,
+		content:  service.NewContentService(client),
+		sequence: service.NewSequenceLogic(client),
+	}
+}
+
+// render executes the named page or fragment of the admin templates, which
+// share their layout and partials.
+func (h *AdminHandler) render(w http.ResponseWriter, status int, name string, data any) {
+	renderAdmin(w, status, name, data)
+}
+
+func renderAdmin(w http.ResponseWriter, status int, name string, data any) {
+	tmpl, err := template.ParseFS(ui.FS, "admin_*.html")
+	if err != nil {
+		http.Error(w, "Failed to parse embedded template: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	// Buffered so that a failing template still gets an error response
+	var buf bytes.Buffer
+	if err := tmpl.ExecuteTemplate(&buf, name, data); err != nil {
+		http.Error(w, "Failed to render template: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.WriteHeader(status)
+	if _, err := buf.WriteTo(w); err != nil {
+		log.Printf("writing response: %v", err)
+	}
+}
+
+// redirect sends HTMX requests, which would swap a redirected page into
+// the target, to url with a full page load.
+func redirect(w http.ResponseWriter, r *http.Request, url string) {
+	if r.Header.Get("HX-Request") != "" {
+		w.Header().Set("HX-Redirect", url)
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+	http.Redirect(w, r, url, http.StatusSeeOther)
+}
+
+// retarget swaps the response into the element with the given ID instead
+// of the request's target, for forms whose success swaps something bigger.
+func retarget(w http.ResponseWriter, id string) {
+	w.Header().Set("HX-Retarget", "#"+id)
+	w.Header().Set("HX-Reswap", "outerHTML")
+}
+
+// userError returns the message of an error caused by the submitted
+// values, which is shown in place, and false for any other error.
+func userError(err error) (string, bool) {
+	var verr *service.ValidationError
+	switch {
+	case errors.As(err, &verr):
+		msgs := make([]string, 0, len(verr.Violations))
+		for _, v := range verr.Violations {
+			msgs = append(msgs, v.Message)
+		}
+		return strings.Join(msgs, "; "), true
+	case errors.Is(err, service.ErrInvalidMove):
+		return err.Error(), true
+	case ent.IsValidationError(err):
+		return err.Error(), true
+	}
+	return "", false
+}
+
+// fail answers an error of a write. Errors caused by the submitted values
+// are shown by show; others are a server error.
+func fail(w http.ResponseWriter, err error, show func(msg string)) {
+	if ent.IsNotFound(err) {
+		http.Error(w, "Not found", http.StatusNotFound)
+		return
+	}
+	if msg, ok := userError(err); ok {
+		show(msg)
+		return
+	}
+	http.Error(w, "Failed to save: "+err.Error(), http.StatusInternalServerError)
+}
+
+// loadError answers an error loading what a page shows.
+func loadError(w http.ResponseWriter, what string, err error) {
+	if ent.IsNotFound(err) {
+		http.Error(w, what+" not found", http.StatusNotFound)
+		return
+	}
+	http.Error(w, "Failed to load "+strings.ToLower(what)+": "+err.Error(), http.StatusInternalServerError)
+}
+
+// idParam resolves a positive integer URL parameter, writing the error
+// response itself when it is invalid.
+func idParam(w http.ResponseWriter, r *http.Request, name string) (int, bool) {
+	id, err := strconv.Atoi(chi.URLParam(r, name))
+	if err != nil || id <= 0 {
+		http.Error(w, "Invalid "+name, http.StatusBadRequest)
+		return 0, false
+	}
+	return id, true
+}
+
+// --- Exams ---
+
+// examForm is the data of the exam-form template.
+type examForm struct {
+	// ID is 0 for a new exam.
+	ID    int
+	Input service.ExamInput
+	// TimeLimit is the submitted value, kept when it is not a number.
+	TimeLimit string
+	Error     string
+	Saved     bool
+}
+
+func newExamForm(e *ent.Exam) examForm {
+	return examForm{
+		ID: e.ID,
+		Input: service.ExamInput{
+			Title:              e.Title,
+			Description:        e.Description,
+			TimeLimit:          e.TimeLimit,
+			IsActive:           e.IsActive,
+			ShuffleChoices:     e.ShuffleChoices,
+			WeightByDifficulty: e.WeightByDifficulty,
+			PartialCredit:      e.PartialCredit,
+			NegativeMarking:    e.NegativeMarking,
+		},
+		TimeLimit: strconv.Itoa(e.TimeLimit),
+	}
+}
+
+// parseExamForm reads the submitted exam form, setting Error when a value
+// is invalid.
+func parseExamForm(r *http.Request, id int) examForm {
+	f := examForm{
+		ID: id,
+		Input: service.ExamInput{
+			Title:              strings.TrimSpace(r.PostFormValue("title")),
+			Description:        strings.TrimSpace(r.PostFormValue("description")),
+			IsActive:           r.PostFormValue("is_active") != "",
+			ShuffleChoices:     r.PostFormValue("shuffle_choices") != "",
+			WeightByDifficulty: r.PostFormValue("weight_by_difficulty") != "",
+			PartialCredit:      r.PostFormValue("partial_credit") != "",
+			NegativeMarking:    r.PostFormValue("negative_marking") != "",
+		},
+		TimeLimit: strings.TrimSpace(r.PostFormValue("time_limit")),
+	}
+	limit, err := strconv.Atoi(f.TimeLimit)
+	switch {
+	case f.Input.Title == "":
+		f.Error = "Title is required."
+	case err != nil || limit < 0:
+		f.Error = "Time limit must be a number of minutes, 0 for untimed."
+	}
+	f.Input.TimeLimit = limit
+	return f
+}
+
+type examsPage struct {
+	Exams []*ent.Exam
+	New   examForm
+}
+
+// Exams lists the exams with a form to create one.
+func (h *AdminHandler) Exams(w http.ResponseWriter, r *http.Request) {
+	exams, err := h.content.ListExams(r.Context())
+	if err != nil {
+		loadError(w, "Exams", err)
+		return
+	}
+	h.render(w, http.StatusOK, "exams-page", examsPage{
+		Exams: exams,
+		New:   examForm{Input: service.ExamInput{IsActive: true}, TimeLimit: "60"},
+	})
+}
+
+// CreateExam creates an exam and opens it.
+func (h *AdminHandler) CreateExam(w http.ResponseWriter, r *http.Request) {
+	f := parseExamForm(r, 0)
+	if f.Error != "" {
+		h.render(w, http.StatusUnprocessableEntity, "exam-form", f)
+		return
+	}
+	created, err := h.content.CreateExam(r.Context(), f.Input)
+	if err != nil {
+		fail(w, err, func(msg string) {
+			f.Error = msg
+			h.render(w, http.StatusUnprocessableEntity, "exam-form", f)
+		})
+		return
+	}
+	redirect(w, r, fmt.Sprintf("/admin/exams/%d", created.ID))
+}
+
+type examPage struct {
+	Exam *ent.Exam
+	Form examForm
+	Tree *treeView
+}
+
+// Exam shows the exam settings and its hierarchy.
+func (h *AdminHandler) Exam(w http.ResponseWriter, r *http.Request) {
+	examID, ok := idParam(w, r, "examID")
+	if !ok {
+		return
+	}
+	e, err := h.content.GetExam(r.Context(), examID)
+	if err != nil {
+		loadError(w, "Exam", err)
+		return
+	}
+	tree, err := h.tree(r.Context(), e)
+	if err != nil {
+		loadError(w, "Exam", err)
+		return
+	}
+	h.render(w, http.StatusOK, "exam-page", examPage{Exam: e, Form: newExamForm(e), Tree: tree})
+}
+
+// UpdateExam saves the exam settings and answers with the form.
+func (h *AdminHandler) UpdateExam(w http.ResponseWriter, r *http.Request) {
+	examID, ok := idParam(w, r, "examID")
+	if !ok {
+		return
+	}
+	f := parseExamForm(r, examID)
+	if f.Error != "" {
+		h.render(w, http.StatusUnprocessableEntity, "exam-form", f)
+		return
+	}
+	updated, err := h.content.UpdateExam(r.Context(), examID, f.Input)
+	if err != nil {
+		fail(w, err, func(msg string) {
+			f.Error = msg
+			h.render(w, http.StatusUnprocessableEntity, "exam-form", f)
+		})
+		return
+	}
+	f = newExamForm(updated)
+	f.Saved = true
+	h.render(w, http.StatusOK, "exam-form", f)
+}
+
+// DeleteExam deletes the exam with everything in it.
+func (h *AdminHandler) DeleteExam(w http.ResponseWriter, r *http.Request) {
+	examID, ok := idParam(w, r, "examID")
+	if !ok {
+		return
+	}
+	if err := h.content.DeleteExam(r.Context(), examID); err != nil {
+		fail(w, err, func(msg string) { http.Error(w, msg, http.StatusUnprocessableEntity) })
+		return
+	}
+	redirect(w, r, "/admin")
+}
+
+// --- Hierarchy ---
+
+// treeView is the data of the tree template: the sections, topics and
+// units of an exam, each container listing its children in seq order.
+type treeView struct {
+	ExamID int
+	Root   *node
+	Error  string
+}
+
+// node is a container or unit in the tree.
+type node struct {
+	ExamID int
+	Kind   service.Kind
+	ID     int
+	Title  string
+	seq    int
+	// Problems counts the problems of a unit.
+	Problems int
+	Children []*node
+	// Add is the form adding a child; nil for units.
+	Add *addForm
+	// Error is shown next to the title after a rejected rename.
+	Error string
+}
+
+// addForm is the data of the add-form template.
+type addForm struct {
+	ExamID     int
+	ParentKind service.Kind
+	ParentID   int
+	// Kinds lists what can be added; Kind is the selected one.
+	Kinds []service.Kind
+	Kind  service.Kind
+	Title string
+	Error string
+}
+
+// FormID is the ID of the add form in the page.
+func (f *addForm) FormID() string {
+	return fmt.Sprintf("add-%s-%d", f.ParentKind, f.ParentID)
+}
+
+// childKinds lists the kinds each container can hold, in the order they
+// are offered.
+var childKinds = map[service.Kind][]service.Kind{
+	service.KindExam:    {service.KindSection, service.KindTopic, service.KindUnit},
+	service.KindSection: {service.KindTopic, service.KindUnit},
+	service.KindTopic:   {service.KindUnit},
+}
+
+// kindOrder breaks ties between siblings of different kinds, like
+// SequenceLogic does.
+var kindOrder = map[service.Kind]int{service.KindSection: 0, service.KindTopic: 1, service.KindUnit: 2}
+
+func newNode(examID int, kind service.Kind, id int, title string, seq int) *node {
+	n := &node{ExamID: examID, Kind: kind, ID: id, Title: title, seq: seq}
+	if kinds := childKinds[kind]; kinds != nil {
+		n.Add = &addForm{ExamID: examID, ParentKind: kind, ParentID: id, Kinds: kinds, Kind: kinds[len(kinds)-1]}
+	}
+	return n
+}
+
+// tree builds the hierarchy of an exam loaded by GetExam.
+func (h *AdminHandler) tree(ctx context.Context, e *ent.Exam) (*treeView, error) {
+	problems, err := h.client.Problem.Query().
+		Where(problem.HasUnitWith(unit.ExamID(e.ID))).
+		Select(problem.FieldUnitID).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("querying problems: %w", err)
+	}
+	counts := make(map[int]int)
+	for _, p := range problems {
+		counts[p.UnitID]++
+	}
+
+	root := newNode(e.ID, service.KindExam, e.ID, e.Title, 0)
+	sections := make(map[int]*node)
+	topics := make(map[int]*node)
+	for _, s := range e.Edges.Sections {
+		n := newNode(e.ID, service.KindSection, s.ID, s.Title, s.Seq)
+		sections[s.ID] = n
+		root.Children = append(root.Children, n)
+	}
+	for _, t := range e.Edges.Topics {
+		n := newNode(e.ID, service.KindTopic, t.ID, t.Title, t.Seq)
+		topics[t.ID] = n
+		parent := root
+		if t.SectionID != nil && sections[*t.SectionID] != nil {
+			parent = sections[*t.SectionID]
+		}
+		parent.Children = append(parent.Children, n)
+	}
+	for _, u := range e.Edges.Units {
+		n := newNode(e.ID, service.KindUnit, u.ID, u.Title, u.Seq)
+		n.Problems = counts[u.ID]
+		parent := root
+		switch {
+		case u.TopicID != nil && topics[*u.TopicID] != nil:
+			parent = topics[*u.TopicID]
+		case u.SectionID != nil && sections[*u.SectionID] != nil:
+			parent = sections[*u.SectionID]
+		}
+		parent.Children = append(parent.Children, n)
+	}
+	sortTree(root)
+	return &treeView{ExamID: e.ID, Root: root}, nil
+}
+
+func sortTree(n *node) {
+	sort.SliceStable(n.Children, func(i, j int) bool {
+		a, b := n.Children[i], n.Children[j]
+		if a.seq != b.seq {
+			return a.seq < b.seq
+		}
+		return kindOrder[a.Kind] < kindOrder[b.Kind]
+	})
+	for _, c := range n.Children {
+		sortTree(c)
+	}
+}
+
+// renderTree answers with the hierarchy of the exam, reloaded after a
+// change; msg is shown above it.
+func (h *AdminHandler) renderTree(w http.ResponseWriter, r *http.Request, examID int, status int, msg string) {
+	e, err := h.content.GetExam(r.Context(), examID)
+	if err != nil {
+		loadError(w, "Exam", err)
+		return
+	}
+	tree, err := h.tree(r.Context(), e)
+	if err != nil {
+		loadError(w, "Exam", err)
+		return
+	}
+	tree.Error = msg
+	h.render(w, status, "tree", tree)
+}
+
+// CreateNode adds a section, topic or unit to a container of the exam.
+// The new node is appended to its siblings.
+func (h *AdminHandler) CreateNode(w http.ResponseWriter, r *http.Request) {
+	examID, ok := idParam(w, r, "examID")
+	if !ok {
+		return
+	}
+	parentID, _ := strconv.Atoi(r.PostFormValue("parent_id"))
+	f := &addForm{
+		ExamID:     examID,
+		ParentKind: service.Kind(r.PostFormValue("parent_kind")),
+		ParentID:   parentID,
+		Kind:       service.Kind(r.PostFormValue("kind")),
+		Title:      strings.TrimSpace(r.PostFormValue("title")),
+	}
+	f.Kinds = childKinds[f.ParentKind]
+	show := func(msg string) {
+		f.Error = msg
+		retarget(w, f.FormID())
+		h.render(w, http.StatusUnprocessableEntity, "add-form", f)
+	}
+	if f.Kinds == nil || parentID <= 0 {
+		http.Error(w, "Invalid parent", http.StatusBadRequest)
+		return
+	}
+	if f.Title == "" {
+		show("Title is required.")
+		return
+	}
+
+	var sectionID, topicID *int
+	switch f.ParentKind {
+	case service.KindSection:
+		sectionID = &parentID
+	case service.KindTopic:
+		topicID = &parentID
+	}
+	var err error
+	switch f.Kind {
+	case service.KindSection:
+		if f.ParentKind != service.KindExam {
+			show("Sections can only be added to the exam.")
+			return
+		}
+		_, err = h.content.CreateSection(r.Context(), service.SectionInput{ExamID: examID, Title: f.Title})
+	case service.KindTopic:
+		if topicID != nil {
+			show("Topics cannot be nested.")
+			return
+		}
+		_, err = h.content.CreateTopic(r.Context(), service.TopicInput{ExamID: examID, SectionID: sectionID, Title: f.Title})
+	case service.KindUnit:
+		_, err = h.content.CreateUnit(r.Context(), service.UnitInput{ExamID: examID, SectionID: sectionID, TopicID: topicID, Title: f.Title})
+	default:
+		http.Error(w, "Invalid kind", http.StatusBadRequest)
+		return
+	}
+	if err != nil {
+		fail(w, err, show)
+		return
+	}
+	h.renderTree(w, r, examID, http.StatusOK, "")
+}
+
+// nodeParams resolves the {kind} and {id} URL parameters of a node.
+func nodeParams(w http.ResponseWriter, r *http.Request) (service.Kind, int, bool) {
+	kind := service.Kind(chi.URLParam(r, "kind"))
+	if _, ok := kindOrder[kind]; !ok {
+		http.Error(w, "Invalid kind", http.StatusBadRequest)
+		return "", 0, false
+	}
+	id, ok := idParam(w, r, "id")
+	return kind, id, ok
+}
+
+// RenameNode changes the title of a section, topic or unit and answers
+// with its title form.
+func (h *AdminHandler) RenameNode(w http.ResponseWriter, r *http.Request) {
+	examID, ok := idParam(w, r, "examID")
+	if !ok {
+		return
+	}
+	kind, id, ok := nodeParams(w, r)
+	if !ok {
+		return
+	}
+	n := &node{ExamID: examID, Kind: kind, ID: id, Title: strings.TrimSpace(r.PostFormValue("title"))}
+	show := func(msg string) {
+		n.Error = msg
+		h.render(w, http.StatusUnprocessableEntity, "node-title", n)
+	}
+	if n.Title == "" {
+		show("Title is required.")
+		return
+	}
+
+	var err error
+	switch kind {
+	case service.KindSection:
+		_, err = h.content.UpdateSection(r.Context(), id, service.SectionInput{Title: n.Title})
+	case service.KindTopic:
+		_, err = h.content.UpdateTopic(r.Context(), id, service.TopicInput{Title: n.Title})
+	case service.KindUnit:
+		_, err = h.content.UpdateUnit(r.Context(), id, service.UnitInput{Title: n.Title})
+	}
+	if err != nil {
+		fail(w, err, show)
+		return
+	}
+	h.render(w, http.StatusOK, "node-title", n)
+}
+
+// DeleteNode deletes a section, topic or unit with everything below it.
+func (h *AdminHandler) DeleteNode(w http.ResponseWriter, r *http.Request) {
+	examID, ok := idParam(w, r, "examID")
+	if !ok {
+		return
+	}
+	kind, id, ok := nodeParams(w, r)
+	if !ok {
+		return
+	}
+
+	var err error
+	switch kind {
+	case service.KindSection:
+		err = h.content.DeleteSection(r.Context(), id)
+	case service.KindTopic:
+		err = h.content.DeleteTopic(r.Context(), id)
+	case service.KindUnit:
+		err = h.content.DeleteUnit(r.Context(), id)
+	}
+	if err != nil {
+		fail(w, err, func(msg string) { h.renderTree(w, r, examID, http.StatusUnprocessableEntity, msg) })
+		return
+	}
+	h.renderTree(w, r, examID, http.StatusOK, "")
+}
+
+// MoveNode persists a drag: the node is placed at the 0-based index among
+// the children of the given container, which may be a new parent. The
+// tree is answered in either case, so a rejected move snaps back.
+func (h *AdminHandler) MoveNode(w http.ResponseWriter, r *http.Request) {
+	examID, ok := idParam(w, r, "examID")
+	if !ok {
+		return
+	}
+	id, err1 := strconv.Atoi(r.PostFormValue("id"))
+	parentID, err2 := strconv.Atoi(r.PostFormValue("parent_id"))
+	index, err3 := strconv.Atoi(r.PostFormValue("index"))
+	if err := errors.Join(err1, err2, err3); err != nil {
+		http.Error(w, "Invalid move", http.StatusBadRequest)
+		return
+	}
+	kind := service.Kind(r.PostFormValue("kind"))
+	to := service.Parent{Kind: service.Kind(r.PostFormValue("parent_kind")), ID: parentID}
+
+	if err := h.sequence.Move(r.Context(), kind, id, to, index); err != nil {
+		fail(w, err, func(msg string) { h.renderTree(w, r, examID, http.StatusUnprocessableEntity, msg) })
+		return
+	}
+	h.renderTree(w, r, examID, http.StatusOK, "")
+}
@@ -0,0 +1,581 @@
+package handler
+
+import (
+	"examination/internal/ent"
+	"examination/internal/ent/problem"
+	"examination/internal/ent/problemtranslation"
+	"examination/internal/features/content/service"
+	"examination/internal/features/exam/i18n"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// newChoiceRows is the number of empty choice rows of the new translation
+// form. Rows left empty are ignored.
+const newChoiceRows = 4
+
+// --- Units ---
+
+type unitPage struct {
+	Exam     *ent.Exam
+	Unit     *ent.Unit
+	Problems []problemRow
+	New      problemForm
+}
+
+// problemRow lists a problem by the title of its default translation.
+type problemRow struct {
+	Problem *ent.Problem
+	Title   string
+	Locales []string
+}
+
+// Unit lists the problems of a unit with a form to add one.
+func (h *AdminHandler) Unit(w http.ResponseWriter, r *http.Request) {
+	unitID, ok := idParam(w, r, "unitID")
+	if !ok {
+		return
+	}
+	u, err := h.client.Unit.Get(r.Context(), unitID)
+	if err != nil {
+		loadError(w, "Unit", err)
+		return
+	}
+	e, err := u.QueryExam().Only(r.Context())
+	if err != nil {
+		loadError(w, "Unit", err)
+		return
+	}
+	problems, err := h.client.Problem.Query().
+		Where(problem.UnitID(unitID)).
+		WithTranslations(func(tq *ent.ProblemTranslationQuery) {
+			tq.Order(ent.Asc(problemtranslation.FieldLocale))
+		}).
+		Order(ent.Asc(problem.FieldID)).
+		All(r.Context())
+	if err != nil {
+		loadError(w, "Unit", err)
+		return
+	}
+
+	page := unitPage{Exam: e, Unit: u, New: problemForm{
+		UnitID:      unitID,
+		Difficulty:  "1",
+		Interaction: problem.DefaultInteraction.String(),
+		Tolerance:   "0",
+		TextMatch:   problem.DefaultTextMatch.String(),
+	}}
+	for _, p := range problems {
+		row := problemRow{Problem: p}
+		if t, _ := i18n.Select(p.Edges.Translations, []string{i18n.DefaultLocale}); t != nil {
+			row.Title = t.Title
+		}
+		for _, t := range p.Edges.Translations {
+			row.Locales = append(row.Locales, t.Locale)
+		}
+		page.Problems = append(page.Problems, row)
+	}
+	h.render(w, http.StatusOK, "unit-page", page)
+}
+
+// --- Problems ---
+
+// problemForm is the data of the problem-form template.
+type problemForm struct {
+	// ID is 0 for a new problem.
+	ID          int
+	UnitID      int
+	Difficulty  string
+	Interaction string
+	Tolerance   string
+	TextMatch   string
+	Error       string
+	Saved       bool
+}
+
+// newProblemForm returns the form showing p's settings.
+func newProblemForm(p *ent.Problem) problemForm {
+	return problemForm{
+		ID:          p.ID,
+		UnitID:      p.UnitID,
+		Difficulty:  strconv.Itoa(p.Difficulty),
+		Interaction: p.Interaction.String(),
+		Tolerance:   strconv.FormatFloat(p.Tolerance, 'g', -1, 64),
+		TextMatch:   p.TextMatch.String(),
+	}
+}
+
+// parseProblemForm reads the submitted problem form, setting Error when a
+// value is invalid.
+func parseProblemForm(r *http.Request, id, unitID int) (problemForm, service.ProblemInput) {
+	f := problemForm{
+		ID:          id,
+		UnitID:      unitID,
+		Difficulty:  strings.TrimSpace(r.PostFormValue("difficulty")),
+		Interaction: r.PostFormValue("interaction"),
+		Tolerance:   strings.TrimSpace(r.PostFormValue("tolerance")),
+		TextMatch:   r.PostFormValue("text_match"),
+	}
+	if f.Tolerance == "" {
+		f.Tolerance = "0"
+	}
+	in := service.ProblemInput{
+		UnitID:      unitID,
+		Interaction: problem.Interaction(f.Interaction),
+		TextMatch:   problem.TextMatch(f.TextMatch),
+	}
+	var err error
+	in.Difficulty, err = strconv.Atoi(f.Difficulty)
+	if err != nil || in.Difficulty < 1 {
+		f.Error = "Difficulty must be a whole number of at least 1."
+		return f, in
+	}
+	if problem.InteractionValidator(in.Interaction) != nil {
+		f.Error = "Choose how the problem is answered."
+		return f, in
+	}
+	in.Tolerance, err = strconv.ParseFloat(f.Tolerance, 64)
+	if err != nil || in.Tolerance < 0 {
+		f.Error = "Tolerance must be a number of at least 0."
+		return f, in
+	}
+	if problem.TextMatchValidator(in.TextMatch) != nil {
+		f.Error = "Choose how text answers are matched."
+	}
+	return f, in
+}
+
+// CreateProblem adds a problem to the unit and opens it to add its
+// translations.
+func (h *AdminHandler) CreateProblem(w http.ResponseWriter, r *http.Request) {
+	unitID, ok := idParam(w, r, "unitID")
+	if !ok {
+		return
+	}
+	f, in := parseProblemForm(r, 0, unitID)
+	if f.Error != "" {
+		h.render(w, http.StatusUnprocessableEntity, "problem-form", f)
+		return
+	}
+	created, err := h.content.CreateProblem(r.Context(), in)
+	if err != nil {
+		fail(w, err, func(msg string) {
+			f.Error = msg
+			h.render(w, http.StatusUnprocessableEntity, "problem-form", f)
+		})
+		return
+	}
+	redirect(w, r, fmt.Sprintf("/admin/problems/%d", created.ID))
+}
+
+type problemPage struct {
+	Exam         *ent.Exam
+	Unit         *ent.Unit
+	Problem      *ent.Problem
+	Form         problemForm
+	Translations []*translationView
+	New          newTranslationForm
+	// Upload is the empty asset upload form.
+	Upload assetForm
+}
+
+// Problem shows a problem with its translations and their choices.
+func (h *AdminHandler) Problem(w http.ResponseWriter, r *http.Request) {
+	problemID, ok := idParam(w, r, "problemID")
+	if !ok {
+		return
+	}
+	p, err := h.content.GetProblem(r.Context(), problemID)
+	if err != nil {
+		loadError(w, "Problem", err)
+		return
+	}
+	u, err := p.QueryUnit().WithExam().Only(r.Context())
+	if err != nil {
+		loadError(w, "Problem", err)
+		return
+	}
+
+	page := problemPage{
+		Exam:    u.Edges.Exam,
+		Unit:    u,
+		Problem: p,
+		Form:    newProblemForm(p),
+		New:     newTranslation(p.ID),
+	}
+	for _, t := range p.Edges.Translations {
+		page.Translations = append(page.Translations, newTranslationView(t))
+	}
+	h.render(w, http.StatusOK, "problem-page", page)
+}
+
+// UpdateProblem saves the problem settings and answers with the form.
+func (h *AdminHandler) UpdateProblem(w http.ResponseWriter, r *http.Request) {
+	problemID, ok := idParam(w, r, "problemID")
+	if !ok {
+		return
+	}
+	unitID, _ := strconv.Atoi(r.PostFormValue("unit_id"))
+	f, in := parseProblemForm(r, problemID, unitID)
+	if f.Error != "" {
+		h.render(w, http.StatusUnprocessableEntity, "problem-form", f)
+		return
+	}
+	updated, err := h.content.UpdateProblem(r.Context(), problemID, in)
+	if err != nil {
+		fail(w, err, func(msg string) {
+			f.Error = msg
+			h.render(w, http.StatusUnprocessableEntity, "problem-form", f)
+		})
+		return
+	}
+	f = newProblemForm(updated)
+	f.Saved = true
+	h.render(w, http.StatusOK, "problem-form", f)
+}
+
+// DeleteProblem deletes the problem and returns to its unit.
+func (h *AdminHandler) DeleteProblem(w http.ResponseWriter, r *http.Request) {
+	problemID, ok := idParam(w, r, "problemID")
+	if !ok {
+		return
+	}
+	p, err := h.content.GetProblem(r.Context(), problemID)
+	if err != nil {
+		loadError(w, "Problem", err)
+		return
+	}
+	if err := h.content.DeleteProblem(r.Context(), problemID); err != nil {
+		fail(w, err, func(msg string) { http.Error(w, msg, http.StatusUnprocessableEntity) })
+		return
+	}
+	redirect(w, r, fmt.Sprintf("/admin/units/%d", p.UnitID))
+}
+
+// --- Translations ---
+
+// translationView is the data of the translation template: the text form
+// of a translation and its choices.
+type translationView struct {
+	Form    translationForm
+	Choices choicesView
+}
+
+func newTranslationView(t *ent.ProblemTranslation) *translationView {
+	return &translationView{
+		Form: translationForm{
+			ID: t.ID, Locale: t.Locale, Title: t.Title, Content: t.Content, Explanation: t.Explanation,
+		},
+		Choices: newChoicesView(t.ID, t.Edges.Choices),
+	}
+}
+
+// translationForm is the data of the translation-form template.
+type translationForm struct {
+	ID          int
+	Locale      string
+	Title       string
+	Content     string
+	Explanation string
+	Error       string
+	Saved       bool
+}
+
+// parseTranslationForm reads the submitted text fields of a translation,
+// setting Error when a required one is empty.
+func parseTranslationForm(r *http.Request, id int) translationForm {
+	f := translationForm{
+		ID:          id,
+		Locale:      strings.TrimSpace(r.PostFormValue("locale")),
+		Title:       strings.TrimSpace(r.PostFormValue("title")),
+		Content:     strings.TrimSpace(r.PostFormValue("content")),
+		Explanation: strings.TrimSpace(r.PostFormValue("explanation")),
+	}
+	switch {
+	case f.Locale == "":
+		f.Error = "Locale is required."
+	case f.Title == "":
+		f.Error = "Title is required."
+	case f.Content == "":
+		f.Error = "Content is required."
+	}
+	return f
+}
+
+func (f translationForm) input() service.ProblemTranslationInput {
+	return service.ProblemTranslationInput{Locale: f.Locale, Title: f.Title, Content: f.Content, Explanation: f.Explanation}
+}
+
+// newTranslationForm is the data of the new-translation template. The
+// choices are created with the translation, which needs a correct one.
+type newTranslationForm struct {
+	ProblemID int
+	Text      translationForm
+	Choices   []service.ChoiceInput
+}
+
+func newTranslation(problemID int) newTranslationForm {
+	return newTranslationForm{ProblemID: problemID, Choices: make([]service.ChoiceInput, newChoiceRows)}
+}
+
+// CreateTranslation adds a translation with its choices to the problem.
+// The card is appended to the translations and the form is reset.
+func (h *AdminHandler) CreateTranslation(w http.ResponseWriter, r *http.Request) {
+	problemID, ok := idParam(w, r, "problemID")
+	if !ok {
+		return
+	}
+	f := newTranslationForm{ProblemID: problemID, Text: parseTranslationForm(r, 0)}
+	contents := r.PostForm["choice_content"]
+	correct := make(map[string]bool)
+	for _, i := range r.PostForm["choice_correct"] {
+		correct[i] = true
+	}
+	var choices []service.ChoiceInput
+	for i, content := range contents {
+		c := service.ChoiceInput{Content: strings.TrimSpace(content), IsCorrect: correct[strconv.Itoa(i)]}
+		f.Choices = append(f.Choices, c)
+		if c.Content != "" {
+			c.Seq = len(choices) + 1
+			choices = append(choices, c)
+		} else if c.IsCorrect && f.Text.Error == "" {
+			f.Text.Error = fmt.Sprintf("Choice %d is marked correct but empty.", i+1)
+		}
+	}
+	show := func(msg string) {
+		f.Text.Error = msg
+		h.render(w, http.StatusUnprocessableEntity, "new-translation", f)
+	}
+	if f.Text.Error != "" {
+		show(f.Text.Error)
+		return
+	}
+
+	in := f.Text.input()
+	in.ProblemID = problemID
+	in.Choices = choices
+	created, err := h.content.CreateProblemTranslation(r.Context(), in)
+	if ent.IsConstraintError(err) {
+		// Locales are unique within a problem
+		show(fmt.Sprintf("The problem already has a translation in %q.", in.Locale))
+		return
+	}
+	if err != nil {
+		fail(w, err, show)
+		return
+	}
+	h.render(w, http.StatusOK, "translation-created", struct {
+		Translation *translationView
+		New         newTranslationForm
+	}{newTranslationView(created), newTranslation(problemID)})
+}
+
+// UpdateTranslation saves the text fields of a translation and answers
+// with its form.
+func (h *AdminHandler) UpdateTranslation(w http.ResponseWriter, r *http.Request) {
+	translationID, ok := idParam(w, r, "translationID")
+	if !ok {
+		return
+	}
+	f := parseTranslationForm(r, translationID)
+	if f.Error != "" {
+		h.render(w, http.StatusUnprocessableEntity, "translation-form", f)
+		return
+	}
+	updated, err := h.content.UpdateProblemTranslation(r.Context(), translationID, f.input())
+	if err != nil {
+		fail(w, err, func(msg string) {
+			f.Error = msg
+			h.render(w, http.StatusUnprocessableEntity, "translation-form", f)
+		})
+		return
+	}
+	h.render(w, http.StatusOK, "translation-form", translationForm{
+		ID: updated.ID, Locale: updated.Locale, Title: updated.Title,
+		Content: updated.Content, Explanation: updated.Explanation, Saved: true,
+	})
+}
+
+// DeleteTranslation deletes a translation with its choices. The empty
+// answer removes its card.
+func (h *AdminHandler) DeleteTranslation(w http.ResponseWriter, r *http.Request) {
+	translationID, ok := idParam(w, r, "translationID")
+	if !ok {
+		return
+	}
+	if err := h.content.DeleteProblemTranslation(r.Context(), translationID); err != nil {
+		fail(w, err, func(msg string) { http.Error(w, msg, http.StatusUnprocessableEntity) })
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+// --- Choices ---
+
+// choicesView is the data of the choices template: the choices of a
+// translation in seq order with a form to add one.
+type choicesView struct {
+	TranslationID int
+	Choices       []choiceForm
+	Add           choiceForm
+	// Error is shown above the choices after a rejected delete or move.
+	Error string
+}
+
+func newChoicesView(translationID int, choices []*ent.Choice) choicesView {
+	v := choicesView{TranslationID: translationID, Add: choiceForm{TranslationID: translationID}}
+	for _, c := range choices {
+		v.Choices = append(v.Choices, choiceForm{
+			ID: c.ID, TranslationID: translationID, Content: c.Content,
+			Explanation: c.Explanation, IsCorrect: c.IsCorrect,
+		})
+	}
+	return v
+}
+
+// choiceForm is the data of the choice template.
+type choiceForm struct {
+	// ID is 0 for a new choice.
+	ID            int
+	TranslationID int
+	Content       string
+	Explanation   string
+	IsCorrect     bool
+	Error         string
+}
+
+// parseChoiceForm reads the submitted choice form, setting Error when the
+// content is empty.
+func parseChoiceForm(r *http.Request, id, translationID int) choiceForm {
+	f := choiceForm{
+		ID:            id,
+		TranslationID: translationID,
+		Content:       strings.TrimSpace(r.PostFormValue("content")),
+		Explanation:   strings.TrimSpace(r.PostFormValue("explanation")),
+		IsCorrect:     r.PostFormValue("is_correct") != "",
+	}
+	if f.Content == "" {
+		f.Error = "Content is required."
+	}
+	return f
+}
+
+func (f choiceForm) input() service.ChoiceInput {
+	return service.ChoiceInput{
+		ProblemTranslationID: f.TranslationID, Content: f.Content, IsCorrect: f.IsCorrect, Explanation: f.Explanation,
+	}
+}
+
+// renderChoices answers with the choices of the translation, reloaded after
+// a change. A rejected change is answered with 422 and v's add form and
+// error shown with the stored choices.
+func (h *AdminHandler) renderChoices(w http.ResponseWriter, r *http.Request, translationID int, rejected *choicesView) {
+	t, err := h.content.GetProblemTranslation(r.Context(), translationID)
+	if err != nil {
+		loadError(w, "Translation", err)
+		return
+	}
+	v := newChoicesView(t.ID, t.Edges.Choices)
+	status := http.StatusOK
+	if rejected != nil {
+		v.Add, v.Error = rejected.Add, rejected.Error
+		status = http.StatusUnprocessableEntity
+	}
+	h.render(w, status, "choices", v)
+}
+
+// CreateChoice appends a choice to the translation.
+func (h *AdminHandler) CreateChoice(w http.ResponseWriter, r *http.Request) {
+	translationID, ok := idParam(w, r, "translationID")
+	if !ok {
+		return
+	}
+	f := parseChoiceForm(r, 0, translationID)
+	show := func(msg string) {
+		f.Error = msg
+		h.renderChoices(w, r, translationID, &choicesView{Add: f})
+	}
+	if f.Error != "" {
+		show(f.Error)
+		return
+	}
+	if _, err := h.content.CreateChoice(r.Context(), f.input()); err != nil {
+		fail(w, err, show)
+		return
+	}
+	h.renderChoices(w, r, translationID, nil)
+}
+
+// MoveChoice persists a drag of a choice to the 0-based index among the
+// choices of its translation.
+func (h *AdminHandler) MoveChoice(w http.ResponseWriter, r *http.Request) {
+	translationID, ok := idParam(w, r, "translationID")
+	if !ok {
+		return
+	}
+	id, err1 := strconv.Atoi(r.PostFormValue("id"))
+	index, err2 := strconv.Atoi(r.PostFormValue("index"))
+	if err1 != nil || err2 != nil {
+		http.Error(w, "Invalid move", http.StatusBadRequest)
+		return
+	}
+	to := service.Parent{Kind: service.KindTranslation, ID: translationID}
+	if err := h.sequence.Move(r.Context(), service.KindChoice, id, to, index); err != nil {
+		fail(w, err, func(msg string) {
+			h.renderChoices(w, r, translationID, &choicesView{Add: choiceForm{TranslationID: translationID}, Error: msg})
+		})
+		return
+	}
+	h.renderChoices(w, r, translationID, nil)
+}
+
+// UpdateChoice saves a choice and answers with its row.
+func (h *AdminHandler) UpdateChoice(w http.ResponseWriter, r *http.Request) {
+	choiceID, ok := idParam(w, r, "choiceID")
+	if !ok {
+		return
+	}
+	translationID, _ := strconv.Atoi(r.PostFormValue("translation_id"))
+	f := parseChoiceForm(r, choiceID, translationID)
+	if f.Error != "" {
+		h.render(w, http.StatusUnprocessableEntity, "choice", f)
+		return
+	}
+	updated, err := h.content.UpdateChoice(r.Context(), choiceID, f.input())
+	if err != nil {
+		fail(w, err, func(msg string) {
+			f.Error = msg
+			h.render(w, http.StatusUnprocessableEntity, "choice", f)
+		})
+		return
+	}
+	h.render(w, http.StatusOK, "choice", choiceForm{
+		ID: updated.ID, TranslationID: updated.ProblemTranslationID, Content: updated.Content,
+		Explanation: updated.Explanation, IsCorrect: updated.IsCorrect,
+	})
+}
+
+// DeleteChoice deletes a choice and answers with the remaining choices of
+// its translation.
+func (h *AdminHandler) DeleteChoice(w http.ResponseWriter, r *http.Request) {
+	choiceID, ok := idParam(w, r, "choiceID")
+	if !ok {
+		return
+	}
+	c, err := h.content.GetChoice(r.Context(), choiceID)
+	if err != nil {
+		loadError(w, "Choice", err)
+		return
+	}
+	if err := h.content.DeleteChoice(r.Context(), choiceID); err != nil {
+		fail(w, err, func(msg string) {
+			h.renderChoices(w, r, c.ProblemTranslationID, &choicesView{
+				Add: choiceForm{TranslationID: c.ProblemTranslationID}, Error: msg,
+			})
+		})
+		return
+	}
+	h.renderChoices(w, r, c.ProblemTranslationID, nil)
+}
@@ -0,0 +1,199 @@
+package handler_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"testing"
+
+	"examination/internal/ent"
+	"examination/internal/ent/choice"
+	"examination/internal/ent/unit"
+	"examination/internal/features/content/handler"
+	"examination/internal/features/content/service"
+	"examination/internal/testutil"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// adminServer serves the admin routes as cmd/server mounts them, without
+// the staff check, which the auth handler tests cover.
+type adminServer struct {
+	t       *testing.T
+	client  *ent.Client
+	content *service.ContentService
+	router  chi.Router
+}
+
+func newAdminServer(t *testing.T) *adminServer {
+	client := testutil.NewClient(t)
+	h := handler.NewAdminHandler(client)
+	r := chi.NewRouter()
+	r.Route("/admin", func(r chi.Router) {
+		r.Post("/exams", h.CreateExam)
+		r.Put("/exams/{examID}", h.UpdateExam)
+		r.Post("/exams/{examID}/nodes", h.CreateNode)
+		r.Delete("/exams/{examID}/nodes/{kind}/{id}", h.DeleteNode)
+		r.Post("/exams/{examID}/move", h.MoveNode)
+		r.Delete("/problems/{problemID}", h.DeleteProblem)
+		r.Post("/translations/{translationID}/move", h.MoveChoice)
+		r.Put("/choices/{choiceID}", h.UpdateChoice)
+	})
+	return &adminServer{t: t, client: client, content: service.NewContentService(client), router: r}
+}
+
+// htmx sends a form as HTMX does.
+func (s *adminServer) htmx(method, path string, form url.Values) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, path, strings.NewReader(form.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("HX-Request", "true")
+	rec := httptest.NewRecorder()
+	s.router.ServeHTTP(rec, req)
+	return rec
+}
+
+// seed creates an exam with a section holding two units, the first with
+// a problem whose English translation has two choices, the first correct.
+func (s *adminServer) seed() (*ent.Exam, *ent.Section, []*ent.Unit, *ent.ProblemTranslation) {
+	ctx := context.Background()
+	e, err := s.content.CreateExam(ctx, service.ExamInput{Title: "Exam", TimeLimit: 30, IsActive: true})
+	require.NoError(s.t, err)
+	sec, err := s.content.CreateSection(ctx, service.SectionInput{ExamID: e.ID, Title: "Section"})
+	require.NoError(s.t, err)
+	var units []*ent.Unit
+	for _, title := range []string{"First", "Second"} {
+		u, err := s.content.CreateUnit(ctx, service.UnitInput{ExamID: e.ID, SectionID: &sec.ID, Title: title})
+		require.NoError(s.t, err)
+		units = append(units, u)
+	}
+	p, err := s.content.CreateProblem(ctx, service.ProblemInput{UnitID: units[0].ID, Difficulty: 1})
+	require.NoError(s.t, err)
+	tr, err := s.content.CreateProblemTranslation(ctx, service.ProblemTranslationInput{
+		ProblemID: p.ID, Locale: "en", Title: "Q", Content: "Pick one.",
+		Choices: []service.ChoiceInput{{Content: "A", IsCorrect: true, Seq: 1}, {Content: "B", Seq: 2}},
+	})
+	require.NoError(s.t, err)
+	return e, sec, units, tr
+}
+
+func TestAdminHandler_CreateExam(t *testing.T) {
+	s := newAdminServer(t)
+
+	rec := s.htmx(http.MethodPost, "/admin/exams", url.Values{"title": {"Exam"}, "time_limit": {"45"}})
+	require.Equal(t, http.StatusNoContent, rec.Code)
+	e := s.client.Exam.Query().OnlyX(context.Background())
+	assert.Equal(t, "/admin/exams/"+strconv.Itoa(e.ID), rec.Header().Get("HX-Redirect"))
+	assert.Equal(t, 45, e.TimeLimit)
+
+	// Without HTMX the browser follows a plain redirect
+	req := httptest.NewRequest(http.MethodPost, "/admin/exams", strings.NewReader(url.Values{"title": {"Other"}, "time_limit": {"30"}}.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	rec = httptest.NewRecorder()
+	s.router.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusSeeOther, rec.Code)
+	assert.Empty(t, rec.Header().Get("HX-Redirect"))
+	assert.Regexp(t, `^/admin/exams/\d+$`, rec.Header().Get("Location"))
+
+	// A rejected form comes back in place with what was typed
+	rec = s.htmx(http.MethodPost, "/admin/exams", url.Values{"title": {""}, "description": {"Kept"}, "time_limit": {"45"}})
+	assert.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+	assert.Contains(t, rec.Body.String(), "Title is required.")
+	assert.Contains(t, rec.Body.String(), "Kept")
+	assert.Equal(t, 2, s.client.Exam.Query().CountX(context.Background()))
+}
+
+func TestAdminHandler_CreateNode(t *testing.T) {
+	s := newAdminServer(t)
+	e, sec, _, _ := s.seed()
+	path := "/admin/exams/" + strconv.Itoa(e.ID) + "/nodes"
+
+	rec := s.htmx(http.MethodPost, path, url.Values{
+		"parent_kind": {"section"}, "parent_id": {strconv.Itoa(sec.ID)}, "kind": {"unit"}, "title": {"Third"},
+	})
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Body.String(), "Third")
+	assert.True(t, s.client.Unit.Query().Where(unit.Title("Third"), unit.SectionID(sec.ID), unit.Seq(3)).ExistX(context.Background()))
+
+	// The error replaces the add form rather than the tree
+	rec = s.htmx(http.MethodPost, path, url.Values{
+		"parent_kind": {"section"}, "parent_id": {strconv.Itoa(sec.ID)}, "kind": {"section"}, "title": {"Nested"},
+	})
+	assert.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+	assert.Equal(t, "#add-section-"+strconv.Itoa(sec.ID), rec.Header().Get("HX-Retarget"))
+	assert.Equal(t, "outerHTML", rec.Header().Get("HX-Reswap"))
+	assert.Contains(t, rec.Body.String(), "Sections can only be added to the exam.")
+}
+
+func TestAdminHandler_RejectedEdit(t *testing.T) {
+	ctx := context.Background()
+	s := newAdminServer(t)
+	_, _, _, tr := s.seed()
+	correct := s.client.Choice.Query().Where(choice.IsCorrect(true)).OnlyX(ctx)
+
+	// Unmarking the only correct choice is refused and the row keeps the input
+	rec := s.htmx(http.MethodPut, "/admin/choices/"+strconv.Itoa(correct.ID), url.Values{
+		"translation_id": {strconv.Itoa(tr.ID)}, "content": {"A, reworded"},
+	})
+	assert.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+	assert.Contains(t, rec.Body.String(), "has no correct choice")
+	assert.Contains(t, rec.Body.String(), "A, reworded")
+	got := s.client.Choice.GetX(ctx, correct.ID)
+	assert.True(t, got.IsCorrect)
+	assert.Equal(t, "A", got.Content)
+}
+
+func TestAdminHandler_Reorder(t *testing.T) {
+	ctx := context.Background()
+	s := newAdminServer(t)
+	e, sec, units, tr := s.seed()
+	move := "/admin/exams/" + strconv.Itoa(e.ID) + "/move"
+
+	rec := s.htmx(http.MethodPost, move, url.Values{
+		"kind": {"unit"}, "id": {strconv.Itoa(units[1].ID)},
+		"parent_kind": {"section"}, "parent_id": {strconv.Itoa(sec.ID)}, "index": {"0"},
+	})
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, 1, s.client.Unit.GetX(ctx, units[1].ID).Seq)
+	assert.Equal(t, 2, s.client.Unit.GetX(ctx, units[0].ID).Seq)
+
+	// A move the hierarchy does not allow snaps back with the reason
+	rec = s.htmx(http.MethodPost, move, url.Values{
+		"kind": {"section"}, "id": {strconv.Itoa(sec.ID)},
+		"parent_kind": {"unit"}, "parent_id": {strconv.Itoa(units[0].ID)}, "index": {"0"},
+	})
+	assert.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+	assert.Contains(t, rec.Body.String(), "invalid move")
+	assert.Equal(t, 1, s.client.Section.GetX(ctx, sec.ID).Seq)
+
+	byTranslation := s.client.Choice.Query().Where(choice.ProblemTranslationID(tr.ID)).Order(choice.BySeq())
+	choices := byTranslation.Clone().AllX(ctx)
+	rec = s.htmx(http.MethodPost, "/admin/translations/"+strconv.Itoa(tr.ID)+"/move", url.Values{
+		"id": {strconv.Itoa(choices[1].ID)}, "index": {"0"},
+	})
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, []int{choices[1].ID, choices[0].ID}, byTranslation.Clone().IDsX(ctx))
+}
+
+func TestAdminHandler_Delete(t *testing.T) {
+	ctx := context.Background()
+	s := newAdminServer(t)
+	e, sec, units, tr := s.seed()
+
+	p := s.client.Problem.GetX(ctx, tr.ProblemID)
+	rec := s.htmx(http.MethodDelete, "/admin/problems/"+strconv.Itoa(p.ID), nil)
+	require.Equal(t, http.StatusNoContent, rec.Code)
+	assert.Equal(t, "/admin/units/"+strconv.Itoa(units[0].ID), rec.Header().Get("HX-Redirect"))
+	assert.Zero(t, s.client.Problem.Query().CountX(ctx))
+	assert.Zero(t, s.client.Choice.Query().CountX(ctx))
+
+	rec = s.htmx(http.MethodDelete, "/admin/exams/"+strconv.Itoa(e.ID)+"/nodes/section/"+strconv.Itoa(sec.ID), nil)
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.NotContains(t, rec.Body.String(), "Section")
+	assert.Zero(t, s.client.Section.Query().CountX(ctx))
+	assert.Zero(t, s.client.Unit.Query().CountX(ctx), "units go with their section")
+}
@@ -0,0 +1,141 @@
+package handler
+
+import (
+	"errors"
+	"examination/internal/ent"
+	authhandler "examination/internal/features/auth/handler"
+	authservice "examination/internal/features/auth/service"
+	"examination/internal/features/content/assetstore"
+	"examination/internal/features/content/markdown"
+	"examination/internal/features/content/service"
+	"io"
+	"log"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+)
+
+// AssetHandler serves the images and attachments of problem content at
+// markdown.AssetPath and takes uploads from the authoring screens.
+type AssetHandler struct {
+	assets *service.AssetService
+}
+
+func NewAssetHandler(client *ent.Client, storage assetstore.Storage) *AssetHandler {
+	return &AssetHandler{assets: service.NewAssetService(client, storage)}
+}
+
+// uploadHint is shown when the upload holds no usable file.
+const uploadHint = "Choose a file of at most 10 MiB."
+
+// assetForm is the data of the asset-form template: the upload form and
+// the last file uploaded with it.
+type assetForm struct {
+	Asset *ent.Asset
+	Error string
+}
+
+// Image reports whether the asset is shown in content rather than linked.
+func (f assetForm) Image() bool {
+	return strings.HasPrefix(f.Asset.ContentType, "image/")
+}
+
+// Markdown is what shows the asset in content.
+func (f assetForm) Markdown() string {
+	ref := "[" + f.Asset.Name + "](" + markdown.AssetScheme + f.Asset.Hash + ")"
+	if f.Image() {
+		return "!" + ref
+	}
+	return ref
+}
+
+// RequireSignInForPrivate restricts the asset named by the {hash} URL
+// parameter to signed-in users, unless it is shown in the preview of an
+// active exam, which is public.
+func (h *AssetHandler) RequireSignInForPrivate(next http.Handler) http.Handler {
+	signedIn := authhandler.RequireRole(authservice.Roles...)(next)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		public, err := h.assets.Public(r.Context(), chi.URLParam(r, "hash"))
+		if err != nil {
+			http.Error(w, "Failed to load asset: "+err.Error(), http.StatusInternalServerError)
+			return
+		}
+		if !public {
+			signedIn.ServeHTTP(w, r)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// Serve sends the asset named by the {hash} URL parameter. An asset never
+// changes, so browsers keep it for a year and revalidate by ETag. Files
+// are sandboxed, so that an SVG opened on its own cannot run script.
+func (h *AssetHandler) Serve(w http.ResponseWriter, r *http.Request) {
+	hash := chi.URLParam(r, "hash")
+	a, body, err := h.assets.Open(r.Context(), hash)
+	if ent.IsNotFound(err) {
+		http.Error(w, "Asset not found", http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		http.Error(w, "Failed to load asset: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	defer body.Close()
+
+	etag := `"` + a.Hash + `"`
+	header := w.Header()
+	header.Set("Cache-Control", "private, max-age=31536000, immutable")
+	header.Set("ETag", etag)
+	if r.Header.Get("If-None-Match") == etag {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+	disposition := "attachment"
+	if strings.HasPrefix(a.ContentType, "image/") {
+		disposition = "inline"
+	}
+	header.Set("Content-Type", a.ContentType)
+	header.Set("Content-Length", strconv.FormatInt(a.Size, 10))
+	header.Set("Content-Disposition", mime.FormatMediaType(disposition, map[string]string{"filename": a.Name}))
+	header.Set("Content-Security-Policy", "default-src 'none'; style-src 'unsafe-inline'; sandbox")
+	header.Set("X-Content-Type-Options", "nosniff")
+	if _, err := io.Copy(w, body); err != nil {
+		log.Printf("writing asset %s: %v", a.Hash, err)
+	}
+}
+
+// Upload saves the file of the upload form and answers with the form,
+// showing the markdown that references the file.
+func (h *AssetHandler) Upload(w http.ResponseWriter, r *http.Request) {
+	r.Body = http.MaxBytesReader(w, r.Body, service.MaxAssetSize+1<<20)
+	file, header, err := r.FormFile("file")
+	if err != nil {
+		renderAdmin(w, http.StatusUnprocessableEntity, "asset-form", assetForm{Error: uploadHint})
+		return
+	}
+	defer file.Close()
+	data, err := io.ReadAll(file)
+	if err != nil {
+		renderAdmin(w, http.StatusUnprocessableEntity, "asset-form", assetForm{Error: uploadHint})
+		return
+	}
+
+	a, err := h.assets.Save(r.Context(), header.Filename, data)
+	switch {
+	case errors.Is(err, service.ErrAssetType):
+		renderAdmin(w, http.StatusUnprocessableEntity, "asset-form", assetForm{Error: "Assets may be PNG, JPEG, GIF, WebP or SVG images, or PDF files."})
+		return
+	case errors.Is(err, service.ErrAssetSize):
+		renderAdmin(w, http.StatusUnprocessableEntity, "asset-form", assetForm{Error: uploadHint})
+		return
+	case err != nil:
+		http.Error(w, "Failed to save: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	renderAdmin(w, http.StatusOK, "asset-form", assetForm{Asset: a})
+}
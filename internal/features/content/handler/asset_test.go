@@ -0,0 +1,66 @@
+package handler_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	authhandler "examination/internal/features/auth/handler"
+	authservice "examination/internal/features/auth/service"
+	"examination/internal/features/content/assetstore"
+	"examination/internal/features/content/bundle"
+	"examination/internal/features/content/handler"
+	"examination/internal/features/content/service"
+	"examination/internal/testutil"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAssetHandler_PublicWithActivePreview(t *testing.T) {
+	ctx := context.Background()
+	client := testutil.NewClient(t)
+	storage := assetstore.NewDisk(t.TempDir())
+	assets := service.NewAssetService(client, storage)
+	shown, err := assets.Save(ctx, "shown.png", []byte("shown"))
+	require.NoError(t, err)
+	private, err := assets.Save(ctx, "private.png", []byte("private"))
+	require.NoError(t, err)
+	_, _, err = service.NewContentService(client).ImportBundle(ctx, &bundle.Exam{Key: "e", Title: "E", IsActive: true, Units: []bundle.Unit{{Key: "u", Title: "U", Problems: []bundle.Problem{{
+		Key: "p",
+		Translations: []bundle.Translation{{
+			Locale:  "en",
+			Title:   "Q",
+			Content: "![Shown](asset:" + shown.Hash + ")",
+			Choices: []bundle.Choice{{Content: "A", Correct: true}, {Content: "B"}},
+		}},
+	}}}}})
+	require.NoError(t, err)
+
+	auth := authservice.NewAuthService(client)
+	u, err := auth.SaveUser(ctx, authservice.UserInput{Email: "c@example.com", Name: "C", Password: "password1", Roles: []string{authservice.RoleCandidate}})
+	require.NoError(t, err)
+	token, _, err := auth.StartSession(ctx, u.ID)
+	require.NoError(t, err)
+
+	// Wired as in cmd/server
+	h := handler.NewAssetHandler(client, storage)
+	r := chi.NewRouter()
+	r.Use(authhandler.NewAuthHandler(client).Session)
+	r.With(h.RequireSignInForPrivate).Get("/assets/{hash}", h.Serve)
+
+	get := func(hash, token string) int {
+		req := httptest.NewRequest(http.MethodGet, "/assets/"+hash, nil)
+		if token != "" {
+			req.AddCookie(&http.Cookie{Name: "session", Value: token})
+		}
+		rec := httptest.NewRecorder()
+		r.ServeHTTP(rec, req)
+		return rec.Code
+	}
+	assert.Equal(t, http.StatusOK, get(shown.Hash, ""))
+	assert.Equal(t, http.StatusSeeOther, get(private.Hash, ""))
+	assert.Equal(t, http.StatusOK, get(private.Hash, token))
+}
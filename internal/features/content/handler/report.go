@@ -0,0 +1,85 @@
+package handler
+
+import (
+	"examination/internal/ent"
+	"examination/internal/features/content/service"
+	"examination/internal/features/content/ui"
+	"html/template"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+)
+
+// reportPage is the template data of translation_report.html.
+type reportPage struct {
+	Reports []*service.TranslationReport
+	// Locales are the locales asked for with ?locale=.
+	Locales string
+}
+
+// TranslationReportHandler renders the translation coverage of exams, to
+// check before publishing an edition in a new locale.
+type TranslationReportHandler struct {
+	content *service.ContentService
+}
+
+func NewTranslationReportHandler(client *ent.Client) *TranslationReportHandler {
+	return &TranslationReportHandler{content: service.NewContentService(client)}
+}
+
+// Index reports on every exam. ?locale=ko,ja adds locales no problem is
+// translated into yet.
+func (h *TranslationReportHandler) Index(w http.ResponseWriter, r *http.Request) {
+	locales := localesParam(r)
+	reports, err := h.content.TranslationReports(r.Context(), locales...)
+	if err != nil {
+		http.Error(w, "Failed to build report: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	h.render(w, reportPage{Reports: reports, Locales: strings.Join(locales, ",")})
+}
+
+// Exam reports on the exam of the {examID} URL parameter.
+func (h *TranslationReportHandler) Exam(w http.ResponseWriter, r *http.Request) {
+	examID, err := strconv.Atoi(chi.URLParam(r, "examID"))
+	if err != nil || examID <= 0 {
+		http.Error(w, "Invalid exam ID", http.StatusBadRequest)
+		return
+	}
+
+	locales := localesParam(r)
+	report, err := h.content.TranslationReport(r.Context(), examID, locales...)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			http.Error(w, "Exam not found", http.StatusNotFound)
+			return
+		}
+		http.Error(w, "Failed to build report: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	h.render(w, reportPage{Reports: []*service.TranslationReport{report}, Locales: strings.Join(locales, ",")})
+}
+
+func (h *TranslationReportHandler) render(w http.ResponseWriter, data reportPage) {
+	tmpl, err := template.ParseFS(ui.FS, "translation_report.html")
+	if err != nil {
+		http.Error(w, "Failed to parse embedded template: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if err := tmpl.Execute(w, data); err != nil {
+		http.Error(w, "Failed to render template: "+err.Error(), http.StatusInternalServerError)
+	}
+}
+
+// localesParam splits the comma-separated ?locale= parameter.
+func localesParam(r *http.Request) []string {
+	var locales []string
+	for _, l := range strings.Split(r.URL.Query().Get("locale"), ",") {
+		if l = strings.TrimSpace(l); l != "" {
+			locales = append(locales, l)
+		}
+	}
+	return locales
+}
@@ -0,0 +1,35 @@
+package handler
+
+import (
+	"examination/internal/features/content/ui"
+	"io/fs"
+	"net/http"
+	"strings"
+)
+
+// StaticHandler serves the embedded static assets, such as KaTeX and
+// math.js, to every page that renders problem content. They only change
+// with a deploy, so browsers may cache them for a day.
+type StaticHandler struct {
+	files http.Handler
+}
+
+// NewStaticHandler serves the assets below prefix, the path the handler is
+// mounted at.
+func NewStaticHandler(prefix string) *StaticHandler {
+	static, err := fs.Sub(ui.Static, "static")
+	if err != nil {
+		panic(err) // static is embedded, so it always exists
+	}
+	return &StaticHandler{files: http.StripPrefix(prefix, http.FileServerFS(static))}
+}
+
+func (h *StaticHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	// No directory listings
+	if strings.HasSuffix(r.URL.Path, "/") {
+		http.NotFound(w, r)
+		return
+	}
+	w.Header().Set("Cache-Control", "public, max-age=86400")
+	h.files.ServeHTTP(w, r)
+}
@@ -0,0 +1,111 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"examination/internal/ent"
+	"examination/internal/features/content/service"
+	"log"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+)
+
+// VariantHandler exposes problem variant lineage as JSON for authoring tools.
+type VariantHandler struct {
+	content *service.ContentService
+}
+
+func NewVariantHandler(client *ent.Client) *VariantHandler {
+	return &VariantHandler{content: service.NewContentService(client)}
+}
+
+// Clone copies the SOURCE problem into a new VARIANT and responds with it.
+func (h *VariantHandler) Clone(w http.ResponseWriter, r *http.Request) {
+	problemID, ok := problemIDParam(w, r)
+	if !ok {
+		return
+	}
+
+	created, err := h.content.CloneVariant(r.Context(), problemID)
+	if err != nil {
+		switch {
+		case ent.IsNotFound(err):
+			http.Error(w, "Problem not found", http.StatusNotFound)
+		case errors.Is(err, service.ErrNotSource):
+			http.Error(w, "Only SOURCE problems can be cloned", http.StatusBadRequest)
+		default:
+			http.Error(w, "Failed to clone problem: "+err.Error(), http.StatusInternalServerError)
+		}
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, created)
+}
+
+// Tree responds with the variant tree the problem belongs to.
+func (h *VariantHandler) Tree(w http.ResponseWriter, r *http.Request) {
+	problemID, ok := problemIDParam(w, r)
+	if !ok {
+		return
+	}
+
+	tree, err := h.content.VariantTree(r.Context(), problemID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			http.Error(w, "Problem not found", http.StatusNotFound)
+			return
+		}
+		http.Error(w, "Failed to load variants: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, tree)
+}
+
+// Diff responds with the field-level changes of the variant from its parent.
+func (h *VariantHandler) Diff(w http.ResponseWriter, r *http.Request) {
+	problemID, ok := problemIDParam(w, r)
+	if !ok {
+		return
+	}
+
+	changes, err := h.content.DiffVariant(r.Context(), problemID)
+	if err != nil {
+		switch {
+		case ent.IsNotFound(err):
+			http.Error(w, "Problem not found", http.StatusNotFound)
+		case errors.Is(err, service.ErrNoParent):
+			http.Error(w, "Problem is not a variant", http.StatusBadRequest)
+		default:
+			http.Error(w, "Failed to diff problem: "+err.Error(), http.StatusInternalServerError)
+		}
+		return
+	}
+	if changes == nil {
+		changes = []service.Change{}
+	}
+
+	writeJSON(w, http.StatusOK, changes)
+}
+
+// problemIDParam resolves the {problemID} URL parameter, writing the error
+// response itself when it is invalid.
+func problemIDParam(w http.ResponseWriter, r *http.Request) (int, bool) {
+	problemID, err := strconv.Atoi(chi.URLParam(r, "problemID"))
+	if err != nil || problemID <= 0 {
+		http.Error(w, "Invalid problem ID", http.StatusBadRequest)
+		return 0, false
+	}
+	return problemID, true
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	// The status is already sent; an encoding error can only be logged
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		log.Printf("encoding response: %v", err)
+	}
+}
@@ -0,0 +1,351 @@
+// Package latex finds the math in problem markdown and checks that it is
+// well-formed LaTeX, so that authors see mistakes when they save instead of
+// a rendering error in front of candidates.
+//
+// Math is written as $$display$$ or $inline$. As in pandoc, inline math
+// opens with a $ that is not followed by a space and closes with a $ that
+// is neither preceded by a space nor followed by a digit, so that prices
+// such as $5 and $10 stay text. \$ is a literal dollar sign, and code spans
+// and fenced code blocks never hold math. Package markdown uses Find to
+// keep math away from the markdown parser when content is rendered.
+//
+// The check is structural: braces, \begin and \end, \left and \right,
+// scripts and alignment tabs. Unknown commands are left to KaTeX, which
+// shows them in red where the math is rendered.
+package latex
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Span is a piece of math found in markdown.
+type Span struct {
+	// TeX is the source between the delimiters.
+	TeX     string
+	Display bool
+	// Line is the 1-based line of the opening delimiter.
+	Line int
+	// Start and End are the byte offsets of the span in the markdown,
+	// delimiters included.
+	Start, End int
+}
+
+// Error is malformed math.
+type Error struct {
+	Line int
+	TeX  string
+	Msg  string
+}
+
+// Error quotes the TeX as written, on one line, as backslashes abound.
+func (e *Error) Error() string {
+	return fmt.Sprintf(`line %d: %s in "%s"`, e.Line, e.Msg, strings.Join(strings.Fields(e.TeX), " "))
+}
+
+// Validate checks the math in markdown and returns the first *Error found.
+func Validate(markdown string) error {
+	spans, err := Find(markdown)
+	if err != nil {
+		return err
+	}
+	for _, s := range spans {
+		if err := Check(s.TeX); err != nil {
+			return &Error{Line: s.Line, TeX: s.TeX, Msg: err.Error()}
+		}
+	}
+	return nil
+}
+
+// Find returns the math spans of markdown in order. An unterminated $$ is
+// an *Error; an unterminated $ is text.
+func Find(markdown string) ([]Span, error) {
+	var spans []Span
+	s := markdown
+	line := func(i int) int { return strings.Count(s[:i], "\n") + 1 }
+
+	var fence string
+	for i := 0; i < len(s); {
+		if i == 0 || s[i-1] == '\n' {
+			end := strings.IndexByte(s[i:], '\n')
+			if end < 0 {
+				end = len(s) - i
+			}
+			indent := len(s[i:i+end]) - len(strings.TrimLeft(s[i:i+end], " "))
+			text := s[i+indent : i+end]
+			run := fenceRun(text)
+			switch {
+			case fence != "":
+				// Inside a fenced code block until its closing fence
+				if run != "" && run[0] == fence[0] && len(run) >= len(fence) && strings.TrimSpace(text[len(run):]) == "" {
+					fence = ""
+				}
+				i += end + 1
+				continue
+			case run != "" && indent < 4:
+				fence = run
+				i += end + 1
+				continue
+			}
+		}
+
+		switch c := s[i]; {
+		case c == '\\':
+			i += 2
+		case c == '`':
+			// A code span closes with a backtick run of the same length
+			n := len(s[i:]) - len(strings.TrimLeft(s[i:], "`"))
+			run := s[i : i+n]
+			j := i + n
+			for {
+				k := strings.Index(s[j:], run)
+				if k < 0 {
+					j = i + n
+					break
+				}
+				j += k
+				if m := len(s[j:]) - len(strings.TrimLeft(s[j:], "`")); m == n {
+					j += n
+					break
+				} else {
+					j += m
+				}
+			}
+			i = j
+		case strings.HasPrefix(s[i:], "$$"):
+			end := closing(s, i+2, "$$")
+			if end < 0 {
+				return nil, &Error{Line: line(i), TeX: excerpt(s[i+2:]), Msg: "missing closing $$"}
+			}
+			spans = append(spans, Span{TeX: s[i+2 : end], Display: true, Line: line(i), Start: i, End: end + 2})
+			i = end + 2
+		case c == '$':
+			if end := inlineEnd(s, i+1); end > 0 {
+				spans = append(spans, Span{TeX: s[i+1 : end], Line: line(i), Start: i, End: end + 1})
+				i = end + 1
+			} else {
+				i++
+			}
+		default:
+			i++
+		}
+	}
+	return spans, nil
+}
+
+// fenceRun returns the run of at least three backticks or tildes that
+// text starts with, or "".
+func fenceRun(text string) string {
+	if text == "" || text[0] != '`' && text[0] != '~' {
+		return ""
+	}
+	run := text[:len(text)-len(strings.TrimLeft(text, text[:1]))]
+	if len(run) < 3 {
+		return ""
+	}
+	return run
+}
+
+// closing returns the index of the next unescaped delim from i, or -1.
+func closing(s string, i int, delim string) int {
+	for i < len(s) {
+		switch {
+		case s[i] == '\\':
+			i += 2
+		case strings.HasPrefix(s[i:], delim):
+			return i
+		default:
+			i++
+		}
+	}
+	return -1
+}
+
+// inlineEnd returns the index of the $ closing inline math opened before
+// i, or -1 when the $ is text. Inline math does not span paragraphs.
+func inlineEnd(s string, i int) int {
+	if i >= len(s) || isSpace(s[i]) {
+		return -1
+	}
+	for j := i; j < len(s); j++ {
+		switch {
+		case s[j] == '\\':
+			j++
+		case strings.HasPrefix(s[j:], "\n\n"):
+			return -1
+		case s[j] == '$' && j > i && !isSpace(s[j-1]) && (j+1 == len(s) || !isDigit(s[j+1])):
+			return j
+		}
+	}
+	return -1
+}
+
+// environments are the environments KaTeX supports.
+var environments = map[string]bool{
+	"array": true, "darray": true, "subarray": true,
+	"matrix": true, "pmatrix": true, "bmatrix": true, "Bmatrix": true, "vmatrix": true, "Vmatrix": true, "smallmatrix": true,
+	"matrix*": true, "pmatrix*": true, "bmatrix*": true, "Bmatrix*": true, "vmatrix*": true, "Vmatrix*": true,
+	"cases": true, "dcases": true, "rcases": true, "drcases": true,
+	"aligned": true, "alignedat": true, "gathered": true, "split": true,
+	"align": true, "align*": true, "alignat": true, "alignat*": true, "gather": true, "gather*": true,
+	"equation": true, "equation*": true, "multline": true, "multline*": true, "CD": true,
+}
+
+// Check reports the first structural mistake in the TeX of a math span.
+func Check(tex string) error {
+	if strings.TrimSpace(tex) == "" {
+		return errors.New("is empty")
+	}
+
+	// open holds "{", "\left" and environment names, innermost last
+	var open []string
+	envs := 0
+	for i := 0; i < len(tex); {
+		c := tex[i]
+		switch {
+		case c == '%':
+			// A comment runs to the end of the line
+			if j := strings.IndexByte(tex[i:], '\n'); j >= 0 {
+				i += j
+			} else {
+				i = len(tex)
+			}
+			continue
+		case c == '\\':
+			name, next := command(tex, i)
+			i = next
+			switch name {
+			case "begin", "end":
+				env, next, ok := argument(tex, i)
+				if !ok {
+					return fmt.Errorf(`\%s needs an environment name in braces`, name)
+				}
+				i = next
+				if name == "begin" {
+					if !environments[env] {
+						return fmt.Errorf(`unknown environment %q`, env)
+					}
+					open = append(open, env)
+					envs++
+					continue
+				}
+				if len(open) == 0 {
+					return fmt.Errorf(`\end{%s} without \begin{%s}`, env, env)
+				}
+				if top := open[len(open)-1]; top != env {
+					return unclosed(top)
+				}
+				open = open[:len(open)-1]
+				envs--
+			case "left":
+				if !delimiter(tex, i) {
+					return errors.New(`\left needs a delimiter`)
+				}
+				open = append(open, `\left`)
+			case "right":
+				if !delimiter(tex, i) {
+					return errors.New(`\right needs a delimiter`)
+				}
+				if len(open) == 0 || open[len(open)-1] != `\left` {
+					return errors.New(`\right without \left`)
+				}
+				open = open[:len(open)-1]
+			}
+			continue
+		case c == '{':
+			open = append(open, "{")
+		case c == '}':
+			if len(open) == 0 {
+				return errors.New("unbalanced }")
+			}
+			if top := open[len(open)-1]; top != "{" {
+				return unclosed(top)
+			}
+			open = open[:len(open)-1]
+		case c == '^' || c == '_':
+			j := i + 1
+			for j < len(tex) && isSpace(tex[j]) {
+				j++
+			}
+			if j == len(tex) || strings.IndexByte("}^_&", tex[j]) >= 0 {
+				if c == '^' {
+					return errors.New("^ needs a superscript")
+				}
+				return errors.New("_ needs a subscript")
+			}
+		case c == '&':
+			if envs == 0 {
+				return errors.New("& outside an environment")
+			}
+		}
+		i++
+	}
+	if len(open) > 0 {
+		return unclosed(open[len(open)-1])
+	}
+	return nil
+}
+
+// unclosed describes what is missing to close an open group.
+func unclosed(open string) error {
+	switch open {
+	case "{":
+		return errors.New("missing }")
+	case `\left`:
+		return errors.New(`\left without \right`)
+	default:
+		return fmt.Errorf(`missing \end{%s}`, open)
+	}
+}
+
+// command returns the name of the command starting with the backslash at
+// i and the index after it. Control symbols such as \{ or \\ have a
+// one-character name.
+func command(tex string, i int) (string, int) {
+	j := i + 1
+	for j < len(tex) && isLetter(tex[j]) {
+		j++
+	}
+	if j == i+1 && j < len(tex) {
+		j++
+	}
+	return tex[i+1 : j], j
+}
+
+// argument reads a braced argument after optional spaces.
+func argument(tex string, i int) (string, int, bool) {
+	for i < len(tex) && isSpace(tex[i]) {
+		i++
+	}
+	if i == len(tex) || tex[i] != '{' {
+		return "", i, false
+	}
+	end := strings.IndexByte(tex[i:], '}')
+	if end < 0 {
+		return "", i, false
+	}
+	name := strings.TrimSpace(tex[i+1 : i+end])
+	return name, i + end + 1, name != ""
+}
+
+// delimiter reports whether a delimiter follows \left or \right at i.
+func delimiter(tex string, i int) bool {
+	for i < len(tex) && isSpace(tex[i]) {
+		i++
+	}
+	return i < len(tex) && strings.IndexByte("{}^_&$%", tex[i]) < 0
+}
+
+// excerpt shortens text for an error message.
+func excerpt(s string) string {
+	s, _, _ = strings.Cut(s, "\n")
+	if len(s) > 40 {
+		return s[:40] + "..."
+	}
+	return s
+}
+
+func isSpace(c byte) bool  { return c == ' ' || c == '\t' || c == '\n' || c == '\r' }
+func isDigit(c byte) bool  { return c >= '0' && c <= '9' }
+func isLetter(c byte) bool { return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' }
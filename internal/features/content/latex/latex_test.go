@@ -0,0 +1,79 @@
+package latex_test
+
+import (
+	"testing"
+
+	"examination/internal/features/content/latex"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFind(t *testing.T) {
+	md := "Let $f(x) = x^2$ cost $5 and $10.\n" +
+		"\n" +
+		"$$\n\\int_0^1 f(x)\\,dx\n$$\n" +
+		"Escaped \\$x$ and `$code$` stay text.\n" +
+		"```go\nfmt.Println(\"$a$\")\n```\n" +
+		"Last $y$"
+
+	spans, err := latex.Find(md)
+	require.NoError(t, err)
+	assert.Equal(t, []latex.Span{
+		{TeX: "f(x) = x^2", Line: 1, Start: 4, End: 16},
+		{TeX: "\n\\int_0^1 f(x)\\,dx\n", Display: true, Line: 3, Start: 35, End: 58},
+		{TeX: "y", Line: 10, Start: len(md) - 3, End: len(md)},
+	}, spans)
+}
+
+func TestFind_UnterminatedDisplay(t *testing.T) {
+	_, err := latex.Find("Intro\n\n$$\\frac{1}{2}")
+	var lerr *latex.Error
+	require.ErrorAs(t, err, &lerr)
+	assert.Equal(t, 3, lerr.Line)
+	assert.Equal(t, "missing closing $$", lerr.Msg)
+}
+
+func TestCheck(t *testing.T) {
+	tests := []struct {
+		tex  string
+		want string
+	}{
+		{`\frac{1}{2}`, ""},
+		{`\left( \frac{a}{b} \right)`, ""},
+		{`\left. f \right|_{x=0}`, ""},
+		{`\{ x \mid x > 0 \}`, ""},
+		{`\begin{pmatrix} a & b \\ c & d \end{pmatrix}`, ""},
+		{`\begin{array}{cc} 1 & 2 \end{array}`, ""},
+		{`x^2 % squared`, ""},
+		{`\frac{1}{2`, "missing }"},
+		{`x}`, "unbalanced }"},
+		{`\left( x`, `\left without \right`},
+		{`x \right)`, `\right without \left`},
+		{`\begin{matrix} a`, `missing \end{matrix}`},
+		{`\begin{matrix} { \end{matrix}`, "missing }"},
+		{`\end{matrix}`, `\end{matrix} without \begin{matrix}`},
+		{`\begin{matirx} a \end{matirx}`, `unknown environment "matirx"`},
+		{`x^`, "^ needs a superscript"},
+		{`x_{}`, ""},
+		{`{x_}`, "_ needs a subscript"},
+		{`a & b`, "& outside an environment"},
+		{`\&`, ""},
+		{`  `, "is empty"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.tex, func(t *testing.T) {
+			err := latex.Check(tt.tex)
+			if tt.want == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidate(t *testing.T) {
+	assert.NoError(t, latex.Validate("Prices $5 and $10, and $\\sqrt{2}$."))
+	assert.EqualError(t, latex.Validate("One\nTwo $\\frac{1}{2$"), `line 2: missing } in "\frac{1}{2"`)
+}
@@ -0,0 +1,186 @@
+// Package markdown renders problem content to HTML on the server.
+//
+// Content is CommonMark with the GitHub extensions. Fenced code is
+// highlighted with inline styles, so that pages need no stylesheet or
+// script for it. Authors may write HTML, but the output goes through an
+// allowlist that drops scripts, event handlers and anything else a
+// candidate's browser would run, so content imported from a bundle is as
+// safe to show as content typed in the admin UI.
+//
+// Math is cut out before parsing, by the rules of package latex, and put
+// back as <span class=math-inline> or <span class=math-display> holding the
+// TeX with its delimiters, for static/math.js to typeset with KaTeX.
+// Without script the TeX stays readable as written.
+//
+// Images and links may point at uploaded assets by hash, as in
+// ![Circuit](asset:HASH); they are served from AssetPath.
+package markdown
+
+import (
+	"bytes"
+	"html"
+	"html/template"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"examination/internal/features/content/latex"
+
+	chromahtml "github.com/alecthomas/chroma/v2/formatters/html"
+	"github.com/microcosm-cc/bluemonday"
+	"github.com/yuin/goldmark"
+	highlighting "github.com/yuin/goldmark-highlighting/v2"
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/extension"
+	"github.com/yuin/goldmark/parser"
+	goldmarkhtml "github.com/yuin/goldmark/renderer/html"
+	"github.com/yuin/goldmark/text"
+	"github.com/yuin/goldmark/util"
+)
+
+const (
+	// AssetScheme starts the destination of an image or link to an asset,
+	// followed by its hash.
+	AssetScheme = "asset:"
+	// AssetPath is the route assets are served from, by hash.
+	AssetPath = "/assets/"
+)
+
+var assetRef = regexp.MustCompile(AssetScheme + `([0-9a-f]{64})`)
+
+// Assets returns the hashes of the assets src references, in order and
+// without repeats.
+func Assets(src string) []string {
+	var hashes []string
+	seen := map[string]bool{}
+	for _, m := range assetRef.FindAllStringSubmatch(src, -1) {
+		if !seen[m[1]] {
+			seen[m[1]] = true
+			hashes = append(hashes, m[1])
+		}
+	}
+	return hashes
+}
+
+// assetLinks points images and links to assets at AssetPath.
+type assetLinks struct{}
+
+func (assetLinks) Transform(doc *ast.Document, _ text.Reader, _ parser.Context) {
+	ast.Walk(doc, func(n ast.Node, entering bool) (ast.WalkStatus, error) {
+		if !entering {
+			return ast.WalkContinue, nil
+		}
+		switch n := n.(type) {
+		case *ast.Image:
+			n.Destination = assetURL(n.Destination)
+		case *ast.Link:
+			n.Destination = assetURL(n.Destination)
+		}
+		return ast.WalkContinue, nil
+	})
+}
+
+func assetURL(dest []byte) []byte {
+	hash, ok := bytes.CutPrefix(dest, []byte(AssetScheme))
+	if !ok {
+		return dest
+	}
+	return append([]byte(AssetPath), hash...)
+}
+
+var md = goldmark.New(
+	goldmark.WithExtensions(
+		extension.GFM,
+		highlighting.NewHighlighting(
+			highlighting.WithStyle("github"),
+			highlighting.WithFormatOptions(chromahtml.TabWidth(4)),
+		),
+	),
+	goldmark.WithParserOptions(parser.WithASTTransformers(util.Prioritized(assetLinks{}, 100))),
+	// Raw HTML is kept here and filtered by policy below
+	goldmark.WithRendererOptions(goldmarkhtml.WithUnsafe()),
+)
+
+var policy = newPolicy()
+
+func newPolicy() *bluemonday.Policy {
+	p := bluemonday.UGCPolicy()
+	// The highlighter's inline styles, and table column alignment
+	p.AllowStyles("color", "background-color", "font-weight", "font-style", "text-decoration").OnElements("span", "pre")
+	p.AllowStyles("text-align").OnElements("th", "td")
+	p.AllowAttrs("class").Matching(regexp.MustCompile(`^language-[\w+-]+$`)).OnElements("code")
+	return p
+}
+
+// Placeholders for math are private-use characters, which neither the
+// parser nor the policy touch.
+const (
+	mathOpen  = "\uE000"
+	mathClose = "\uE001"
+)
+
+var placeholder = regexp.MustCompile(mathOpen + `(\d+)` + mathClose)
+
+// Render converts markdown to sanitized HTML.
+func Render(src string) template.HTML {
+	if strings.TrimSpace(src) == "" {
+		return ""
+	}
+	// Placeholder characters already in the content would be taken for math
+	src = strings.NewReplacer(mathOpen, "", mathClose, "").Replace(src)
+
+	// Math that does not parse, which validation keeps out of saved
+	// content, is left to the markdown parser as text
+	spans, err := latex.Find(src)
+	if err != nil {
+		spans = nil
+	}
+	var b strings.Builder
+	last := 0
+	for i, s := range spans {
+		b.WriteString(src[last:s.Start])
+		b.WriteString(mathOpen + strconv.Itoa(i) + mathClose)
+		last = s.End
+	}
+	b.WriteString(src[last:])
+
+	var out bytes.Buffer
+	if err := md.Convert([]byte(b.String()), &out); err != nil {
+		return template.HTML(template.HTMLEscapeString(src))
+	}
+	safe := policy.SanitizeBytes(out.Bytes())
+
+	// The markup added here has no quotes, so a placeholder that ended up
+	// inside an attribute value cannot close it
+	rendered := placeholder.ReplaceAllStringFunc(string(safe), func(m string) string {
+		n, err := strconv.Atoi(placeholder.FindStringSubmatch(m)[1])
+		if err != nil || n >= len(spans) {
+			return m
+		}
+		s := spans[n]
+		if s.Display {
+			return "<span class=math-display>" + html.EscapeString("$$"+s.TeX+"$$") + "</span>"
+		}
+		return "<span class=math-inline>" + html.EscapeString("$"+s.TeX+"$") + "</span>"
+	})
+	return template.HTML(rendered)
+}
+
+// RenderInline converts a short piece of markdown, such as a choice, to
+// sanitized HTML without the paragraph that would wrap it.
+func RenderInline(src string) template.HTML {
+	out := strings.TrimSpace(string(Render(src)))
+	if inner, ok := strings.CutPrefix(out, "<p>"); ok && strings.Count(out, "<p>") == 1 {
+		if inner, ok := strings.CutSuffix(inner, "</p>"); ok {
+			return template.HTML(inner)
+		}
+	}
+	return template.HTML(out)
+}
+
+// Funcs are the template functions that show content: markdown for
+// problem text and explanations, inline for choices.
+var Funcs = template.FuncMap{
+	"markdown": Render,
+	"inline":   RenderInline,
+}
@@ -0,0 +1,85 @@
+package markdown_test
+
+import (
+	"html/template"
+	"strings"
+	"testing"
+
+	"examination/internal/features/content/markdown"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRender_Sanitizes(t *testing.T) {
+	out := string(markdown.Render("Hi <script>alert(1)</script>**there** <img src=\"a.png\" onerror=\"alert(1)\">\n\n" +
+		"[link](javascript:alert(1)) <a href=\"https://example.com\" onclick=\"alert(1)\">ok</a>"))
+
+	assert.NotContains(t, out, "script")
+	assert.NotContains(t, out, "onerror")
+	assert.NotContains(t, out, "onclick")
+	assert.NotContains(t, out, "javascript:")
+	assert.Contains(t, out, "<strong>there</strong>")
+	assert.Contains(t, out, `<img src="a.png">`)
+	assert.Contains(t, out, `href="https://example.com"`)
+}
+
+func TestRender_HighlightsCode(t *testing.T) {
+	out := string(markdown.Render("```go\nfunc main() {}\n```"))
+
+	assert.Contains(t, out, "<pre style=")
+	assert.Contains(t, out, `<span style="color: #cf222e">func</span>`)
+}
+
+func TestRender_KeepsMath(t *testing.T) {
+	out := string(markdown.Render("Let $a_1 * b_1 < c$ cost $5.\n\n$$\n\\frac{x}{y}\n$$\n\n`$code$`"))
+
+	assert.Contains(t, out, "<span class=math-inline>$a_1 * b_1 &lt; c$</span> cost $5.")
+	assert.Contains(t, out, "<span class=math-display>$$\n\\frac{x}{y}\n$$</span>")
+	assert.Contains(t, out, "<code>$code$</code>")
+}
+
+func TestRender_MathInAttribute(t *testing.T) {
+	out := string(markdown.Render(`[x]($"onclick="alert(1)$)`))
+
+	assert.NotContains(t, out, `"onclick`)
+}
+
+func TestRender_PlaceholdersInContent(t *testing.T) {
+	// The characters Render marks math with, typed or imported into content
+	out := string(markdown.Render("Total \uE0003\uE001 and $x$ \uE0000\uE001"))
+
+	assert.Equal(t, "<p>Total 3 and <span class=math-inline>$x$</span> 0</p>\n", out)
+}
+
+func TestRenderInline(t *testing.T) {
+	tests := []struct {
+		src  string
+		want template.HTML
+	}{
+		{"", ""},
+		{"`x` and $y$", "<code>x</code> and <span class=math-inline>$y$</span>"},
+		{"One\n\nTwo", "<p>One</p>\n<p>Two</p>"},
+		{"<b onclick=\"x()\">bold</b>", "<b>bold</b>"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.src, func(t *testing.T) {
+			assert.Equal(t, tt.want, markdown.RenderInline(tt.src))
+		})
+	}
+}
+
+func TestRender_Assets(t *testing.T) {
+	hash := strings.Repeat("0a", 32)
+	out := string(markdown.Render("![Circuit](asset:" + hash + ") [Datasheet](asset:" + hash + ")"))
+
+	assert.Contains(t, out, `<img src="/assets/`+hash+`" alt="Circuit">`)
+	assert.Contains(t, out, `<a href="/assets/`+hash+`" rel="nofollow">Datasheet</a>`)
+}
+
+func TestAssets(t *testing.T) {
+	a, b := strings.Repeat("0a", 32), strings.Repeat("b1", 32)
+	src := "![x](asset:" + a + ")\n\n[y](asset:" + b + ") ![z](asset:" + a + ") asset:short"
+
+	assert.Equal(t, []string{a, b}, markdown.Assets(src))
+	assert.Empty(t, markdown.Assets("no assets"))
+}
@@ -0,0 +1,490 @@
+package qti
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io/fs"
+	"math"
+	"path"
+	"slices"
+	"strings"
+
+	"examination/internal/features/content/bundle"
+)
+
+// ErrNoManifest is returned for packages without an imsmanifest.xml.
+var ErrNoManifest = errors.New("not a QTI content package: imsmanifest.xml is missing")
+
+// defaultLocale is used for items that do not declare xml:lang.
+const defaultLocale = "en"
+
+// Decode reads a QTI 2.1 content package, such as an opened zip file. The
+// first assessmentTest gives the exam structure: top-level sections holding
+// item refs become exam-level units, other top-level sections become
+// sections, and below them sections holding item refs are units and the
+// others topics. A package without a test becomes one unit per item.
+// Items other than a single choiceInteraction are skipped and reported.
+func Decode(fsys fs.FS) (*bundle.Exam, []Issue, error) {
+	var m manifest
+	if err := readXML(fsys, manifestFile, &m); err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, nil, ErrNoManifest
+		}
+		return nil, nil, err
+	}
+	dec := &decoder{fsys: fsys, keys: make(map[string]bool)}
+	e := &bundle.Exam{Version: bundle.Version}
+
+	var tests, items []resource
+	for _, r := range m.Resources {
+		switch r.Type {
+		case testResource:
+			tests = append(tests, r)
+		case itemResource:
+			items = append(items, r)
+		default:
+			dec.issues.add(manifestFile, "resource %s of type %q is not imported", r.Identifier, r.Type)
+		}
+	}
+
+	if len(tests) == 0 {
+		e.Key, e.Title = m.Identifier, m.Identifier
+		if e.Key == "" {
+			e.Key, e.Title = "qti", "QTI"
+		}
+		dec.issues.add(manifestFile, "the package has no assessmentTest; each item becomes a unit")
+		for _, r := range items {
+			p, ok := dec.item(r.Href, categories{})
+			if ok {
+				u := bundle.Unit{Key: p.Key, Title: p.Translations[0].Title, Seq: len(e.Units) + 1, Problems: []bundle.Problem{p}}
+				if u.Title == "" {
+					u.Title = u.Key
+				}
+				e.Units = append(e.Units, u)
+			}
+		}
+	} else {
+		if len(tests) > 1 {
+			dec.issues.add(manifestFile, "only the first of %d assessmentTests is imported", len(tests))
+		}
+		if err := dec.test(tests[0].Href, e); err != nil {
+			return nil, nil, err
+		}
+	}
+	e.ShuffleChoices = dec.shuffle > 0
+	if dec.shuffle > 0 && dec.shuffle < dec.interactions {
+		dec.issues.add("exam", "choice shuffling is set per exam; %d of %d items shuffle, so every item will", dec.shuffle, dec.interactions)
+	}
+	dec.checkParents(e)
+
+	if err := e.Validate(); err != nil {
+		return nil, nil, err
+	}
+	return e, dec.issues, nil
+}
+
+type decoder struct {
+	fsys   fs.FS
+	issues issues
+	// keys are the problem keys imported so far.
+	keys map[string]bool
+	// shuffle counts the interactions that shuffle, out of interactions.
+	shuffle, interactions int
+}
+
+func readXML(fsys fs.FS, name string, v any) error {
+	f, err := fsys.Open(name)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	d := xml.NewDecoder(f)
+	d.Strict = false
+	d.Entity = xml.HTMLEntity
+	if err := d.Decode(v); err != nil {
+		return fmt.Errorf("decoding %s: %w", name, err)
+	}
+	return nil
+}
+
+func (dec *decoder) test(href string, e *bundle.Exam) error {
+	var t assessmentTest
+	if err := readXML(dec.fsys, href, &t); err != nil {
+		return err
+	}
+	e.Key, e.Title = t.Identifier, t.Title
+	if t.TimeLimits != nil && t.TimeLimits.MaxTime > 0 {
+		e.TimeLimit = int(math.Ceil(t.TimeLimits.MaxTime / 60))
+	}
+	if len(t.TestParts) > 1 {
+		dec.issues.add(href, "the %d testParts are merged into one exam", len(t.TestParts))
+	}
+
+	base := path.Dir(href)
+	for _, part := range t.TestParts {
+		for _, s := range part.Sections {
+			seq := len(e.Sections) + len(e.Units) + 1
+			if len(s.Sections) == 0 {
+				u := dec.unit(base, s)
+				u.Seq = seq
+				e.Units = append(e.Units, u)
+				continue
+			}
+			bs := bundle.Section{Key: s.Identifier, Title: title(s), Seq: seq}
+			for i, c := range dec.children(s) {
+				if len(c.Sections) == 0 {
+					u := dec.unit(base, c)
+					u.Seq = i + 1
+					bs.Units = append(bs.Units, u)
+					continue
+				}
+				bt := bundle.Topic{Key: c.Identifier, Title: title(c), Seq: i + 1}
+				for j, g := range dec.children(c) {
+					u := dec.unit(base, g)
+					u.Seq = j + 1
+					bt.Units = append(bt.Units, u)
+				}
+				bs.Topics = append(bs.Topics, bt)
+			}
+			e.Sections = append(e.Sections, bs)
+		}
+	}
+	return nil
+}
+
+// children returns the subsections of s. Item refs next to subsections are
+// moved into a unit of their own, placed first.
+func (dec *decoder) children(s section) []section {
+	if len(s.ItemRefs) == 0 {
+		return s.Sections
+	}
+	key := s.Identifier + "-items"
+	dec.issues.add("section "+s.Identifier, "items next to subsections are moved into unit %q", key)
+	own := section{Identifier: key, Title: s.Title, ItemRefs: s.ItemRefs}
+	return append([]section{own}, s.Sections...)
+}
+
+// unit turns a section into a unit with every item below it.
+func (dec *decoder) unit(base string, s section) bundle.Unit {
+	u := bundle.Unit{Key: s.Identifier, Title: title(s)}
+	if s.Selection != nil && s.Selection.Select != 1 {
+		dec.issues.add("section "+s.Identifier, "selects %d items; units always select one problem", s.Selection.Select)
+	}
+	refs := s.ItemRefs
+	if len(s.Sections) > 0 {
+		dec.issues.add("section "+s.Identifier, "sections nested below units are flattened into unit %q", s.Identifier)
+		var collect func(ss []section)
+		collect = func(ss []section) {
+			for _, sub := range ss {
+				refs = append(refs, sub.ItemRefs...)
+				collect(sub.Sections)
+			}
+		}
+		collect(s.Sections)
+	}
+	for _, ref := range refs {
+		p, ok := dec.item(path.Join(base, ref.Href), parseCategories(ref.Category))
+		if ok {
+			u.Problems = append(u.Problems, p)
+		}
+	}
+	return u
+}
+
+// item reads an assessmentItem into a problem.
+func (dec *decoder) item(name string, c categories) (bundle.Problem, bool) {
+	var it assessmentItem
+	if err := readXML(dec.fsys, name, &it); err != nil {
+		dec.issues.add(name, "skipped: %v", err)
+		return bundle.Problem{}, false
+	}
+	if dec.keys[it.Identifier] {
+		dec.issues.add(name, "skipped: item %q is referenced more than once", it.Identifier)
+		return bundle.Problem{}, false
+	}
+	if len(it.Templates) > 0 {
+		dec.issues.add(name, "template variables are not supported; the item is imported as authored")
+	}
+
+	r := &bodyReader{name: name, issues: &dec.issues}
+	content, interactions, err := r.flow(it.Body.Inner, true)
+	if err != nil {
+		dec.issues.add(name, "skipped: %v", err)
+		return bundle.Problem{}, false
+	}
+	if r.unsupported != "" {
+		dec.issues.add(name, "skipped: %s is not supported", r.unsupported)
+		return bundle.Problem{}, false
+	}
+	if len(interactions) != 1 {
+		dec.issues.add(name, "skipped: expected one choiceInteraction, found %d", len(interactions))
+		return bundle.Problem{}, false
+	}
+	ci := interactions[0]
+
+	i := slices.IndexFunc(it.Responses, func(rd responseDeclaration) bool { return rd.Identifier == ci.ResponseIdentifier })
+	if i < 0 || len(it.Responses[i].Correct) == 0 {
+		dec.issues.add(name, "skipped: no correct response is declared")
+		return bundle.Problem{}, false
+	}
+	correct := it.Responses[i].Correct
+
+	locale := it.Lang
+	if locale == "" {
+		locale = defaultLocale
+		dec.issues.add(name, "no xml:lang; imported as %s", defaultLocale)
+	}
+	t := bundle.Translation{Locale: locale, Title: it.Title, Content: content, Choices: []bundle.Choice{}}
+	if ci.Prompt != nil {
+		// The prompt is shown right above the choices, so it ends the content
+		prompt, _, err := r.inline(ci.Prompt.Inner)
+		if err != nil {
+			dec.issues.add(name, "skipped: %v", err)
+			return bundle.Problem{}, false
+		}
+		t.Content = strings.TrimSpace(t.Content + "\n\n" + prompt)
+	}
+	for _, sc := range ci.Choices {
+		text, explanation, err := r.inline(sc.Inner)
+		if err != nil {
+			dec.issues.add(name, "skipped: %v", err)
+			return bundle.Problem{}, false
+		}
+		t.Choices = append(t.Choices, bundle.Choice{
+			Content:     text,
+			Correct:     slices.Contains(correct, sc.Identifier),
+			Explanation: explanation,
+		})
+	}
+
+	var explanations []string
+	for _, fb := range it.Feedback {
+		text, _, err := r.flow(fb.Inner, false)
+		if err != nil {
+			dec.issues.add(name, "feedback %s not imported: %v", fb.Identifier, err)
+			continue
+		}
+		explanations = append(explanations, text)
+	}
+	if len(explanations) > 1 {
+		dec.issues.add(name, "%d modal feedbacks are merged into one explanation shown after every response", len(explanations))
+	}
+	t.Explanation = strings.Join(explanations, "\n\n")
+
+	dec.interactions++
+	if ci.Shuffle {
+		dec.shuffle++
+	}
+	dec.keys[it.Identifier] = true
+	p := bundle.Problem{Key: it.Identifier, Difficulty: c.Difficulty, Parent: c.Parent, Translations: []bundle.Translation{t}}
+	if c.Variant {
+		p.Type = "VARIANT"
+	}
+	if it.Responses[i].Cardinality == "multiple" {
+		p.Interaction = "MULTIPLE"
+	}
+	return p, true
+}
+
+// checkParents drops variant parents that were not imported.
+func (dec *decoder) checkParents(e *bundle.Exam) {
+	fix := func(us []bundle.Unit) {
+		for i := range us {
+			for j := range us[i].Problems {
+				p := &us[i].Problems[j]
+				if p.Parent != "" && !dec.keys[p.Parent] {
+					dec.issues.add("problem "+p.Key, "parent %q is not in the package and is dropped", p.Parent)
+					p.Parent = ""
+				}
+			}
+		}
+	}
+	for _, s := range e.Sections {
+		for _, t := range s.Topics {
+			fix(t.Units)
+		}
+		fix(s.Units)
+	}
+	for _, t := range e.Topics {
+		fix(t.Units)
+	}
+	fix(e.Units)
+}
+
+// bodyReader turns XHTML item content into markdown: divs written by
+// Encode are unwrapped, and other markup is kept as HTML, which markdown
+// allows.
+type bodyReader struct {
+	name   string
+	issues *issues
+	// unsupported names the first interaction that cannot be imported.
+	unsupported string
+}
+
+// flow converts block content, each block becoming a markdown paragraph.
+// With interactive set, choiceInteractions are decoded and returned in
+// order instead.
+func (r *bodyReader) flow(inner string, interactive bool) (string, []choiceInteraction[rawChoice], error) {
+	var (
+		parts        []string
+		interactions []choiceInteraction[rawChoice]
+	)
+	d, err := newFragmentDecoder(inner)
+	if err != nil {
+		return "", nil, err
+	}
+	for {
+		tok, err := d.Token()
+		if err != nil {
+			return "", nil, err
+		}
+		switch t := tok.(type) {
+		case xml.StartElement:
+			if interactive && t.Name.Local == "choiceInteraction" {
+				var ci choiceInteraction[rawChoice]
+				if err := d.DecodeElement(&ci, &t); err != nil {
+					return "", nil, err
+				}
+				interactions = append(interactions, ci)
+				continue
+			}
+			if t.Name.Local == "div" && attr(t, "class") == markdownClass {
+				var div markdownDiv
+				if err := d.DecodeElement(&div, &t); err != nil {
+					return "", nil, err
+				}
+				parts = append(parts, strings.TrimSpace(div.Text))
+				continue
+			}
+			html, err := r.markup(d, t)
+			if err != nil {
+				return "", nil, err
+			}
+			parts = append(parts, html)
+		case xml.CharData:
+			if text := strings.TrimSpace(string(t)); text != "" {
+				parts = append(parts, text)
+			}
+		case xml.EndElement:
+			// The end of the fragment
+			return strings.Join(parts, "\n\n"), interactions, nil
+		}
+	}
+}
+
+// inline converts inline content. The text of feedbackInlines is returned
+// separately.
+func (r *bodyReader) inline(inner string) (text, feedback string, err error) {
+	var content, fb strings.Builder
+	d, err := newFragmentDecoder(inner)
+	if err != nil {
+		return "", "", err
+	}
+	for done := false; !done; {
+		tok, err := d.Token()
+		if err != nil {
+			return "", "", err
+		}
+		switch t := tok.(type) {
+		case xml.StartElement:
+			if t.Name.Local == "feedbackInline" {
+				var f feedbackInline
+				if err := d.DecodeElement(&f, &t); err != nil {
+					return "", "", err
+				}
+				fb.WriteString(f.Text)
+				continue
+			}
+			html, err := r.markup(d, t)
+			if err != nil {
+				return "", "", err
+			}
+			content.WriteString(html)
+		case xml.CharData:
+			content.Write(t)
+		case xml.EndElement:
+			done = true
+		}
+	}
+	return strings.TrimSpace(content.String()), strings.TrimSpace(fb.String()), nil
+}
+
+// newFragmentDecoder reads content that may have several root elements by
+// wrapping it in one. The wrapper's start is consumed; its end ends the
+// content.
+func newFragmentDecoder(inner string) (*xml.Decoder, error) {
+	d := xml.NewDecoder(strings.NewReader("<fragment>" + inner + "</fragment>"))
+	d.Strict = false
+	d.Entity = xml.HTMLEntity
+	_, err := d.Token()
+	return d, err
+}
+
+// markup re-encodes the element starting with start as HTML, reporting
+// interactions, which cannot be imported as markup, and references to
+// files, which are not imported.
+func (r *bodyReader) markup(d *xml.Decoder, start xml.StartElement) (string, error) {
+	var b strings.Builder
+	enc := xml.NewEncoder(&b)
+	depth := 0
+	var tok xml.Token = start
+	for {
+		switch t := tok.(type) {
+		case xml.StartElement:
+			depth++
+			if strings.HasSuffix(t.Name.Local, "Interaction") && r.unsupported == "" {
+				r.unsupported = t.Name.Local
+			}
+			for _, a := range []string{"src", "data"} {
+				if v := attr(t, a); v != "" {
+					r.issues.add(r.name, "%s references %s, which is not imported", t.Name.Local, v)
+				}
+			}
+			t.Name.Space = ""
+			attrs := make([]xml.Attr, 0, len(t.Attr))
+			for _, a := range t.Attr {
+				if a.Name.Space == "" {
+					attrs = append(attrs, a)
+				}
+			}
+			t.Attr = attrs
+			tok = t
+		case xml.EndElement:
+			depth--
+			t.Name.Space = ""
+			tok = t
+		}
+		if err := enc.EncodeToken(tok); err != nil {
+			return "", err
+		}
+		if depth == 0 {
+			break
+		}
+		var err error
+		if tok, err = d.Token(); err != nil {
+			return "", err
+		}
+	}
+	if err := enc.Flush(); err != nil {
+		return "", err
+	}
+	return b.String(), nil
+}
+
+// title falls back to the identifier, as titles are optional in QTI.
+func title(s section) string {
+	if s.Title != "" {
+		return s.Title
+	}
+	return s.Identifier
+}
+
+func attr(se xml.StartElement, name string) string {
+	for _, a := range se.Attr {
+		if a.Name.Local == name {
+			return a.Value
+		}
+	}
+	return ""
+}
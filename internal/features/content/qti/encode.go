@@ -0,0 +1,334 @@
+package qti
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"path"
+	"slices"
+	"strings"
+
+	"examination/internal/features/content/bundle"
+)
+
+// responseProcessing scores 1 for the correct response, shows the feedback
+// of the selected choices and always shows the explanation.
+const responseProcessing = `
+  <responseCondition>
+    <responseIf>
+      <match><variable identifier="RESPONSE"/><correct identifier="RESPONSE"/></match>
+      <setOutcomeValue identifier="SCORE"><baseValue baseType="float">1</baseValue></setOutcomeValue>
+    </responseIf>
+    <responseElse>
+      <setOutcomeValue identifier="SCORE"><baseValue baseType="float">0</baseValue></setOutcomeValue>
+    </responseElse>
+  </responseCondition>
+  <setOutcomeValue identifier="FEEDBACK"><variable identifier="RESPONSE"/></setOutcomeValue>
+  <setOutcomeValue identifier="EXPLANATION"><baseValue baseType="identifier">EXPLANATION</baseValue></setOutcomeValue>
+`
+
+// Encode writes the exam as a QTI 2.1 content package (a zip file) with
+// each problem in the given locale. Version rules, the description, other
+// locales, section-less topics and assets have no QTI equivalent and are
+// reported.
+func Encode(w io.Writer, e *bundle.Exam, locale string) ([]Issue, error) {
+	if err := e.Validate(); err != nil {
+		return nil, err
+	}
+	enc := &encoder{exam: e, locale: locale, files: make(map[string][]byte)}
+
+	if e.Description != "" {
+		enc.issues.add("exam", "the description has no QTI equivalent and is not exported")
+	}
+	if e.WeightByDifficulty || e.PartialCredit || e.NegativeMarking {
+		enc.issues.add("exam", "the scoring policy has no QTI equivalent and is not exported")
+	}
+	for i := range e.VersionRules {
+		enc.issues.add(fmt.Sprintf("version_rules[%d]", i), "version rules have no QTI equivalent and are not exported")
+	}
+	for i, a := range e.Assets {
+		enc.issues.add(fmt.Sprintf("assets[%d]", i), "%s is not packaged; content keeps its asset: reference", a.Name)
+	}
+
+	test := assessmentTest{
+		Xmlns:      nsQTI,
+		Identifier: enc.id("exam", e.Key),
+		Title:      e.Title,
+		TestParts: []testPart{{
+			Identifier:     "part-1",
+			NavigationMode: "nonlinear",
+			SubmissionMode: "simultaneous",
+		}},
+	}
+	if e.TimeLimit > 0 {
+		test.TimeLimits = &timeLimits{MaxTime: float64(e.TimeLimit * 60)}
+	}
+
+	var root []child
+	for _, s := range e.Sections {
+		var kids []child
+		for _, t := range s.Topics {
+			kids = append(kids, child{t.Seq, enc.topic(t)})
+		}
+		for _, u := range s.Units {
+			kids = append(kids, child{u.Seq, enc.unit(u)})
+		}
+		root = append(root, child{s.Seq, section{
+			Identifier: enc.id("section "+s.Key, s.Key),
+			Title:      s.Title,
+			Visible:    true,
+			Sections:   ordered(kids),
+		}})
+	}
+	for _, t := range e.Topics {
+		enc.issues.add("topic "+t.Key, "topics without a section are exported as top-level sections and import back as sections")
+		root = append(root, child{t.Seq, enc.topic(t)})
+	}
+	for _, u := range e.Units {
+		root = append(root, child{u.Seq, enc.unit(u)})
+	}
+	test.TestParts[0].Sections = ordered(root)
+	if err := enc.file(testFile, test); err != nil {
+		return nil, err
+	}
+
+	m := manifest{
+		Xmlns:      nsCP,
+		Identifier: "MANIFEST-" + test.Identifier,
+		Metadata:   &metadata{Schema: "QTIv2.1 Package", SchemaVersion: "1.0.0"},
+	}
+	testRes := resource{Identifier: "TEST-" + test.Identifier, Type: testResource, Href: testFile, Files: []file{{testFile}}}
+	for _, href := range enc.items {
+		id := "ITEM-" + strings.TrimSuffix(path.Base(href), ".xml")
+		testRes.Dependencies = append(testRes.Dependencies, dependency{id})
+		m.Resources = append(m.Resources, resource{Identifier: id, Type: itemResource, Href: href, Files: []file{{href}}})
+	}
+	m.Resources = append([]resource{testRes}, m.Resources...)
+	if err := enc.file(manifestFile, m); err != nil {
+		return nil, err
+	}
+
+	zw := zip.NewWriter(w)
+	names := append([]string{manifestFile, testFile}, enc.items...)
+	for _, name := range names {
+		f, err := zw.Create(name)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := f.Write(enc.files[name]); err != nil {
+			return nil, err
+		}
+	}
+	if err := zw.Close(); err != nil {
+		return nil, err
+	}
+	return enc.issues, nil
+}
+
+type encoder struct {
+	exam   *bundle.Exam
+	locale string
+	issues issues
+	files  map[string][]byte
+	// items are the item file names in test order.
+	items []string
+}
+
+// child is an assessmentSection with the seq it is ordered by.
+type child struct {
+	seq     int
+	section section
+}
+
+// ordered sorts siblings by seq, placing those without one last in the
+// given order, like a bundle import does.
+func ordered(kids []child) []section {
+	slices.SortStableFunc(kids, func(a, b child) int {
+		switch {
+		case a.seq == b.seq:
+			return 0
+		case a.seq == 0:
+			return 1
+		case b.seq == 0:
+			return -1
+		}
+		return a.seq - b.seq
+	})
+	out := make([]section, 0, len(kids))
+	for _, k := range kids {
+		out = append(out, k.section)
+	}
+	return out
+}
+
+// id returns key if it is a valid QTI identifier and a sanitized version
+// otherwise, which is reported as it will not import back to the same key.
+func (enc *encoder) id(where, key string) string {
+	if identifier.MatchString(key) {
+		return key
+	}
+	id := []byte(key)
+	for i, c := range id {
+		if !identifier.Match([]byte{'_', c}) {
+			id[i] = '_'
+		}
+	}
+	sanitized := string(id)
+	if !identifier.MatchString(sanitized) {
+		sanitized = "_" + sanitized
+	}
+	enc.issues.add(where, "key %q is not a QTI identifier; exported as %q", key, sanitized)
+	return sanitized
+}
+
+func (enc *encoder) topic(t bundle.Topic) section {
+	s := section{Identifier: enc.id("topic "+t.Key, t.Key), Title: t.Title, Visible: true}
+	var kids []child
+	for _, u := range t.Units {
+		kids = append(kids, child{u.Seq, enc.unit(u)})
+	}
+	s.Sections = ordered(kids)
+	return s
+}
+
+// unit becomes a section selecting one of its problems.
+func (enc *encoder) unit(u bundle.Unit) section {
+	s := section{Identifier: enc.id("unit "+u.Key, u.Key), Title: u.Title, Visible: true}
+	if len(u.Problems) > 1 {
+		s.Selection = &selection{Select: 1}
+	}
+	for _, p := range u.Problems {
+		id := enc.id("problem "+p.Key, p.Key)
+		href := path.Join(itemsDir, id+".xml")
+		item, ok := enc.item(id, p)
+		if !ok {
+			continue
+		}
+		if err := enc.file(href, item); err != nil {
+			enc.issues.add("problem "+p.Key, "%v", err)
+			continue
+		}
+		enc.items = append(enc.items, href)
+
+		c := categories{Difficulty: p.Difficulty, Variant: p.Type == "VARIANT"}
+		if p.Parent != "" {
+			c.Parent = enc.id("problem "+p.Key, p.Parent)
+		}
+		s.ItemRefs = append(s.ItemRefs, itemRef{Identifier: id, Href: href, Category: c.String()})
+	}
+	return s
+}
+
+func (enc *encoder) item(id string, p bundle.Problem) (*assessmentItem, bool) {
+	where := "problem " + p.Key
+	if len(p.Translations) == 0 {
+		enc.issues.add(where, "has no translations and is not exported")
+		return nil, false
+	}
+	switch p.Interaction {
+	case "", "SINGLE", "MULTIPLE":
+	default:
+		enc.issues.add(where, "is a %s problem, which only exports as a choiceInteraction; not exported", p.Interaction)
+		return nil, false
+	}
+	i := slices.IndexFunc(p.Translations, func(t bundle.Translation) bool { return t.Locale == enc.locale })
+	if i < 0 {
+		i = 0
+		enc.issues.add(where, "has no %s translation; exported in %s", enc.locale, p.Translations[0].Locale)
+	}
+	t := p.Translations[i]
+	var others []string
+	for _, o := range p.Translations {
+		if o.Locale != t.Locale {
+			others = append(others, o.Locale)
+		}
+	}
+	if len(others) > 0 {
+		enc.issues.add(where, "translations in %s are not exported", strings.Join(others, ", "))
+	}
+
+	item := &assessmentItem{
+		Xmlns:      nsQTI,
+		Identifier: id,
+		Title:      t.Title,
+		Lang:       t.Locale,
+		Processing: &raw{Inner: responseProcessing},
+	}
+	interaction := choiceInteraction[simpleChoice]{
+		ResponseIdentifier: responseID,
+		Shuffle:            enc.exam.ShuffleChoices,
+	}
+	var correct []string
+	for j, c := range t.Choices {
+		sc := simpleChoice{Identifier: fmt.Sprintf("C%d", j+1), Text: c.Content}
+		if c.Correct {
+			correct = append(correct, sc.Identifier)
+		}
+		if c.Explanation != "" {
+			sc.Feedback = &feedbackInline{
+				OutcomeIdentifier: feedbackID,
+				Identifier:        sc.Identifier,
+				ShowHide:          "show",
+				Text:              c.Explanation,
+			}
+		}
+		interaction.Choices = append(interaction.Choices, sc)
+	}
+	cardinality := "single"
+	interaction.MaxChoices = 1
+	if p.Interaction == "MULTIPLE" || len(correct) > 1 {
+		cardinality = "multiple"
+		interaction.MaxChoices = 0
+	}
+	item.Responses = []responseDeclaration{{
+		Identifier:  responseID,
+		Cardinality: cardinality,
+		BaseType:    "identifier",
+		Correct:     correct,
+	}}
+	item.Outcomes = []outcomeDeclaration{
+		{Identifier: "SCORE", Cardinality: "single", BaseType: "float"},
+		{Identifier: feedbackID, Cardinality: cardinality, BaseType: "identifier"},
+		{Identifier: explanationID, Cardinality: "single", BaseType: "identifier"},
+	}
+
+	var body bytes.Buffer
+	if t.Content != "" {
+		if err := xml.NewEncoder(&body).Encode(markdownDiv{Class: markdownClass, Text: t.Content}); err != nil {
+			enc.issues.add(where, "%v", err)
+			return nil, false
+		}
+	}
+	if err := xml.NewEncoder(&body).Encode(interaction); err != nil {
+		enc.issues.add(where, "%v", err)
+		return nil, false
+	}
+	item.Body.Inner = body.String()
+
+	if t.Explanation != "" {
+		var fb bytes.Buffer
+		if err := xml.NewEncoder(&fb).Encode(markdownDiv{Class: markdownClass, Text: t.Explanation}); err != nil {
+			enc.issues.add(where, "%v", err)
+			return nil, false
+		}
+		item.Feedback = []modalFeedback{{
+			OutcomeIdentifier: explanationID,
+			ShowHide:          "show",
+			Identifier:        explanationID,
+			Inner:             fb.String(),
+		}}
+	}
+	return item, true
+}
+
+// file marshals v as an XML document stored under name.
+func (enc *encoder) file(name string, v any) error {
+	data, err := xml.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return fmt.Errorf("encoding %s: %w", name, err)
+	}
+	enc.files[name] = append([]byte(xml.Header), append(data, '\n')...)
+	return nil
+}
@@ -0,0 +1,247 @@
+// Package qti converts exam bundles to and from IMS QTI 2.1 content
+// packages, so question banks can be exchanged with LMS tools.
+//
+// Problems map to assessmentItems with a single choiceInteraction; the
+// exam maps to an assessmentTest with one testPart, in which sections,
+// topics and units are nested assessmentSections. A unit holding several
+// problems (a source and its variants) selects one of them, as attempts do.
+// Constructs one side cannot express are reported as Issues rather than
+// silently dropped.
+package qti
+
+import (
+	"encoding/xml"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+const (
+	nsQTI = "http://www.imsglobal.org/xsd/imsqti_v2p1"
+	nsCP  = "http://www.imsglobal.org/xsd/imscp_v1p1"
+
+	itemResource = "imsqti_item_xmlv2p1"
+	testResource = "imsqti_test_xmlv2p1"
+
+	manifestFile = "imsmanifest.xml"
+	testFile     = "test.xml"
+	itemsDir     = "items"
+
+	responseID    = "RESPONSE"
+	feedbackID    = "FEEDBACK"
+	explanationID = "EXPLANATION"
+
+	// markdownClass marks the divs holding markdown, which players show as text.
+	markdownClass = "markdown"
+
+	difficultyCategory = "difficulty-"
+	variantCategory    = "variant"
+	variantOfCategory  = "variant-of-"
+)
+
+// Issue is a construct that could not be converted. Path names the file
+// or entity it was found in.
+type Issue struct {
+	Path    string
+	Message string
+}
+
+func (i Issue) String() string {
+	return i.Path + ": " + i.Message
+}
+
+type issues []Issue
+
+func (is *issues) add(path, format string, args ...any) {
+	*is = append(*is, Issue{Path: path, Message: fmt.Sprintf(format, args...)})
+}
+
+// identifier matches the QTI identifier syntax, restricted to ASCII.
+var identifier = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_.-]*$`)
+
+// --- Content package ---
+
+type manifest struct {
+	XMLName       xml.Name   `xml:"manifest"`
+	Xmlns         string     `xml:"xmlns,attr,omitempty"`
+	Identifier    string     `xml:"identifier,attr"`
+	Metadata      *metadata  `xml:"metadata"`
+	Organizations struct{}   `xml:"organizations"`
+	Resources     []resource `xml:"resources>resource"`
+}
+
+type metadata struct {
+	Schema        string `xml:"schema"`
+	SchemaVersion string `xml:"schemaversion"`
+}
+
+type resource struct {
+	Identifier   string       `xml:"identifier,attr"`
+	Type         string       `xml:"type,attr"`
+	Href         string       `xml:"href,attr"`
+	Files        []file       `xml:"file"`
+	Dependencies []dependency `xml:"dependency"`
+}
+
+type file struct {
+	Href string `xml:"href,attr"`
+}
+
+type dependency struct {
+	IdentifierRef string `xml:"identifierref,attr"`
+}
+
+// --- Test ---
+
+type assessmentTest struct {
+	XMLName    xml.Name    `xml:"assessmentTest"`
+	Xmlns      string      `xml:"xmlns,attr,omitempty"`
+	Identifier string      `xml:"identifier,attr"`
+	Title      string      `xml:"title,attr"`
+	TimeLimits *timeLimits `xml:"timeLimits"`
+	TestParts  []testPart  `xml:"testPart"`
+}
+
+type timeLimits struct {
+	MaxTime float64 `xml:"maxTime,attr,omitempty"`
+}
+
+type testPart struct {
+	Identifier     string    `xml:"identifier,attr"`
+	NavigationMode string    `xml:"navigationMode,attr"`
+	SubmissionMode string    `xml:"submissionMode,attr"`
+	Sections       []section `xml:"assessmentSection"`
+}
+
+// section is an assessmentSection. QTI allows sections and item refs to
+// interleave; sections holding both are reported on import.
+type section struct {
+	Identifier string     `xml:"identifier,attr"`
+	Title      string     `xml:"title,attr"`
+	Visible    bool       `xml:"visible,attr"`
+	Selection  *selection `xml:"selection"`
+	Sections   []section  `xml:"assessmentSection"`
+	ItemRefs   []itemRef  `xml:"assessmentItemRef"`
+}
+
+type selection struct {
+	Select int `xml:"select,attr"`
+}
+
+type itemRef struct {
+	Identifier string `xml:"identifier,attr"`
+	Href       string `xml:"href,attr"`
+	Category   string `xml:"category,attr,omitempty"`
+}
+
+// --- Item ---
+
+type assessmentItem struct {
+	XMLName       xml.Name              `xml:"assessmentItem"`
+	Xmlns         string                `xml:"xmlns,attr,omitempty"`
+	Identifier    string                `xml:"identifier,attr"`
+	Title         string                `xml:"title,attr"`
+	Lang          string                `xml:"http://www.w3.org/XML/1998/namespace lang,attr,omitempty"`
+	Adaptive      bool                  `xml:"adaptive,attr"`
+	TimeDependent bool                  `xml:"timeDependent,attr"`
+	Responses     []responseDeclaration `xml:"responseDeclaration"`
+	Outcomes      []outcomeDeclaration  `xml:"outcomeDeclaration"`
+	Templates     []raw                 `xml:"templateDeclaration"`
+	Body          raw                   `xml:"itemBody"`
+	Processing    *raw                  `xml:"responseProcessing"`
+	Feedback      []modalFeedback       `xml:"modalFeedback"`
+}
+
+type responseDeclaration struct {
+	Identifier  string   `xml:"identifier,attr"`
+	Cardinality string   `xml:"cardinality,attr"`
+	BaseType    string   `xml:"baseType,attr"`
+	Correct     []string `xml:"correctResponse>value"`
+}
+
+type outcomeDeclaration struct {
+	Identifier  string `xml:"identifier,attr"`
+	Cardinality string `xml:"cardinality,attr"`
+	BaseType    string `xml:"baseType,attr"`
+}
+
+type modalFeedback struct {
+	OutcomeIdentifier string `xml:"outcomeIdentifier,attr"`
+	ShowHide          string `xml:"showHide,attr"`
+	Identifier        string `xml:"identifier,attr"`
+	Inner             string `xml:",innerxml"`
+}
+
+// raw keeps the content of an element verbatim.
+type raw struct {
+	Inner string `xml:",innerxml"`
+}
+
+// choiceInteraction is written with simpleChoices and read with rawChoices,
+// whose content may be any inline markup.
+type choiceInteraction[C any] struct {
+	XMLName            xml.Name `xml:"choiceInteraction"`
+	ResponseIdentifier string   `xml:"responseIdentifier,attr"`
+	Shuffle            bool     `xml:"shuffle,attr"`
+	MaxChoices         int      `xml:"maxChoices,attr"`
+	Prompt             *raw     `xml:"prompt"`
+	Choices            []C      `xml:"simpleChoice"`
+}
+
+type simpleChoice struct {
+	Identifier string          `xml:"identifier,attr"`
+	Text       string          `xml:",chardata"`
+	Feedback   *feedbackInline `xml:"feedbackInline"`
+}
+
+type rawChoice struct {
+	Identifier string `xml:"identifier,attr"`
+	Inner      string `xml:",innerxml"`
+}
+
+type feedbackInline struct {
+	XMLName           xml.Name `xml:"feedbackInline"`
+	OutcomeIdentifier string   `xml:"outcomeIdentifier,attr"`
+	Identifier        string   `xml:"identifier,attr"`
+	ShowHide          string   `xml:"showHide,attr"`
+	Text              string   `xml:",chardata"`
+}
+
+type markdownDiv struct {
+	XMLName xml.Name `xml:"div"`
+	Class   string   `xml:"class,attr"`
+	Text    string   `xml:",chardata"`
+}
+
+// categories holds the problem fields the item refs carry as categories.
+type categories struct {
+	Difficulty int
+	Variant    bool
+	Parent     string
+}
+
+func (c categories) String() string {
+	parts := []string{difficultyCategory + strconv.Itoa(c.Difficulty)}
+	if c.Parent != "" {
+		parts = append(parts, variantOfCategory+c.Parent)
+	} else if c.Variant {
+		parts = append(parts, variantCategory)
+	}
+	return strings.Join(parts, " ")
+}
+
+func parseCategories(s string) categories {
+	var c categories
+	for _, f := range strings.Fields(s) {
+		switch {
+		case strings.HasPrefix(f, difficultyCategory):
+			c.Difficulty, _ = strconv.Atoi(strings.TrimPrefix(f, difficultyCategory))
+		case strings.HasPrefix(f, variantOfCategory):
+			c.Variant, c.Parent = true, strings.TrimPrefix(f, variantOfCategory)
+		case f == variantCategory:
+			c.Variant = true
+		}
+	}
+	return c
+}
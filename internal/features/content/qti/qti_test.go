@@ -0,0 +1,169 @@
+package qti_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	"examination/internal/features/content/bundle"
+	"examination/internal/features/content/qti"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func problem(key string, difficulty int, content string, choices ...bundle.Choice) bundle.Problem {
+	return bundle.Problem{Key: key, Difficulty: difficulty, Translations: []bundle.Translation{{
+		Locale: "en", Title: key, Content: content, Choices: choices,
+	}}}
+}
+
+func messages(issues []qti.Issue) []string {
+	var out []string
+	for _, i := range issues {
+		out = append(out, i.String())
+	}
+	return out
+}
+
+func TestRoundTrip(t *testing.T) {
+	source := problem("q1", 2, "Which is an **entity**?\n\n```go\nif a < b {}\n```",
+		bundle.Choice{Content: "Customer", Correct: true, Explanation: "Has an identity."},
+		bundle.Choice{Content: "Blue & green"},
+	)
+	source.Translations[0].Explanation = "See chapter 3."
+	variant := problem("q1-v", 3, "Pick the entities.",
+		bundle.Choice{Content: "Order", Correct: true},
+		bundle.Choice{Content: "Invoice", Correct: true},
+	)
+	variant.Type, variant.Parent, variant.Interaction = "VARIANT", "q1", "MULTIPLE"
+
+	e := &bundle.Exam{
+		Version:        bundle.Version,
+		Key:            "sdd",
+		Title:          "SDD",
+		TimeLimit:      45,
+		ShuffleChoices: true,
+		Sections: []bundle.Section{{
+			Key: "basics", Title: "Basics", Seq: 1,
+			Topics: []bundle.Topic{{Key: "modeling", Title: "Modeling", Seq: 2, Units: []bundle.Unit{
+				{Key: "u1", Title: "Unit 1", Seq: 1, Problems: []bundle.Problem{source, variant}},
+			}}},
+			Units: []bundle.Unit{
+				{Key: "u2", Title: "Unit 2", Seq: 1, Problems: []bundle.Problem{problem("q2", 1, "", bundle.Choice{Content: "Yes", Correct: true})}},
+			},
+		}},
+		Units: []bundle.Unit{
+			{Key: "u3", Title: "Unit 3", Seq: 2, Problems: []bundle.Problem{problem("q3", 1, "Last", bundle.Choice{Content: "A", Correct: true})}},
+		},
+	}
+
+	var buf bytes.Buffer
+	issues, err := qti.Encode(&buf, e, "en")
+	require.NoError(t, err)
+	assert.Empty(t, issues)
+
+	zr, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	require.NoError(t, err)
+	back, issues, err := qti.Decode(zr)
+	require.NoError(t, err)
+	assert.Empty(t, issues)
+	assert.Equal(t, e, back)
+}
+
+func TestEncode_ReportsUnsupported(t *testing.T) {
+	p := problem("q 1", 1, "Q", bundle.Choice{Content: "A", Correct: true})
+	p.Translations = append(p.Translations, bundle.Translation{Locale: "ko", Title: "Q", Choices: []bundle.Choice{{Content: "A", Correct: true}}})
+	numeric := problem("n", 1, "1 + 1", bundle.Choice{Content: "2"})
+	numeric.Interaction = "NUMERIC"
+	e := &bundle.Exam{
+		Key:           "sdd",
+		Title:         "SDD",
+		Description:   "About",
+		PartialCredit: true,
+		Topics:        []bundle.Topic{{Key: "t", Title: "T", Units: []bundle.Unit{{Key: "u", Title: "U", Problems: []bundle.Problem{p, numeric}}}}},
+		VersionRules:  []bundle.VersionRule{{Operator: "Equal"}},
+		Assets:        []bundle.Asset{{Hash: strings.Repeat("0a", 32), Name: "circuit.png"}},
+	}
+	issues, err := qti.Encode(&bytes.Buffer{}, e, "en")
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		"exam: the description has no QTI equivalent and is not exported",
+		"exam: the scoring policy has no QTI equivalent and is not exported",
+		"version_rules[0]: version rules have no QTI equivalent and are not exported",
+		"assets[0]: circuit.png is not packaged; content keeps its asset: reference",
+		"topic t: topics without a section are exported as top-level sections and import back as sections",
+		`problem q 1: key "q 1" is not a QTI identifier; exported as "q_1"`,
+		"problem q 1: translations in ko are not exported",
+		"problem n: is a NUMERIC problem, which only exports as a choiceInteraction; not exported",
+	}, messages(issues))
+}
+
+const manifestXML = `<?xml version="1.0" encoding="UTF-8"?>
+<manifest xmlns="http://www.imsglobal.org/xsd/imscp_v1p1" identifier="bank">
+  <resources>
+    <resource identifier="i1" type="imsqti_item_xmlv2p1" href="i1.xml"/>
+    <resource identifier="i2" type="imsqti_item_xmlv2p1" href="i2.xml"/>
+  </resources>
+</manifest>`
+
+const htmlItem = `<?xml version="1.0" encoding="UTF-8"?>
+<assessmentItem xmlns="http://www.imsglobal.org/xsd/imsqti_v2p1" identifier="i1" title="Capitals" adaptive="false" timeDependent="false">
+  <responseDeclaration identifier="RESPONSE" cardinality="single" baseType="identifier">
+    <correctResponse><value>B</value></correctResponse>
+  </responseDeclaration>
+  <itemBody>
+    <p>What is the capital of <em>France</em>?</p>
+    <img src="images/map.png" alt="Map"/>
+    <choiceInteraction responseIdentifier="RESPONSE" shuffle="false" maxChoices="1">
+      <prompt>Choose one.</prompt>
+      <simpleChoice identifier="A">Lyon</simpleChoice>
+      <simpleChoice identifier="B"><b>Paris</b></simpleChoice>
+    </choiceInteraction>
+  </itemBody>
+</assessmentItem>`
+
+const textEntryItem = `<?xml version="1.0" encoding="UTF-8"?>
+<assessmentItem xmlns="http://www.imsglobal.org/xsd/imsqti_v2p1" identifier="i2" title="Fill in" xml:lang="en">
+  <responseDeclaration identifier="RESPONSE" cardinality="single" baseType="string">
+    <correctResponse><value>Paris</value></correctResponse>
+  </responseDeclaration>
+  <itemBody>
+    <p>The capital of France is <textEntryInteraction responseIdentifier="RESPONSE"/>.</p>
+  </itemBody>
+</assessmentItem>`
+
+func TestDecode_ItemsWithoutTest(t *testing.T) {
+	fsys := fstest.MapFS{
+		"imsmanifest.xml": {Data: []byte(manifestXML)},
+		"i1.xml":          {Data: []byte(htmlItem)},
+		"i2.xml":          {Data: []byte(textEntryItem)},
+	}
+	e, issues, err := qti.Decode(fsys)
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{
+		"imsmanifest.xml: the package has no assessmentTest; each item becomes a unit",
+		"i1.xml: img references images/map.png, which is not imported",
+		"i1.xml: no xml:lang; imported as en",
+		"i2.xml: skipped: textEntryInteraction is not supported",
+	}, messages(issues))
+
+	assert.Equal(t, "bank", e.Key)
+	require.Len(t, e.Units, 1)
+	assert.Equal(t, "Capitals", e.Units[0].Title)
+	require.Len(t, e.Units[0].Problems, 1)
+	tr := e.Units[0].Problems[0].Translations[0]
+	assert.Equal(t, `<p>What is the capital of <em>France</em>?</p>`+"\n\n"+`<img src="images/map.png" alt="Map"></img>`+"\n\nChoose one.", tr.Content)
+	assert.Equal(t, []bundle.Choice{
+		{Content: "Lyon"},
+		{Content: "<b>Paris</b>", Correct: true},
+	}, tr.Choices)
+}
+
+func TestDecode_NotAPackage(t *testing.T) {
+	_, _, err := qti.Decode(fstest.MapFS{})
+	assert.ErrorIs(t, err, qti.ErrNoManifest)
+}
@@ -0,0 +1,187 @@
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+	"time"
+
+	"examination/internal/ent"
+	"examination/internal/ent/asset"
+	"examination/internal/ent/choice"
+	"examination/internal/ent/exam"
+	"examination/internal/ent/problem"
+	"examination/internal/ent/problemtranslation"
+	"examination/internal/ent/unit"
+	"examination/internal/features/content/assetstore"
+	"examination/internal/features/content/bundle"
+	"examination/internal/features/content/markdown"
+)
+
+// MaxAssetSize is the largest file an asset may be.
+const MaxAssetSize = 10 << 20
+
+var (
+	// ErrAssetType is returned for files of a type assets may not have.
+	ErrAssetType = errors.New("unsupported asset type")
+	// ErrAssetSize is returned for empty files and files over MaxAssetSize.
+	ErrAssetSize = errors.New("asset must be between 1 byte and 10 MiB")
+)
+
+// assetTypes maps the extensions assets may have to their content types.
+// Images are shown in content; PDFs are offered as attachments.
+var assetTypes = map[string]string{
+	".png":  "image/png",
+	".jpg":  "image/jpeg",
+	".jpeg": "image/jpeg",
+	".gif":  "image/gif",
+	".webp": "image/webp",
+	".svg":  "image/svg+xml",
+	".pdf":  "application/pdf",
+}
+
+// AssetService stores the images and attachments that problem content
+// references as asset:HASH. Rows hold what the pages need to serve a file;
+// the bytes are in a Storage under the same hash.
+type AssetService struct {
+	client  *ent.Client
+	storage assetstore.Storage
+}
+
+func NewAssetService(client *ent.Client, storage assetstore.Storage) *AssetService {
+	return &AssetService{client: client, storage: storage}
+}
+
+// Save stores data as an asset named name. A file stored before, under
+// any name, is returned as it was saved then.
+func (s *AssetService) Save(ctx context.Context, name string, data []byte) (*ent.Asset, error) {
+	contentType, ok := assetTypes[strings.ToLower(path.Ext(name))]
+	if !ok {
+		return nil, fmt.Errorf("%w: %q", ErrAssetType, name)
+	}
+	if len(data) == 0 || len(data) > MaxAssetSize {
+		return nil, ErrAssetSize
+	}
+	sum := sha256.Sum256(data)
+	hash := hex.EncodeToString(sum[:])
+
+	a, err := s.client.Asset.Query().Where(asset.Hash(hash)).Only(ctx)
+	if err == nil || !ent.IsNotFound(err) {
+		return a, err
+	}
+	// The bytes go first, so that a row never names a missing file
+	if err := s.storage.Put(ctx, hash, data); err != nil {
+		return nil, fmt.Errorf("storing asset: %w", err)
+	}
+	a, err = s.client.Asset.Create().
+		SetHash(hash).
+		SetName(path.Base(name)).
+		SetContentType(contentType).
+		SetSize(int64(len(data))).
+		SetCreatedAt(time.Now()).
+		Save(ctx)
+	if ent.IsConstraintError(err) {
+		// Saved by a concurrent upload of the same file
+		return s.client.Asset.Query().Where(asset.Hash(hash)).Only(ctx)
+	}
+	return a, err
+}
+
+// Public reports whether the asset is shown in the preview of an active
+// exam, which anyone may open: it is referenced by the content of a
+// problem translation or of its choices. Explanations are not previewed.
+func (s *AssetService) Public(ctx context.Context, hash string) (bool, error) {
+	ref := markdown.AssetScheme + hash
+	return s.client.ProblemTranslation.Query().
+		Where(
+			problemtranslation.HasProblemWith(problem.HasUnitWith(unit.HasExamWith(exam.IsActive(true)))),
+			problemtranslation.Or(
+				problemtranslation.ContentContains(ref),
+				problemtranslation.HasChoicesWith(choice.ContentContains(ref)),
+			),
+		).
+		Exist(ctx)
+}
+
+// Open returns the asset with the hash and its bytes, which the caller
+// closes. An unknown hash is an ent not-found error.
+func (s *AssetService) Open(ctx context.Context, hash string) (*ent.Asset, io.ReadCloser, error) {
+	a, err := s.client.Asset.Query().Where(asset.Hash(hash)).Only(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	r, err := s.storage.Open(ctx, hash)
+	if err != nil {
+		return nil, nil, fmt.Errorf("opening asset %s: %w", hash, err)
+	}
+	return a, r, nil
+}
+
+// ExportBundle adds the assets that the content of b references to
+// b.Assets, with their files. References to unknown assets are left out.
+func (s *AssetService) ExportBundle(ctx context.Context, b *bundle.Exam) error {
+	seen := make(map[string]bool)
+	for _, text := range b.Texts() {
+		for _, hash := range markdown.Assets(text) {
+			if seen[hash] {
+				continue
+			}
+			seen[hash] = true
+			a, r, err := s.Open(ctx, hash)
+			if ent.IsNotFound(err) {
+				continue
+			}
+			if err != nil {
+				return err
+			}
+			data, err := io.ReadAll(r)
+			r.Close()
+			if err != nil {
+				return fmt.Errorf("reading asset %s: %w", hash, err)
+			}
+			b.Assets = append(b.Assets, bundle.Asset{
+				Hash: a.Hash,
+				Name: a.Name,
+				Data: base64.StdEncoding.EncodeToString(data),
+			})
+		}
+	}
+	return nil
+}
+
+// ImportBundle saves the assets of b, before its content is imported, and
+// returns how many were new. An asset without data must already exist.
+func (s *AssetService) ImportBundle(ctx context.Context, b *bundle.Exam) (int, error) {
+	created := 0
+	for _, ba := range b.Assets {
+		exists, err := s.client.Asset.Query().Where(asset.Hash(ba.Hash)).Exist(ctx)
+		if err != nil {
+			return created, err
+		}
+		if exists {
+			continue
+		}
+		if ba.Data == "" {
+			return created, fmt.Errorf("asset %s (%s) has no data and is not on the server", ba.Hash, ba.Name)
+		}
+		data, err := ba.Bytes()
+		if err != nil {
+			return created, fmt.Errorf("asset %s: %w", ba.Hash, err)
+		}
+		a, err := s.Save(ctx, ba.Name, data)
+		if err != nil {
+			return created, fmt.Errorf("asset %s (%s): %w", ba.Hash, ba.Name, err)
+		}
+		if a.Hash != ba.Hash {
+			return created, fmt.Errorf("asset %s (%s): data does not match the hash", ba.Hash, ba.Name)
+		}
+		created++
+	}
+	return created, nil
+}
@@ -0,0 +1,142 @@
+package service_test
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"examination/internal/features/content/assetstore"
+	"examination/internal/features/content/bundle"
+	"examination/internal/features/content/service"
+	"examination/internal/testutil"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAssetService_Save(t *testing.T) {
+	ctx := context.Background()
+	svc := service.NewAssetService(testutil.NewClient(t), assetstore.NewDisk(t.TempDir()))
+
+	a, err := svc.Save(ctx, "diagrams/Circuit.PNG", []byte("png bytes"))
+	require.NoError(t, err)
+	assert.Equal(t, "Circuit.PNG", a.Name)
+	assert.Equal(t, "image/png", a.ContentType)
+	assert.EqualValues(t, 9, a.Size)
+	assert.Len(t, a.Hash, 64)
+
+	// The same bytes under another name are the same asset
+	again, err := svc.Save(ctx, "copy.png", []byte("png bytes"))
+	require.NoError(t, err)
+	assert.Equal(t, a.ID, again.ID)
+	assert.Equal(t, "Circuit.PNG", again.Name)
+
+	got, r, err := svc.Open(ctx, a.Hash)
+	require.NoError(t, err)
+	defer r.Close()
+	data, err := io.ReadAll(r)
+	require.NoError(t, err)
+	assert.Equal(t, a.ID, got.ID)
+	assert.Equal(t, "png bytes", string(data))
+
+	_, err = svc.Save(ctx, "script.html", []byte("<script>"))
+	assert.ErrorIs(t, err, service.ErrAssetType)
+	_, err = svc.Save(ctx, "empty.png", nil)
+	assert.ErrorIs(t, err, service.ErrAssetSize)
+}
+
+func TestAssetService_BundleRoundTrip(t *testing.T) {
+	ctx := context.Background()
+	svc := service.NewAssetService(testutil.NewClient(t), assetstore.NewDisk(t.TempDir()))
+	a, err := svc.Save(ctx, "circuit.svg", []byte("<svg/>"))
+	require.NoError(t, err)
+	_, err = svc.Save(ctx, "unused.png", []byte("unused"))
+	require.NoError(t, err)
+
+	b := &bundle.Exam{Key: "k", Title: "T", Units: []bundle.Unit{{Key: "u", Title: "U", Problems: []bundle.Problem{{
+		Key: "p",
+		Translations: []bundle.Translation{{
+			Locale:  "en",
+			Content: "![Circuit](asset:" + a.Hash + ")",
+			Choices: []bundle.Choice{{Content: "A", Correct: true, Explanation: "[Again](asset:" + a.Hash + ")"}},
+		}},
+	}}}}}
+	require.NoError(t, svc.ExportBundle(ctx, b))
+	require.Len(t, b.Assets, 1)
+	assert.Equal(t, bundle.Asset{Hash: a.Hash, Name: "circuit.svg", Data: "PHN2Zy8+"}, b.Assets[0])
+	require.NoError(t, b.Validate())
+
+	t.Run("another server", func(t *testing.T) {
+		other := service.NewAssetService(testutil.NewClient(t), assetstore.NewDisk(t.TempDir()))
+		created, err := other.ImportBundle(ctx, b)
+		require.NoError(t, err)
+		assert.Equal(t, 1, created)
+		created, err = other.ImportBundle(ctx, b)
+		require.NoError(t, err)
+		assert.Zero(t, created)
+	})
+
+	// Without data, the asset must already be there
+	b.Assets[0].Data = ""
+	_, err = svc.ImportBundle(ctx, b)
+	require.NoError(t, err)
+	t.Run("server without the asset", func(t *testing.T) {
+		empty := service.NewAssetService(testutil.NewClient(t), assetstore.NewDisk(t.TempDir()))
+		_, err := empty.ImportBundle(ctx, b)
+		assert.ErrorContains(t, err, "has no data")
+	})
+}
+
+func TestAssetService_Public(t *testing.T) {
+	ctx := context.Background()
+	client := testutil.NewClient(t)
+	svc := service.NewAssetService(client, assetstore.NewDisk(t.TempDir()))
+	save := func(name string) string {
+		a, err := svc.Save(ctx, name, []byte(name))
+		require.NoError(t, err)
+		return a.Hash
+	}
+	content, choice, explanation, inactive := save("content.png"), save("choice.png"), save("explanation.png"), save("inactive.png")
+
+	examWith := func(key string, active bool, tr bundle.Translation) *bundle.Exam {
+		return &bundle.Exam{Key: key, Title: key, IsActive: active, Units: []bundle.Unit{{Key: "u", Title: "U", Problems: []bundle.Problem{{
+			Key:          "p",
+			Translations: []bundle.Translation{tr},
+		}}}}}
+	}
+	contents := service.NewContentService(client)
+	_, _, err := contents.ImportBundle(ctx, examWith("open", true, bundle.Translation{
+		Locale:      "en",
+		Title:       "Q",
+		Content:     "![Content](asset:" + content + ")",
+		Explanation: "[Explanation](asset:" + explanation + ")",
+		Choices: []bundle.Choice{
+			{Content: "![Choice](asset:" + choice + ")", Correct: true},
+			{Content: "B"},
+		},
+	}))
+	require.NoError(t, err)
+	_, _, err = contents.ImportBundle(ctx, examWith("draft", false, bundle.Translation{
+		Locale:  "en",
+		Title:   "Q",
+		Content: "![Inactive](asset:" + inactive + ")",
+		Choices: []bundle.Choice{{Content: "A", Correct: true}, {Content: "B"}},
+	}))
+	require.NoError(t, err)
+
+	cases := []struct {
+		name string
+		hash string
+		want bool
+	}{
+		{"content", content, true},
+		{"choice", choice, true},
+		{"explanation", explanation, false},
+		{"inactive exam", inactive, false},
+	}
+	for _, tc := range cases {
+		got, err := svc.Public(ctx, tc.hash)
+		require.NoError(t, err)
+		assert.Equal(t, tc.want, got, tc.name)
+	}
+}
@@ -0,0 +1,323 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"slices"
+
+	"examination/internal/dbtx"
+	"examination/internal/ent"
+	"examination/internal/ent/predicate"
+	"examination/internal/ent/problem"
+	"examination/internal/ent/topic"
+	"examination/internal/ent/unit"
+)
+
+// ErrInfeasible is returned when the bank cannot satisfy a blueprint target.
+var ErrInfeasible = errors.New("blueprint target cannot be satisfied")
+
+// DefaultTolerance is the allowed distance from a target's mean difficulty
+// when the target does not set one. Means of integer difficulties rarely hit
+// a target such as 2.5 exactly.
+const DefaultTolerance = 0.25
+
+// Blueprint describes an exam to generate from the existing question bank.
+type Blueprint struct {
+	Title       string `json:"title"`
+	Description string `json:"description,omitempty"`
+	TimeLimit   int    `json:"time_limit"`
+	// Seed makes the sampling reproducible; the same seed and bank always
+	// generate the same exam.
+	Seed    uint64   `json:"seed"`
+	Targets []Target `json:"targets"`
+}
+
+// Target asks for problems sampled from one bank Section or Topic, e.g.
+// "5 problems, mean difficulty 2.5, at most 1 at difficulty 5". Exactly one
+// of SectionID and TopicID is set. A section target becomes a section of the
+// generated exam, a topic target an exam-level topic; both are titled after
+// their source unless Title is set.
+type Target struct {
+	SectionID int    `json:"section_id,omitempty"`
+	TopicID   int    `json:"topic_id,omitempty"`
+	Title     string `json:"title,omitempty"`
+	Count     int    `json:"count"`
+	// MeanDifficulty is the wanted mean; 0 leaves the mean unconstrained.
+	MeanDifficulty float64 `json:"mean_difficulty,omitempty"`
+	// Tolerance is the allowed distance from MeanDifficulty; 0 means DefaultTolerance.
+	Tolerance float64 `json:"tolerance,omitempty"`
+	// MaxPerDifficulty caps the number of problems per difficulty.
+	MaxPerDifficulty map[int]int `json:"max_per_difficulty,omitempty"`
+}
+
+// PlannedGroup is the outcome of one target.
+type PlannedGroup struct {
+	Target Target
+	// Kind is KindSection or KindTopic.
+	Kind  Kind
+	Title string
+	// Problems are the sampled SOURCE problems, ordered by ID, with their
+	// unit, translations and choices loaded.
+	Problems []*ent.Problem
+	Mean     float64
+}
+
+// Plan is the sampled content of a blueprint, before anything is written.
+type Plan struct {
+	Blueprint Blueprint
+	Groups    []PlannedGroup
+}
+
+// PlanBlueprint samples the bank for every target without writing anything.
+// Only SOURCE problems with at least one translation are sampled; a problem
+// is used at most once per plan.
+func (s *ContentService) PlanBlueprint(ctx context.Context, bp Blueprint) (*Plan, error) {
+	if bp.Title == "" {
+		return nil, fmt.Errorf("%w: title is required", ErrInfeasible)
+	}
+	rng := rand.New(rand.NewPCG(bp.Seed, 0))
+	used := make(map[int]bool)
+	plan := &Plan{Blueprint: bp}
+
+	for i, t := range bp.Targets {
+		g, pool, err := s.targetPool(ctx, t)
+		if err != nil {
+			return nil, fmt.Errorf("target %d: %w", i+1, err)
+		}
+		pool = slices.DeleteFunc(pool, func(p *ent.Problem) bool { return used[p.ID] })
+
+		picked, err := sample(pool, t, rng)
+		if err != nil {
+			return nil, fmt.Errorf("target %d (%s): %w", i+1, g.Title, err)
+		}
+		for _, p := range picked {
+			used[p.ID] = true
+		}
+		g.Problems = picked
+		g.Mean = meanDifficulty(picked)
+		plan.Groups = append(plan.Groups, g)
+	}
+	return plan, nil
+}
+
+// GenerateExam plans the blueprint and writes the result as a new, inactive
+// exam so it can be previewed before it is activated. The sampled problems
+// are copied with their translations and choices, one unit each.
+func (s *ContentService) GenerateExam(ctx context.Context, bp Blueprint) (*ent.Exam, *Plan, error) {
+	plan, err := s.PlanBlueprint(ctx, bp)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var created *ent.Exam
+	err = dbtx.WithTx(ctx, s.client, func(tx *ent.Tx) error {
+		var err error
+		created, err = tx.Exam.Create().
+			SetTitle(bp.Title).
+			SetDescription(bp.Description).
+			SetTimeLimit(bp.TimeLimit).
+			SetIsActive(false).
+			Save(ctx)
+		if err != nil {
+			return fmt.Errorf("creating exam: %w", err)
+		}
+
+		// Groups are numbered in blueprint order, so seqs are gapless as created
+		for i, g := range plan.Groups {
+			in := UnitInput{ExamID: created.ID}
+			switch g.Kind {
+			case KindSection:
+				sec, err := tx.Section.Create().SetExamID(created.ID).SetTitle(g.Title).SetSeq(i + 1).Save(ctx)
+				if err != nil {
+					return fmt.Errorf("creating section: %w", err)
+				}
+				in.SectionID = &sec.ID
+			case KindTopic:
+				tp, err := tx.Topic.Create().SetExamID(created.ID).SetTitle(g.Title).SetSeq(i + 1).Save(ctx)
+				if err != nil {
+					return fmt.Errorf("creating topic: %w", err)
+				}
+				in.TopicID = &tp.ID
+			}
+
+			for j, src := range g.Problems {
+				u, err := tx.Unit.Create().
+					SetExamID(created.ID).
+					SetNillableSectionID(in.SectionID).
+					SetNillableTopicID(in.TopicID).
+					SetTitle(src.Edges.Unit.Title).
+					SetSeq(j + 1).
+					Save(ctx)
+				if err != nil {
+					return fmt.Errorf("creating unit: %w", err)
+				}
+				p, err := createProblem(ctx, tx, ProblemInput{
+					UnitID:      u.ID,
+					Difficulty:  src.Difficulty,
+					Interaction: src.Interaction,
+					Tolerance:   src.Tolerance,
+					TextMatch:   src.TextMatch,
+				})
+				if err != nil {
+					return err
+				}
+				if err := copyTranslations(ctx, tx, src, p.ID); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return created, plan, nil
+}
+
+// targetPool resolves the source of a target and loads its candidate problems.
+func (s *ContentService) targetPool(ctx context.Context, t Target) (PlannedGroup, []*ent.Problem, error) {
+	g := PlannedGroup{Target: t, Title: t.Title}
+	var units predicate.Unit
+	switch {
+	case t.SectionID != 0 && t.TopicID != 0:
+		return g, nil, fmt.Errorf("%w: set either section_id or topic_id, not both", ErrInfeasible)
+	case t.SectionID != 0:
+		sec, err := s.client.Section.Get(ctx, t.SectionID)
+		if err != nil {
+			return g, nil, fmt.Errorf("loading section %d: %w", t.SectionID, err)
+		}
+		g.Kind = KindSection
+		if g.Title == "" {
+			g.Title = sec.Title
+		}
+		units = unit.Or(unit.SectionID(sec.ID), unit.HasTopicWith(topic.SectionID(sec.ID)))
+	case t.TopicID != 0:
+		tp, err := s.client.Topic.Get(ctx, t.TopicID)
+		if err != nil {
+			return g, nil, fmt.Errorf("loading topic %d: %w", t.TopicID, err)
+		}
+		g.Kind = KindTopic
+		if g.Title == "" {
+			g.Title = tp.Title
+		}
+		units = unit.TopicID(tp.ID)
+	default:
+		return g, nil, fmt.Errorf("%w: section_id or topic_id is required", ErrInfeasible)
+	}
+
+	pool, err := s.client.Unit.Query().
+		Where(units).
+		QueryProblems().
+		Where(problem.TypeEQ(problem.TypeSOURCE), problem.HasTranslations()).
+		WithUnit().
+		WithTranslations(withChoicesInSeq).
+		Order(ent.Asc(problem.FieldID)).
+		All(ctx)
+	if err != nil {
+		return g, nil, fmt.Errorf("querying problems: %w", err)
+	}
+	return g, pool, nil
+}
+
+// sample picks t.Count problems from the pool that respect the difficulty
+// caps and land within tolerance of the mean. It starts from a random
+// feasible pick and improves the mean by swapping problems in and out,
+// restarting from another random pick when it gets stuck.
+func sample(pool []*ent.Problem, t Target, rng *rand.Rand) ([]*ent.Problem, error) {
+	if t.Count <= 0 {
+		return nil, fmt.Errorf("%w: count must be positive", ErrInfeasible)
+	}
+	if len(pool) < t.Count {
+		return nil, fmt.Errorf("%w: %d problems wanted but only %d available", ErrInfeasible, t.Count, len(pool))
+	}
+	tolerance := t.Tolerance
+	if tolerance <= 0 {
+		tolerance = DefaultTolerance
+	}
+	distance := func(picked []*ent.Problem) float64 {
+		if t.MeanDifficulty == 0 {
+			return 0
+		}
+		return math.Abs(meanDifficulty(picked) - t.MeanDifficulty)
+	}
+
+	const restarts = 50
+	var best []*ent.Problem
+	for range restarts {
+		shuffled := slices.Clone(pool)
+		rng.Shuffle(len(shuffled), func(i, j int) { shuffled[i], shuffled[j] = shuffled[j], shuffled[i] })
+
+		// Greedy fill within the caps
+		counts := make(map[int]int)
+		var picked, rest []*ent.Problem
+		for _, p := range shuffled {
+			if len(picked) < t.Count && underCap(t, counts, p.Difficulty) {
+				picked = append(picked, p)
+				counts[p.Difficulty]++
+			} else {
+				rest = append(rest, p)
+			}
+		}
+		if len(picked) < t.Count {
+			return nil, fmt.Errorf("%w: only %d problems fit the difficulty caps", ErrInfeasible, len(picked))
+		}
+
+		// Swap towards the mean while it improves
+		for distance(picked) > tolerance {
+			bi, bj, bd := -1, -1, distance(picked)
+			for i, in := range picked {
+				for j, out := range rest {
+					if in.Difficulty == out.Difficulty {
+						continue
+					}
+					counts[in.Difficulty]--
+					ok := underCap(t, counts, out.Difficulty)
+					counts[in.Difficulty]++
+					if !ok {
+						continue
+					}
+					picked[i] = out
+					if d := distance(picked); d < bd {
+						bi, bj, bd = i, j, d
+					}
+					picked[i] = in
+				}
+			}
+			if bi < 0 {
+				break
+			}
+			counts[picked[bi].Difficulty]--
+			counts[rest[bj].Difficulty]++
+			picked[bi], rest[bj] = rest[bj], picked[bi]
+		}
+
+		if best == nil || distance(picked) < distance(best) {
+			best = picked
+		}
+		if distance(best) <= tolerance {
+			slices.SortFunc(best, func(a, b *ent.Problem) int { return a.ID - b.ID })
+			return best, nil
+		}
+	}
+	return nil, fmt.Errorf("%w: closest mean difficulty is %.2f, wanted %.2f ± %.2f",
+		ErrInfeasible, meanDifficulty(best), t.MeanDifficulty, tolerance)
+}
+
+func underCap(t Target, counts map[int]int, difficulty int) bool {
+	limit, ok := t.MaxPerDifficulty[difficulty]
+	return !ok || counts[difficulty] < limit
+}
+
+func meanDifficulty(ps []*ent.Problem) float64 {
+	if len(ps) == 0 {
+		return 0
+	}
+	sum := 0
+	for _, p := range ps {
+		sum += p.Difficulty
+	}
+	return float64(sum) / float64(len(ps))
+}
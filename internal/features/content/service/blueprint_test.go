@@ -0,0 +1,129 @@
+package service_test
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"examination/internal/ent"
+	"examination/internal/features/content/service"
+	"examination/internal/testutil"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// seedBank creates a bank section with one translated problem per difficulty.
+func seedBank(t *testing.T, svc *service.ContentService, difficulties ...int) *ent.Section {
+	ctx := context.Background()
+	e, err := svc.CreateExam(ctx, service.ExamInput{Title: "Bank", TimeLimit: 30})
+	require.NoError(t, err)
+	s, err := svc.CreateSection(ctx, service.SectionInput{ExamID: e.ID, Title: "Databases"})
+	require.NoError(t, err)
+	for _, d := range difficulties {
+		u, err := svc.CreateUnit(ctx, service.UnitInput{ExamID: e.ID, SectionID: &s.ID, Title: "Unit"})
+		require.NoError(t, err)
+		p, err := svc.CreateProblem(ctx, service.ProblemInput{UnitID: u.ID, Difficulty: d})
+		require.NoError(t, err)
+		_, err = svc.CreateProblemTranslation(ctx, service.ProblemTranslationInput{
+			ProblemID: p.ID,
+			Locale:    "en",
+			Title:     "Question",
+			Content:   "Pick one.",
+			Choices:   []service.ChoiceInput{{Content: "A", IsCorrect: true, Seq: 1}},
+		})
+		require.NoError(t, err)
+	}
+	return s
+}
+
+func TestContentService_PlanBlueprint(t *testing.T) {
+	ctx := context.Background()
+	client := testutil.NewClient(t)
+	svc := service.NewContentService(client)
+	s := seedBank(t, svc, 1, 1, 1, 2, 2, 3, 3, 5, 5, 5)
+
+	bp := service.Blueprint{
+		Title: "Mock",
+		Seed:  7,
+		Targets: []service.Target{{
+			SectionID:        s.ID,
+			Count:            5,
+			MeanDifficulty:   2.5,
+			MaxPerDifficulty: map[int]int{5: 1},
+		}},
+	}
+	plan, err := svc.PlanBlueprint(ctx, bp)
+	require.NoError(t, err)
+	require.Len(t, plan.Groups, 1)
+	g := plan.Groups[0]
+	assert.Equal(t, "Databases", g.Title)
+	require.Len(t, g.Problems, 5)
+	assert.LessOrEqual(t, math.Abs(g.Mean-2.5), service.DefaultTolerance)
+	fives := 0
+	for _, p := range g.Problems {
+		if p.Difficulty == 5 {
+			fives++
+		}
+	}
+	assert.LessOrEqual(t, fives, 1)
+
+	// The same seed samples the same problems
+	again, err := svc.PlanBlueprint(ctx, bp)
+	require.NoError(t, err)
+	assert.Equal(t, problemIDs(g.Problems), problemIDs(again.Groups[0].Problems))
+
+	bp.Targets[0].MeanDifficulty = 4.5
+	_, err = svc.PlanBlueprint(ctx, bp)
+	assert.ErrorIs(t, err, service.ErrInfeasible, "the cap on difficulty 5 keeps the mean low")
+
+	bp.Targets[0].Count = 11
+	_, err = svc.PlanBlueprint(ctx, bp)
+	assert.ErrorIs(t, err, service.ErrInfeasible)
+}
+
+func TestContentService_GenerateExam(t *testing.T) {
+	ctx := context.Background()
+	client := testutil.NewClient(t)
+	svc := service.NewContentService(client)
+	s := seedBank(t, svc, 1, 2, 3, 4)
+
+	e, plan, err := svc.GenerateExam(ctx, service.Blueprint{
+		Title:     "Mock",
+		TimeLimit: 20,
+		Targets:   []service.Target{{SectionID: s.ID, Title: "Part 1", Count: 3}},
+	})
+	require.NoError(t, err)
+	assert.False(t, e.IsActive, "generated exams start inactive")
+
+	got, err := svc.GetExam(ctx, e.ID)
+	require.NoError(t, err)
+	require.Len(t, got.Edges.Sections, 1)
+	assert.Equal(t, "Part 1", got.Edges.Sections[0].Title)
+	require.Len(t, got.Edges.Units, 3)
+
+	// The problems are copies, not moves
+	copies, err := service.NewSequenceLogic(client).Flatten(ctx, e.ID, func(pq *ent.ProblemQuery) {
+		pq.WithTranslations()
+	})
+	require.NoError(t, err)
+	for i, slot := range copies {
+		p := slot.Unit.Edges.Problems[0]
+		assert.NotEqual(t, plan.Groups[0].Problems[i].ID, p.ID)
+		assert.Equal(t, plan.Groups[0].Problems[i].Difficulty, p.Difficulty)
+		assert.Len(t, p.Edges.Translations, 1)
+	}
+	assert.Equal(t, 4, client.Problem.Query().CountX(ctx)-3)
+
+	violations, err := service.NewValidator(client).AuditExam(ctx, e.ID)
+	require.NoError(t, err)
+	assert.Empty(t, violations)
+}
+
+func problemIDs(ps []*ent.Problem) []int {
+	ids := make([]int, 0, len(ps))
+	for _, p := range ps {
+		ids = append(ids, p.ID)
+	}
+	return ids
+}
@@ -0,0 +1,980 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"examination/internal/dbtx"
+	"examination/internal/ent"
+	"examination/internal/ent/choice"
+	"examination/internal/ent/exam"
+	"examination/internal/ent/problem"
+	"examination/internal/ent/problemtranslation"
+	"examination/internal/ent/section"
+	"examination/internal/ent/topic"
+	"examination/internal/ent/unit"
+	"examination/internal/ent/versionrule"
+	"examination/internal/features/content/bundle"
+)
+
+// ErrDuplicateKey is returned when importing onto an exam in which several
+// problems share a key, since the bundle could only update one of them.
+var ErrDuplicateKey = errors.New("problem key is not unique within the exam")
+
+// ImportStats counts the entities an import created, updated and deleted.
+// Every entity listed in the bundle that already existed counts as updated,
+// whether or not its fields changed.
+type ImportStats struct {
+	Created int
+	Updated int
+	Deleted int
+}
+
+// ExportBundle returns the exam as a bundle. Entities without a key get one
+// derived from their ID ("section-12"), which is stored so that the next
+// import of the file matches them instead of creating copies.
+func (s *ContentService) ExportBundle(ctx context.Context, examID int) (*bundle.Exam, error) {
+	var b *bundle.Exam
+	err := dbtx.WithTx(ctx, s.client, func(tx *ent.Tx) error {
+		if err := assignKeys(ctx, tx, examID); err != nil {
+			return err
+		}
+		var err error
+		b, err = exportBundle(ctx, tx.Client(), examID)
+		return err
+	})
+	return b, err
+}
+
+// assignKeys stores a key on every entity of the exam that has none.
+func assignKeys(ctx context.Context, tx *ent.Tx, examID int) error {
+	e, err := tx.Exam.Get(ctx, examID)
+	if err != nil {
+		return err
+	}
+	if e.Key == "" {
+		if err := e.Update().SetKey(fmt.Sprintf("exam-%d", e.ID)).Exec(ctx); err != nil {
+			return fmt.Errorf("assigning exam key: %w", err)
+		}
+	}
+
+	sections, err := tx.Section.Query().
+		Where(section.ExamID(examID), section.Or(section.KeyIsNil(), section.KeyEQ(""))).
+		IDs(ctx)
+	if err != nil {
+		return fmt.Errorf("querying sections: %w", err)
+	}
+	for _, id := range sections {
+		if err := tx.Section.UpdateOneID(id).SetKey(fmt.Sprintf("section-%d", id)).Exec(ctx); err != nil {
+			return fmt.Errorf("assigning section key: %w", err)
+		}
+	}
+	topics, err := tx.Topic.Query().
+		Where(topic.ExamID(examID), topic.Or(topic.KeyIsNil(), topic.KeyEQ(""))).
+		IDs(ctx)
+	if err != nil {
+		return fmt.Errorf("querying topics: %w", err)
+	}
+	for _, id := range topics {
+		if err := tx.Topic.UpdateOneID(id).SetKey(fmt.Sprintf("topic-%d", id)).Exec(ctx); err != nil {
+			return fmt.Errorf("assigning topic key: %w", err)
+		}
+	}
+	units, err := tx.Unit.Query().
+		Where(unit.ExamID(examID), unit.Or(unit.KeyIsNil(), unit.KeyEQ(""))).
+		IDs(ctx)
+	if err != nil {
+		return fmt.Errorf("querying units: %w", err)
+	}
+	for _, id := range units {
+		if err := tx.Unit.UpdateOneID(id).SetKey(fmt.Sprintf("unit-%d", id)).Exec(ctx); err != nil {
+			return fmt.Errorf("assigning unit key: %w", err)
+		}
+	}
+	problems, err := tx.Problem.Query().
+		Where(problem.HasUnitWith(unit.ExamID(examID)), problem.Or(problem.KeyIsNil(), problem.KeyEQ(""))).
+		IDs(ctx)
+	if err != nil {
+		return fmt.Errorf("querying problems: %w", err)
+	}
+	for _, id := range problems {
+		if err := tx.Problem.UpdateOneID(id).SetKey(fmt.Sprintf("problem-%d", id)).Exec(ctx); err != nil {
+			return fmt.Errorf("assigning problem key: %w", err)
+		}
+	}
+	return nil
+}
+
+func exportBundle(ctx context.Context, c *ent.Client, examID int) (*bundle.Exam, error) {
+	e, err := c.Exam.Query().
+		Where(exam.ID(examID)).
+		WithSections().
+		WithTopics().
+		WithUnits(func(uq *ent.UnitQuery) {
+			uq.WithProblems(func(pq *ent.ProblemQuery) {
+				pq.Order(ent.Asc(problem.FieldID)).
+					WithParent().
+					WithTranslations(withChoicesInSeq)
+			})
+		}).
+		WithVersionRules(func(vq *ent.VersionRuleQuery) {
+			vq.Order(ent.Asc(versionrule.FieldID)).WithProblem()
+		}).
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	b := &bundle.Exam{
+		Version:            bundle.Version,
+		Key:                e.Key,
+		Title:              e.Title,
+		Description:        e.Description,
+		TimeLimit:          e.TimeLimit,
+		IsActive:           e.IsActive,
+		ShuffleChoices:     e.ShuffleChoices,
+		WeightByDifficulty: e.WeightByDifficulty,
+		PartialCredit:      e.PartialCredit,
+		NegativeMarking:    e.NegativeMarking,
+	}
+
+	// Units and topics are attached to the innermost container they belong to
+	unitsOf := func(match func(u *ent.Unit) bool) []bundle.Unit {
+		var us []*ent.Unit
+		for _, u := range e.Edges.Units {
+			if match(u) {
+				us = append(us, u)
+			}
+		}
+		sortBySeq(us, func(u *ent.Unit) (int, int) { return u.Seq, u.ID })
+		var out []bundle.Unit
+		for _, u := range us {
+			out = append(out, exportUnit(u))
+		}
+		return out
+	}
+	topicsOf := func(sectionID *int) []bundle.Topic {
+		var ts []*ent.Topic
+		for _, t := range e.Edges.Topics {
+			if sameID(t.SectionID, sectionID) {
+				ts = append(ts, t)
+			}
+		}
+		sortBySeq(ts, func(t *ent.Topic) (int, int) { return t.Seq, t.ID })
+		var out []bundle.Topic
+		for _, t := range ts {
+			out = append(out, bundle.Topic{
+				Key:   t.Key,
+				Title: t.Title,
+				Seq:   t.Seq,
+				Units: unitsOf(func(u *ent.Unit) bool { return sameID(u.TopicID, &t.ID) }),
+			})
+		}
+		return out
+	}
+
+	sections := e.Edges.Sections
+	sortBySeq(sections, func(s *ent.Section) (int, int) { return s.Seq, s.ID })
+	for _, s := range sections {
+		b.Sections = append(b.Sections, bundle.Section{
+			Key:    s.Key,
+			Title:  s.Title,
+			Seq:    s.Seq,
+			Topics: topicsOf(&s.ID),
+			Units: unitsOf(func(u *ent.Unit) bool {
+				return u.TopicID == nil && sameID(u.SectionID, &s.ID)
+			}),
+		})
+	}
+	b.Topics = topicsOf(nil)
+	b.Units = unitsOf(func(u *ent.Unit) bool { return u.TopicID == nil && u.SectionID == nil })
+
+	for _, r := range e.Edges.VersionRules {
+		br := bundle.VersionRule{
+			Year:     r.Year,
+			Round:    r.Round,
+			Category: r.Category,
+			Operator: r.Operator.String(),
+			Status:   r.Status.String(),
+		}
+		if p := r.Edges.Problem; p != nil {
+			br.Problem = p.Key
+		}
+		b.VersionRules = append(b.VersionRules, br)
+	}
+	return b, nil
+}
+
+func exportUnit(u *ent.Unit) bundle.Unit {
+	bu := bundle.Unit{Key: u.Key, Title: u.Title, Seq: u.Seq}
+	for _, p := range u.Edges.Problems {
+		bp := bundle.Problem{
+			Key:        p.Key,
+			Type:       p.Type.String(),
+			Difficulty: p.Difficulty,
+			Tolerance:  p.Tolerance,
+		}
+		// Defaults are left out, so bundles of choice problems read as before
+		if p.Interaction != problem.DefaultInteraction {
+			bp.Interaction = p.Interaction.String()
+		}
+		if p.TextMatch != problem.DefaultTextMatch {
+			bp.TextMatch = p.TextMatch.String()
+		}
+		// A parent outside the exam has no key the bundle could refer to
+		if parent := p.Edges.Parent; parent != nil && parent.Key != "" {
+			bp.Parent = parent.Key
+		}
+		for _, t := range p.Edges.Translations {
+			bt := bundle.Translation{
+				Locale:      t.Locale,
+				Title:       t.Title,
+				Content:     t.Content,
+				Explanation: t.Explanation,
+				Choices:     []bundle.Choice{},
+			}
+			for _, c := range t.Edges.Choices {
+				bt.Choices = append(bt.Choices, bundle.Choice{
+					Content:     c.Content,
+					Correct:     c.IsCorrect,
+					Explanation: c.Explanation,
+				})
+			}
+			bp.Translations = append(bp.Translations, bt)
+		}
+		bu.Problems = append(bu.Problems, bp)
+	}
+	return bu
+}
+
+// ImportBundle creates or updates the exam described by the bundle, matching
+// entities by key, in a single transaction. Entities of the exam that the
+// bundle no longer lists are deleted, so importing an exported file is a
+// no-op and IDs, and the attempts referring to them, survive re-imports.
+//
+// Problems used by attempts are kept as graded: an import that would
+// delete one or a translation of it, or change its interaction, answer
+// matching or the content, correctness or number of its choices, fails
+// with a ValidationError listing them.
+func (s *ContentService) ImportBundle(ctx context.Context, b *bundle.Exam) (*ent.Exam, ImportStats, error) {
+	if err := b.Validate(); err != nil {
+		return nil, ImportStats{}, err
+	}
+	var (
+		imported *ent.Exam
+		stats    ImportStats
+	)
+	err := dbtx.WithTx(ctx, s.client, func(tx *ent.Tx) error {
+		im := &importer{tx: tx}
+		if err := im.run(ctx, b); err != nil {
+			return err
+		}
+		stats = im.stats
+		var err error
+		imported, err = tx.Exam.Get(ctx, im.exam.ID)
+		return err
+	})
+	return imported, stats, err
+}
+
+// importer holds the state of one ImportBundle run: the exam's existing
+// entities by key and the IDs the bundle listed.
+type importer struct {
+	tx    *ent.Tx
+	exam  *ent.Exam
+	stats ImportStats
+
+	sections map[string]*ent.Section
+	topics   map[string]*ent.Topic
+	units    map[string]*ent.Unit
+	problems map[string]*ent.Problem
+
+	seenSections, seenTopics, seenUnits, seenProblems []int
+
+	// inUse holds the problems of the exam that attempts use; violations
+	// lists the changes to them the bundle asks for.
+	inUse      map[int]bool
+	violations []Violation
+}
+
+// refuse records a change to a problem in use.
+func (im *importer) refuse(kind Kind, id int, format string, args ...any) {
+	im.violations = append(im.violations, Violation{Kind: kind, ID: id, Code: CodeInUse, Message: fmt.Sprintf(format, args...)})
+}
+
+func (im *importer) run(ctx context.Context, b *bundle.Exam) error {
+	if err := im.upsertExam(ctx, b); err != nil {
+		return err
+	}
+	if err := im.load(ctx); err != nil {
+		return err
+	}
+
+	// The exam orders sections, section-less topics and exam-level units
+	// in one sequence, as does a section with its topics and direct units.
+	rootSeqs := effectiveSeqs(append(append(
+		seqsOf(b.Sections, func(s bundle.Section) int { return s.Seq }),
+		seqsOf(b.Topics, func(t bundle.Topic) int { return t.Seq })...),
+		seqsOf(b.Units, func(u bundle.Unit) int { return u.Seq })...))
+	for i, bs := range b.Sections {
+		s, err := im.upsertSection(ctx, bs, rootSeqs[i])
+		if err != nil {
+			return err
+		}
+		seqs := effectiveSeqs(append(
+			seqsOf(bs.Topics, func(t bundle.Topic) int { return t.Seq }),
+			seqsOf(bs.Units, func(u bundle.Unit) int { return u.Seq })...))
+		for j, bt := range bs.Topics {
+			if err := im.upsertTopic(ctx, bt, seqs[j], &s.ID); err != nil {
+				return err
+			}
+		}
+		for j, bu := range bs.Units {
+			if err := im.upsertUnit(ctx, bu, seqs[len(bs.Topics)+j], &s.ID, nil); err != nil {
+				return err
+			}
+		}
+	}
+	for i, bt := range b.Topics {
+		if err := im.upsertTopic(ctx, bt, rootSeqs[len(b.Sections)+i], nil); err != nil {
+			return err
+		}
+	}
+	for i, bu := range b.Units {
+		if err := im.upsertUnit(ctx, bu, rootSeqs[len(b.Sections)+len(b.Topics)+i], nil, nil); err != nil {
+			return err
+		}
+	}
+	if err := im.linkParents(ctx, b); err != nil {
+		return err
+	}
+	if err := im.checkUnseen(ctx); err != nil {
+		return err
+	}
+	if err := asError(im.violations, nil); err != nil {
+		return err
+	}
+	if err := im.deleteUnseen(ctx); err != nil {
+		return err
+	}
+	if err := im.importVersionRules(ctx, b.VersionRules); err != nil {
+		return err
+	}
+	if err := im.normalize(ctx); err != nil {
+		return err
+	}
+
+	c := im.tx.Client()
+	if err := asError(checkTopics(ctx, c, topic.ExamID(im.exam.ID))); err != nil {
+		return err
+	}
+	if err := asError(checkUnits(ctx, c, unit.ExamID(im.exam.ID))); err != nil {
+		return err
+	}
+	if err := asError(checkVersionRules(ctx, c, versionrule.ExamID(im.exam.ID))); err != nil {
+		return err
+	}
+	return asError(checkTranslations(ctx, c,
+		problemtranslation.HasProblemWith(problem.HasUnitWith(unit.ExamID(im.exam.ID)))))
+}
+
+func (im *importer) upsertExam(ctx context.Context, b *bundle.Exam) error {
+	existing, err := im.tx.Exam.Query().Where(exam.Key(b.Key)).Only(ctx)
+	switch {
+	case ent.IsNotFound(err):
+		im.exam, err = im.tx.Exam.Create().
+			SetKey(b.Key).
+			SetTitle(b.Title).
+			SetDescription(b.Description).
+			SetTimeLimit(b.TimeLimit).
+			SetIsActive(b.IsActive).
+			SetShuffleChoices(b.ShuffleChoices).
+			SetWeightByDifficulty(b.WeightByDifficulty).
+			SetPartialCredit(b.PartialCredit).
+			SetNegativeMarking(b.NegativeMarking).
+			Save(ctx)
+		if err != nil {
+			return fmt.Errorf("creating exam: %w", err)
+		}
+		im.stats.Created++
+		return nil
+	case err != nil:
+		return fmt.Errorf("querying exam %q: %w", b.Key, err)
+	}
+	im.exam, err = existing.Update().
+		SetTitle(b.Title).
+		SetDescription(b.Description).
+		SetTimeLimit(b.TimeLimit).
+		SetIsActive(b.IsActive).
+		SetShuffleChoices(b.ShuffleChoices).
+		SetWeightByDifficulty(b.WeightByDifficulty).
+		SetPartialCredit(b.PartialCredit).
+		SetNegativeMarking(b.NegativeMarking).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("updating exam %d: %w", existing.ID, err)
+	}
+	im.stats.Updated++
+	return nil
+}
+
+// load indexes the exam's existing entities by key. Entities without a key
+// are never matched and end up deleted.
+func (im *importer) load(ctx context.Context) error {
+	im.sections = make(map[string]*ent.Section)
+	im.topics = make(map[string]*ent.Topic)
+	im.units = make(map[string]*ent.Unit)
+	im.problems = make(map[string]*ent.Problem)
+
+	sections, err := im.tx.Section.Query().Where(section.ExamID(im.exam.ID)).All(ctx)
+	if err != nil {
+		return fmt.Errorf("querying sections: %w", err)
+	}
+	for _, s := range sections {
+		im.sections[s.Key] = s
+	}
+	topics, err := im.tx.Topic.Query().Where(topic.ExamID(im.exam.ID)).All(ctx)
+	if err != nil {
+		return fmt.Errorf("querying topics: %w", err)
+	}
+	for _, t := range topics {
+		im.topics[t.Key] = t
+	}
+	units, err := im.tx.Unit.Query().Where(unit.ExamID(im.exam.ID)).All(ctx)
+	if err != nil {
+		return fmt.Errorf("querying units: %w", err)
+	}
+	for _, u := range units {
+		im.units[u.Key] = u
+	}
+	problems, err := im.tx.Problem.Query().
+		Where(problem.HasUnitWith(unit.ExamID(im.exam.ID))).
+		Order(ent.Asc(problem.FieldID)).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("querying problems: %w", err)
+	}
+	var dups []string
+	for _, p := range problems {
+		if first, ok := im.problems[p.Key]; ok && p.Key != "" {
+			dups = append(dups, fmt.Sprintf("%q (problems %d and %d)", p.Key, first.ID, p.ID))
+			continue
+		}
+		im.problems[p.Key] = p
+	}
+	if len(dups) > 0 {
+		return fmt.Errorf("%w: %s", ErrDuplicateKey, strings.Join(dups, ", "))
+	}
+	ids, err := im.tx.Problem.Query().
+		Where(problem.HasUnitWith(unit.ExamID(im.exam.ID)), problem.Or(problem.HasAnswers(), problem.HasAttemptItems())).
+		IDs(ctx)
+	if err != nil {
+		return fmt.Errorf("querying problems in use: %w", err)
+	}
+	im.inUse = make(map[int]bool, len(ids))
+	for _, id := range ids {
+		im.inUse[id] = true
+	}
+	delete(im.sections, "")
+	delete(im.topics, "")
+	delete(im.units, "")
+	delete(im.problems, "")
+	return nil
+}
+
+func (im *importer) upsertSection(ctx context.Context, bs bundle.Section, seq int) (*ent.Section, error) {
+	s, ok := im.sections[bs.Key]
+	var err error
+	if ok {
+		s, err = s.Update().SetTitle(bs.Title).SetSeq(seq).Save(ctx)
+		im.stats.Updated++
+	} else {
+		s, err = im.tx.Section.Create().
+			SetExamID(im.exam.ID).
+			SetKey(bs.Key).
+			SetTitle(bs.Title).
+			SetSeq(seq).
+			Save(ctx)
+		im.stats.Created++
+	}
+	if err != nil {
+		return nil, fmt.Errorf("importing section %q: %w", bs.Key, err)
+	}
+	im.seenSections = append(im.seenSections, s.ID)
+	return s, nil
+}
+
+func (im *importer) upsertTopic(ctx context.Context, bt bundle.Topic, seq int, sectionID *int) error {
+	t, ok := im.topics[bt.Key]
+	var err error
+	if ok {
+		upd := t.Update().SetTitle(bt.Title).SetSeq(seq)
+		if sectionID != nil {
+			upd.SetSectionID(*sectionID)
+		} else {
+			upd.ClearSectionID()
+		}
+		t, err = upd.Save(ctx)
+		im.stats.Updated++
+	} else {
+		t, err = im.tx.Topic.Create().
+			SetExamID(im.exam.ID).
+			SetNillableSectionID(sectionID).
+			SetKey(bt.Key).
+			SetTitle(bt.Title).
+			SetSeq(seq).
+			Save(ctx)
+		im.stats.Created++
+	}
+	if err != nil {
+		return fmt.Errorf("importing topic %q: %w", bt.Key, err)
+	}
+	im.seenTopics = append(im.seenTopics, t.ID)
+
+	seqs := effectiveSeqs(seqsOf(bt.Units, func(u bundle.Unit) int { return u.Seq }))
+	for i, bu := range bt.Units {
+		if err := im.upsertUnit(ctx, bu, seqs[i], nil, &t.ID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (im *importer) upsertUnit(ctx context.Context, bu bundle.Unit, seq int, sectionID, topicID *int) error {
+	u, ok := im.units[bu.Key]
+	var err error
+	if ok {
+		upd := u.Update().SetTitle(bu.Title).SetSeq(seq)
+		if sectionID != nil {
+			upd.SetSectionID(*sectionID)
+		} else {
+			upd.ClearSectionID()
+		}
+		if topicID != nil {
+			upd.SetTopicID(*topicID)
+		} else {
+			upd.ClearTopicID()
+		}
+		u, err = upd.Save(ctx)
+		im.stats.Updated++
+	} else {
+		u, err = im.tx.Unit.Create().
+			SetExamID(im.exam.ID).
+			SetNillableSectionID(sectionID).
+			SetNillableTopicID(topicID).
+			SetKey(bu.Key).
+			SetTitle(bu.Title).
+			SetSeq(seq).
+			Save(ctx)
+		im.stats.Created++
+	}
+	if err != nil {
+		return fmt.Errorf("importing unit %q: %w", bu.Key, err)
+	}
+	im.seenUnits = append(im.seenUnits, u.ID)
+
+	for _, bp := range bu.Problems {
+		if err := im.upsertProblem(ctx, bp, u.ID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (im *importer) upsertProblem(ctx context.Context, bp bundle.Problem, unitID int) error {
+	in := ProblemInput{
+		UnitID:      unitID,
+		Type:        problem.TypeSOURCE,
+		Difficulty:  bp.Difficulty,
+		Interaction: problem.DefaultInteraction,
+		Tolerance:   bp.Tolerance,
+		TextMatch:   problem.DefaultTextMatch,
+	}
+	if bp.Type != "" {
+		in.Type = problem.Type(bp.Type)
+	}
+	if bp.Interaction != "" {
+		in.Interaction = problem.Interaction(bp.Interaction)
+	}
+	if bp.TextMatch != "" {
+		in.TextMatch = problem.TextMatch(bp.TextMatch)
+	}
+	p, ok := im.problems[bp.Key]
+	var err error
+	if ok && im.inUse[p.ID] && (p.Interaction != in.Interaction || p.Tolerance != in.Tolerance || p.TextMatch != in.TextMatch) {
+		im.refuse(KindProblem, p.ID, "problem %q is used by attempts; its interaction and answer matching cannot change", bp.Key)
+	}
+	if ok {
+		p, err = p.Update().
+			SetUnitID(unitID).
+			SetType(in.Type).
+			SetDifficulty(in.Difficulty).
+			SetInteraction(in.Interaction).
+			SetTolerance(in.Tolerance).
+			SetTextMatch(in.TextMatch).
+			Save(ctx)
+		im.stats.Updated++
+	} else {
+		p, err = createProblem(ctx, im.tx, in)
+		if err == nil {
+			p, err = p.Update().SetKey(bp.Key).Save(ctx)
+		}
+		im.stats.Created++
+	}
+	if err != nil {
+		return fmt.Errorf("importing problem %q: %w", bp.Key, err)
+	}
+	im.problems[bp.Key] = p
+	im.seenProblems = append(im.seenProblems, p.ID)
+	return im.upsertTranslations(ctx, p, bp.Translations)
+}
+
+// upsertTranslations matches translations by locale and choices by
+// position, so that choices keep their IDs, and the answers selecting
+// them, as long as their position does not change.
+func (im *importer) upsertTranslations(ctx context.Context, p *ent.Problem, bts []bundle.Translation) error {
+	problemID := p.ID
+	existing, err := im.tx.ProblemTranslation.Query().
+		Where(problemtranslation.ProblemID(problemID)).
+		WithChoices(func(cq *ent.ChoiceQuery) {
+			cq.Order(ent.Asc(choice.FieldSeq), ent.Asc(choice.FieldID))
+		}).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("querying translations: %w", err)
+	}
+	byLocale := make(map[string]*ent.ProblemTranslation, len(existing))
+	for _, t := range existing {
+		byLocale[t.Locale] = t
+	}
+
+	for _, bt := range bts {
+		t, ok := byLocale[bt.Locale]
+		delete(byLocale, bt.Locale)
+		if !ok {
+			in := ProblemTranslationInput{
+				ProblemID:   problemID,
+				Locale:      bt.Locale,
+				Title:       bt.Title,
+				Content:     bt.Content,
+				Explanation: bt.Explanation,
+			}
+			for i, c := range bt.Choices {
+				in.Choices = append(in.Choices, ChoiceInput{
+					Content:     c.Content,
+					IsCorrect:   c.Correct,
+					Explanation: c.Explanation,
+					Seq:         i + 1,
+				})
+			}
+			if _, err := createProblemTranslation(ctx, im.tx, in); err != nil {
+				return err
+			}
+			im.stats.Created += 1 + len(bt.Choices)
+			continue
+		}
+
+		err := t.Update().
+			SetTitle(bt.Title).
+			SetContent(bt.Content).
+			SetExplanation(bt.Explanation).
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("updating translation %d: %w", t.ID, err)
+		}
+		im.stats.Updated++
+		choices := t.Edges.Choices
+		if im.inUse[problemID] && !sameChoices(choices, bt.Choices) {
+			im.refuse(KindTranslation, t.ID, "problem %q is used by attempts; the choices of its %s translation cannot change", p.Key, t.Locale)
+		}
+		for i, c := range bt.Choices {
+			if i < len(choices) {
+				err = choices[i].Update().
+					SetContent(c.Content).
+					SetIsCorrect(c.Correct).
+					SetExplanation(c.Explanation).
+					SetSeq(i + 1).
+					Exec(ctx)
+				im.stats.Updated++
+			} else {
+				err = im.tx.Choice.Create().
+					SetProblemTranslationID(t.ID).
+					SetContent(c.Content).
+					SetIsCorrect(c.Correct).
+					SetExplanation(c.Explanation).
+					SetSeq(i + 1).
+					Exec(ctx)
+				im.stats.Created++
+			}
+			if err != nil {
+				return fmt.Errorf("importing choice %d of translation %d: %w", i+1, t.ID, err)
+			}
+		}
+		if len(choices) > len(bt.Choices) {
+			var ids []int
+			for _, c := range choices[len(bt.Choices):] {
+				ids = append(ids, c.ID)
+			}
+			n, err := im.tx.Choice.Delete().Where(choice.IDIn(ids...)).Exec(ctx)
+			if err != nil {
+				return fmt.Errorf("deleting choices: %w", err)
+			}
+			im.stats.Deleted += n
+		}
+	}
+
+	var stale []int
+	for _, t := range byLocale {
+		stale = append(stale, t.ID)
+		if im.inUse[problemID] {
+			im.refuse(KindTranslation, t.ID, "problem %q is used by attempts; its %s translation cannot be removed", p.Key, t.Locale)
+		}
+	}
+	if len(stale) > 0 {
+		if err := deleteTranslations(ctx, im.tx, problemtranslation.IDIn(stale...)); err != nil {
+			return err
+		}
+		im.stats.Deleted += len(stale)
+	}
+	return nil
+}
+
+// linkParents sets the variant parents once every problem exists. A parent
+// in another exam is not exported, so it is kept rather than cleared.
+func (im *importer) linkParents(ctx context.Context, b *bundle.Exam) error {
+	inExam := make(map[int]bool, len(im.problems))
+	for _, p := range im.problems {
+		inExam[p.ID] = true
+	}
+	var walk func(us []bundle.Unit) error
+	walk = func(us []bundle.Unit) error {
+		for _, bu := range us {
+			for _, bp := range bu.Problems {
+				p := im.problems[bp.Key]
+				upd := p.Update()
+				switch {
+				case bp.Parent != "":
+					upd.SetParentID(im.problems[bp.Parent].ID)
+				case p.ParentID != nil && inExam[*p.ParentID]:
+					upd.ClearParentID()
+				default:
+					continue
+				}
+				if err := upd.Exec(ctx); err != nil {
+					return fmt.Errorf("linking problem %q: %w", bp.Key, err)
+				}
+			}
+		}
+		return nil
+	}
+	for _, s := range b.Sections {
+		for _, t := range s.Topics {
+			if err := walk(t.Units); err != nil {
+				return err
+			}
+		}
+		if err := walk(s.Units); err != nil {
+			return err
+		}
+	}
+	for _, t := range b.Topics {
+		if err := walk(t.Units); err != nil {
+			return err
+		}
+	}
+	return walk(b.Units)
+}
+
+// sameChoices reports whether the bundle's choices grade answers like the
+// existing ones: the same number, in the same order, with the same content
+// and correctness. Explanations may change.
+func sameChoices(existing []*ent.Choice, bcs []bundle.Choice) bool {
+	if len(existing) != len(bcs) {
+		return false
+	}
+	for i, c := range existing {
+		if c.Content != bcs[i].Content || c.IsCorrect != bcs[i].Correct {
+			return false
+		}
+	}
+	return true
+}
+
+// checkUnseen refuses to delete the problems in use that the bundle did not
+// list, directly or with their unit.
+func (im *importer) checkUnseen(ctx context.Context) error {
+	unseen, err := im.tx.Problem.Query().
+		Where(problem.HasUnitWith(unit.ExamID(im.exam.ID)), problem.IDNotIn(im.seenProblems...)).
+		Order(ent.Asc(problem.FieldID)).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("querying problems: %w", err)
+	}
+	for _, p := range unseen {
+		if im.inUse[p.ID] {
+			im.refuse(KindProblem, p.ID, "problem %q is used by attempts and cannot be removed", p.Key)
+		}
+	}
+	return nil
+}
+
+// deleteUnseen removes the exam's entities the bundle did not list,
+// children first.
+func (im *importer) deleteUnseen(ctx context.Context) error {
+	examID := im.exam.ID
+	n, err := im.tx.Problem.Query().
+		Where(problem.HasUnitWith(unit.ExamID(examID)), problem.IDNotIn(im.seenProblems...)).
+		Count(ctx)
+	if err != nil {
+		return fmt.Errorf("querying problems: %w", err)
+	}
+	if err := deleteProblems(ctx, im.tx, problem.HasUnitWith(unit.ExamID(examID)), problem.IDNotIn(im.seenProblems...)); err != nil {
+		return err
+	}
+	im.stats.Deleted += n
+
+	n, err = im.tx.Unit.Query().Where(unit.ExamID(examID), unit.IDNotIn(im.seenUnits...)).Count(ctx)
+	if err != nil {
+		return fmt.Errorf("querying units: %w", err)
+	}
+	if err := deleteUnits(ctx, im.tx, unit.ExamID(examID), unit.IDNotIn(im.seenUnits...)); err != nil {
+		return err
+	}
+	im.stats.Deleted += n
+
+	n, err = im.tx.Topic.Delete().Where(topic.ExamID(examID), topic.IDNotIn(im.seenTopics...)).Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("deleting topics: %w", err)
+	}
+	im.stats.Deleted += n
+	n, err = im.tx.Section.Delete().Where(section.ExamID(examID), section.IDNotIn(im.seenSections...)).Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("deleting sections: %w", err)
+	}
+	im.stats.Deleted += n
+	return nil
+}
+
+// importVersionRules matches the exam's rules by position, like choices,
+// so that re-importing the same list changes nothing.
+func (im *importer) importVersionRules(ctx context.Context, rules []bundle.VersionRule) error {
+	existing, err := im.tx.VersionRule.Query().
+		Where(versionrule.ExamID(im.exam.ID)).
+		Order(ent.Asc(versionrule.FieldID)).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("querying version rules: %w", err)
+	}
+	for i, r := range rules {
+		status := versionrule.StatusACTIVE
+		if r.Status != "" {
+			status = versionrule.Status(r.Status)
+		}
+		var problemID *int
+		if r.Problem != "" {
+			problemID = &im.problems[r.Problem].ID
+		}
+		if i < len(existing) {
+			upd := existing[i].Update().
+				ClearYear().SetNillableYear(r.Year).
+				ClearRound().SetNillableRound(r.Round).
+				ClearCategory().SetNillableCategory(r.Category).
+				SetOperator(versionrule.Operator(r.Operator)).
+				SetStatus(status).
+				ClearProblemID().SetNillableProblemID(problemID)
+			err = upd.Exec(ctx)
+			im.stats.Updated++
+		} else {
+			err = im.tx.VersionRule.Create().
+				SetExamID(im.exam.ID).
+				SetNillableYear(r.Year).
+				SetNillableRound(r.Round).
+				SetNillableCategory(r.Category).
+				SetOperator(versionrule.Operator(r.Operator)).
+				SetStatus(status).
+				SetNillableProblemID(problemID).
+				Exec(ctx)
+			im.stats.Created++
+		}
+		if err != nil {
+			return fmt.Errorf("importing version rule %d: %w", i+1, err)
+		}
+	}
+	if len(existing) > len(rules) {
+		var ids []int
+		for _, r := range existing[len(rules):] {
+			ids = append(ids, r.ID)
+		}
+		n, err := im.tx.VersionRule.Delete().Where(versionrule.IDIn(ids...)).Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("deleting version rules: %w", err)
+		}
+		im.stats.Deleted += n
+	}
+	return nil
+}
+
+// normalize turns the imported seqs into gapless sequences.
+func (im *importer) normalize(ctx context.Context) error {
+	c := im.tx.Client()
+	parents := []Parent{{Kind: KindExam, ID: im.exam.ID}}
+	for _, id := range im.seenSections {
+		parents = append(parents, Parent{Kind: KindSection, ID: id})
+	}
+	for _, id := range im.seenTopics {
+		parents = append(parents, Parent{Kind: KindTopic, ID: id})
+	}
+	for _, p := range parents {
+		if err := normalize(ctx, c, p); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func seqsOf[T any](items []T, seq func(T) int) []int {
+	out := make([]int, len(items))
+	for i, it := range items {
+		out[i] = seq(it)
+	}
+	return out
+}
+
+// effectiveSeqs keeps the explicit seqs and places the zero ones after the
+// largest explicit seq, in the given order.
+func effectiveSeqs(seqs []int) []int {
+	last := 0
+	for _, s := range seqs {
+		last = max(last, s)
+	}
+	out := make([]int, len(seqs))
+	for i, s := range seqs {
+		if s <= 0 {
+			last++
+			s = last
+		}
+		out[i] = s
+	}
+	return out
+}
+
+func sortBySeq[T any](items []T, key func(T) (seq, id int)) {
+	slices.SortStableFunc(items, func(a, b T) int {
+		as, ai := key(a)
+		bs, bi := key(b)
+		if as != bs {
+			return as - bs
+		}
+		return ai - bi
+	})
+}
+
+func sameID(a, b *int) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return *a == *b
+}
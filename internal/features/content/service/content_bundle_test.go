@@ -0,0 +1,230 @@
+package service_test
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"examination/internal/ent/attempt"
+	"examination/internal/ent/choice"
+	"examination/internal/ent/problem"
+	"examination/internal/ent/versionrule"
+	"examination/internal/features/content/bundle"
+	"examination/internal/features/content/service"
+	"examination/internal/testutil"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestContentService_BundleRoundTrip(t *testing.T) {
+	ctx := context.Background()
+	client := testutil.NewClient(t)
+	svc := service.NewContentService(client)
+
+	e := seedTree(t, svc)
+	src := client.Problem.Query().Order(problem.ByID()).FirstX(ctx)
+	variant, err := svc.CloneVariant(ctx, src.ID)
+	require.NoError(t, err)
+	client.VersionRule.Create().
+		SetExamID(e.ID).
+		SetProblemID(variant.ID).
+		SetYear(2025).
+		SetOperator(versionrule.OperatorGreaterEqual).
+		ExecX(ctx)
+
+	// A candidate's answer must survive re-imports
+	a := client.Attempt.Create().SetExamID(e.ID).SetLocale("en").SetStartedAt(time.Now()).SaveX(ctx)
+	picked := client.Choice.Query().Order(choice.ByID()).FirstX(ctx)
+	ans := client.Answer.Create().SetAttemptID(a.ID).SetProblemID(src.ID).SetUpdatedAt(a.StartedAt).AddChoices(picked).SaveX(ctx)
+
+	exported, err := svc.ExportBundle(ctx, e.ID)
+	require.NoError(t, err)
+	require.NoError(t, exported.Validate())
+	assert.Equal(t, "exam-1", exported.Key)
+	require.Len(t, exported.Sections, 1)
+	assert.Len(t, exported.Sections[0].Topics, 1)
+	assert.Len(t, exported.Sections[0].Units, 1)
+	require.Len(t, exported.VersionRules, 1)
+	assert.Equal(t, "problem-3", exported.VersionRules[0].Problem)
+
+	// Importing the export changes nothing
+	imported, stats, err := svc.ImportBundle(ctx, exported)
+	require.NoError(t, err)
+	assert.Equal(t, e.ID, imported.ID)
+	assert.Zero(t, stats.Created)
+	assert.Zero(t, stats.Deleted)
+	again, err := svc.ExportBundle(ctx, e.ID)
+	require.NoError(t, err)
+	assert.Equal(t, exported, again)
+	assert.Equal(t, []int{picked.ID}, client.Answer.GetX(ctx, ans.ID).QueryChoices().IDsX(ctx))
+	v := client.Problem.GetX(ctx, variant.ID)
+	assert.Equal(t, src.ID, *v.ParentID)
+
+	// Edit the file: retitle, drop the direct unit, add a locale and a unit
+	edited := *again
+	edited.Title = "Renamed"
+	edited.Sections[0].Units = nil
+	tu := &edited.Sections[0].Topics[0].Units[0]
+	tu.Problems[0].Translations = append(tu.Problems[0].Translations, bundle.Translation{
+		Locale: "ko", Title: "질문", Content: "하나를 고르세요.",
+		Choices: []bundle.Choice{{Content: "가", Correct: true}, {Content: "나"}},
+	})
+	edited.Units = []bundle.Unit{{Key: "extra", Title: "Extra", Problems: []bundle.Problem{{
+		Key: "extra-1", Difficulty: 2,
+		Translations: []bundle.Translation{{Locale: "en", Title: "Q", Content: "?", Choices: []bundle.Choice{{Content: "Yes", Correct: true}}}},
+	}}}}
+
+	_, stats, err = svc.ImportBundle(ctx, &edited)
+	require.NoError(t, err)
+	assert.Positive(t, stats.Created)
+	assert.Positive(t, stats.Deleted)
+
+	got, err := svc.GetExam(ctx, e.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "Renamed", got.Title)
+	require.Len(t, got.Edges.Units, 2)
+	assert.Equal(t, "extra", got.Edges.Units[1].Key)
+	assert.Nil(t, got.Edges.Units[1].SectionID, "exam-level unit")
+	assert.Equal(t, 2, got.Edges.Units[1].Seq, "placed after the section")
+	assert.Equal(t, 2, client.Problem.Query().Where(problem.ParentIDIsNil()).CountX(ctx))
+	assert.Equal(t, 2, src.QueryTranslations().CountX(ctx))
+	assert.Equal(t, 3, client.Problem.Query().CountX(ctx), "the dropped unit's problem is deleted")
+	assert.Equal(t, 1, client.VersionRule.Query().CountX(ctx))
+}
+
+func TestContentService_ImportBundleKeepsProblemsInUse(t *testing.T) {
+	ctx := context.Background()
+	client := testutil.NewClient(t)
+	svc := service.NewContentService(client)
+
+	e := seedTree(t, svc)
+	answered := client.Problem.Query().Order(problem.ByID()).FirstX(ctx)
+	picked := client.Choice.Query().Order(choice.ByID()).FirstX(ctx)
+	a := client.Attempt.Create().SetExamID(e.ID).SetLocale("en").SetStartedAt(time.Now()).SetStatus(attempt.StatusSUBMITTED).SaveX(ctx)
+	client.Answer.Create().SetAttemptID(a.ID).SetProblemID(answered.ID).SetUpdatedAt(a.StartedAt).AddChoices(picked).ExecX(ctx)
+
+	// The answered problem is the first of the topic's unit
+	cases := []struct {
+		name string
+		edit func(b *bundle.Exam)
+	}{
+		{"remove the problem", func(b *bundle.Exam) { b.Sections[0].Topics = nil }},
+		{"change the answer key", func(b *bundle.Exam) {
+			cs := b.Sections[0].Topics[0].Units[0].Problems[0].Translations[0].Choices
+			cs[0].Correct, cs[1].Correct = false, true
+		}},
+		{"reorder the choices", func(b *bundle.Exam) {
+			cs := b.Sections[0].Topics[0].Units[0].Problems[0].Translations[0].Choices
+			cs[0], cs[1] = cs[1], cs[0]
+		}},
+		{"change the interaction", func(b *bundle.Exam) {
+			b.Sections[0].Topics[0].Units[0].Problems[0].Interaction = "MULTIPLE"
+		}},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			b, err := svc.ExportBundle(ctx, e.ID)
+			require.NoError(t, err)
+			tc.edit(b)
+
+			_, _, err = svc.ImportBundle(ctx, b)
+			var verr *service.ValidationError
+			require.ErrorAs(t, err, &verr)
+			require.NotEmpty(t, verr.Violations)
+			assert.Equal(t, service.CodeInUse, verr.Violations[0].Code)
+			assert.Contains(t, verr.Violations[0].Message, "used by attempts")
+			assert.Equal(t, []int{picked.ID}, client.Answer.Query().QueryChoices().IDsX(ctx))
+			assert.True(t, client.Choice.GetX(ctx, picked.ID).IsCorrect)
+		})
+	}
+
+	// Wording the explanations and other problems may still change
+	b, err := svc.ExportBundle(ctx, e.ID)
+	require.NoError(t, err)
+	b.Sections[0].Topics[0].Units[0].Problems[0].Translations[0].Choices[0].Explanation = "Because."
+	b.Sections[0].Units = nil
+	_, _, err = svc.ImportBundle(ctx, b)
+	require.NoError(t, err)
+	assert.Equal(t, "Because.", client.Choice.GetX(ctx, picked.ID).Explanation)
+	assert.Equal(t, 1, client.Answer.Query().CountX(ctx))
+}
+
+func TestContentService_ImportBundleRejectsDuplicateProblemKeys(t *testing.T) {
+	ctx := context.Background()
+	client := testutil.NewClient(t)
+	svc := service.NewContentService(client)
+
+	e := seedTree(t, svc)
+	b, err := svc.ExportBundle(ctx, e.ID)
+	require.NoError(t, err)
+	ps := client.Problem.Query().Order(problem.ByID()).AllX(ctx)
+	client.Problem.UpdateOne(ps[1]).SetKey(ps[0].Key).ExecX(ctx)
+
+	_, _, err = svc.ImportBundle(ctx, b)
+	assert.ErrorIs(t, err, service.ErrDuplicateKey)
+	assert.ErrorContains(t, err, ps[0].Key)
+	assert.Equal(t, 2, client.Problem.Query().CountX(ctx), "nothing is deleted")
+}
+
+func TestContentService_ImportBundleIntoEmptyDatabase(t *testing.T) {
+	ctx := context.Background()
+	client := testutil.NewClient(t)
+	svc := service.NewContentService(client)
+	e := seedTree(t, svc)
+	client.Exam.UpdateOneID(e.ID).SetPartialCredit(true).SetNegativeMarking(true).ExecX(ctx)
+	b, err := svc.ExportBundle(ctx, e.ID)
+	require.NoError(t, err)
+	assert.True(t, b.PartialCredit)
+	assert.True(t, b.NegativeMarking)
+	assert.False(t, b.WeightByDifficulty)
+
+	// The subtest's database stands in for another environment
+	t.Run("other", func(t *testing.T) {
+		other := service.NewContentService(testutil.NewClient(t))
+		e, stats, err := other.ImportBundle(ctx, b)
+		require.NoError(t, err)
+		assert.Equal(t, b.Key, e.Key)
+		assert.Zero(t, stats.Updated)
+		got, err := other.ExportBundle(ctx, e.ID)
+		require.NoError(t, err)
+		assert.Equal(t, b, got)
+	})
+}
+
+func TestContentService_ImportBundleRejectsInvalidContent(t *testing.T) {
+	ctx := context.Background()
+	client := testutil.NewClient(t)
+	svc := service.NewContentService(client)
+
+	b := &bundle.Exam{Key: "k", Title: "Exam", Units: []bundle.Unit{{Key: "u", Title: "Unit", Problems: []bundle.Problem{{
+		Key: "p", Difficulty: 1,
+		Translations: []bundle.Translation{{Locale: "en", Title: "Q", Content: "?", Choices: []bundle.Choice{{Content: "No"}}}},
+	}}}}}
+	_, _, err := svc.ImportBundle(ctx, b)
+	var verr *service.ValidationError
+	assert.ErrorAs(t, err, &verr)
+	assert.Zero(t, client.Exam.Query().CountX(ctx), "the import is rolled back")
+}
+
+func TestContentService_MarkdownFolderRoundTrip(t *testing.T) {
+	ctx := context.Background()
+	client := testutil.NewClient(t)
+	svc := service.NewContentService(client)
+
+	e := seedTree(t, svc)
+	exported, err := svc.ExportBundle(ctx, e.ID)
+	require.NoError(t, err)
+
+	dir := t.TempDir()
+	require.NoError(t, bundle.EncodeDir(dir, exported))
+	read, err := bundle.DecodeDir(os.DirFS(dir))
+	require.NoError(t, err)
+	assert.Equal(t, exported, read)
+
+	_, stats, err := svc.ImportBundle(ctx, read)
+	require.NoError(t, err)
+	assert.Zero(t, stats.Created)
+	assert.Zero(t, stats.Deleted)
+}
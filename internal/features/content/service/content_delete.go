@@ -0,0 +1,142 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"examination/internal/ent"
+	"examination/internal/ent/answer"
+	"examination/internal/ent/attempt"
+	"examination/internal/ent/attemptitem"
+	"examination/internal/ent/choice"
+	"examination/internal/ent/predicate"
+	"examination/internal/ent/problem"
+	"examination/internal/ent/problemtranslation"
+	"examination/internal/ent/section"
+	"examination/internal/ent/topic"
+	"examination/internal/ent/unit"
+	"examination/internal/ent/versionrule"
+)
+
+// The helpers below implement the cascading delete of the exam hierarchy.
+// Children are always removed before their parents so that foreign keys
+// stay valid for the whole transaction. Candidate answers referencing
+// deleted problems are removed with them, as are attempt items.
+
+func deleteExam(ctx context.Context, tx *ent.Tx, id int) error {
+	if _, err := tx.Answer.Delete().Where(answer.HasAttemptWith(attempt.ExamID(id))).Exec(ctx); err != nil {
+		return fmt.Errorf("deleting answers: %w", err)
+	}
+	if _, err := tx.AttemptItem.Delete().Where(attemptitem.HasAttemptWith(attempt.ExamID(id))).Exec(ctx); err != nil {
+		return fmt.Errorf("deleting attempt items: %w", err)
+	}
+	if _, err := tx.Attempt.Delete().Where(attempt.ExamID(id)).Exec(ctx); err != nil {
+		return fmt.Errorf("deleting attempts: %w", err)
+	}
+	if err := deleteUnits(ctx, tx, unit.ExamID(id)); err != nil {
+		return err
+	}
+	if _, err := tx.Topic.Delete().Where(topic.ExamID(id)).Exec(ctx); err != nil {
+		return fmt.Errorf("deleting topics: %w", err)
+	}
+	if _, err := tx.Section.Delete().Where(section.ExamID(id)).Exec(ctx); err != nil {
+		return fmt.Errorf("deleting sections: %w", err)
+	}
+	if _, err := tx.VersionRule.Delete().Where(versionrule.ExamID(id)).Exec(ctx); err != nil {
+		return fmt.Errorf("deleting version rules: %w", err)
+	}
+	if err := tx.Exam.DeleteOneID(id).Exec(ctx); err != nil {
+		return fmt.Errorf("deleting exam %d: %w", id, err)
+	}
+	return nil
+}
+
+func deleteSection(ctx context.Context, tx *ent.Tx, id int) error {
+	if err := deleteUnits(ctx, tx, unit.Or(
+		unit.SectionID(id),
+		unit.HasTopicWith(topic.SectionID(id)),
+	)); err != nil {
+		return err
+	}
+	if _, err := tx.Topic.Delete().Where(topic.SectionID(id)).Exec(ctx); err != nil {
+		return fmt.Errorf("deleting topics: %w", err)
+	}
+	if err := tx.Section.DeleteOneID(id).Exec(ctx); err != nil {
+		return fmt.Errorf("deleting section %d: %w", id, err)
+	}
+	return nil
+}
+
+func deleteTopic(ctx context.Context, tx *ent.Tx, id int) error {
+	if err := deleteUnits(ctx, tx, unit.TopicID(id)); err != nil {
+		return err
+	}
+	if err := tx.Topic.DeleteOneID(id).Exec(ctx); err != nil {
+		return fmt.Errorf("deleting topic %d: %w", id, err)
+	}
+	return nil
+}
+
+func deleteUnits(ctx context.Context, tx *ent.Tx, ps ...predicate.Unit) error {
+	ids, err := tx.Unit.Query().Where(ps...).IDs(ctx)
+	if err != nil {
+		return fmt.Errorf("querying units: %w", err)
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	if err := deleteProblems(ctx, tx, problem.UnitIDIn(ids...)); err != nil {
+		return err
+	}
+	if _, err := tx.Unit.Delete().Where(unit.IDIn(ids...)).Exec(ctx); err != nil {
+		return fmt.Errorf("deleting units: %w", err)
+	}
+	return nil
+}
+
+func deleteProblems(ctx context.Context, tx *ent.Tx, ps ...predicate.Problem) error {
+	ids, err := tx.Problem.Query().Where(ps...).IDs(ctx)
+	if err != nil {
+		return fmt.Errorf("querying problems: %w", err)
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	if err := deleteTranslations(ctx, tx, problemtranslation.ProblemIDIn(ids...)); err != nil {
+		return err
+	}
+	if _, err := tx.VersionRule.Delete().Where(versionrule.ProblemIDIn(ids...)).Exec(ctx); err != nil {
+		return fmt.Errorf("deleting problem version rules: %w", err)
+	}
+	if _, err := tx.Answer.Delete().Where(answer.ProblemIDIn(ids...)).Exec(ctx); err != nil {
+		return fmt.Errorf("deleting answers: %w", err)
+	}
+	if _, err := tx.AttemptItem.Delete().Where(attemptitem.ProblemIDIn(ids...)).Exec(ctx); err != nil {
+		return fmt.Errorf("deleting attempt items: %w", err)
+	}
+	// Variants outlive their source; they are detached rather than deleted.
+	if _, err := tx.Problem.Update().Where(problem.ParentIDIn(ids...)).ClearParent().Save(ctx); err != nil {
+		return fmt.Errorf("detaching variants: %w", err)
+	}
+	if _, err := tx.Problem.Delete().Where(problem.IDIn(ids...)).Exec(ctx); err != nil {
+		return fmt.Errorf("deleting problems: %w", err)
+	}
+	return nil
+}
+
+func deleteTranslations(ctx context.Context, tx *ent.Tx, ps ...predicate.ProblemTranslation) error {
+	ids, err := tx.ProblemTranslation.Query().Where(ps...).IDs(ctx)
+	if err != nil {
+		return fmt.Errorf("querying translations: %w", err)
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	if _, err := tx.Choice.Delete().Where(choice.ProblemTranslationIDIn(ids...)).Exec(ctx); err != nil {
+		return fmt.Errorf("deleting choices: %w", err)
+	}
+	if _, err := tx.ProblemTranslation.Delete().Where(problemtranslation.IDIn(ids...)).Exec(ctx); err != nil {
+		return fmt.Errorf("deleting translations: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,292 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"examination/internal/dbtx"
+	"examination/internal/ent"
+	"examination/internal/ent/choice"
+	"examination/internal/ent/problem"
+	"examination/internal/ent/problemtranslation"
+)
+
+// ProblemInput holds the writable fields of a Problem.
+// UnitID and ParentID are only used on create. An empty Type defaults to
+// SOURCE on create and is left unchanged on update; so are Interaction,
+// defaulting to SINGLE, and TextMatch, defaulting to EXACT.
+type ProblemInput struct {
+	UnitID      int
+	Type        problem.Type
+	Difficulty  int
+	Interaction problem.Interaction
+	Tolerance   float64
+	TextMatch   problem.TextMatch
+	ParentID    *int
+}
+
+// ProblemTranslationInput holds the writable fields of a ProblemTranslation.
+// ProblemID and Locale are only used on create. Choices are created in the
+// same transaction as the translation and must suit the problem's
+// interaction, see Validator.
+type ProblemTranslationInput struct {
+	ProblemID   int
+	Locale      string
+	Title       string
+	Content     string
+	Explanation string
+	Choices     []ChoiceInput
+}
+
+// ChoiceInput holds the writable fields of a Choice.
+// ProblemTranslationID is only used on create and is ignored for choices
+// nested in a ProblemTranslationInput, whose seqs are normalized as a group.
+type ChoiceInput struct {
+	ProblemTranslationID int
+	Content              string
+	IsCorrect            bool
+	Explanation          string
+	Seq                  int
+}
+
+// --- Problem ---
+
+func (s *ContentService) CreateProblem(ctx context.Context, in ProblemInput) (*ent.Problem, error) {
+	var created *ent.Problem
+	err := dbtx.WithTx(ctx, s.client, func(tx *ent.Tx) error {
+		var err error
+		created, err = createProblem(ctx, tx, in)
+		return err
+	})
+	return created, err
+}
+
+func createProblem(ctx context.Context, tx *ent.Tx, in ProblemInput) (*ent.Problem, error) {
+	if in.Type == "" {
+		in.Type = problem.TypeSOURCE
+	}
+	if in.Interaction == "" {
+		in.Interaction = problem.DefaultInteraction
+	}
+	if in.TextMatch == "" {
+		in.TextMatch = problem.DefaultTextMatch
+	}
+	created, err := tx.Problem.Create().
+		SetUnitID(in.UnitID).
+		SetType(in.Type).
+		SetDifficulty(in.Difficulty).
+		SetInteraction(in.Interaction).
+		SetTolerance(in.Tolerance).
+		SetTextMatch(in.TextMatch).
+		SetNillableParentID(in.ParentID).
+		SetCreatedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("creating problem: %w", err)
+	}
+	return created, nil
+}
+
+// GetProblem returns the problem with all translations and their choices in seq order.
+func (s *ContentService) GetProblem(ctx context.Context, id int) (*ent.Problem, error) {
+	return s.client.Problem.Query().
+		Where(problem.ID(id)).
+		WithTranslations(func(ptq *ent.ProblemTranslationQuery) {
+			ptq.Order(ent.Asc(problemtranslation.FieldLocale)).
+				WithChoices(func(cq *ent.ChoiceQuery) {
+					cq.Order(ent.Asc(choice.FieldSeq))
+				})
+		}).
+		Only(ctx)
+}
+
+// UpdateProblem updates the problem. Its translations are checked again,
+// as a new interaction gives their choices another meaning.
+func (s *ContentService) UpdateProblem(ctx context.Context, id int, in ProblemInput) (*ent.Problem, error) {
+	var updated *ent.Problem
+	err := dbtx.WithTx(ctx, s.client, func(tx *ent.Tx) error {
+		upd := tx.Problem.UpdateOneID(id).
+			SetDifficulty(in.Difficulty).
+			SetTolerance(in.Tolerance)
+		if in.Type != "" {
+			upd.SetType(in.Type)
+		}
+		if in.Interaction != "" {
+			upd.SetInteraction(in.Interaction)
+		}
+		if in.TextMatch != "" {
+			upd.SetTextMatch(in.TextMatch)
+		}
+		var err error
+		updated, err = upd.Save(ctx)
+		if err != nil {
+			return fmt.Errorf("updating problem %d: %w", id, err)
+		}
+		return asError(checkTranslations(ctx, tx.Client(), problemtranslation.ProblemID(id)))
+	})
+	return updated, err
+}
+
+// DeleteProblem deletes the problem, its translations, choices and version
+// rules. Variants derived from it are kept and detached from their parent.
+func (s *ContentService) DeleteProblem(ctx context.Context, id int) error {
+	return dbtx.WithTx(ctx, s.client, func(tx *ent.Tx) error {
+		return deleteProblems(ctx, tx, problem.ID(id))
+	})
+}
+
+// --- ProblemTranslation ---
+
+func (s *ContentService) CreateProblemTranslation(ctx context.Context, in ProblemTranslationInput) (*ent.ProblemTranslation, error) {
+	var created *ent.ProblemTranslation
+	err := dbtx.WithTx(ctx, s.client, func(tx *ent.Tx) error {
+		var err error
+		created, err = createProblemTranslation(ctx, tx, in)
+		return err
+	})
+	return created, err
+}
+
+func createProblemTranslation(ctx context.Context, tx *ent.Tx, in ProblemTranslationInput) (*ent.ProblemTranslation, error) {
+	created, err := tx.ProblemTranslation.Create().
+		SetProblemID(in.ProblemID).
+		SetLocale(in.Locale).
+		SetTitle(in.Title).
+		SetContent(in.Content).
+		SetExplanation(in.Explanation).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("creating translation: %w", err)
+	}
+
+	if len(in.Choices) > 0 {
+		builders := make([]*ent.ChoiceCreate, 0, len(in.Choices))
+		for _, c := range in.Choices {
+			builders = append(builders, tx.Choice.Create().
+				SetProblemTranslationID(created.ID).
+				SetContent(c.Content).
+				SetIsCorrect(c.IsCorrect).
+				SetExplanation(c.Explanation).
+				SetSeq(c.Seq))
+		}
+		if _, err := tx.Choice.CreateBulk(builders...).Save(ctx); err != nil {
+			return nil, fmt.Errorf("creating choices: %w", err)
+		}
+		translation := Parent{Kind: KindTranslation, ID: created.ID}
+		if err := normalize(ctx, tx.Client(), translation); err != nil {
+			return nil, err
+		}
+		choices, err := created.QueryChoices().Order(ent.Asc(choice.FieldSeq)).All(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("querying choices: %w", err)
+		}
+		created.Edges.Choices = choices
+	}
+
+	if err := asError(checkTranslations(ctx, tx.Client(), problemtranslation.ID(created.ID))); err != nil {
+		return nil, err
+	}
+	return created, nil
+}
+
+// GetProblemTranslation returns the translation with its choices in seq order.
+func (s *ContentService) GetProblemTranslation(ctx context.Context, id int) (*ent.ProblemTranslation, error) {
+	return s.client.ProblemTranslation.Query().
+		Where(problemtranslation.ID(id)).
+		WithChoices(func(cq *ent.ChoiceQuery) {
+			cq.Order(ent.Asc(choice.FieldSeq))
+		}).
+		Only(ctx)
+}
+
+// UpdateProblemTranslation updates the text fields of the translation,
+// whose math must stay well-formed. Choices are managed individually.
+func (s *ContentService) UpdateProblemTranslation(ctx context.Context, id int, in ProblemTranslationInput) (*ent.ProblemTranslation, error) {
+	var updated *ent.ProblemTranslation
+	err := dbtx.WithTx(ctx, s.client, func(tx *ent.Tx) error {
+		var err error
+		updated, err = tx.ProblemTranslation.UpdateOneID(id).
+			SetTitle(in.Title).
+			SetContent(in.Content).
+			SetExplanation(in.Explanation).
+			Save(ctx)
+		if err != nil {
+			return fmt.Errorf("updating translation %d: %w", id, err)
+		}
+		return asError(checkTranslations(ctx, tx.Client(), problemtranslation.ID(id)))
+	})
+	return updated, err
+}
+
+// DeleteProblemTranslation deletes the translation and its choices.
+func (s *ContentService) DeleteProblemTranslation(ctx context.Context, id int) error {
+	return dbtx.WithTx(ctx, s.client, func(tx *ent.Tx) error {
+		return deleteTranslations(ctx, tx, problemtranslation.ID(id))
+	})
+}
+
+// --- Choice ---
+
+func (s *ContentService) CreateChoice(ctx context.Context, in ChoiceInput) (*ent.Choice, error) {
+	var created *ent.Choice
+	err := dbtx.WithTx(ctx, s.client, func(tx *ent.Tx) error {
+		var err error
+		created, err = tx.Choice.Create().
+			SetProblemTranslationID(in.ProblemTranslationID).
+			SetContent(in.Content).
+			SetIsCorrect(in.IsCorrect).
+			SetExplanation(in.Explanation).
+			SetSeq(in.Seq).
+			Save(ctx)
+		if err != nil {
+			return fmt.Errorf("creating choice: %w", err)
+		}
+		if err := asError(checkTranslations(ctx, tx.Client(), problemtranslation.ID(in.ProblemTranslationID))); err != nil {
+			return err
+		}
+		if err := placeNew(ctx, tx.Client(), KindChoice, created.ID, in.Seq); err != nil {
+			return err
+		}
+		created, err = tx.Choice.Get(ctx, created.ID)
+		return err
+	})
+	return created, err
+}
+
+func (s *ContentService) GetChoice(ctx context.Context, id int) (*ent.Choice, error) {
+	return s.client.Choice.Get(ctx, id)
+}
+
+func (s *ContentService) UpdateChoice(ctx context.Context, id int, in ChoiceInput) (*ent.Choice, error) {
+	var updated *ent.Choice
+	err := dbtx.WithTx(ctx, s.client, func(tx *ent.Tx) error {
+		var err error
+		updated, err = tx.Choice.UpdateOneID(id).
+			SetContent(in.Content).
+			SetIsCorrect(in.IsCorrect).
+			SetExplanation(in.Explanation).
+			Save(ctx)
+		if err != nil {
+			return fmt.Errorf("updating choice %d: %w", id, err)
+		}
+		return asError(checkTranslations(ctx, tx.Client(), problemtranslation.ID(updated.ProblemTranslationID)))
+	})
+	return updated, err
+}
+
+func (s *ContentService) DeleteChoice(ctx context.Context, id int) error {
+	return dbtx.WithTx(ctx, s.client, func(tx *ent.Tx) error {
+		p, err := parentOf(ctx, tx.Client(), KindChoice, id)
+		if err != nil {
+			return err
+		}
+		if err := tx.Choice.DeleteOneID(id).Exec(ctx); err != nil {
+			return fmt.Errorf("deleting choice %d: %w", id, err)
+		}
+		if err := asError(checkTranslations(ctx, tx.Client(), problemtranslation.ID(p.ID))); err != nil {
+			return err
+		}
+		return normalize(ctx, tx.Client(), p)
+	})
+}
@@ -0,0 +1,198 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"examination/internal/ent"
+	"examination/internal/ent/exam"
+	"examination/internal/ent/problem"
+	"examination/internal/features/exam/i18n"
+)
+
+// TranslationReport is the translation coverage of one exam. Locales are
+// those any problem of the exam is translated into, plus those asked for.
+type TranslationReport struct {
+	Exam *ent.Exam
+	// Problems counts the problems of the exam.
+	Problems int
+	// Locales is sorted by locale.
+	Locales []LocaleCoverage
+	// Missing lists the problems lacking a locale, in exam order.
+	Missing []MissingTranslations
+	// Mismatches lists the translations whose choices disagree with the
+	// reference locale of their problem, in exam order.
+	Mismatches []TranslationMismatch
+}
+
+// Complete reports whether every problem is translated into every locale
+// and no translations disagree.
+func (r *TranslationReport) Complete() bool {
+	return len(r.Missing) == 0 && len(r.Mismatches) == 0
+}
+
+// LocaleCoverage counts the problems translated into a locale.
+type LocaleCoverage struct {
+	Locale     string
+	Translated int
+	Problems   int
+}
+
+// Percent is the share of translated problems, 100 for an exam without
+// problems.
+func (c LocaleCoverage) Percent() float64 {
+	if c.Problems == 0 {
+		return 100
+	}
+	return float64(c.Translated) * 100 / float64(c.Problems)
+}
+
+// MissingTranslations is a problem lacking translations. Number is the
+// question number of its unit.
+type MissingTranslations struct {
+	Number  int
+	Problem *ent.Problem
+	Locales []string
+}
+
+// TranslationMismatch is a translation whose choice count or correct
+// choices differ from the reference locale of the problem: the default
+// locale when the problem has it, the first locale otherwise. Correct
+// choices are compared by position.
+type TranslationMismatch struct {
+	Number    int
+	Problem   *ent.Problem
+	Locale    string
+	Reference string
+	Message   string
+}
+
+// TranslationReport returns the translation coverage of the exam. locales
+// are reported even when no problem is translated into them yet.
+func (s *ContentService) TranslationReport(ctx context.Context, examID int, locales ...string) (*TranslationReport, error) {
+	e, err := s.client.Exam.Get(ctx, examID)
+	if err != nil {
+		return nil, err
+	}
+	return translationReport(ctx, s.client, e, locales)
+}
+
+// TranslationReports returns the translation coverage of every exam, in ID
+// order.
+func (s *ContentService) TranslationReports(ctx context.Context, locales ...string) ([]*TranslationReport, error) {
+	exams, err := s.client.Exam.Query().Order(exam.ByID()).All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("querying exams: %w", err)
+	}
+	reports := make([]*TranslationReport, 0, len(exams))
+	for _, e := range exams {
+		r, err := translationReport(ctx, s.client, e, locales)
+		if err != nil {
+			return nil, err
+		}
+		reports = append(reports, r)
+	}
+	return reports, nil
+}
+
+func translationReport(ctx context.Context, c *ent.Client, e *ent.Exam, locales []string) (*TranslationReport, error) {
+	slots, err := flatten(ctx, c, e.ID, func(pq *ent.ProblemQuery) {
+		pq.WithTranslations(withChoicesInSeq)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r := &TranslationReport{Exam: e}
+	all := slices.Clone(locales)
+	for _, slot := range slots {
+		for _, p := range slot.Unit.Edges.Problems {
+			for _, t := range p.Edges.Translations {
+				all = append(all, t.Locale)
+			}
+		}
+	}
+	slices.Sort(all)
+	all = slices.Compact(all)
+
+	translated := make(map[string]int, len(all))
+	for _, slot := range slots {
+		for _, p := range slot.Unit.Edges.Problems {
+			r.Problems++
+			has := make(map[string]bool, len(p.Edges.Translations))
+			for _, t := range p.Edges.Translations {
+				has[t.Locale] = true
+				translated[t.Locale]++
+			}
+			var missing []string
+			for _, l := range all {
+				if !has[l] {
+					missing = append(missing, l)
+				}
+			}
+			if len(missing) > 0 {
+				r.Missing = append(r.Missing, MissingTranslations{Number: slot.Number, Problem: p, Locales: missing})
+			}
+			for _, m := range mismatches(p) {
+				m.Number = slot.Number
+				r.Mismatches = append(r.Mismatches, m)
+			}
+		}
+	}
+	for _, l := range all {
+		r.Locales = append(r.Locales, LocaleCoverage{Locale: l, Translated: translated[l], Problems: r.Problems})
+	}
+	return r, nil
+}
+
+// mismatches compares the choices of every translation of the problem,
+// loaded in locale order with choices in seq order, with the reference one.
+// The choices of NUMERIC and TEXT problems are accepted answers, which each
+// locale may list differently, so they are not compared.
+func mismatches(p *ent.Problem) []TranslationMismatch {
+	ts := p.Edges.Translations
+	typed := p.Interaction == problem.InteractionNUMERIC || p.Interaction == problem.InteractionTEXT
+	if len(ts) < 2 || typed {
+		return nil
+	}
+	ref := ts[0]
+	if i := slices.IndexFunc(ts, func(t *ent.ProblemTranslation) bool { return t.Locale == i18n.DefaultLocale }); i >= 0 {
+		ref = ts[i]
+	}
+	want := correctPositions(ref)
+
+	var out []TranslationMismatch
+	for _, t := range ts {
+		if t == ref {
+			continue
+		}
+		m := TranslationMismatch{Problem: p, Locale: t.Locale, Reference: ref.Locale}
+		if n, refN := len(t.Edges.Choices), len(ref.Edges.Choices); n != refN {
+			m.Message = fmt.Sprintf("has %d choices, %s has %d", n, ref.Locale, refN)
+		} else if got := correctPositions(t); got != want {
+			m.Message = fmt.Sprintf("marks choices %s correct, %s marks %s", got, ref.Locale, want)
+		} else {
+			continue
+		}
+		out = append(out, m)
+	}
+	return out
+}
+
+// correctPositions lists the 1-based positions of the correct choices,
+// such as "1, 3", or "none".
+func correctPositions(t *ent.ProblemTranslation) string {
+	var ps []string
+	for i, c := range t.Edges.Choices {
+		if c.IsCorrect {
+			ps = append(ps, strconv.Itoa(i+1))
+		}
+	}
+	if len(ps) == 0 {
+		return "none"
+	}
+	return strings.Join(ps, ", ")
+}
@@ -0,0 +1,97 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+
+	"examination/internal/ent"
+	"examination/internal/ent/problem"
+	"examination/internal/features/content/service"
+	"examination/internal/testutil"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestContentService_TranslationReport(t *testing.T) {
+	ctx := context.Background()
+	client := testutil.NewClient(t)
+	svc := service.NewContentService(client)
+
+	e := seedTree(t, svc)
+	problems := client.Problem.Query().Order(problem.ByID()).AllX(ctx)
+
+	// The first problem gets a Korean translation marking the other choice
+	// correct, the second one with a choice too many
+	translate := func(p *ent.Problem, choices ...service.ChoiceInput) {
+		_, err := svc.CreateProblemTranslation(ctx, service.ProblemTranslationInput{
+			ProblemID: p.ID, Locale: "ko", Title: "질문", Content: "하나를 고르세요.", Choices: choices,
+		})
+		require.NoError(t, err)
+	}
+	translate(problems[0], service.ChoiceInput{Content: "A", Seq: 1}, service.ChoiceInput{Content: "B", IsCorrect: true, Seq: 2})
+	translate(problems[1],
+		service.ChoiceInput{Content: "A", IsCorrect: true, Seq: 1},
+		service.ChoiceInput{Content: "B", Seq: 2},
+		service.ChoiceInput{Content: "C", Seq: 3},
+	)
+
+	report, err := svc.TranslationReport(ctx, e.ID, "ja")
+	require.NoError(t, err)
+	assert.False(t, report.Complete())
+	assert.Equal(t, 2, report.Problems)
+	assert.Equal(t, []service.LocaleCoverage{
+		{Locale: "en", Translated: 2, Problems: 2},
+		{Locale: "ja", Translated: 0, Problems: 2},
+		{Locale: "ko", Translated: 2, Problems: 2},
+	}, report.Locales)
+	assert.InDelta(t, 0, report.Locales[1].Percent(), 0.01)
+
+	require.Len(t, report.Missing, 2)
+	assert.Equal(t, 1, report.Missing[0].Number)
+	assert.Equal(t, []string{"ja"}, report.Missing[0].Locales)
+
+	require.Len(t, report.Mismatches, 2)
+	assert.Equal(t, problems[0].ID, report.Mismatches[0].Problem.ID)
+	assert.Equal(t, "en", report.Mismatches[0].Reference)
+	assert.Equal(t, "marks choices 2 correct, en marks 1", report.Mismatches[0].Message)
+	assert.Equal(t, "has 3 choices, en has 2", report.Mismatches[1].Message)
+
+	// Without the extra locale only the mismatches are left
+	reports, err := svc.TranslationReports(ctx)
+	require.NoError(t, err)
+	require.Len(t, reports, 1)
+	assert.Empty(t, reports[0].Missing)
+	assert.Len(t, reports[0].Mismatches, 2)
+}
+
+func TestContentService_TranslationReportTypedAnswers(t *testing.T) {
+	ctx := context.Background()
+	svc := service.NewContentService(testutil.NewClient(t))
+
+	e, err := svc.CreateExam(ctx, service.ExamInput{Title: "Exam", TimeLimit: 30, IsActive: true})
+	require.NoError(t, err)
+	u, err := svc.CreateUnit(ctx, service.UnitInput{ExamID: e.ID, Title: "Unit"})
+	require.NoError(t, err)
+	p, err := svc.CreateProblem(ctx, service.ProblemInput{UnitID: u.ID, Difficulty: 1, Interaction: problem.InteractionTEXT})
+	require.NoError(t, err)
+
+	// Accepted answers differ in number and are never marked correct
+	translate := func(locale string, accepted ...string) {
+		var choices []service.ChoiceInput
+		for i, a := range accepted {
+			choices = append(choices, service.ChoiceInput{Content: a, Seq: i + 1})
+		}
+		_, err := svc.CreateProblemTranslation(ctx, service.ProblemTranslationInput{
+			ProblemID: p.ID, Locale: locale, Title: "Color", Content: "Name the color.", Choices: choices,
+		})
+		require.NoError(t, err)
+	}
+	translate("en", "grey", "gray")
+	translate("ko", "회색")
+
+	report, err := svc.TranslationReport(ctx, e.ID)
+	require.NoError(t, err)
+	assert.True(t, report.Complete())
+	assert.Empty(t, report.Mismatches)
+}
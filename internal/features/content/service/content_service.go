@@ -1,6 +1,341 @@
 package service
 
+import (
+	"context"
+	"fmt"
+
+	"examination/internal/dbtx"
+	"examination/internal/ent"
+	"examination/internal/ent/exam"
+	"examination/internal/ent/section"
+	"examination/internal/ent/topic"
+	"examination/internal/ent/unit"
+)
+
 // ContentService handles high-level CRUD operations for content.
+// Every write runs inside a single transaction so that a failure leaves
+// the exam hierarchy untouched. Writes that would break a hierarchy
+// invariant (see Validator) fail with a *ValidationError.
 type ContentService struct {
-	// dependencies
+	client *ent.Client
+}
+
+func NewContentService(client *ent.Client) *ContentService {
+	return &ContentService{client: client}
+}
+
+// ExamInput holds the writable fields of an Exam.
+type ExamInput struct {
+	Title       string
+	Description string
+	TimeLimit   int
+	IsActive    bool
+	// ShuffleChoices shuffles the choice order per attempt.
+	ShuffleChoices bool
+	// WeightByDifficulty, PartialCredit and NegativeMarking are the scoring
+	// policy of the exam.
+	WeightByDifficulty bool
+	PartialCredit      bool
+	NegativeMarking    bool
+}
+
+// SectionInput holds the writable fields of a Section.
+// ExamID is only used on create.
+//
+// For every orderable input, Seq is the desired 1-based position among the
+// siblings on create (0 appends) and is ignored on update: reordering goes
+// through SequenceLogic so that sequences stay gapless.
+type SectionInput struct {
+	ExamID int
+	Title  string
+	Seq    int
+}
+
+// TopicInput holds the writable fields of a Topic.
+// ExamID and SectionID are only used on create.
+type TopicInput struct {
+	ExamID    int
+	SectionID *int
+	Title     string
+	Seq       int
+}
+
+// UnitInput holds the writable fields of a Unit.
+// ExamID, SectionID and TopicID are only used on create. When TopicID is
+// set, the Unit hook clears SectionID.
+type UnitInput struct {
+	ExamID    int
+	SectionID *int
+	TopicID   *int
+	Title     string
+	Seq       int
+}
+
+// --- Exam ---
+
+func (s *ContentService) CreateExam(ctx context.Context, in ExamInput) (*ent.Exam, error) {
+	var created *ent.Exam
+	err := dbtx.WithTx(ctx, s.client, func(tx *ent.Tx) error {
+		var err error
+		created, err = tx.Exam.Create().
+			SetTitle(in.Title).
+			SetDescription(in.Description).
+			SetTimeLimit(in.TimeLimit).
+			SetIsActive(in.IsActive).
+			SetShuffleChoices(in.ShuffleChoices).
+			SetWeightByDifficulty(in.WeightByDifficulty).
+			SetPartialCredit(in.PartialCredit).
+			SetNegativeMarking(in.NegativeMarking).
+			Save(ctx)
+		if err != nil {
+			return fmt.Errorf("creating exam: %w", err)
+		}
+		return nil
+	})
+	return created, err
+}
+
+// GetExam returns the exam with its sections, topics and units in seq order.
+func (s *ContentService) GetExam(ctx context.Context, id int) (*ent.Exam, error) {
+	return s.client.Exam.Query().
+		Where(exam.ID(id)).
+		WithSections(func(sq *ent.SectionQuery) {
+			sq.Order(ent.Asc(section.FieldSeq))
+		}).
+		WithTopics(func(tq *ent.TopicQuery) {
+			tq.Order(ent.Asc(topic.FieldSeq))
+		}).
+		WithUnits(func(uq *ent.UnitQuery) {
+			uq.Order(ent.Asc(unit.FieldSeq))
+		}).
+		Only(ctx)
+}
+
+func (s *ContentService) ListExams(ctx context.Context) ([]*ent.Exam, error) {
+	return s.client.Exam.Query().Order(ent.Asc(exam.FieldID)).All(ctx)
+}
+
+func (s *ContentService) UpdateExam(ctx context.Context, id int, in ExamInput) (*ent.Exam, error) {
+	var updated *ent.Exam
+	err := dbtx.WithTx(ctx, s.client, func(tx *ent.Tx) error {
+		var err error
+		updated, err = tx.Exam.UpdateOneID(id).
+			SetTitle(in.Title).
+			SetDescription(in.Description).
+			SetTimeLimit(in.TimeLimit).
+			SetIsActive(in.IsActive).
+			SetShuffleChoices(in.ShuffleChoices).
+			SetWeightByDifficulty(in.WeightByDifficulty).
+			SetPartialCredit(in.PartialCredit).
+			SetNegativeMarking(in.NegativeMarking).
+			Save(ctx)
+		if err != nil {
+			return fmt.Errorf("updating exam %d: %w", id, err)
+		}
+		return nil
+	})
+	return updated, err
+}
+
+// DeleteExam deletes the exam together with its whole hierarchy and version rules.
+func (s *ContentService) DeleteExam(ctx context.Context, id int) error {
+	return dbtx.WithTx(ctx, s.client, func(tx *ent.Tx) error {
+		return deleteExam(ctx, tx, id)
+	})
+}
+
+// --- Section ---
+
+func (s *ContentService) CreateSection(ctx context.Context, in SectionInput) (*ent.Section, error) {
+	var created *ent.Section
+	err := dbtx.WithTx(ctx, s.client, func(tx *ent.Tx) error {
+		var err error
+		created, err = tx.Section.Create().
+			SetExamID(in.ExamID).
+			SetTitle(in.Title).
+			SetSeq(in.Seq).
+			Save(ctx)
+		if err != nil {
+			return fmt.Errorf("creating section: %w", err)
+		}
+		if err := placeNew(ctx, tx.Client(), KindSection, created.ID, in.Seq); err != nil {
+			return err
+		}
+		created, err = tx.Section.Get(ctx, created.ID)
+		return err
+	})
+	return created, err
+}
+
+// GetSection returns the section with its topics and direct units in seq order.
+func (s *ContentService) GetSection(ctx context.Context, id int) (*ent.Section, error) {
+	return s.client.Section.Query().
+		Where(section.ID(id)).
+		WithTopics(func(tq *ent.TopicQuery) {
+			tq.Order(ent.Asc(topic.FieldSeq))
+		}).
+		WithUnits(func(uq *ent.UnitQuery) {
+			uq.Order(ent.Asc(unit.FieldSeq))
+		}).
+		Only(ctx)
+}
+
+func (s *ContentService) UpdateSection(ctx context.Context, id int, in SectionInput) (*ent.Section, error) {
+	var updated *ent.Section
+	err := dbtx.WithTx(ctx, s.client, func(tx *ent.Tx) error {
+		var err error
+		updated, err = tx.Section.UpdateOneID(id).
+			SetTitle(in.Title).
+			Save(ctx)
+		if err != nil {
+			return fmt.Errorf("updating section %d: %w", id, err)
+		}
+		return nil
+	})
+	return updated, err
+}
+
+// DeleteSection deletes the section, its topics and every unit below them.
+func (s *ContentService) DeleteSection(ctx context.Context, id int) error {
+	return dbtx.WithTx(ctx, s.client, func(tx *ent.Tx) error {
+		p, err := parentOf(ctx, tx.Client(), KindSection, id)
+		if err != nil {
+			return err
+		}
+		if err := deleteSection(ctx, tx, id); err != nil {
+			return err
+		}
+		return normalize(ctx, tx.Client(), p)
+	})
+}
+
+// --- Topic ---
+
+func (s *ContentService) CreateTopic(ctx context.Context, in TopicInput) (*ent.Topic, error) {
+	var created *ent.Topic
+	err := dbtx.WithTx(ctx, s.client, func(tx *ent.Tx) error {
+		var err error
+		created, err = tx.Topic.Create().
+			SetExamID(in.ExamID).
+			SetNillableSectionID(in.SectionID).
+			SetTitle(in.Title).
+			SetSeq(in.Seq).
+			Save(ctx)
+		if err != nil {
+			return fmt.Errorf("creating topic: %w", err)
+		}
+		if err := asError(checkTopics(ctx, tx.Client(), topic.ID(created.ID))); err != nil {
+			return err
+		}
+		if err := placeNew(ctx, tx.Client(), KindTopic, created.ID, in.Seq); err != nil {
+			return err
+		}
+		created, err = tx.Topic.Get(ctx, created.ID)
+		return err
+	})
+	return created, err
+}
+
+// GetTopic returns the topic with its units in seq order.
+func (s *ContentService) GetTopic(ctx context.Context, id int) (*ent.Topic, error) {
+	return s.client.Topic.Query().
+		Where(topic.ID(id)).
+		WithUnits(func(uq *ent.UnitQuery) {
+			uq.Order(ent.Asc(unit.FieldSeq))
+		}).
+		Only(ctx)
+}
+
+func (s *ContentService) UpdateTopic(ctx context.Context, id int, in TopicInput) (*ent.Topic, error) {
+	var updated *ent.Topic
+	err := dbtx.WithTx(ctx, s.client, func(tx *ent.Tx) error {
+		var err error
+		updated, err = tx.Topic.UpdateOneID(id).
+			SetTitle(in.Title).
+			Save(ctx)
+		if err != nil {
+			return fmt.Errorf("updating topic %d: %w", id, err)
+		}
+		return nil
+	})
+	return updated, err
+}
+
+// DeleteTopic deletes the topic and every unit below it.
+func (s *ContentService) DeleteTopic(ctx context.Context, id int) error {
+	return dbtx.WithTx(ctx, s.client, func(tx *ent.Tx) error {
+		p, err := parentOf(ctx, tx.Client(), KindTopic, id)
+		if err != nil {
+			return err
+		}
+		if err := deleteTopic(ctx, tx, id); err != nil {
+			return err
+		}
+		return normalize(ctx, tx.Client(), p)
+	})
+}
+
+// --- Unit ---
+
+func (s *ContentService) CreateUnit(ctx context.Context, in UnitInput) (*ent.Unit, error) {
+	var created *ent.Unit
+	err := dbtx.WithTx(ctx, s.client, func(tx *ent.Tx) error {
+		var err error
+		created, err = tx.Unit.Create().
+			SetExamID(in.ExamID).
+			SetNillableSectionID(in.SectionID).
+			SetNillableTopicID(in.TopicID).
+			SetTitle(in.Title).
+			SetSeq(in.Seq).
+			Save(ctx)
+		if err != nil {
+			return fmt.Errorf("creating unit: %w", err)
+		}
+		if err := asError(checkUnits(ctx, tx.Client(), unit.ID(created.ID))); err != nil {
+			return err
+		}
+		if err := placeNew(ctx, tx.Client(), KindUnit, created.ID, in.Seq); err != nil {
+			return err
+		}
+		created, err = tx.Unit.Get(ctx, created.ID)
+		return err
+	})
+	return created, err
+}
+
+// GetUnit returns the unit with its problems.
+func (s *ContentService) GetUnit(ctx context.Context, id int) (*ent.Unit, error) {
+	return s.client.Unit.Query().
+		Where(unit.ID(id)).
+		WithProblems().
+		Only(ctx)
+}
+
+func (s *ContentService) UpdateUnit(ctx context.Context, id int, in UnitInput) (*ent.Unit, error) {
+	var updated *ent.Unit
+	err := dbtx.WithTx(ctx, s.client, func(tx *ent.Tx) error {
+		var err error
+		updated, err = tx.Unit.UpdateOneID(id).
+			SetTitle(in.Title).
+			Save(ctx)
+		if err != nil {
+			return fmt.Errorf("updating unit %d: %w", id, err)
+		}
+		return nil
+	})
+	return updated, err
+}
+
+// DeleteUnit deletes the unit and all of its problems.
+func (s *ContentService) DeleteUnit(ctx context.Context, id int) error {
+	return dbtx.WithTx(ctx, s.client, func(tx *ent.Tx) error {
+		p, err := parentOf(ctx, tx.Client(), KindUnit, id)
+		if err != nil {
+			return err
+		}
+		if err := deleteUnits(ctx, tx, unit.ID(id)); err != nil {
+			return err
+		}
+		return normalize(ctx, tx.Client(), p)
+	})
 }
@@ -0,0 +1,146 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+
+	"examination/internal/ent"
+	"examination/internal/features/content/service"
+	"examination/internal/testutil"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// seedTree creates an exam with one section holding a topic (with one unit)
+// and a direct unit, each unit carrying one problem with a translation.
+func seedTree(t *testing.T, svc *service.ContentService) *ent.Exam {
+	ctx := context.Background()
+
+	e, err := svc.CreateExam(ctx, service.ExamInput{Title: "Exam", TimeLimit: 30, IsActive: true})
+	require.NoError(t, err)
+	s, err := svc.CreateSection(ctx, service.SectionInput{ExamID: e.ID, Title: "Section", Seq: 1})
+	require.NoError(t, err)
+	tp, err := svc.CreateTopic(ctx, service.TopicInput{ExamID: e.ID, SectionID: &s.ID, Title: "Topic", Seq: 1})
+	require.NoError(t, err)
+
+	units := []service.UnitInput{
+		{ExamID: e.ID, TopicID: &tp.ID, Title: "Topic Unit", Seq: 1},
+		{ExamID: e.ID, SectionID: &s.ID, Title: "Section Unit", Seq: 2},
+	}
+	for _, in := range units {
+		u, err := svc.CreateUnit(ctx, in)
+		require.NoError(t, err)
+		p, err := svc.CreateProblem(ctx, service.ProblemInput{UnitID: u.ID, Difficulty: 1})
+		require.NoError(t, err)
+		_, err = svc.CreateProblemTranslation(ctx, service.ProblemTranslationInput{
+			ProblemID: p.ID,
+			Locale:    "en",
+			Title:     "Question",
+			Content:   "Pick one.",
+			Choices: []service.ChoiceInput{
+				{Content: "A", IsCorrect: true, Seq: 1},
+				{Content: "B", Seq: 2},
+			},
+		})
+		require.NoError(t, err)
+	}
+	return e
+}
+
+func TestContentService_CreateAndGet(t *testing.T) {
+	ctx := context.Background()
+	client := testutil.NewClient(t)
+	svc := service.NewContentService(client)
+
+	e := seedTree(t, svc)
+
+	got, err := svc.GetExam(ctx, e.ID)
+	require.NoError(t, err)
+	assert.Len(t, got.Edges.Sections, 1)
+	assert.Len(t, got.Edges.Topics, 1)
+	assert.Len(t, got.Edges.Units, 2)
+
+	p, err := svc.GetProblem(ctx, client.Problem.Query().FirstIDX(ctx))
+	require.NoError(t, err)
+	require.Len(t, p.Edges.Translations, 1)
+	assert.Len(t, p.Edges.Translations[0].Edges.Choices, 2)
+}
+
+func TestContentService_Update(t *testing.T) {
+	ctx := context.Background()
+	svc := service.NewContentService(testutil.NewClient(t))
+
+	e := seedTree(t, svc)
+
+	updated, err := svc.UpdateExam(ctx, e.ID, service.ExamInput{Title: "Renamed", TimeLimit: 45})
+	require.NoError(t, err)
+	assert.Equal(t, "Renamed", updated.Title)
+	assert.Equal(t, 45, updated.TimeLimit)
+	assert.False(t, updated.IsActive)
+
+	_, err = svc.UpdateExam(ctx, e.ID+100, service.ExamInput{Title: "Missing"})
+	assert.True(t, ent.IsNotFound(err))
+}
+
+func TestContentService_DeleteExamCascades(t *testing.T) {
+	ctx := context.Background()
+	client := testutil.NewClient(t)
+	svc := service.NewContentService(client)
+
+	e := seedTree(t, svc)
+	other := seedTree(t, svc)
+
+	require.NoError(t, svc.DeleteExam(ctx, e.ID))
+
+	assert.Equal(t, 1, client.Exam.Query().CountX(ctx))
+	assert.Equal(t, 1, client.Section.Query().CountX(ctx))
+	assert.Equal(t, 1, client.Topic.Query().CountX(ctx))
+	assert.Equal(t, 2, client.Unit.Query().CountX(ctx))
+	assert.Equal(t, 2, client.Problem.Query().CountX(ctx))
+	assert.Equal(t, 2, client.ProblemTranslation.Query().CountX(ctx))
+	assert.Equal(t, 4, client.Choice.Query().CountX(ctx))
+
+	_, err := svc.GetExam(ctx, other.ID)
+	assert.NoError(t, err)
+}
+
+func TestContentService_DeleteSectionCascades(t *testing.T) {
+	ctx := context.Background()
+	client := testutil.NewClient(t)
+	svc := service.NewContentService(client)
+
+	e := seedTree(t, svc)
+	s := client.Section.Query().FirstX(ctx)
+
+	require.NoError(t, svc.DeleteSection(ctx, s.ID))
+
+	assert.Zero(t, client.Topic.Query().CountX(ctx))
+	assert.Zero(t, client.Unit.Query().CountX(ctx))
+	assert.Zero(t, client.Choice.Query().CountX(ctx))
+
+	_, err := svc.GetExam(ctx, e.ID)
+	assert.NoError(t, err)
+}
+
+func TestContentService_DeleteProblemDetachesVariants(t *testing.T) {
+	ctx := context.Background()
+	client := testutil.NewClient(t)
+	svc := service.NewContentService(client)
+
+	seedTree(t, svc)
+	source := client.Problem.Query().FirstX(ctx)
+	variant, err := svc.CreateProblem(ctx, service.ProblemInput{
+		UnitID:     source.UnitID,
+		Type:       "VARIANT",
+		Difficulty: 2,
+		ParentID:   &source.ID,
+	})
+	require.NoError(t, err)
+
+	require.NoError(t, svc.DeleteProblem(ctx, source.ID))
+
+	got, err := svc.GetProblem(ctx, variant.ID)
+	require.NoError(t, err)
+	assert.Nil(t, got.ParentID)
+}
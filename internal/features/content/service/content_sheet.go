@@ -0,0 +1,294 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"examination/internal/dbtx"
+	"examination/internal/ent"
+	"examination/internal/ent/choice"
+	"examination/internal/ent/problem"
+	"examination/internal/ent/problemtranslation"
+	"examination/internal/ent/unit"
+	"examination/internal/features/content/sheet"
+)
+
+var (
+	// ErrSheetMismatch is returned, joined once per problem, for sheets
+	// listing problems or choices the exam does not have.
+	ErrSheetMismatch = errors.New("translation sheet does not match the exam")
+	// ErrIncompleteTranslation is returned, joined once per problem, for
+	// problems translated in part: a title, content or choice is missing.
+	ErrIncompleteTranslation = errors.New("incomplete translation")
+)
+
+// ExportTranslations returns a sheet with every text of the exam's problems
+// in the source locale, in exam order, and empty target cells. Problems
+// without a source translation are left out.
+func (s *ContentService) ExportTranslations(ctx context.Context, examID int, source, target string) (*sheet.Sheet, error) {
+	if _, err := s.client.Exam.Get(ctx, examID); err != nil {
+		return nil, err
+	}
+	slots, err := flatten(ctx, s.client, examID, func(pq *ent.ProblemQuery) {
+		pq.WithTranslations(func(tq *ent.ProblemTranslationQuery) {
+			tq.Where(problemtranslation.Locale(source))
+			withChoicesInSeq(tq)
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sh := &sheet.Sheet{Source: source, Target: target}
+	add := func(problemID, seq int, field, text string) {
+		sh.Rows = append(sh.Rows, sheet.Row{ProblemID: problemID, Seq: seq, Field: field, Source: text})
+	}
+	for _, slot := range slots {
+		for _, p := range slot.Unit.Edges.Problems {
+			if len(p.Edges.Translations) == 0 {
+				continue
+			}
+			t := p.Edges.Translations[0]
+			add(p.ID, 0, sheet.Title, t.Title)
+			add(p.ID, 0, sheet.Content, t.Content)
+			if t.Explanation != "" {
+				add(p.ID, 0, sheet.Explanation, t.Explanation)
+			}
+			for _, c := range t.Edges.Choices {
+				add(p.ID, c.Seq, sheet.Choice, c.Content)
+				if c.Explanation != "" {
+					add(p.ID, c.Seq, sheet.ChoiceExplanation, c.Explanation)
+				}
+			}
+		}
+	}
+	return sh, nil
+}
+
+// ImportTranslations creates or updates the target-locale translations of
+// the sheet in a single transaction. Choices take their seq and is_correct
+// from the source locale; the choices of an existing translation are
+// matched by position. Problems whose target cells are all empty are left
+// untouched. Sheets listing problems outside the exam, or choices other
+// than those of the source translation, are refused as a whole.
+func (s *ContentService) ImportTranslations(ctx context.Context, examID int, sh *sheet.Sheet) (ImportStats, error) {
+	var stats ImportStats
+	err := dbtx.WithTx(ctx, s.client, func(tx *ent.Tx) error {
+		// Group the rows by problem, keeping the order of the sheet
+		var ids []int
+		rows := make(map[int][]sheet.Row)
+		for _, r := range sh.Rows {
+			if _, ok := rows[r.ProblemID]; !ok {
+				ids = append(ids, r.ProblemID)
+			}
+			rows[r.ProblemID] = append(rows[r.ProblemID], r)
+		}
+
+		problems, err := tx.Problem.Query().
+			Where(problem.IDIn(ids...), problem.HasUnitWith(unit.ExamID(examID))).
+			WithTranslations(func(tq *ent.ProblemTranslationQuery) {
+				tq.Where(problemtranslation.LocaleIn(sh.Source, sh.Target))
+				withChoicesInSeq(tq)
+			}).
+			All(ctx)
+		if err != nil {
+			return fmt.Errorf("querying problems: %w", err)
+		}
+		byID := make(map[int]*ent.Problem, len(problems))
+		for _, p := range problems {
+			byID[p.ID] = p
+		}
+
+		var (
+			errs []error
+			plan []translationUpdate
+		)
+		for _, id := range ids {
+			u, err := planTranslation(byID[id], id, examID, sh, rows[id])
+			if err != nil {
+				errs = append(errs, err)
+			} else if u != nil {
+				plan = append(plan, *u)
+			}
+		}
+		if err := errors.Join(errs...); err != nil {
+			return err
+		}
+
+		var touched []int
+		for _, u := range plan {
+			id, err := u.apply(ctx, tx, sh.Target, &stats)
+			if err != nil {
+				return err
+			}
+			touched = append(touched, id)
+		}
+		return asError(checkTranslations(ctx, tx.Client(), problemtranslation.IDIn(touched...)))
+	})
+	if err != nil {
+		return ImportStats{}, err
+	}
+	return stats, nil
+}
+
+// translationUpdate is the target translation of one problem as the sheet
+// describes it.
+type translationUpdate struct {
+	problemID int
+	source    *ent.ProblemTranslation
+	// target is nil when the problem has no translation in the target locale.
+	target *ent.ProblemTranslation
+
+	title, content, explanation string
+	// choices and explanations are in the order of the source choices.
+	choices, explanations []string
+}
+
+// planTranslation checks the rows of one problem against the exam. It
+// returns nil when no target cell is filled in.
+func planTranslation(p *ent.Problem, id, examID int, sh *sheet.Sheet, rows []sheet.Row) (*translationUpdate, error) {
+	if p == nil {
+		return nil, fmt.Errorf("%w: problem %d is not in exam %d", ErrSheetMismatch, id, examID)
+	}
+	u := &translationUpdate{problemID: id}
+	for _, t := range p.Edges.Translations {
+		if t.Locale == sh.Source {
+			u.source = t
+		} else {
+			u.target = t
+		}
+	}
+	if u.source == nil {
+		return nil, fmt.Errorf("%w: problem %d has no %q translation", ErrSheetMismatch, id, sh.Source)
+	}
+
+	choices := u.source.Edges.Choices
+	u.choices = make([]string, len(choices))
+	u.explanations = make([]string, len(choices))
+	listed := 0
+	filled := false
+	for _, r := range rows {
+		filled = filled || r.Target != ""
+		i := 0
+		if r.Seq > 0 {
+			i = slices.IndexFunc(choices, func(c *ent.Choice) bool { return c.Seq == r.Seq })
+			if i < 0 {
+				return nil, fmt.Errorf("%w: problem %d has no choice %d in %q", ErrSheetMismatch, id, r.Seq, sh.Source)
+			}
+		}
+		switch r.Field {
+		case sheet.Title:
+			u.title = r.Target
+		case sheet.Content:
+			u.content = r.Target
+		case sheet.Explanation:
+			u.explanation = r.Target
+		case sheet.Choice:
+			u.choices[i] = r.Target
+			listed++
+		case sheet.ChoiceExplanation:
+			u.explanations[i] = r.Target
+		}
+	}
+	if listed != len(choices) {
+		return nil, fmt.Errorf("%w: problem %d has %d choices in %q but the sheet lists %d",
+			ErrSheetMismatch, id, len(choices), sh.Source, listed)
+	}
+	if !filled {
+		return nil, nil
+	}
+
+	var missing []string
+	if u.title == "" {
+		missing = append(missing, "title")
+	}
+	if u.content == "" {
+		missing = append(missing, "content")
+	}
+	for i, c := range u.choices {
+		if c == "" {
+			missing = append(missing, fmt.Sprintf("choice %d", choices[i].Seq))
+		}
+	}
+	if len(missing) > 0 {
+		return nil, fmt.Errorf("%w: problem %d is missing %v in %q", ErrIncompleteTranslation, id, missing, sh.Target)
+	}
+	return u, nil
+}
+
+// apply writes the translation and returns its ID.
+func (u translationUpdate) apply(ctx context.Context, tx *ent.Tx, locale string, stats *ImportStats) (int, error) {
+	source := u.source.Edges.Choices
+	if u.target == nil {
+		in := ProblemTranslationInput{
+			ProblemID:   u.problemID,
+			Locale:      locale,
+			Title:       u.title,
+			Content:     u.content,
+			Explanation: u.explanation,
+		}
+		for i, c := range source {
+			in.Choices = append(in.Choices, ChoiceInput{
+				Content:     u.choices[i],
+				IsCorrect:   c.IsCorrect,
+				Explanation: u.explanations[i],
+				Seq:         c.Seq,
+			})
+		}
+		created, err := createProblemTranslation(ctx, tx, in)
+		if err != nil {
+			return 0, err
+		}
+		stats.Created += 1 + len(source)
+		return created.ID, nil
+	}
+
+	t := u.target
+	err := t.Update().
+		SetTitle(u.title).
+		SetContent(u.content).
+		SetExplanation(u.explanation).
+		Exec(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("updating translation %d: %w", t.ID, err)
+	}
+	stats.Updated++
+	existing := t.Edges.Choices
+	for i, c := range source {
+		if i < len(existing) {
+			err = existing[i].Update().
+				SetContent(u.choices[i]).
+				SetIsCorrect(c.IsCorrect).
+				SetExplanation(u.explanations[i]).
+				SetSeq(c.Seq).
+				Exec(ctx)
+			stats.Updated++
+		} else {
+			err = tx.Choice.Create().
+				SetProblemTranslationID(t.ID).
+				SetContent(u.choices[i]).
+				SetIsCorrect(c.IsCorrect).
+				SetExplanation(u.explanations[i]).
+				SetSeq(c.Seq).
+				Exec(ctx)
+			stats.Created++
+		}
+		if err != nil {
+			return 0, fmt.Errorf("importing choice %d of translation %d: %w", c.Seq, t.ID, err)
+		}
+	}
+	if len(existing) > len(source) {
+		var ids []int
+		for _, c := range existing[len(source):] {
+			ids = append(ids, c.ID)
+		}
+		n, err := tx.Choice.Delete().Where(choice.IDIn(ids...)).Exec(ctx)
+		if err != nil {
+			return 0, fmt.Errorf("deleting choices: %w", err)
+		}
+		stats.Deleted += n
+	}
+	return t.ID, nil
+}
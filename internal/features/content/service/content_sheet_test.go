@@ -0,0 +1,129 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+
+	"examination/internal/ent/problem"
+	"examination/internal/ent/problemtranslation"
+	"examination/internal/features/content/service"
+	"examination/internal/features/content/sheet"
+	"examination/internal/testutil"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// translate fills in every target cell of the sheet.
+func translate(sh *sheet.Sheet, prefix string) {
+	for i := range sh.Rows {
+		sh.Rows[i].Target = prefix + sh.Rows[i].Source
+	}
+}
+
+func TestContentService_TranslationSheet(t *testing.T) {
+	ctx := context.Background()
+	client := testutil.NewClient(t)
+	svc := service.NewContentService(client)
+
+	e := seedTree(t, svc)
+	first := client.Problem.Query().Order(problem.ByID()).FirstX(ctx)
+
+	sh, err := svc.ExportTranslations(ctx, e.ID, "en", "ko")
+	require.NoError(t, err)
+	assert.Equal(t, []sheet.Row{
+		{ProblemID: first.ID, Field: sheet.Title, Source: "Question"},
+		{ProblemID: first.ID, Field: sheet.Content, Source: "Pick one."},
+		{ProblemID: first.ID, Seq: 1, Field: sheet.Choice, Source: "A"},
+		{ProblemID: first.ID, Seq: 2, Field: sheet.Choice, Source: "B"},
+	}, sh.Rows[:4])
+	require.Len(t, sh.Rows, 8)
+
+	// Only the first problem is translated; the other is left alone
+	translate(sh, "ko ")
+	for i := 4; i < len(sh.Rows); i++ {
+		sh.Rows[i].Target = ""
+	}
+	stats, err := svc.ImportTranslations(ctx, e.ID, sh)
+	require.NoError(t, err)
+	assert.Equal(t, service.ImportStats{Created: 3}, stats)
+
+	ko := client.ProblemTranslation.Query().
+		Where(problemtranslation.Locale("ko")).
+		WithChoices().
+		OnlyX(ctx)
+	assert.Equal(t, first.ID, ko.ProblemID)
+	assert.Equal(t, "ko Question", ko.Title)
+	require.Len(t, ko.Edges.Choices, 2)
+	for _, c := range ko.Edges.Choices {
+		assert.Equal(t, c.Seq == 1, c.IsCorrect)
+		assert.Equal(t, map[int]string{1: "ko A", 2: "ko B"}[c.Seq], c.Content)
+	}
+
+	// Re-importing updates in place
+	sh.Rows[0].Target = "제목"
+	stats, err = svc.ImportTranslations(ctx, e.ID, sh)
+	require.NoError(t, err)
+	assert.Equal(t, service.ImportStats{Updated: 3}, stats)
+	assert.Equal(t, "제목", client.ProblemTranslation.GetX(ctx, ko.ID).Title)
+}
+
+func TestContentService_ImportTranslationsRefusesMismatches(t *testing.T) {
+	ctx := context.Background()
+	client := testutil.NewClient(t)
+	svc := service.NewContentService(client)
+
+	e := seedTree(t, svc)
+	other, err := svc.CreateExam(ctx, service.ExamInput{Title: "Other"})
+	require.NoError(t, err)
+
+	export := func() *sheet.Sheet {
+		sh, err := svc.ExportTranslations(ctx, e.ID, "en", "ko")
+		require.NoError(t, err)
+		translate(sh, "ko ")
+		return sh
+	}
+	tests := map[string]struct {
+		edit   func(sh *sheet.Sheet)
+		examID int
+		want   error
+	}{
+		"other exam": {examID: other.ID, want: service.ErrSheetMismatch},
+		"unknown problem": {
+			edit: func(sh *sheet.Sheet) { sh.Rows[0].ProblemID += 100 },
+			want: service.ErrSheetMismatch,
+		},
+		"missing choice": {
+			edit: func(sh *sheet.Sheet) { sh.Rows = append(sh.Rows[:3], sh.Rows[4:]...) },
+			want: service.ErrSheetMismatch,
+		},
+		"extra choice": {
+			edit: func(sh *sheet.Sheet) {
+				extra := sh.Rows[3]
+				extra.Seq = 3
+				sh.Rows = append(sh.Rows, extra)
+			},
+			want: service.ErrSheetMismatch,
+		},
+		"empty choice": {
+			edit: func(sh *sheet.Sheet) { sh.Rows[2].Target = "" },
+			want: service.ErrIncompleteTranslation,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			sh := export()
+			if tt.edit != nil {
+				tt.edit(sh)
+			}
+			examID := e.ID
+			if tt.examID != 0 {
+				examID = tt.examID
+			}
+			_, err := svc.ImportTranslations(ctx, examID, sh)
+			assert.ErrorIs(t, err, tt.want)
+			// Nothing is written, not even the problems that match
+			assert.Zero(t, client.ProblemTranslation.Query().Where(problemtranslation.Locale("ko")).CountX(ctx))
+		})
+	}
+}
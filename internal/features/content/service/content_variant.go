@@ -0,0 +1,282 @@
+package service
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+
+	"examination/internal/dbtx"
+	"examination/internal/ent"
+	"examination/internal/ent/choice"
+	"examination/internal/ent/problem"
+	"examination/internal/ent/problemtranslation"
+)
+
+var (
+	// ErrNotSource is returned when cloning a problem that is itself a variant.
+	ErrNotSource = errors.New("problem is not a SOURCE problem")
+	// ErrNoParent is returned when diffing a problem that has no parent.
+	ErrNoParent = errors.New("problem has no parent")
+)
+
+// ChangeKind tells how a field of a variant differs from its parent.
+type ChangeKind string
+
+const (
+	ChangeModified ChangeKind = "modified"
+	// ChangeAdded marks a translation or choice only the variant has.
+	ChangeAdded ChangeKind = "added"
+	// ChangeRemoved marks a translation or choice only the parent has.
+	ChangeRemoved ChangeKind = "removed"
+)
+
+// Change is a single field-level difference between a variant and its parent.
+// Field is a path such as "difficulty", "translations[en].title" or
+// "translations[en].choices[2].is_correct"; choices are matched by seq.
+type Change struct {
+	Field   string     `json:"field"`
+	Kind    ChangeKind `json:"kind"`
+	Parent  string     `json:"parent,omitempty"`
+	Variant string     `json:"variant,omitempty"`
+}
+
+// VariantNode is a problem in a variant tree, with its differences from
+// its parent. The root is the SOURCE problem and has no changes.
+type VariantNode struct {
+	Problem  *ent.Problem   `json:"problem"`
+	Changes  []Change       `json:"changes,omitempty"`
+	Children []*VariantNode `json:"children,omitempty"`
+}
+
+// CloneVariant copies a SOURCE problem into a new VARIANT in the same unit,
+// with all its translations and choices. The variant's parent is the source.
+func (s *ContentService) CloneVariant(ctx context.Context, sourceID int) (*ent.Problem, error) {
+	var created *ent.Problem
+	err := dbtx.WithTx(ctx, s.client, func(tx *ent.Tx) error {
+		src, err := tx.Problem.Query().
+			Where(problem.ID(sourceID)).
+			WithTranslations(withChoicesInSeq).
+			Only(ctx)
+		if err != nil {
+			return err
+		}
+		if src.Type != problem.TypeSOURCE {
+			return fmt.Errorf("cloning problem %d: %w", sourceID, ErrNotSource)
+		}
+
+		created, err = createProblem(ctx, tx, ProblemInput{
+			UnitID:      src.UnitID,
+			Type:        problem.TypeVARIANT,
+			Difficulty:  src.Difficulty,
+			Interaction: src.Interaction,
+			Tolerance:   src.Tolerance,
+			TextMatch:   src.TextMatch,
+			ParentID:    &src.ID,
+		})
+		if err != nil {
+			return err
+		}
+		return copyTranslations(ctx, tx, src, created.ID)
+	})
+	return created, err
+}
+
+// copyTranslations copies the translations and choices of src, loaded with
+// withChoicesInSeq, to the problem dstID.
+func copyTranslations(ctx context.Context, tx *ent.Tx, src *ent.Problem, dstID int) error {
+	for _, t := range src.Edges.Translations {
+		in := ProblemTranslationInput{
+			ProblemID:   dstID,
+			Locale:      t.Locale,
+			Title:       t.Title,
+			Content:     t.Content,
+			Explanation: t.Explanation,
+		}
+		for _, c := range t.Edges.Choices {
+			in.Choices = append(in.Choices, ChoiceInput{
+				Content:     c.Content,
+				IsCorrect:   c.IsCorrect,
+				Explanation: c.Explanation,
+				Seq:         c.Seq,
+			})
+		}
+		if _, err := createProblemTranslation(ctx, tx, in); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// VariantTree returns the whole lineage the problem belongs to, rooted at
+// its SOURCE problem, with each variant diffed against its parent.
+func (s *ContentService) VariantTree(ctx context.Context, problemID int) (*VariantNode, error) {
+	root, err := s.client.Problem.Get(ctx, problemID)
+	if err != nil {
+		return nil, err
+	}
+	seen := map[int]bool{root.ID: true}
+	for root.ParentID != nil {
+		if root, err = s.client.Problem.Get(ctx, *root.ParentID); err != nil {
+			return nil, fmt.Errorf("querying parent: %w", err)
+		}
+		if seen[root.ID] {
+			return nil, fmt.Errorf("problem %d: variant lineage has a cycle", problemID)
+		}
+		seen[root.ID] = true
+	}
+	return s.variantNode(ctx, root, nil)
+}
+
+func (s *ContentService) variantNode(ctx context.Context, p, parent *ent.Problem) (*VariantNode, error) {
+	p, err := s.client.Problem.Query().
+		Where(problem.ID(p.ID)).
+		WithTranslations(withChoicesInSeq).
+		WithChildren(func(pq *ent.ProblemQuery) {
+			pq.Order(ent.Asc(problem.FieldID))
+		}).
+		Only(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("querying problem: %w", err)
+	}
+
+	node := &VariantNode{Problem: p}
+	if parent != nil {
+		node.Changes = diffProblems(parent, p)
+	}
+	for _, child := range p.Edges.Children {
+		n, err := s.variantNode(ctx, child, p)
+		if err != nil {
+			return nil, err
+		}
+		node.Children = append(node.Children, n)
+	}
+	// Children are exposed as nodes; drop the raw edge from the payload
+	p.Edges.Children = nil
+	return node, nil
+}
+
+// DiffVariant returns the field-level differences of a variant from its parent.
+func (s *ContentService) DiffVariant(ctx context.Context, variantID int) ([]Change, error) {
+	v, err := s.client.Problem.Query().
+		Where(problem.ID(variantID)).
+		WithTranslations(withChoicesInSeq).
+		WithParent(func(pq *ent.ProblemQuery) {
+			pq.WithTranslations(withChoicesInSeq)
+		}).
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if v.Edges.Parent == nil {
+		return nil, fmt.Errorf("diffing problem %d: %w", variantID, ErrNoParent)
+	}
+	return diffProblems(v.Edges.Parent, v), nil
+}
+
+func withChoicesInSeq(ptq *ent.ProblemTranslationQuery) {
+	ptq.Order(ent.Asc(problemtranslation.FieldLocale)).
+		WithChoices(func(cq *ent.ChoiceQuery) {
+			cq.Order(ent.Asc(choice.FieldSeq))
+		})
+}
+
+// diffProblems compares two problems with their translations and choices loaded.
+func diffProblems(parent, variant *ent.Problem) []Change {
+	var cs []Change
+	if parent.Difficulty != variant.Difficulty {
+		cs = append(cs, modified("difficulty", strconv.Itoa(parent.Difficulty), strconv.Itoa(variant.Difficulty)))
+	}
+	cs = appendIfChanged(cs, "interaction", parent.Interaction.String(), variant.Interaction.String())
+	if parent.Tolerance != variant.Tolerance {
+		cs = append(cs, modified("tolerance", formatFloat(parent.Tolerance), formatFloat(variant.Tolerance)))
+	}
+	cs = appendIfChanged(cs, "text_match", parent.TextMatch.String(), variant.TextMatch.String())
+
+	pt := translationsByLocale(parent)
+	vt := translationsByLocale(variant)
+	for _, locale := range unionKeys(pt, vt) {
+		path := fmt.Sprintf("translations[%s]", locale)
+		p, v := pt[locale], vt[locale]
+		switch {
+		case v == nil:
+			cs = append(cs, Change{Field: path, Kind: ChangeRemoved, Parent: p.Title})
+		case p == nil:
+			cs = append(cs, Change{Field: path, Kind: ChangeAdded, Variant: v.Title})
+		default:
+			cs = appendIfChanged(cs, path+".title", p.Title, v.Title)
+			cs = appendIfChanged(cs, path+".content", p.Content, v.Content)
+			cs = appendIfChanged(cs, path+".explanation", p.Explanation, v.Explanation)
+			cs = append(cs, diffChoices(path, p.Edges.Choices, v.Edges.Choices)...)
+		}
+	}
+	return cs
+}
+
+func diffChoices(path string, parent, variant []*ent.Choice) []Change {
+	pc := make(map[int]*ent.Choice, len(parent))
+	for _, c := range parent {
+		pc[c.Seq] = c
+	}
+	vc := make(map[int]*ent.Choice, len(variant))
+	for _, c := range variant {
+		vc[c.Seq] = c
+	}
+
+	var cs []Change
+	for _, seq := range unionKeys(pc, vc) {
+		cpath := fmt.Sprintf("%s.choices[%d]", path, seq)
+		p, v := pc[seq], vc[seq]
+		switch {
+		case v == nil:
+			cs = append(cs, Change{Field: cpath, Kind: ChangeRemoved, Parent: p.Content})
+		case p == nil:
+			cs = append(cs, Change{Field: cpath, Kind: ChangeAdded, Variant: v.Content})
+		default:
+			cs = appendIfChanged(cs, cpath+".content", p.Content, v.Content)
+			cs = appendIfChanged(cs, cpath+".is_correct", strconv.FormatBool(p.IsCorrect), strconv.FormatBool(v.IsCorrect))
+			cs = appendIfChanged(cs, cpath+".explanation", p.Explanation, v.Explanation)
+		}
+	}
+	return cs
+}
+
+func translationsByLocale(p *ent.Problem) map[string]*ent.ProblemTranslation {
+	m := make(map[string]*ent.ProblemTranslation, len(p.Edges.Translations))
+	for _, t := range p.Edges.Translations {
+		m[t.Locale] = t
+	}
+	return m
+}
+
+func modified(field, parent, variant string) Change {
+	return Change{Field: field, Kind: ChangeModified, Parent: parent, Variant: variant}
+}
+
+func formatFloat(f float64) string {
+	return strconv.FormatFloat(f, 'g', -1, 64)
+}
+
+func appendIfChanged(cs []Change, field, parent, variant string) []Change {
+	if parent == variant {
+		return cs
+	}
+	return append(cs, modified(field, parent, variant))
+}
+
+// unionKeys returns the keys of both maps in ascending order.
+func unionKeys[K cmp.Ordered, V any](a, b map[K]V) []K {
+	keys := make([]K, 0, len(a)+len(b))
+	for k := range a {
+		keys = append(keys, k)
+	}
+	for k := range b {
+		if _, ok := a[k]; !ok {
+			keys = append(keys, k)
+		}
+	}
+	slices.Sort(keys)
+	return keys
+}
@@ -0,0 +1,83 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+
+	"examination/internal/ent/problem"
+	"examination/internal/ent/problemtranslation"
+	"examination/internal/features/content/service"
+	"examination/internal/testutil"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestContentService_CloneVariant(t *testing.T) {
+	ctx := context.Background()
+	client := testutil.NewClient(t)
+	svc := service.NewContentService(client)
+
+	seedTree(t, svc)
+	src := client.Problem.Query().FirstX(ctx)
+
+	v, err := svc.CloneVariant(ctx, src.ID)
+	require.NoError(t, err)
+	assert.Equal(t, problem.TypeVARIANT, v.Type)
+	assert.Equal(t, src.ID, *v.ParentID)
+	assert.Equal(t, src.UnitID, v.UnitID)
+
+	got, err := svc.GetProblem(ctx, v.ID)
+	require.NoError(t, err)
+	require.Len(t, got.Edges.Translations, 1)
+	assert.Len(t, got.Edges.Translations[0].Edges.Choices, 2)
+
+	changes, err := svc.DiffVariant(ctx, v.ID)
+	require.NoError(t, err)
+	assert.Empty(t, changes, "a fresh clone equals its source")
+
+	_, err = svc.CloneVariant(ctx, v.ID)
+	assert.ErrorIs(t, err, service.ErrNotSource)
+	_, err = svc.DiffVariant(ctx, src.ID)
+	assert.ErrorIs(t, err, service.ErrNoParent)
+}
+
+func TestContentService_VariantTreeAndDiff(t *testing.T) {
+	ctx := context.Background()
+	client := testutil.NewClient(t)
+	svc := service.NewContentService(client)
+
+	seedTree(t, svc)
+	src := client.Problem.Query().FirstX(ctx)
+	v1, err := svc.CloneVariant(ctx, src.ID)
+	require.NoError(t, err)
+	v2, err := svc.CloneVariant(ctx, src.ID)
+	require.NoError(t, err)
+
+	// Edit the first variant
+	_, err = svc.UpdateProblem(ctx, v1.ID, service.ProblemInput{Difficulty: 3})
+	require.NoError(t, err)
+	tr := client.ProblemTranslation.Query().Where(problemtranslation.ProblemID(v1.ID)).OnlyX(ctx)
+	_, err = svc.UpdateProblemTranslation(ctx, tr.ID, service.ProblemTranslationInput{Title: "Reworded", Content: tr.Content})
+	require.NoError(t, err)
+	_, err = svc.CreateChoice(ctx, service.ChoiceInput{ProblemTranslationID: tr.ID, Content: "C"})
+	require.NoError(t, err)
+
+	changes, err := svc.DiffVariant(ctx, v1.ID)
+	require.NoError(t, err)
+	assert.Equal(t, []service.Change{
+		{Field: "difficulty", Kind: service.ChangeModified, Parent: "1", Variant: "3"},
+		{Field: "translations[en].title", Kind: service.ChangeModified, Parent: "Question", Variant: "Reworded"},
+		{Field: "translations[en].choices[3]", Kind: service.ChangeAdded, Variant: "C"},
+	}, changes)
+
+	// The tree is the same from any member of the lineage
+	tree, err := svc.VariantTree(ctx, v2.ID)
+	require.NoError(t, err)
+	assert.Equal(t, src.ID, tree.Problem.ID)
+	require.Len(t, tree.Children, 2)
+	assert.Equal(t, v1.ID, tree.Children[0].Problem.ID)
+	assert.Len(t, tree.Children[0].Changes, 3)
+	assert.Equal(t, v2.ID, tree.Children[1].Problem.ID)
+	assert.Empty(t, tree.Children[1].Changes)
+}
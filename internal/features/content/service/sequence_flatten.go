@@ -0,0 +1,112 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"examination/internal/ent"
+	"examination/internal/ent/problem"
+	"examination/internal/ent/section"
+	"examination/internal/ent/topic"
+	"examination/internal/ent/unit"
+)
+
+// Slot is a unit in flattened exam order.
+// Number is the canonical 1-based question number of the unit.
+type Slot struct {
+	Number int
+	// Section is nil for units placed directly under the exam.
+	Section *ent.Section
+	// Topic is nil for units that are not grouped in a topic.
+	Topic *ent.Topic
+	// Unit has its problems loaded, ordered by ID.
+	Unit *ent.Unit
+}
+
+// Flatten walks the exam hierarchy depth-first (exam -> sections -> topics
+// -> units, each level in seq order) and returns its units in exam order.
+// The optional query functions customize how the problems of each unit are
+// loaded, e.g. to eager-load translations.
+func (l *SequenceLogic) Flatten(ctx context.Context, examID int, opts ...func(*ent.ProblemQuery)) ([]Slot, error) {
+	return flatten(ctx, l.client, examID, opts...)
+}
+
+func flatten(ctx context.Context, c *ent.Client, examID int, opts ...func(*ent.ProblemQuery)) ([]Slot, error) {
+	sections, err := c.Section.Query().Where(section.ExamID(examID)).All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("querying sections: %w", err)
+	}
+	topics, err := c.Topic.Query().Where(topic.ExamID(examID)).All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("querying topics: %w", err)
+	}
+	units, err := c.Unit.Query().
+		Where(unit.ExamID(examID)).
+		WithProblems(func(pq *ent.ProblemQuery) {
+			pq.Order(ent.Asc(problem.FieldID))
+			for _, opt := range opts {
+				opt(pq)
+			}
+		}).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("querying units: %w", err)
+	}
+
+	// Index the hierarchy by container
+	sectionByID := make(map[int]*ent.Section, len(sections))
+	topicByID := make(map[int]*ent.Topic, len(topics))
+	unitByID := make(map[int]*ent.Unit, len(units))
+	tree := make(map[Parent][]node)
+	root := Parent{Kind: KindExam, ID: examID}
+
+	for _, s := range sections {
+		sectionByID[s.ID] = s
+		tree[root] = append(tree[root], node{kind: KindSection, id: s.ID, seq: s.Seq})
+	}
+	for _, t := range topics {
+		topicByID[t.ID] = t
+		p := root
+		if t.SectionID != nil {
+			p = Parent{Kind: KindSection, ID: *t.SectionID}
+		}
+		tree[p] = append(tree[p], node{kind: KindTopic, id: t.ID, seq: t.Seq})
+	}
+	for _, u := range units {
+		unitByID[u.ID] = u
+		p := root
+		switch {
+		case u.TopicID != nil:
+			p = Parent{Kind: KindTopic, ID: *u.TopicID}
+		case u.SectionID != nil:
+			p = Parent{Kind: KindSection, ID: *u.SectionID}
+		}
+		tree[p] = append(tree[p], node{kind: KindUnit, id: u.ID, seq: u.Seq})
+	}
+	for _, nodes := range tree {
+		sortNodes(nodes)
+	}
+
+	var slots []Slot
+	var walk func(p Parent, s *ent.Section, t *ent.Topic)
+	walk = func(p Parent, s *ent.Section, t *ent.Topic) {
+		for _, n := range tree[p] {
+			switch n.kind {
+			case KindSection:
+				walk(Parent{Kind: KindSection, ID: n.id}, sectionByID[n.id], nil)
+			case KindTopic:
+				walk(Parent{Kind: KindTopic, ID: n.id}, s, topicByID[n.id])
+			case KindUnit:
+				slots = append(slots, Slot{
+					Number:  len(slots) + 1,
+					Section: s,
+					Topic:   t,
+					Unit:    unitByID[n.id],
+				})
+			}
+		}
+	}
+	walk(root, nil, nil)
+
+	return slots, nil
+}
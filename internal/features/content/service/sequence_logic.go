@@ -1,6 +1,473 @@
 package service
 
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"examination/internal/dbtx"
+	"examination/internal/ent"
+	"examination/internal/ent/choice"
+	"examination/internal/ent/problemtranslation"
+	"examination/internal/ent/section"
+	"examination/internal/ent/topic"
+	"examination/internal/ent/unit"
+)
+
+// Kind identifies an entity of the content hierarchy that carries a seq field
+// or contains such entities.
+type Kind string
+
+const (
+	KindExam        Kind = "exam"
+	KindSection     Kind = "section"
+	KindTopic       Kind = "topic"
+	KindUnit        Kind = "unit"
+	KindProblem     Kind = "problem"
+	KindTranslation Kind = "translation"
+	KindChoice      Kind = "choice"
+	KindVersionRule Kind = "version_rule"
+)
+
+// ErrInvalidMove is returned when an item cannot be placed under the requested parent.
+var ErrInvalidMove = errors.New("invalid move")
+
+// Parent identifies the container an item is ordered in.
+type Parent struct {
+	Kind Kind
+	ID   int
+}
+
+// allowedParents lists the containers each orderable kind may live in.
+var allowedParents = map[Kind][]Kind{
+	KindSection: {KindExam},
+	KindTopic:   {KindExam, KindSection},
+	KindUnit:    {KindExam, KindSection, KindTopic},
+	KindChoice:  {KindTranslation},
+}
+
 // SequenceLogic handles complex ordering logic (DFS traversal, reordering).
+//
+// Every container orders its children in a single gapless sequence starting
+// at 1. Mixed containers share that sequence: an exam orders its sections
+// together with the topics and units that have no section, and a section
+// orders its topics together with its direct units.
 type SequenceLogic struct {
-	// dependencies
+	client *ent.Client
+}
+
+func NewSequenceLogic(client *ent.Client) *SequenceLogic {
+	return &SequenceLogic{client: client}
+}
+
+// MoveUp swaps the item with its previous sibling.
+func (l *SequenceLogic) MoveUp(ctx context.Context, kind Kind, id int) error {
+	return l.moveBy(ctx, kind, id, -1)
+}
+
+// MoveDown swaps the item with its next sibling.
+func (l *SequenceLogic) MoveDown(ctx context.Context, kind Kind, id int) error {
+	return l.moveBy(ctx, kind, id, 1)
+}
+
+// MoveTo places the item at the 0-based index among its current siblings.
+// Out of range indexes are clamped.
+func (l *SequenceLogic) MoveTo(ctx context.Context, kind Kind, id int, index int) error {
+	return dbtx.WithTx(ctx, l.client, func(tx *ent.Tx) error {
+		p, err := parentOf(ctx, tx.Client(), kind, id)
+		if err != nil {
+			return err
+		}
+		return place(ctx, tx.Client(), p, node{kind: kind, id: id}, index)
+	})
+}
+
+// Move places the item at the 0-based index under another parent, e.g. a
+// Unit from one Topic to another. Both old and new siblings are renumbered
+// in the same transaction. The new parent must belong to the same exam, and
+// a choice can only move to a translation of the same problem.
+func (l *SequenceLogic) Move(ctx context.Context, kind Kind, id int, to Parent, index int) error {
+	return dbtx.WithTx(ctx, l.client, func(tx *ent.Tx) error {
+		return move(ctx, tx.Client(), kind, id, to, index)
+	})
+}
+
+func (l *SequenceLogic) moveBy(ctx context.Context, kind Kind, id int, delta int) error {
+	return dbtx.WithTx(ctx, l.client, func(tx *ent.Tx) error {
+		c := tx.Client()
+		p, err := parentOf(ctx, c, kind, id)
+		if err != nil {
+			return err
+		}
+		nodes, err := children(ctx, c, p)
+		if err != nil {
+			return err
+		}
+		target := node{kind: kind, id: id}
+		return place(ctx, c, p, target, indexOf(nodes, target)+delta)
+	})
+}
+
+func move(ctx context.Context, c *ent.Client, kind Kind, id int, to Parent, index int) error {
+	if !allowed(kind, to.Kind) {
+		return fmt.Errorf("%w: %s cannot be placed under %s", ErrInvalidMove, kind, to.Kind)
+	}
+	from, err := parentOf(ctx, c, kind, id)
+	if err != nil {
+		return err
+	}
+	if from != to {
+		check := checkSameExam
+		if kind == KindChoice {
+			check = checkSameProblem
+		}
+		if err := check(ctx, c, kind, id, to); err != nil {
+			return err
+		}
+		if err := setParent(ctx, c, kind, id, to); err != nil {
+			return err
+		}
+		if err := normalize(ctx, c, from); err != nil {
+			return err
+		}
+		if err := validateMoved(ctx, c, kind, id, from); err != nil {
+			return err
+		}
+	}
+	return place(ctx, c, to, node{kind: kind, id: id}, index)
+}
+
+// validateMoved re-checks the invariants touched by a change of parent.
+func validateMoved(ctx context.Context, c *ent.Client, kind Kind, id int, from Parent) error {
+	switch kind {
+	case KindTopic:
+		return asError(checkTopics(ctx, c, topic.ID(id)))
+	case KindUnit:
+		return asError(checkUnits(ctx, c, unit.ID(id)))
+	case KindChoice:
+		ch, err := c.Choice.Get(ctx, id)
+		if err != nil {
+			return err
+		}
+		return asError(checkTranslations(ctx, c, problemtranslation.IDIn(from.ID, ch.ProblemTranslationID)))
+	}
+	return nil
+}
+
+// placeNew positions a freshly created item. A seq of 0 appends it to its
+// siblings, any other value is treated as the desired 1-based position.
+func placeNew(ctx context.Context, c *ent.Client, kind Kind, id int, seq int) error {
+	p, err := parentOf(ctx, c, kind, id)
+	if err != nil {
+		return err
+	}
+	index := seq - 1
+	if seq <= 0 {
+		nodes, err := children(ctx, c, p)
+		if err != nil {
+			return err
+		}
+		index = len(nodes)
+	}
+	return place(ctx, c, p, node{kind: kind, id: id}, index)
+}
+
+// place moves target to the 0-based index among the children of p and
+// renumbers all of them.
+func place(ctx context.Context, c *ent.Client, p Parent, target node, index int) error {
+	nodes, err := children(ctx, c, p)
+	if err != nil {
+		return err
+	}
+	current := indexOf(nodes, target)
+	if current < 0 {
+		return fmt.Errorf("%s %d not found under %s %d", target.kind, target.id, p.Kind, p.ID)
+	}
+	target = nodes[current]
+	nodes = append(nodes[:current], nodes[current+1:]...)
+
+	index = max(0, min(index, len(nodes)))
+	nodes = append(nodes[:index], append([]node{target}, nodes[index:]...)...)
+	return renumber(ctx, c, nodes)
+}
+
+// normalize closes the gaps in the sequence of the children of p.
+func normalize(ctx context.Context, c *ent.Client, p Parent) error {
+	nodes, err := children(ctx, c, p)
+	if err != nil {
+		return err
+	}
+	return renumber(ctx, c, nodes)
+}
+
+// node is an orderable item as seen by its container.
+type node struct {
+	kind Kind
+	id   int
+	seq  int
+}
+
+// kindOrder breaks ties between different kinds sharing the same seq.
+var kindOrder = map[Kind]int{KindSection: 0, KindTopic: 1, KindUnit: 2, KindChoice: 3}
+
+func sortNodes(nodes []node) {
+	sort.SliceStable(nodes, func(i, j int) bool {
+		a, b := nodes[i], nodes[j]
+		if a.seq != b.seq {
+			return a.seq < b.seq
+		}
+		if a.kind != b.kind {
+			return kindOrder[a.kind] < kindOrder[b.kind]
+		}
+		return a.id < b.id
+	})
+}
+
+func indexOf(nodes []node, target node) int {
+	for i, n := range nodes {
+		if n.kind == target.kind && n.id == target.id {
+			return i
+		}
+	}
+	return -1
+}
+
+// renumber assigns seq 1..n in slice order, skipping rows that already match.
+func renumber(ctx context.Context, c *ent.Client, nodes []node) error {
+	for i, n := range nodes {
+		seq := i + 1
+		if n.seq == seq {
+			continue
+		}
+		var err error
+		switch n.kind {
+		case KindSection:
+			err = c.Section.UpdateOneID(n.id).SetSeq(seq).Exec(ctx)
+		case KindTopic:
+			err = c.Topic.UpdateOneID(n.id).SetSeq(seq).Exec(ctx)
+		case KindUnit:
+			err = c.Unit.UpdateOneID(n.id).SetSeq(seq).Exec(ctx)
+		case KindChoice:
+			err = c.Choice.UpdateOneID(n.id).SetSeq(seq).Exec(ctx)
+		}
+		if err != nil {
+			return fmt.Errorf("renumbering %s %d: %w", n.kind, n.id, err)
+		}
+	}
+	return nil
+}
+
+// children returns the ordered children of p.
+func children(ctx context.Context, c *ent.Client, p Parent) ([]node, error) {
+	var nodes []node
+	add := func(kind Kind, id, seq int) { nodes = append(nodes, node{kind: kind, id: id, seq: seq}) }
+
+	switch p.Kind {
+	case KindExam:
+		sections, err := c.Section.Query().Where(section.ExamID(p.ID)).All(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("querying sections: %w", err)
+		}
+		for _, s := range sections {
+			add(KindSection, s.ID, s.Seq)
+		}
+		topics, err := c.Topic.Query().Where(topic.ExamID(p.ID), topic.SectionIDIsNil()).All(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("querying topics: %w", err)
+		}
+		for _, t := range topics {
+			add(KindTopic, t.ID, t.Seq)
+		}
+		units, err := c.Unit.Query().Where(unit.ExamID(p.ID), unit.SectionIDIsNil(), unit.TopicIDIsNil()).All(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("querying units: %w", err)
+		}
+		for _, u := range units {
+			add(KindUnit, u.ID, u.Seq)
+		}
+	case KindSection:
+		topics, err := c.Topic.Query().Where(topic.SectionID(p.ID)).All(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("querying topics: %w", err)
+		}
+		for _, t := range topics {
+			add(KindTopic, t.ID, t.Seq)
+		}
+		units, err := c.Unit.Query().Where(unit.SectionID(p.ID)).All(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("querying units: %w", err)
+		}
+		for _, u := range units {
+			add(KindUnit, u.ID, u.Seq)
+		}
+	case KindTopic:
+		units, err := c.Unit.Query().Where(unit.TopicID(p.ID)).All(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("querying units: %w", err)
+		}
+		for _, u := range units {
+			add(KindUnit, u.ID, u.Seq)
+		}
+	case KindTranslation:
+		choices, err := c.Choice.Query().Where(choice.ProblemTranslationID(p.ID)).All(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("querying choices: %w", err)
+		}
+		for _, ch := range choices {
+			add(KindChoice, ch.ID, ch.Seq)
+		}
+	default:
+		return nil, fmt.Errorf("%w: %s has no children", ErrInvalidMove, p.Kind)
+	}
+
+	sortNodes(nodes)
+	return nodes, nil
+}
+
+// parentOf returns the container the item is currently ordered in.
+func parentOf(ctx context.Context, c *ent.Client, kind Kind, id int) (Parent, error) {
+	switch kind {
+	case KindSection:
+		s, err := c.Section.Get(ctx, id)
+		if err != nil {
+			return Parent{}, err
+		}
+		return Parent{Kind: KindExam, ID: s.ExamID}, nil
+	case KindTopic:
+		t, err := c.Topic.Get(ctx, id)
+		if err != nil {
+			return Parent{}, err
+		}
+		if t.SectionID != nil {
+			return Parent{Kind: KindSection, ID: *t.SectionID}, nil
+		}
+		return Parent{Kind: KindExam, ID: t.ExamID}, nil
+	case KindUnit:
+		u, err := c.Unit.Get(ctx, id)
+		if err != nil {
+			return Parent{}, err
+		}
+		switch {
+		case u.TopicID != nil:
+			return Parent{Kind: KindTopic, ID: *u.TopicID}, nil
+		case u.SectionID != nil:
+			return Parent{Kind: KindSection, ID: *u.SectionID}, nil
+		default:
+			return Parent{Kind: KindExam, ID: u.ExamID}, nil
+		}
+	case KindChoice:
+		ch, err := c.Choice.Get(ctx, id)
+		if err != nil {
+			return Parent{}, err
+		}
+		return Parent{Kind: KindTranslation, ID: ch.ProblemTranslationID}, nil
+	}
+	return Parent{}, fmt.Errorf("%w: %s is not orderable", ErrInvalidMove, kind)
+}
+
+func setParent(ctx context.Context, c *ent.Client, kind Kind, id int, to Parent) error {
+	var err error
+	switch kind {
+	case KindTopic:
+		upd := c.Topic.UpdateOneID(id)
+		if to.Kind == KindSection {
+			upd.SetSectionID(to.ID)
+		} else {
+			upd.ClearSectionID()
+		}
+		err = upd.Exec(ctx)
+	case KindUnit:
+		upd := c.Unit.UpdateOneID(id)
+		switch to.Kind {
+		case KindTopic:
+			// The Unit hook clears section_id when topic_id is set.
+			upd.SetTopicID(to.ID)
+		case KindSection:
+			upd.SetSectionID(to.ID).ClearTopicID()
+		default:
+			upd.ClearSectionID().ClearTopicID()
+		}
+		err = upd.Exec(ctx)
+	case KindChoice:
+		err = c.Choice.UpdateOneID(id).SetProblemTranslationID(to.ID).Exec(ctx)
+	default:
+		return fmt.Errorf("%w: %s cannot change parent", ErrInvalidMove, kind)
+	}
+	if err != nil {
+		return fmt.Errorf("moving %s %d to %s %d: %w", kind, id, to.Kind, to.ID, err)
+	}
+	return nil
+}
+
+// checkSameProblem keeps a choice within the translations of its problem,
+// so that the answers linking it still answer that problem.
+func checkSameProblem(ctx context.Context, c *ent.Client, _ Kind, id int, to Parent) error {
+	from, err := c.Choice.Query().Where(choice.ID(id)).QueryProblemTranslation().Only(ctx)
+	if err != nil {
+		return err
+	}
+	target, err := c.ProblemTranslation.Get(ctx, to.ID)
+	if err != nil {
+		return err
+	}
+	if from.ProblemID != target.ProblemID {
+		return fmt.Errorf("%w: choice %d belongs to problem %d, not %d", ErrInvalidMove, id, from.ProblemID, target.ProblemID)
+	}
+	return nil
+}
+
+// checkSameExam rejects moves that would take an item out of its exam.
+func checkSameExam(ctx context.Context, c *ent.Client, kind Kind, id int, to Parent) error {
+	var itemExam int
+	switch kind {
+	case KindSection:
+		s, err := c.Section.Get(ctx, id)
+		if err != nil {
+			return err
+		}
+		itemExam = s.ExamID
+	case KindTopic:
+		t, err := c.Topic.Get(ctx, id)
+		if err != nil {
+			return err
+		}
+		itemExam = t.ExamID
+	case KindUnit:
+		u, err := c.Unit.Get(ctx, id)
+		if err != nil {
+			return err
+		}
+		itemExam = u.ExamID
+	}
+
+	targetExam := to.ID
+	switch to.Kind {
+	case KindSection:
+		s, err := c.Section.Get(ctx, to.ID)
+		if err != nil {
+			return err
+		}
+		targetExam = s.ExamID
+	case KindTopic:
+		t, err := c.Topic.Get(ctx, to.ID)
+		if err != nil {
+			return err
+		}
+		targetExam = t.ExamID
+	}
+
+	if itemExam != targetExam {
+		return fmt.Errorf("%w: %s %d belongs to exam %d, not %d", ErrInvalidMove, kind, id, itemExam, targetExam)
+	}
+	return nil
+}
+
+func allowed(kind Kind, parent Kind) bool {
+	for _, k := range allowedParents[kind] {
+		if k == parent {
+			return true
+		}
+	}
+	return false
 }
@@ -0,0 +1,183 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+
+	"examination/internal/ent"
+	"examination/internal/ent/choice"
+	"examination/internal/ent/problemtranslation"
+	"examination/internal/ent/unit"
+	"examination/internal/features/content/service"
+	"examination/internal/testutil"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// unitTitles returns the titles of the units under the topic in seq order,
+// together with their seqs.
+func unitTitles(t *testing.T, client *ent.Client, topicID int) ([]string, []int) {
+	units := client.Unit.Query().Where(unit.TopicID(topicID)).Order(ent.Asc(unit.FieldSeq)).AllX(context.Background())
+	var titles []string
+	var seqs []int
+	for _, u := range units {
+		titles = append(titles, u.Title)
+		seqs = append(seqs, u.Seq)
+	}
+	return titles, seqs
+}
+
+func createUnits(t *testing.T, svc *service.ContentService, examID, topicID int, titles ...string) []*ent.Unit {
+	var units []*ent.Unit
+	for _, title := range titles {
+		u, err := svc.CreateUnit(context.Background(), service.UnitInput{ExamID: examID, TopicID: &topicID, Title: title})
+		require.NoError(t, err)
+		units = append(units, u)
+	}
+	return units
+}
+
+func TestSequenceLogic_CreateKeepsSequenceGapless(t *testing.T) {
+	ctx := context.Background()
+	client := testutil.NewClient(t)
+	svc := service.NewContentService(client)
+
+	e, err := svc.CreateExam(ctx, service.ExamInput{Title: "Exam", TimeLimit: 10})
+	require.NoError(t, err)
+	tp, err := svc.CreateTopic(ctx, service.TopicInput{ExamID: e.ID, Title: "Topic"})
+	require.NoError(t, err)
+
+	createUnits(t, svc, e.ID, tp.ID, "A", "C")
+	_, err = svc.CreateUnit(ctx, service.UnitInput{ExamID: e.ID, TopicID: &tp.ID, Title: "B", Seq: 2})
+	require.NoError(t, err)
+
+	titles, seqs := unitTitles(t, client, tp.ID)
+	assert.Equal(t, []string{"A", "B", "C"}, titles)
+	assert.Equal(t, []int{1, 2, 3}, seqs)
+
+	first := client.Unit.Query().Where(unit.Title("A")).OnlyX(ctx)
+	require.NoError(t, svc.DeleteUnit(ctx, first.ID))
+
+	titles, seqs = unitTitles(t, client, tp.ID)
+	assert.Equal(t, []string{"B", "C"}, titles)
+	assert.Equal(t, []int{1, 2}, seqs)
+}
+
+func TestSequenceLogic_MoveWithinParent(t *testing.T) {
+	ctx := context.Background()
+	client := testutil.NewClient(t)
+	svc := service.NewContentService(client)
+	seq := service.NewSequenceLogic(client)
+
+	e, err := svc.CreateExam(ctx, service.ExamInput{Title: "Exam", TimeLimit: 10})
+	require.NoError(t, err)
+	tp, err := svc.CreateTopic(ctx, service.TopicInput{ExamID: e.ID, Title: "Topic"})
+	require.NoError(t, err)
+	units := createUnits(t, svc, e.ID, tp.ID, "A", "B", "C", "D")
+
+	require.NoError(t, seq.MoveUp(ctx, service.KindUnit, units[2].ID))
+	titles, _ := unitTitles(t, client, tp.ID)
+	assert.Equal(t, []string{"A", "C", "B", "D"}, titles)
+
+	require.NoError(t, seq.MoveDown(ctx, service.KindUnit, units[3].ID), "moving the last item down is a no-op")
+	require.NoError(t, seq.MoveTo(ctx, service.KindUnit, units[3].ID, 0))
+	titles, seqs := unitTitles(t, client, tp.ID)
+	assert.Equal(t, []string{"D", "A", "C", "B"}, titles)
+	assert.Equal(t, []int{1, 2, 3, 4}, seqs)
+}
+
+func TestSequenceLogic_MoveToOtherParent(t *testing.T) {
+	ctx := context.Background()
+	client := testutil.NewClient(t)
+	svc := service.NewContentService(client)
+	seq := service.NewSequenceLogic(client)
+
+	e, err := svc.CreateExam(ctx, service.ExamInput{Title: "Exam", TimeLimit: 10})
+	require.NoError(t, err)
+	from, err := svc.CreateTopic(ctx, service.TopicInput{ExamID: e.ID, Title: "From"})
+	require.NoError(t, err)
+	to, err := svc.CreateTopic(ctx, service.TopicInput{ExamID: e.ID, Title: "To"})
+	require.NoError(t, err)
+	moved := createUnits(t, svc, e.ID, from.ID, "A", "B", "C")[0]
+	createUnits(t, svc, e.ID, to.ID, "X", "Y")
+
+	require.NoError(t, seq.Move(ctx, service.KindUnit, moved.ID, service.Parent{Kind: service.KindTopic, ID: to.ID}, 1))
+
+	titles, seqs := unitTitles(t, client, from.ID)
+	assert.Equal(t, []string{"B", "C"}, titles)
+	assert.Equal(t, []int{1, 2}, seqs)
+	titles, seqs = unitTitles(t, client, to.ID)
+	assert.Equal(t, []string{"X", "A", "Y"}, titles)
+	assert.Equal(t, []int{1, 2, 3}, seqs)
+
+	other, err := svc.CreateExam(ctx, service.ExamInput{Title: "Other", TimeLimit: 10})
+	require.NoError(t, err)
+	err = seq.Move(ctx, service.KindUnit, moved.ID, service.Parent{Kind: service.KindExam, ID: other.ID}, 0)
+	assert.ErrorIs(t, err, service.ErrInvalidMove)
+	err = seq.Move(ctx, service.KindSection, moved.ID, service.Parent{Kind: service.KindTopic, ID: to.ID}, 0)
+	assert.ErrorIs(t, err, service.ErrInvalidMove)
+}
+
+func TestSequenceLogic_MoveChoiceStaysWithinProblem(t *testing.T) {
+	ctx := context.Background()
+	client := testutil.NewClient(t)
+	svc := service.NewContentService(client)
+	seq := service.NewSequenceLogic(client)
+
+	seedTree(t, svc)
+	translations := client.ProblemTranslation.Query().Order(ent.Asc(problemtranslation.FieldID)).AllX(ctx)
+	require.Len(t, translations, 2)
+	moved := translations[0].QueryChoices().Where(choice.IsCorrect(false)).OnlyX(ctx)
+
+	// The translation of another problem is refused
+	err := seq.Move(ctx, service.KindChoice, moved.ID, service.Parent{Kind: service.KindTranslation, ID: translations[1].ID}, 0)
+	assert.ErrorIs(t, err, service.ErrInvalidMove)
+	assert.Equal(t, translations[0].ID, client.Choice.GetX(ctx, moved.ID).ProblemTranslationID)
+
+	// Another translation of the same problem is fine
+	ko, err := svc.CreateProblemTranslation(ctx, service.ProblemTranslationInput{
+		ProblemID: translations[0].ProblemID,
+		Locale:    "ko",
+		Title:     "Question",
+		Content:   "Pick one.",
+		Choices:   []service.ChoiceInput{{Content: "A", IsCorrect: true, Seq: 1}},
+	})
+	require.NoError(t, err)
+	require.NoError(t, seq.Move(ctx, service.KindChoice, moved.ID, service.Parent{Kind: service.KindTranslation, ID: ko.ID}, 1))
+	assert.Equal(t, ko.ID, client.Choice.GetX(ctx, moved.ID).ProblemTranslationID)
+}
+
+func TestSequenceLogic_Flatten(t *testing.T) {
+	ctx := context.Background()
+	client := testutil.NewClient(t)
+	svc := service.NewContentService(client)
+
+	e, err := svc.CreateExam(ctx, service.ExamInput{Title: "Exam", TimeLimit: 10})
+	require.NoError(t, err)
+	s1, err := svc.CreateSection(ctx, service.SectionInput{ExamID: e.ID, Title: "S1"})
+	require.NoError(t, err)
+	s2, err := svc.CreateSection(ctx, service.SectionInput{ExamID: e.ID, Title: "S2"})
+	require.NoError(t, err)
+
+	_, err = svc.CreateUnit(ctx, service.UnitInput{ExamID: e.ID, SectionID: &s2.ID, Title: "S2 Unit"})
+	require.NoError(t, err)
+	_, err = svc.CreateUnit(ctx, service.UnitInput{ExamID: e.ID, SectionID: &s1.ID, Title: "S1 Unit"})
+	require.NoError(t, err)
+	tp, err := svc.CreateTopic(ctx, service.TopicInput{ExamID: e.ID, SectionID: &s1.ID, Title: "T", Seq: 1})
+	require.NoError(t, err)
+	createUnits(t, svc, e.ID, tp.ID, "T Unit 1", "T Unit 2")
+
+	slots, err := service.NewSequenceLogic(client).Flatten(ctx, e.ID)
+	require.NoError(t, err)
+
+	var titles []string
+	for i, s := range slots {
+		assert.Equal(t, i+1, s.Number)
+		titles = append(titles, s.Unit.Title)
+	}
+	assert.Equal(t, []string{"T Unit 1", "T Unit 2", "S1 Unit", "S2 Unit"}, titles)
+	assert.Equal(t, tp.ID, slots[0].Topic.ID)
+	assert.Equal(t, s1.ID, slots[2].Section.ID)
+	assert.Nil(t, slots[2].Topic)
+}
@@ -1,5 +1,325 @@
 package service
 
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"examination/internal/ent"
+	"examination/internal/ent/choice"
+	"examination/internal/ent/predicate"
+	"examination/internal/ent/problem"
+	"examination/internal/ent/problemtranslation"
+	"examination/internal/ent/topic"
+	"examination/internal/ent/unit"
+	"examination/internal/ent/versionrule"
+	"examination/internal/features/content/latex"
+)
+
+// Violation codes reported by the Validator.
+const (
+	// CodeExamMismatch: an entity and its parent belong to different exams.
+	CodeExamMismatch = "exam_mismatch"
+	// CodeNoCorrectChoice: a translation has no choice marked as correct.
+	CodeNoCorrectChoice = "no_correct_choice"
+	// CodeTooFewChoices: a translation of an ORDERING problem has fewer
+	// than two choices to order.
+	CodeTooFewChoices = "too_few_choices"
+	// CodeNoAcceptedAnswer: a translation of a NUMERIC or TEXT problem has
+	// no choice holding an accepted answer.
+	CodeNoAcceptedAnswer = "no_accepted_answer"
+	// CodeInvalidAnswer: an accepted answer is not a number, or not a
+	// regular expression for a REGEX problem.
+	CodeInvalidAnswer = "invalid_answer"
+	// CodeInvalidMath: the LaTeX math of a translation or its choices is
+	// malformed, see latex.Validate.
+	CodeInvalidMath = "invalid_math"
+	// CodeInUse: an import would remove a problem that attempts use, or
+	// change how its answers are graded.
+	CodeInUse = "in_use"
+)
+
+// Violation is a single broken invariant of the content hierarchy.
+type Violation struct {
+	Kind    Kind
+	ID      int
+	Code    string
+	Message string
+}
+
+func (v Violation) String() string {
+	return fmt.Sprintf("%s %d: %s", v.Kind, v.ID, v.Message)
+}
+
+// ValidationError is returned by write operations that would break an invariant.
+type ValidationError struct {
+	Violations []Violation
+}
+
+func (e *ValidationError) Error() string {
+	msgs := make([]string, 0, len(e.Violations))
+	for _, v := range e.Violations {
+		msgs = append(msgs, v.String())
+	}
+	return "validation failed: " + strings.Join(msgs, "; ")
+}
+
 // Validator handles business logic validation for content hierarchy.
+//
+// Invariants:
+//   - a Topic belongs to the same exam as its Section
+//   - a Unit belongs to the same exam as its Topic and its Section
+//   - a problem-bound VersionRule belongs to the exam of the problem's Unit
+//   - a ProblemTranslation has the choices its problem's interaction needs:
+//     a correct one for SINGLE and MULTIPLE, two to order for ORDERING, and
+//     valid accepted answers for NUMERIC and TEXT
+//   - the LaTeX math in a ProblemTranslation and its Choices is well-formed
 type Validator struct {
+	client *ent.Client
+}
+
+func NewValidator(client *ent.Client) *Validator {
+	return &Validator{client: client}
+}
+
+// ValidateTopic checks the invariants of a single topic.
+func (v *Validator) ValidateTopic(ctx context.Context, id int) ([]Violation, error) {
+	return checkTopics(ctx, v.client, topic.ID(id))
+}
+
+// ValidateUnit checks the invariants of a single unit.
+func (v *Validator) ValidateUnit(ctx context.Context, id int) ([]Violation, error) {
+	return checkUnits(ctx, v.client, unit.ID(id))
+}
+
+// ValidateProblemTranslation checks the invariants of a single translation.
+func (v *Validator) ValidateProblemTranslation(ctx context.Context, id int) ([]Violation, error) {
+	return checkTranslations(ctx, v.client, problemtranslation.ID(id))
+}
+
+// AuditExam checks every invariant over the whole exam and returns the
+// violations ordered by kind and ID. An empty result means the exam is consistent.
+func (v *Validator) AuditExam(ctx context.Context, examID int) ([]Violation, error) {
+	var all []Violation
+
+	topics, err := checkTopics(ctx, v.client, topic.ExamID(examID))
+	if err != nil {
+		return nil, err
+	}
+	all = append(all, topics...)
+
+	units, err := checkUnits(ctx, v.client, unit.ExamID(examID))
+	if err != nil {
+		return nil, err
+	}
+	all = append(all, units...)
+
+	rules, err := checkVersionRules(ctx, v.client, versionrule.ExamID(examID))
+	if err != nil {
+		return nil, err
+	}
+	all = append(all, rules...)
+
+	translations, err := checkTranslations(ctx, v.client,
+		problemtranslation.HasProblemWith(problem.HasUnitWith(unit.ExamID(examID))))
+	if err != nil {
+		return nil, err
+	}
+	all = append(all, translations...)
+
+	sort.SliceStable(all, func(i, j int) bool {
+		if all[i].Kind != all[j].Kind {
+			return all[i].Kind < all[j].Kind
+		}
+		return all[i].ID < all[j].ID
+	})
+	return all, nil
+}
+
+// asError turns the result of a check into a *ValidationError when it
+// reports any violation, so write paths can abort their transaction.
+func asError(vs []Violation, err error) error {
+	if err != nil {
+		return err
+	}
+	if len(vs) > 0 {
+		return &ValidationError{Violations: vs}
+	}
+	return nil
+}
+
+func checkTopics(ctx context.Context, c *ent.Client, ps ...predicate.Topic) ([]Violation, error) {
+	topics, err := c.Topic.Query().Where(ps...).WithSection().All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("querying topics: %w", err)
+	}
+
+	var vs []Violation
+	for _, t := range topics {
+		if s := t.Edges.Section; s != nil && s.ExamID != t.ExamID {
+			vs = append(vs, Violation{
+				Kind:    KindTopic,
+				ID:      t.ID,
+				Code:    CodeExamMismatch,
+				Message: fmt.Sprintf("belongs to exam %d but its section %d belongs to exam %d", t.ExamID, s.ID, s.ExamID),
+			})
+		}
+	}
+	return vs, nil
+}
+
+func checkUnits(ctx context.Context, c *ent.Client, ps ...predicate.Unit) ([]Violation, error) {
+	units, err := c.Unit.Query().Where(ps...).WithSection().WithTopic().All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("querying units: %w", err)
+	}
+
+	var vs []Violation
+	for _, u := range units {
+		if s := u.Edges.Section; s != nil && s.ExamID != u.ExamID {
+			vs = append(vs, Violation{
+				Kind:    KindUnit,
+				ID:      u.ID,
+				Code:    CodeExamMismatch,
+				Message: fmt.Sprintf("belongs to exam %d but its section %d belongs to exam %d", u.ExamID, s.ID, s.ExamID),
+			})
+		}
+		if t := u.Edges.Topic; t != nil && t.ExamID != u.ExamID {
+			vs = append(vs, Violation{
+				Kind:    KindUnit,
+				ID:      u.ID,
+				Code:    CodeExamMismatch,
+				Message: fmt.Sprintf("belongs to exam %d but its topic %d belongs to exam %d", u.ExamID, t.ID, t.ExamID),
+			})
+		}
+	}
+	return vs, nil
+}
+
+func checkVersionRules(ctx context.Context, c *ent.Client, ps ...predicate.VersionRule) ([]Violation, error) {
+	rules, err := c.VersionRule.Query().
+		Where(append(ps, versionrule.ProblemIDNotNil())...).
+		WithProblem(func(pq *ent.ProblemQuery) {
+			pq.WithUnit()
+		}).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("querying version rules: %w", err)
+	}
+
+	var vs []Violation
+	for _, r := range rules {
+		p := r.Edges.Problem
+		if p == nil || p.Edges.Unit == nil || p.Edges.Unit.ExamID == r.ExamID {
+			continue
+		}
+		vs = append(vs, Violation{
+			Kind:    KindVersionRule,
+			ID:      r.ID,
+			Code:    CodeExamMismatch,
+			Message: fmt.Sprintf("belongs to exam %d but its problem %d belongs to exam %d", r.ExamID, p.ID, p.Edges.Unit.ExamID),
+		})
+	}
+	return vs, nil
+}
+
+func checkTranslations(ctx context.Context, c *ent.Client, ps ...predicate.ProblemTranslation) ([]Violation, error) {
+	translations, err := c.ProblemTranslation.Query().
+		Where(ps...).
+		WithProblem().
+		WithChoices(func(cq *ent.ChoiceQuery) {
+			cq.Order(ent.Asc(choice.FieldSeq))
+		}).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("querying translations: %w", err)
+	}
+
+	var vs []Violation
+	for _, t := range translations {
+		add := func(code, msg string) {
+			vs = append(vs, Violation{
+				Kind:    KindTranslation,
+				ID:      t.ID,
+				Code:    code,
+				Message: fmt.Sprintf("%q translation of problem %d %s", t.Locale, t.ProblemID, msg),
+			})
+		}
+		if code, msg := checkChoices(t.Edges.Problem, t.Edges.Choices); code != "" {
+			add(code, msg)
+		}
+		if msg := checkMath(t.Edges.Problem, t); msg != "" {
+			add(CodeInvalidMath, msg)
+		}
+	}
+	return vs, nil
+}
+
+// checkMath checks the LaTeX in the markdown of a translation and its
+// choices and returns the violation message, if any. The choices of NUMERIC
+// and TEXT problems are accepted answers, not markdown.
+func checkMath(p *ent.Problem, t *ent.ProblemTranslation) string {
+	type field struct{ name, markdown string }
+	fields := []field{{"content", t.Content}, {"explanation", t.Explanation}}
+	typed := p.Interaction == problem.InteractionNUMERIC || p.Interaction == problem.InteractionTEXT
+	for _, c := range t.Edges.Choices {
+		if !typed {
+			fields = append(fields, field{fmt.Sprintf("choice %d", c.Seq), c.Content})
+		}
+		fields = append(fields, field{fmt.Sprintf("choice %d explanation", c.Seq), c.Explanation})
+	}
+	for _, f := range fields {
+		if err := latex.Validate(f.markdown); err != nil {
+			return fmt.Sprintf("has invalid math in its %s: %v", f.name, err)
+		}
+	}
+	return ""
+}
+
+// checkChoices checks the choices of a translation against the interaction
+// of its problem and returns the violation code and message, if any.
+func checkChoices(p *ent.Problem, choices []*ent.Choice) (code, msg string) {
+	switch p.Interaction {
+	case problem.InteractionORDERING:
+		if len(choices) < 2 {
+			return CodeTooFewChoices, "has fewer than two choices to order"
+		}
+	case problem.InteractionNUMERIC, problem.InteractionTEXT:
+		if len(choices) == 0 {
+			return CodeNoAcceptedAnswer, "has no accepted answer"
+		}
+		for _, c := range choices {
+			if err := checkAccepted(p, c.Content); err != nil {
+				return CodeInvalidAnswer, fmt.Sprintf("accepts %q: %v", c.Content, err)
+			}
+		}
+	default:
+		for _, c := range choices {
+			if c.IsCorrect {
+				return "", ""
+			}
+		}
+		return CodeNoCorrectChoice, "has no correct choice"
+	}
+	return "", ""
+}
+
+// checkAccepted reports why an accepted answer of a NUMERIC or TEXT problem
+// cannot be graded against.
+func checkAccepted(p *ent.Problem, accepted string) error {
+	switch {
+	case p.Interaction == problem.InteractionNUMERIC:
+		if _, err := strconv.ParseFloat(strings.TrimSpace(accepted), 64); err != nil {
+			return errors.New("not a number")
+		}
+	case p.TextMatch == problem.TextMatchREGEX:
+		if _, err := regexp.Compile(accepted); err != nil {
+			return err
+		}
+	}
+	return nil
 }
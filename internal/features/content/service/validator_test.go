@@ -0,0 +1,160 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+
+	"examination/internal/ent/choice"
+	"examination/internal/ent/problem"
+	"examination/internal/features/content/service"
+	"examination/internal/testutil"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidator_RejectsCrossExamWrites(t *testing.T) {
+	ctx := context.Background()
+	client := testutil.NewClient(t)
+	svc := service.NewContentService(client)
+
+	e1 := seedTree(t, svc)
+	e2, err := svc.CreateExam(ctx, service.ExamInput{Title: "Other", TimeLimit: 10})
+	require.NoError(t, err)
+	s1 := client.Section.Query().FirstX(ctx)
+
+	_, err = svc.CreateTopic(ctx, service.TopicInput{ExamID: e2.ID, SectionID: &s1.ID, Title: "Foreign"})
+	var verr *service.ValidationError
+	require.ErrorAs(t, err, &verr)
+	assert.Equal(t, service.CodeExamMismatch, verr.Violations[0].Code)
+
+	_, err = svc.CreateUnit(ctx, service.UnitInput{ExamID: e2.ID, SectionID: &s1.ID, Title: "Foreign"})
+	require.ErrorAs(t, err, &verr)
+
+	// Nothing was written by the rejected transactions
+	assert.Equal(t, 1, client.Topic.Query().CountX(ctx))
+	assert.Equal(t, 2, client.Unit.Query().CountX(ctx))
+
+	violations, err := service.NewValidator(client).AuditExam(ctx, e1.ID)
+	require.NoError(t, err)
+	assert.Empty(t, violations)
+}
+
+func TestValidator_RequiresCorrectChoice(t *testing.T) {
+	ctx := context.Background()
+	client := testutil.NewClient(t)
+	svc := service.NewContentService(client)
+
+	seedTree(t, svc)
+	p := client.Problem.Query().FirstX(ctx)
+
+	_, err := svc.CreateProblemTranslation(ctx, service.ProblemTranslationInput{
+		ProblemID: p.ID,
+		Locale:    "ko",
+		Title:     "질문",
+		Content:   "하나를 고르세요.",
+		Choices:   []service.ChoiceInput{{Content: "A", Seq: 1}},
+	})
+	var verr *service.ValidationError
+	require.ErrorAs(t, err, &verr)
+	assert.Equal(t, service.CodeNoCorrectChoice, verr.Violations[0].Code)
+
+	correct := client.Choice.Query().Where(choice.IsCorrect(true)).FirstX(ctx)
+	err = svc.DeleteChoice(ctx, correct.ID)
+	require.ErrorAs(t, err, &verr)
+
+	_, err = svc.UpdateChoice(ctx, correct.ID, service.ChoiceInput{Content: "A", IsCorrect: false})
+	require.ErrorAs(t, err, &verr)
+}
+
+func TestValidator_ChecksChoicesByInteraction(t *testing.T) {
+	ctx := context.Background()
+	client := testutil.NewClient(t)
+	svc := service.NewContentService(client)
+
+	seedTree(t, svc)
+	p := client.Problem.Query().FirstX(ctx)
+
+	// The seeded choices A and B are not numbers
+	_, err := svc.UpdateProblem(ctx, p.ID, service.ProblemInput{UnitID: p.UnitID, Difficulty: 1, Interaction: problem.InteractionNUMERIC})
+	var verr *service.ValidationError
+	require.ErrorAs(t, err, &verr)
+	assert.Equal(t, service.CodeInvalidAnswer, verr.Violations[0].Code)
+	assert.Equal(t, problem.InteractionSINGLE, client.Problem.GetX(ctx, p.ID).Interaction, "rejected change is rolled back")
+
+	// ORDERING ignores the correct flags but needs two items
+	_, err = svc.UpdateProblem(ctx, p.ID, service.ProblemInput{UnitID: p.UnitID, Difficulty: 1, Interaction: problem.InteractionORDERING})
+	require.NoError(t, err)
+	wrong := client.Choice.Query().Where(choice.IsCorrect(false)).FirstX(ctx)
+	err = svc.DeleteChoice(ctx, wrong.ID)
+	require.ErrorAs(t, err, &verr)
+	assert.Equal(t, service.CodeTooFewChoices, verr.Violations[0].Code)
+
+	_, err = svc.UpdateProblem(ctx, p.ID, service.ProblemInput{UnitID: p.UnitID, Difficulty: 1, Interaction: problem.InteractionTEXT, TextMatch: problem.TextMatchREGEX})
+	require.NoError(t, err)
+	_, err = svc.CreateProblemTranslation(ctx, service.ProblemTranslationInput{
+		ProblemID: p.ID,
+		Locale:    "ko",
+		Title:     "질문",
+		Content:   "답을 쓰세요.",
+		Choices:   []service.ChoiceInput{{Content: "(", Seq: 1}},
+	})
+	require.ErrorAs(t, err, &verr)
+	assert.Equal(t, service.CodeInvalidAnswer, verr.Violations[0].Code)
+}
+
+func TestValidator_ChecksMath(t *testing.T) {
+	ctx := context.Background()
+	client := testutil.NewClient(t)
+	svc := service.NewContentService(client)
+
+	seedTree(t, svc)
+	p := client.Problem.Query().FirstX(ctx)
+
+	_, err := svc.CreateProblemTranslation(ctx, service.ProblemTranslationInput{
+		ProblemID: p.ID,
+		Locale:    "ko",
+		Title:     "극한",
+		Content:   "$\\lim_{x \\to 0} \\frac{\\sin x}{x}$ 의 값은?",
+		Choices: []service.ChoiceInput{
+			{Content: "$1$", IsCorrect: true, Seq: 1},
+			{Content: "$\\left( 0$", Seq: 2},
+		},
+	})
+	var verr *service.ValidationError
+	require.ErrorAs(t, err, &verr)
+	assert.Equal(t, service.CodeInvalidMath, verr.Violations[0].Code)
+	assert.Contains(t, verr.Violations[0].Message, `choice 2: line 1: \left without \right in "\left( 0"`)
+
+	en := client.ProblemTranslation.Query().FirstX(ctx)
+	_, err = svc.UpdateProblemTranslation(ctx, en.ID, service.ProblemTranslationInput{Locale: "en", Title: "Q", Content: "$$x"})
+	require.ErrorAs(t, err, &verr)
+	assert.Equal(t, service.CodeInvalidMath, verr.Violations[0].Code)
+}
+
+func TestValidator_AuditExam(t *testing.T) {
+	ctx := context.Background()
+	client := testutil.NewClient(t)
+	svc := service.NewContentService(client)
+
+	e1 := seedTree(t, svc)
+	e2 := seedTree(t, svc)
+
+	// Break the invariants behind the service's back
+	foreignSection := client.Section.Query().AllX(ctx)[1]
+	u := client.Unit.Create().SetExamID(e1.ID).SetSectionID(foreignSection.ID).SetTitle("Broken").SetSeq(9).SaveX(ctx)
+	tr := client.ProblemTranslation.Query().FirstX(ctx)
+	client.Choice.Update().Where(choice.ProblemTranslationID(tr.ID)).SetIsCorrect(false).ExecX(ctx)
+
+	violations, err := service.NewValidator(client).AuditExam(ctx, e1.ID)
+	require.NoError(t, err)
+	require.Len(t, violations, 2)
+	assert.Equal(t, service.KindTranslation, violations[0].Kind)
+	assert.Equal(t, tr.ID, violations[0].ID)
+	assert.Equal(t, service.KindUnit, violations[1].Kind)
+	assert.Equal(t, u.ID, violations[1].ID)
+
+	violations, err = service.NewValidator(client).AuditExam(ctx, e2.ID)
+	require.NoError(t, err)
+	assert.Empty(t, violations)
+}
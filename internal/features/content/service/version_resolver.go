@@ -0,0 +1,237 @@
+package service
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+
+	"examination/internal/ent"
+	"examination/internal/ent/problem"
+	"examination/internal/ent/unit"
+	"examination/internal/ent/versionrule"
+)
+
+// Edition identifies one sitting of an exam, e.g. "2024 round 2".
+// Round 0 means the round is unspecified and sorts before round 1.
+type Edition struct {
+	Year     int
+	Round    int
+	Category string
+}
+
+func (e Edition) String() string {
+	s := fmt.Sprint(e.Year)
+	if e.Round > 0 {
+		s += fmt.Sprintf(" round %d", e.Round)
+	}
+	if e.Category != "" {
+		s += fmt.Sprintf(" (%s)", e.Category)
+	}
+	return s
+}
+
+// Reason is the outcome of a single rule for a problem.
+type Reason struct {
+	Rule *ent.VersionRule
+	// Applies is false when the rule's category scopes it to other editions.
+	Applies   bool
+	Satisfied bool
+	Message   string
+}
+
+// Decision explains whether a problem is valid for an edition.
+type Decision struct {
+	ProblemID int
+	Included  bool
+	// Reasons lists every ACTIVE rule considered, exam-wide rules first.
+	// It is empty for problems without rules, which are always included.
+	Reasons []Reason
+}
+
+// Resolution is the set of problems of an exam valid for an edition.
+type Resolution struct {
+	Edition   Edition
+	Decisions []Decision
+	included  map[int]bool
+}
+
+// Includes reports whether the problem is valid for the edition.
+// A nil Resolution includes every problem.
+func (r *Resolution) Includes(problemID int) bool {
+	return r == nil || r.included[problemID]
+}
+
+// VersionResolver evaluates the VersionRules of an exam against editions.
+//
+// A rule is either exam-wide (no problem) or bound to one problem, and
+// compares the edition to its own year/round/category with its operator:
+//   - Year and round are compared lexicographically as (year, round); a rule
+//     without a round compares years only, one without a year rounds only.
+//   - Equal and NotEqual also compare the category when the rule has one.
+//   - For the ordering operators the category is a scope: the rule only
+//     applies to editions of that category.
+//
+// A problem is valid when every ACTIVE rule that applies to it is
+// satisfied. DEPRECATED rules are ignored.
+type VersionResolver struct {
+	client *ent.Client
+}
+
+func NewVersionResolver(client *ent.Client) *VersionResolver {
+	return &VersionResolver{client: client}
+}
+
+// Resolve decides every problem of the exam for the edition. Decisions are
+// ordered by problem ID.
+func (r *VersionResolver) Resolve(ctx context.Context, examID int, ed Edition) (*Resolution, error) {
+	problems, err := r.client.Problem.Query().
+		Where(problem.HasUnitWith(unit.ExamID(examID))).
+		Order(ent.Asc(problem.FieldID)).
+		IDs(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("querying problems: %w", err)
+	}
+	rules, err := r.client.VersionRule.Query().
+		Where(versionrule.ExamID(examID), versionrule.StatusEQ(versionrule.StatusACTIVE)).
+		Order(ent.Asc(versionrule.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("querying version rules: %w", err)
+	}
+
+	var examRules []*ent.VersionRule
+	problemRules := make(map[int][]*ent.VersionRule)
+	for _, rule := range rules {
+		if rule.ProblemID == nil {
+			examRules = append(examRules, rule)
+		} else {
+			problemRules[*rule.ProblemID] = append(problemRules[*rule.ProblemID], rule)
+		}
+	}
+
+	res := &Resolution{Edition: ed, included: make(map[int]bool, len(problems))}
+	for _, id := range problems {
+		d := Decision{ProblemID: id, Included: true}
+		for _, rule := range append(append([]*ent.VersionRule(nil), examRules...), problemRules[id]...) {
+			reason := Evaluate(rule, ed)
+			d.Reasons = append(d.Reasons, reason)
+			if reason.Applies && !reason.Satisfied {
+				d.Included = false
+			}
+		}
+		res.Decisions = append(res.Decisions, d)
+		res.included[id] = d.Included
+	}
+	return res, nil
+}
+
+// Evaluate checks a single rule against the edition.
+func Evaluate(rule *ent.VersionRule, ed Edition) Reason {
+	reason := Reason{Rule: rule, Applies: true}
+	target := ruleTarget(rule)
+	op := operatorSymbols[rule.Operator]
+
+	switch rule.Operator {
+	case versionrule.OperatorEqual, versionrule.OperatorNotEqual:
+		equal := compareEdition(rule, ed) == 0 &&
+			(rule.Category == nil || *rule.Category == ed.Category)
+		reason.Satisfied = equal == (rule.Operator == versionrule.OperatorEqual)
+	default:
+		if rule.Category != nil && *rule.Category != ed.Category {
+			reason.Applies = false
+			reason.Message = fmt.Sprintf("rule %d (%s %s) only applies to category %q", rule.ID, op, target, *rule.Category)
+			return reason
+		}
+		c := compareEdition(rule, ed)
+		switch rule.Operator {
+		case versionrule.OperatorGreater:
+			reason.Satisfied = c > 0
+		case versionrule.OperatorGreaterEqual:
+			reason.Satisfied = c >= 0
+		case versionrule.OperatorLess:
+			reason.Satisfied = c < 0
+		case versionrule.OperatorLessEqual:
+			reason.Satisfied = c <= 0
+		}
+	}
+
+	verdict := "satisfied"
+	if !reason.Satisfied {
+		verdict = "not satisfied"
+	}
+	reason.Message = fmt.Sprintf("rule %d: edition %s %s %s is %s", rule.ID, ed, op, target, verdict)
+	return reason
+}
+
+var operatorSymbols = map[versionrule.Operator]string{
+	versionrule.OperatorGreater:      ">",
+	versionrule.OperatorGreaterEqual: ">=",
+	versionrule.OperatorLess:         "<",
+	versionrule.OperatorLessEqual:    "<=",
+	versionrule.OperatorEqual:        "==",
+	versionrule.OperatorNotEqual:     "!=",
+}
+
+// compareEdition compares the edition to the rule on the fields the rule sets.
+func compareEdition(rule *ent.VersionRule, ed Edition) int {
+	if rule.Year != nil {
+		if c := cmp.Compare(ed.Year, *rule.Year); c != 0 || rule.Round == nil {
+			return c
+		}
+	}
+	if rule.Round != nil {
+		return cmp.Compare(ed.Round, *rule.Round)
+	}
+	return 0
+}
+
+// ruleTarget renders the fields a rule sets, e.g. "2024 round 2 (it)".
+func ruleTarget(rule *ent.VersionRule) string {
+	var s string
+	if rule.Year != nil {
+		s = fmt.Sprint(*rule.Year)
+	}
+	if rule.Round != nil {
+		if s != "" {
+			s += " "
+		}
+		s += fmt.Sprintf("round %d", *rule.Round)
+	}
+	if rule.Category != nil {
+		if s != "" {
+			s += " "
+		}
+		s += fmt.Sprintf("(%s)", *rule.Category)
+	}
+	if s == "" {
+		s = "any edition"
+	}
+	return s
+}
+
+// Filter drops the problems the resolution excludes from the slots. Units
+// left without problems are dropped and the remaining units renumbered, so
+// question numbers stay gapless within the edition. A nil resolution
+// returns the slots unchanged.
+func (r *Resolution) Filter(slots []Slot) []Slot {
+	if r == nil {
+		return slots
+	}
+	var kept []Slot
+	for _, slot := range slots {
+		all := slot.Unit.Edges.Problems
+		var problems []*ent.Problem
+		for _, p := range all {
+			if r.Includes(p.ID) {
+				problems = append(problems, p)
+			}
+		}
+		if len(all) > 0 && len(problems) == 0 {
+			continue
+		}
+		slot.Unit.Edges.Problems = problems
+		slot.Number = len(kept) + 1
+		kept = append(kept, slot)
+	}
+	return kept
+}
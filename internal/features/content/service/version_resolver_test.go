@@ -0,0 +1,97 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+
+	"examination/internal/ent"
+	"examination/internal/ent/versionrule"
+	"examination/internal/features/content/service"
+	"examination/internal/testutil"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func ptr[T any](v T) *T { return &v }
+
+func TestEvaluate(t *testing.T) {
+	ed := service.Edition{Year: 2024, Round: 2, Category: "it"}
+
+	tests := []struct {
+		name      string
+		rule      ent.VersionRule
+		applies   bool
+		satisfied bool
+	}{
+		{"year and round compare lexicographically",
+			ent.VersionRule{Year: ptr(2023), Round: ptr(3), Operator: versionrule.OperatorGreater}, true, true},
+		{"same year, later round",
+			ent.VersionRule{Year: ptr(2024), Round: ptr(3), Operator: versionrule.OperatorLess}, true, true},
+		{"year only ignores the round",
+			ent.VersionRule{Year: ptr(2024), Operator: versionrule.OperatorGreaterEqual}, true, true},
+		{"round only",
+			ent.VersionRule{Round: ptr(1), Operator: versionrule.OperatorLessEqual}, true, false},
+		{"equal compares the category",
+			ent.VersionRule{Year: ptr(2024), Round: ptr(2), Category: ptr("law"), Operator: versionrule.OperatorEqual}, true, false},
+		{"not equal",
+			ent.VersionRule{Year: ptr(2025), Operator: versionrule.OperatorNotEqual}, true, true},
+		{"category scopes ordering rules",
+			ent.VersionRule{Year: ptr(2030), Category: ptr("law"), Operator: versionrule.OperatorGreater}, false, false},
+		{"matching category scope",
+			ent.VersionRule{Year: ptr(2030), Category: ptr("it"), Operator: versionrule.OperatorGreater}, true, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reason := service.Evaluate(&tt.rule, ed)
+			assert.Equal(t, tt.applies, reason.Applies)
+			assert.Equal(t, tt.satisfied, reason.Satisfied)
+			assert.NotEmpty(t, reason.Message)
+		})
+	}
+}
+
+func TestVersionResolver_Resolve(t *testing.T) {
+	ctx := context.Background()
+	client := testutil.NewClient(t)
+	svc := service.NewContentService(client)
+	seq := service.NewSequenceLogic(client)
+
+	e := seedTree(t, svc)
+	problems := client.Problem.Query().Order(ent.Asc("id")).AllX(ctx)
+	require.Len(t, problems, 2)
+
+	// The whole exam starts in 2023; the first problem was retired after 2024
+	client.VersionRule.Create().SetExamID(e.ID).SetYear(2023).SetOperator(versionrule.OperatorGreaterEqual).SaveX(ctx)
+	client.VersionRule.Create().SetExamID(e.ID).SetProblemID(problems[0].ID).SetYear(2024).SetOperator(versionrule.OperatorLessEqual).SaveX(ctx)
+	client.VersionRule.Create().SetExamID(e.ID).SetProblemID(problems[1].ID).SetYear(2000).
+		SetOperator(versionrule.OperatorLess).SetStatus(versionrule.StatusDEPRECATED).SaveX(ctx)
+
+	resolver := service.NewVersionResolver(client)
+
+	res, err := resolver.Resolve(ctx, e.ID, service.Edition{Year: 2024, Round: 2})
+	require.NoError(t, err)
+	assert.True(t, res.Includes(problems[0].ID))
+	assert.True(t, res.Includes(problems[1].ID))
+	assert.Len(t, res.Decisions[1].Reasons, 1, "deprecated rules are ignored")
+
+	res, err = resolver.Resolve(ctx, e.ID, service.Edition{Year: 2025, Round: 1})
+	require.NoError(t, err)
+	assert.False(t, res.Includes(problems[0].ID))
+	assert.True(t, res.Includes(problems[1].ID))
+	require.Len(t, res.Decisions[0].Reasons, 2)
+	assert.False(t, res.Decisions[0].Reasons[1].Satisfied)
+
+	// Filtering drops the emptied unit and keeps numbering gapless
+	slots, err := seq.Flatten(ctx, e.ID)
+	require.NoError(t, err)
+	slots = res.Filter(slots)
+	require.Len(t, slots, 1)
+	assert.Equal(t, 1, slots[0].Number)
+	assert.Equal(t, problems[1].ID, slots[0].Unit.Edges.Problems[0].ID)
+
+	res, err = resolver.Resolve(ctx, e.ID, service.Edition{Year: 2022})
+	require.NoError(t, err)
+	assert.False(t, res.Includes(problems[0].ID))
+	assert.False(t, res.Includes(problems[1].ID))
+}
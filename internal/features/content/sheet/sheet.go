@@ -0,0 +1,163 @@
+// Package sheet reads and writes translation sheets: CSV files listing every
+// text of an exam's problems in a source locale next to a column for the
+// target locale, for translators working in spreadsheets.
+//
+// A sheet has one row per text:
+//
+//	problem_id,choice,field,en,ko
+//	12,,title,Entities,
+//	12,,content,Which one is an **entity**?,
+//	12,1,choice,Customer,
+//	12,1,choice_explanation,It has an identity.,
+//	12,2,choice,Blue,
+//
+// The last two header cells name the source and target locales. choice is
+// the seq of the choice for choice fields and empty otherwise.
+package sheet
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// Fields of a row. Explanation rows are only listed when the source has one.
+const (
+	Title             = "title"
+	Content           = "content"
+	Explanation       = "explanation"
+	Choice            = "choice"
+	ChoiceExplanation = "choice_explanation"
+)
+
+// ErrInvalid is returned, joined once per problem found, for sheets that
+// violate the format.
+var ErrInvalid = errors.New("invalid translation sheet")
+
+var (
+	columns      = []string{"problem_id", "choice", "field"}
+	problemTexts = []string{Title, Content, Explanation}
+	choiceTexts  = []string{Choice, ChoiceExplanation}
+)
+
+// bom starts the files written by Encode so that spreadsheets detect UTF-8.
+const bom = "\ufeff"
+
+// Sheet is the texts of an exam in a source locale and their translations.
+type Sheet struct {
+	Source string
+	Target string
+	Rows   []Row
+}
+
+// Row is one text. Seq is the seq of the choice for choice fields and 0
+// otherwise.
+type Row struct {
+	ProblemID int
+	Seq       int
+	Field     string
+	Source    string
+	Target    string
+}
+
+// Encode writes the sheet as CSV.
+func Encode(w io.Writer, s *Sheet) error {
+	if _, err := io.WriteString(w, bom); err != nil {
+		return err
+	}
+	cw := csv.NewWriter(w)
+	if err := cw.Write(append(slices.Clone(columns), s.Source, s.Target)); err != nil {
+		return err
+	}
+	for _, r := range s.Rows {
+		seq := ""
+		if r.Seq > 0 {
+			seq = strconv.Itoa(r.Seq)
+		}
+		if err := cw.Write([]string{strconv.Itoa(r.ProblemID), seq, r.Field, r.Source, r.Target}); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// Decode reads a CSV sheet and checks its structure: the header, known
+// fields, choice seqs only on choice fields and no text listed twice.
+func Decode(r io.Reader) (*Sheet, error) {
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = len(columns) + 2
+	header, err := cr.Read()
+	if err == io.EOF {
+		return nil, fmt.Errorf("%w: the header is missing", ErrInvalid)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalid, err)
+	}
+	header[0] = strings.TrimPrefix(header[0], bom)
+	var errs []error
+	fail := func(line int, format string, args ...any) {
+		errs = append(errs, fmt.Errorf("%w: line %d: %s", ErrInvalid, line, fmt.Sprintf(format, args...)))
+	}
+	if !slices.Equal(header[:len(columns)], columns) {
+		fail(1, "the header must start with %v", columns)
+	}
+	s := &Sheet{Source: header[len(columns)], Target: header[len(columns)+1]}
+	if s.Source == "" || s.Target == "" {
+		fail(1, "the last two columns must name the source and target locales")
+	} else if s.Source == s.Target {
+		fail(1, "the source and target locales are both %q", s.Source)
+	}
+
+	type text struct {
+		problemID, seq int
+		field          string
+	}
+	seen := make(map[text]int)
+	for {
+		rec, err := cr.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrInvalid, err)
+		}
+		// Texts may span lines, so count the lines of the file
+		line, _ := cr.FieldPos(0)
+		row := Row{Field: rec[2], Source: rec[3], Target: rec[4]}
+		if row.ProblemID, err = strconv.Atoi(rec[0]); err != nil || row.ProblemID <= 0 {
+			fail(line, "problem_id %q is not a positive number", rec[0])
+			continue
+		}
+		switch {
+		case slices.Contains(problemTexts, row.Field):
+			if rec[1] != "" {
+				fail(line, "choice must be empty for %s", row.Field)
+				continue
+			}
+		case slices.Contains(choiceTexts, row.Field):
+			if row.Seq, err = strconv.Atoi(rec[1]); err != nil || row.Seq <= 0 {
+				fail(line, "choice %q is not a positive number", rec[1])
+				continue
+			}
+		default:
+			fail(line, "unknown field %q", row.Field)
+			continue
+		}
+		key := text{row.ProblemID, row.Seq, row.Field}
+		if first, ok := seen[key]; ok {
+			fail(line, "repeats line %d", first)
+			continue
+		}
+		seen[key] = line
+		s.Rows = append(s.Rows, row)
+	}
+	if err := errors.Join(errs...); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
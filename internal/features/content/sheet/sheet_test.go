@@ -0,0 +1,48 @@
+package sheet_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"examination/internal/features/content/sheet"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRoundTrip(t *testing.T) {
+	s := &sheet.Sheet{Source: "en", Target: "ko", Rows: []sheet.Row{
+		{ProblemID: 1, Field: sheet.Title, Source: "Channels", Target: "채널"},
+		{ProblemID: 1, Field: sheet.Content, Source: "What does this print?\n\n```go\nfmt.Println(\"a, b\")\n```"},
+		{ProblemID: 1, Seq: 1, Field: sheet.Choice, Source: "a, b"},
+		{ProblemID: 1, Seq: 1, Field: sheet.ChoiceExplanation, Source: "Println adds no quotes."},
+	}}
+	var buf bytes.Buffer
+	require.NoError(t, sheet.Encode(&buf, s))
+	assert.True(t, strings.HasPrefix(buf.String(), "\ufeffproblem_id,choice,field,en,ko\n1,,title,Channels,채널\n"))
+
+	back, err := sheet.Decode(&buf)
+	require.NoError(t, err)
+	assert.Equal(t, s, back)
+}
+
+func TestDecode_Errors(t *testing.T) {
+	tests := map[string]string{
+		"empty":          "",
+		"bad header":     "id,choice,field,en,ko\n",
+		"same locales":   "problem_id,choice,field,en,en\n",
+		"unknown field":  "problem_id,choice,field,en,ko\n1,,prompt,a,b\n",
+		"bad problem":    "problem_id,choice,field,en,ko\nx,,title,a,b\n",
+		"seq on title":   "problem_id,choice,field,en,ko\n1,1,title,a,b\n",
+		"no choice seq":  "problem_id,choice,field,en,ko\n1,,choice,a,b\n",
+		"repeated text":  "problem_id,choice,field,en,ko\n1,,title,a,b\n1,,title,a,c\n",
+		"missing column": "problem_id,choice,field,en,ko\n1,,title,a\n",
+	}
+	for name, data := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := sheet.Decode(strings.NewReader(data))
+			assert.ErrorIs(t, err, sheet.ErrInvalid)
+		})
+	}
+}
@@ -0,0 +1,13 @@
+package ui
+
+import "embed"
+
+//go:embed *.html
+var FS embed.FS
+
+// Static holds the assets served under /static/: math.js and the KaTeX
+// build in static/katex, which is fetched with cmd/katex.
+//
+//go:generate go run ../../../../cmd/katex -out static/katex
+//go:embed static
+var Static embed.FS
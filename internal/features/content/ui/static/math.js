@@ -0,0 +1,24 @@
+// Typesets the math in content rendered by internal/features/content/markdown,
+// which leaves each span as <span class=math-inline>$...$</span> or
+// <span class=math-display>$$...$$</span>. Without KaTeX, or without
+// script, the TeX source stays as written.
+(() => {
+    // renderMath typesets the math inside root
+    window.renderMath = (root) => {
+        if (!window.katex) {
+            return;
+        }
+        root.querySelectorAll('.math-inline, .math-display').forEach(el => {
+            if (el.dataset.typeset) {
+                return;
+            }
+            const display = el.classList.contains('math-display');
+            const delim = display ? 2 : 1;
+            const tex = el.textContent.slice(delim, -delim);
+            katex.render(tex, el, { displayMode: display, throwOnError: false });
+            el.dataset.typeset = 'true';
+        });
+    };
+
+    document.addEventListener('DOMContentLoaded', () => renderMath(document.body));
+})();
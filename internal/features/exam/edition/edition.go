@@ -0,0 +1,46 @@
+// Package edition reads the exam edition a request asks for, so one
+// question bank can be rendered as e.g. "2024 round 2" or "2025 round 1".
+package edition
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"examination/internal/features/content/service"
+)
+
+const (
+	// YearParam, RoundParam and CategoryParam are the query (or form)
+	// parameters of the edition.
+	YearParam     = "year"
+	RoundParam    = "round"
+	CategoryParam = "category"
+)
+
+// ErrInvalid is returned for malformed edition parameters.
+var ErrInvalid = errors.New("invalid edition")
+
+// Parse returns the edition of the request, or nil when the request does
+// not ask for one. The year is required once any parameter is given; the
+// round and category are optional.
+func Parse(r *http.Request) (*service.Edition, error) {
+	year := r.FormValue(YearParam)
+	round := r.FormValue(RoundParam)
+	category := r.FormValue(CategoryParam)
+	if year == "" && round == "" && category == "" {
+		return nil, nil
+	}
+
+	ed := &service.Edition{Category: category}
+	var err error
+	if ed.Year, err = strconv.Atoi(year); err != nil || ed.Year <= 0 {
+		return nil, ErrInvalid
+	}
+	if round != "" {
+		if ed.Round, err = strconv.Atoi(round); err != nil || ed.Round < 0 {
+			return nil, ErrInvalid
+		}
+	}
+	return ed, nil
+}
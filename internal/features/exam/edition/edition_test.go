@@ -0,0 +1,31 @@
+package edition_test
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"examination/internal/features/content/service"
+	"examination/internal/features/exam/edition"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	ed, err := edition.Parse(httptest.NewRequest("GET", "/exams/1/preview", nil))
+	require.NoError(t, err)
+	assert.Nil(t, ed)
+
+	ed, err = edition.Parse(httptest.NewRequest("GET", "/exams/1/preview?year=2024&round=2&category=it", nil))
+	require.NoError(t, err)
+	assert.Equal(t, &service.Edition{Year: 2024, Round: 2, Category: "it"}, ed)
+
+	ed, err = edition.Parse(httptest.NewRequest("GET", "/exams/1/preview?year=2025&round=", nil))
+	require.NoError(t, err)
+	assert.Equal(t, &service.Edition{Year: 2025}, ed)
+
+	for _, q := range []string{"round=1", "year=abc", "year=2024&round=-1"} {
+		_, err = edition.Parse(httptest.NewRequest("GET", "/exams/1/preview?"+q, nil))
+		assert.ErrorIs(t, err, edition.ErrInvalid, q)
+	}
+}
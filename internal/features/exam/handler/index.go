@@ -0,0 +1,49 @@
+package handler
+
+import (
+	"examination/internal/ent"
+	"examination/internal/ent/exam"
+	authhandler "examination/internal/features/auth/handler"
+	authservice "examination/internal/features/auth/service"
+	"examination/internal/features/exam/ui"
+	"html/template"
+	"net/http"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+type ExamIndexHandler struct {
+	client *ent.Client
+}
+
+func NewExamIndexHandler(client *ent.Client) *ExamIndexHandler {
+	return &ExamIndexHandler{client: client}
+}
+
+func (h *ExamIndexHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// Active exams first, then by creation order. Inactive exams are only
+	// listed for staff, like their previews.
+	q := h.client.Exam.Query()
+	if !authservice.HasRole(authhandler.UserFrom(ctx), authservice.StaffRoles...) {
+		q.Where(exam.IsActive(true))
+	}
+	exams, err := q.
+		Order(exam.ByIsActive(sql.OrderDesc()), exam.ByID()).
+		All(ctx)
+	if err != nil {
+		http.Error(w, "Failed to load exams: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	tmpl, err := template.ParseFS(ui.FS, "exam_index.html")
+	if err != nil {
+		http.Error(w, "Failed to parse embedded template: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	if err := tmpl.Execute(w, exams); err != nil {
+		http.Error(w, "Failed to render template: "+err.Error(), http.StatusInternalServerError)
+	}
+}
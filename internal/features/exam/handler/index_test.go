@@ -0,0 +1,62 @@
+package handler_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	authhandler "examination/internal/features/auth/handler"
+	authservice "examination/internal/features/auth/service"
+	"examination/internal/features/exam/handler"
+	"examination/internal/testutil"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExamIndexHandler_HidesInactiveExams(t *testing.T) {
+	ctx := context.Background()
+	client := testutil.NewClient(t)
+	client.Exam.Create().SetTitle("Open exam").SetTimeLimit(30).ExecX(ctx)
+	client.Exam.Create().SetTitle("Draft exam").SetTimeLimit(30).SetIsActive(false).ExecX(ctx)
+
+	auth := authservice.NewAuthService(client)
+	session := func(role string) string {
+		u, err := auth.SaveUser(ctx, authservice.UserInput{Email: role + "@example.com", Name: role, Password: "password1", Roles: []string{role}})
+		require.NoError(t, err)
+		token, _, err := auth.StartSession(ctx, u.ID)
+		require.NoError(t, err)
+		return token
+	}
+	index := authhandler.NewAuthHandler(client).Session(handler.NewExamIndexHandler(client))
+
+	cases := []struct {
+		name      string
+		token     string
+		wantDraft bool
+	}{
+		{"anonymous", "", false},
+		{"candidate", session(authservice.RoleCandidate), false},
+		{"author", session(authservice.RoleAuthor), true},
+		{"admin", session(authservice.RoleAdmin), true},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/exams", nil)
+			if tc.token != "" {
+				req.AddCookie(&http.Cookie{Name: "session", Value: tc.token})
+			}
+			rec := httptest.NewRecorder()
+			index.ServeHTTP(rec, req)
+
+			require.Equal(t, http.StatusOK, rec.Code)
+			assert.Contains(t, rec.Body.String(), "Open exam")
+			if tc.wantDraft {
+				assert.Contains(t, rec.Body.String(), "Draft exam")
+			} else {
+				assert.NotContains(t, rec.Body.String(), "Draft exam")
+			}
+		})
+	}
+}
@@ -1,67 +1,266 @@
 package handler
 
 import (
-	"context"
 	"examination/internal/ent"
-	"examination/internal/ent/choice"
-	"examination/internal/ent/exam"
-	"examination/internal/ent/problemtranslation"
-	"examination/internal/ent/section"
-	"examination/internal/ent/unit"
+	"examination/internal/features/content/markdown"
+	"examination/internal/features/content/service"
+	"examination/internal/features/exam/edition"
+	"examination/internal/features/exam/i18n"
 	"examination/internal/features/exam/ui"
+	"examination/internal/features/exam/view"
+	"fmt"
 	"html/template"
 	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
 )
 
 type ExamPreviewHandler struct {
-	client *ent.Client
+	client   *ent.Client
+	sequence *service.SequenceLogic
+	versions *service.VersionResolver
 }
 
 func NewExamPreviewHandler(client *ent.Client) *ExamPreviewHandler {
-	return &ExamPreviewHandler{client: client}
+	return &ExamPreviewHandler{
+		client:   client,
+		sequence: service.NewSequenceLogic(client),
+		versions: service.NewVersionResolver(client),
+	}
 }
 
-func (h *ExamPreviewHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
-	ctx := context.Background()
-
-	// Fetch the specific exam
-	// Using the title from the seeder
-	targetExam, err := h.client.Exam.Query().
-		Where(exam.TitleEQ("Distributed Systems 101")).
-		WithSections(func(sq *ent.SectionQuery) {
-			sq.Order(ent.Asc(section.FieldSeq)).
-				WithUnits(func(uq *ent.UnitQuery) {
-					uq.Order(ent.Asc(unit.FieldSeq)).
-						WithProblems(func(pq *ent.ProblemQuery) {
-							pq.WithTranslations(func(ptq *ent.ProblemTranslationQuery) {
-								ptq.Where(problemtranslation.LocaleEQ("en")).
-									WithChoices(func(cq *ent.ChoiceQuery) {
-										cq.Order(ent.Asc(choice.FieldSeq))
-									})
-							})
-						})
-				})
-		}).
-		Only(ctx)
+const (
+	// pageParam is the 1-based page, questionParam a question number whose
+	// page is shown and which is scrolled to, modeParam the PageMode.
+	pageParam     = "page"
+	questionParam = "q"
+	modeParam     = "by"
+)
 
-	if err != nil {
-		if ent.IsNotFound(err) {
-			http.Error(w, "Exam not found. Did you run the seeder?", http.StatusNotFound)
-			return
+// previewPage is the template data of exam_preview.html: one page of the
+// exam with the navigation around it.
+type previewPage struct {
+	*view.Exam
+	Pager *view.Pager
+	// Page is the 1-based page shown; there is none for an exam without
+	// questions.
+	Page int
+	// Focus is the question number to scroll to, 0 for none.
+	Focus int
+	// query holds the locale, edition and mode parameters kept by links.
+	query url.Values
+}
+
+// Current returns the page shown.
+func (p *previewPage) Current() view.Section {
+	return p.Pager.Pages[p.Page-1]
+}
+
+// Questions returns the number of questions of the exam.
+func (p *previewPage) Questions() int {
+	return len(p.Pager.Palette)
+}
+
+// First and Last return the question numbers the page starts and ends with.
+func (p *previewPage) First() int {
+	first, _ := p.Pager.Questions(p.Page)
+	return first
+}
+
+func (p *previewPage) Last() int {
+	_, last := p.Pager.Questions(p.Page)
+	return last
+}
+
+// Percent is the share of pages up to and including the current one.
+func (p *previewPage) Percent() int {
+	return p.Page * 100 / len(p.Pager.Pages)
+}
+
+// Prev and Next return the pages before and after the current one, 0 at
+// either end.
+func (p *previewPage) Prev() int {
+	return p.Page - 1
+}
+
+func (p *previewPage) Next() int {
+	if p.Page >= len(p.Pager.Pages) {
+		return 0
+	}
+	return p.Page + 1
+}
+
+// PageURL links to the full preview at the page.
+func (p *previewPage) PageURL(page int) string {
+	return p.link("", pageParam, strconv.Itoa(page))
+}
+
+// PartURL loads the page as a fragment for HTMX.
+func (p *previewPage) PartURL(page int) string {
+	return p.link("/page", pageParam, strconv.Itoa(page))
+}
+
+// QuestionURL links to the full preview scrolled to the question number.
+func (p *previewPage) QuestionURL(number int) string {
+	return p.link("", questionParam, strconv.Itoa(number))
+}
+
+// QuestionPartURL loads the page of the question number as a fragment.
+func (p *previewPage) QuestionPartURL(number int) string {
+	return p.link("/page", questionParam, strconv.Itoa(number))
+}
+
+// LangURL links to the current page in the locale.
+func (p *previewPage) LangURL(locale string) string {
+	return p.link("", i18n.QueryParam, locale, pageParam, strconv.Itoa(p.Page))
+}
+
+// ModeURL links to the first page of the preview paged by the mode.
+func (p *previewPage) ModeURL(mode view.PageMode) string {
+	if mode == view.PageBySection {
+		mode = ""
+	}
+	return p.link("", modeParam, string(mode))
+}
+
+// link returns the URL of the preview, or of the preview fragment with
+// the "/page" suffix, keeping the query of the request with the key-value
+// pairs set. Empty values remove their key.
+func (p *previewPage) link(suffix string, pairs ...string) string {
+	q := url.Values{}
+	for k, v := range p.query {
+		q[k] = v
+	}
+	for i := 0; i+1 < len(pairs); i += 2 {
+		if pairs[i+1] == "" {
+			q.Del(pairs[i])
+		} else {
+			q.Set(pairs[i], pairs[i+1])
 		}
-		http.Error(w, "Failed to load exam: "+err.Error(), http.StatusInternalServerError)
+	}
+	return fmt.Sprintf("/exams/%d/preview%s?%s", p.ID, suffix, q.Encode())
+}
+
+// ServeHTTP renders the preview page by page: ?page=N selects a page and
+// ?q=N the page of a question number, ?by=unit pages by question instead
+// of by section.
+func (h *ExamPreviewHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	h.render(w, r, "")
+}
+
+// Page renders a page of the preview without the page around it, for
+// HTMX navigation. It takes the same parameters as ServeHTTP.
+func (h *ExamPreviewHandler) Page(w http.ResponseWriter, r *http.Request) {
+	h.render(w, r, "page")
+}
+
+// render executes the named template of exam_preview.html, the whole
+// document when name is empty.
+func (h *ExamPreviewHandler) render(w http.ResponseWriter, r *http.Request, name string) {
+	data, ok := h.load(w, r)
+	if !ok {
+		return
+	}
+	if name != "" && len(data.Pager.Pages) == 0 {
+		http.Error(w, "Exam has no questions", http.StatusNotFound)
 		return
 	}
 
 	// Using embedded filesystem
-	tmpl, err := template.ParseFS(ui.FS, "exam_preview.html")
+	tmpl, err := template.New("exam_preview.html").Funcs(markdown.Funcs).ParseFS(ui.FS, "exam_preview.html")
 	if err != nil {
 		http.Error(w, "Failed to parse embedded template: "+err.Error(), http.StatusInternalServerError)
 		return
 	}
 
 	// Render
-	if err := tmpl.Execute(w, targetExam); err != nil {
+	i18n.Remember(w, r)
+	if name == "" {
+		err = tmpl.Execute(w, data)
+	} else {
+		err = tmpl.ExecuteTemplate(w, name, data)
+	}
+	if err != nil {
 		http.Error(w, "Failed to render template: "+err.Error(), http.StatusInternalServerError)
 	}
 }
+
+// load builds the page the request asks for, writing the error response
+// itself when it cannot.
+func (h *ExamPreviewHandler) load(w http.ResponseWriter, r *http.Request) (*previewPage, bool) {
+	ctx := r.Context()
+
+	// Resolve the exam from the URL parameter
+	examID, err := strconv.Atoi(chi.URLParam(r, "examID"))
+	if err != nil || examID <= 0 {
+		http.Error(w, "Invalid exam ID", http.StatusBadRequest)
+		return nil, false
+	}
+
+	targetExam, err := h.client.Exam.Get(ctx, examID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			http.Error(w, "Exam not found", http.StatusNotFound)
+			return nil, false
+		}
+		http.Error(w, "Failed to load exam: "+err.Error(), http.StatusInternalServerError)
+		return nil, false
+	}
+
+	// Restrict to the problems valid for the requested edition, if any
+	ed, err := edition.Parse(r)
+	if err != nil {
+		http.Error(w, "Invalid edition", http.StatusBadRequest)
+		return nil, false
+	}
+	var res *service.Resolution
+	if ed != nil {
+		if res, err = h.versions.Resolve(ctx, examID, *ed); err != nil {
+			http.Error(w, "Failed to resolve edition: "+err.Error(), http.StatusInternalServerError)
+			return nil, false
+		}
+	}
+
+	// Units in canonical exam order (Section -> Topic -> Unit)
+	prefs := i18n.Negotiate(r)
+	exam, err := view.Load(ctx, h.sequence, targetExam, prefs, res)
+	if err != nil {
+		http.Error(w, "Failed to load exam: "+err.Error(), http.StatusInternalServerError)
+		return nil, false
+	}
+
+	query := r.URL.Query()
+	data := &previewPage{
+		Exam:  exam,
+		Pager: view.Paginate(exam, view.PageMode(query.Get(modeParam))),
+		Page:  1,
+		query: url.Values{},
+	}
+	for _, k := range []string{i18n.QueryParam, edition.YearParam, edition.RoundParam, edition.CategoryParam} {
+		if v := query.Get(k); v != "" {
+			data.query.Set(k, v)
+		}
+	}
+	if data.Pager.Mode != view.PageBySection {
+		data.query.Set(modeParam, string(data.Pager.Mode))
+	}
+	// A question number picks its page; otherwise the page number does
+	if v := query.Get(questionParam); v != "" {
+		number, err := strconv.Atoi(v)
+		if err != nil || data.Pager.PageOf(number) == 0 {
+			http.Error(w, "Question not found", http.StatusNotFound)
+			return nil, false
+		}
+		data.Page, data.Focus = data.Pager.PageOf(number), number
+	} else if v := query.Get(pageParam); v != "" {
+		page, err := strconv.Atoi(v)
+		if err != nil || page < 1 || page > len(data.Pager.Pages) {
+			http.Error(w, "Page not found", http.StatusNotFound)
+			return nil, false
+		}
+		data.Page = page
+	}
+	return data, true
+}
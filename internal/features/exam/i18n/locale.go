@@ -0,0 +1,157 @@
+package i18n
+
+import (
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"examination/internal/ent"
+)
+
+const (
+	// DefaultLocale is the locale every fallback chain ends with before
+	// falling back to any available translation.
+	DefaultLocale = "en"
+
+	// QueryParam is the query parameter used to explicitly pick a locale.
+	QueryParam = "lang"
+
+	// CookieName is the cookie remembering the last explicitly picked locale.
+	CookieName = "lang"
+)
+
+// Negotiate returns the ordered list of preferred locales for the request.
+// Sources are consulted in the following order: the ?lang= query parameter,
+// the lang cookie, then the Accept-Language header (sorted by q-value).
+// Regional tags are followed by their base language (ko-KR -> ko-kr, ko),
+// and DefaultLocale is always appended as the last entry.
+func Negotiate(r *http.Request) []string {
+	var prefs []string
+
+	if v := r.URL.Query().Get(QueryParam); v != "" {
+		prefs = append(prefs, v)
+	}
+	if c, err := r.Cookie(CookieName); err == nil && c.Value != "" {
+		prefs = append(prefs, c.Value)
+	}
+	prefs = append(prefs, parseAcceptLanguage(r.Header.Get("Accept-Language"))...)
+	prefs = append(prefs, DefaultLocale)
+
+	return expand(prefs)
+}
+
+// Remember persists an explicitly requested locale (?lang=) in a cookie so
+// that subsequent pages keep the same language.
+func Remember(w http.ResponseWriter, r *http.Request) {
+	v := normalize(r.URL.Query().Get(QueryParam))
+	if v == "" {
+		return
+	}
+	http.SetCookie(w, &http.Cookie{
+		Name:     CookieName,
+		Value:    v,
+		Path:     "/",
+		MaxAge:   int((365 * 24 * time.Hour).Seconds()),
+		HttpOnly: true,
+		SameSite: http.SameSiteLaxMode,
+	})
+}
+
+// Select picks the translation matching the first preferred locale.
+// If none of the preferred locales is available, the translation with the
+// lowest locale (alphabetically) is returned so the choice is deterministic.
+// The boolean reports whether the result is a fallback, i.e. not in the
+// most preferred locale.
+func Select(translations []*ent.ProblemTranslation, prefs []string) (*ent.ProblemTranslation, bool) {
+	if len(translations) == 0 {
+		return nil, false
+	}
+
+	byLocale := make(map[string]*ent.ProblemTranslation, len(translations))
+	for _, t := range translations {
+		byLocale[normalize(t.Locale)] = t
+	}
+
+	for i, p := range prefs {
+		if t, ok := byLocale[p]; ok {
+			return t, i > 0 && !sameLanguage(p, prefs[0])
+		}
+	}
+
+	// Any available translation
+	sorted := make([]*ent.ProblemTranslation, len(translations))
+	copy(sorted, translations)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Locale < sorted[j].Locale })
+	return sorted[0], true
+}
+
+// parseAcceptLanguage returns the language tags of an Accept-Language header
+// ordered by descending q-value. Tags with q=0 and the wildcard are dropped.
+func parseAcceptLanguage(header string) []string {
+	type tag struct {
+		value string
+		q     float64
+	}
+
+	var tags []tag
+	for _, part := range strings.Split(header, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		value, params, _ := strings.Cut(part, ";")
+		q := 1.0
+		if v, ok := strings.CutPrefix(strings.TrimSpace(params), "q="); ok {
+			if f, err := strconv.ParseFloat(v, 64); err == nil {
+				q = f
+			}
+		}
+		value = strings.TrimSpace(value)
+		if value == "*" || q <= 0 {
+			continue
+		}
+		tags = append(tags, tag{value: value, q: q})
+	}
+
+	sort.SliceStable(tags, func(i, j int) bool { return tags[i].q > tags[j].q })
+
+	out := make([]string, 0, len(tags))
+	for _, t := range tags {
+		out = append(out, t.value)
+	}
+	return out
+}
+
+// expand normalizes the tags, adds the base language after each regional
+// tag and removes duplicates while keeping the first occurrence.
+func expand(tags []string) []string {
+	seen := make(map[string]bool)
+	var out []string
+	add := func(v string) {
+		if v != "" && !seen[v] {
+			seen[v] = true
+			out = append(out, v)
+		}
+	}
+
+	for _, t := range tags {
+		t = normalize(t)
+		add(t)
+		if base, _, ok := strings.Cut(t, "-"); ok {
+			add(base)
+		}
+	}
+	return out
+}
+
+func normalize(tag string) string {
+	return strings.ReplaceAll(strings.ToLower(strings.TrimSpace(tag)), "_", "-")
+}
+
+func sameLanguage(a, b string) bool {
+	baseA, _, _ := strings.Cut(a, "-")
+	baseB, _, _ := strings.Cut(b, "-")
+	return baseA == baseB
+}
@@ -0,0 +1,47 @@
+package i18n_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"examination/internal/ent"
+	"examination/internal/features/exam/i18n"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNegotiate(t *testing.T) {
+	r := httptest.NewRequest(http.MethodGet, "/exams/1/preview?lang=ko", nil)
+	r.AddCookie(&http.Cookie{Name: i18n.CookieName, Value: "ja"})
+	r.Header.Set("Accept-Language", "fr;q=0.5, en-US, *;q=0.1")
+
+	assert.Equal(t, []string{"ko", "ja", "en-us", "en", "fr"}, i18n.Negotiate(r))
+}
+
+func TestNegotiate_Default(t *testing.T) {
+	r := httptest.NewRequest(http.MethodGet, "/exams/1/preview", nil)
+
+	assert.Equal(t, []string{i18n.DefaultLocale}, i18n.Negotiate(r))
+}
+
+func TestSelect(t *testing.T) {
+	en := &ent.ProblemTranslation{Locale: "en"}
+	ko := &ent.ProblemTranslation{Locale: "ko"}
+	ja := &ent.ProblemTranslation{Locale: "ja"}
+
+	tr, fallback := i18n.Select([]*ent.ProblemTranslation{en, ko}, []string{"ko-kr", "ko", "en"})
+	assert.Same(t, ko, tr)
+	assert.False(t, fallback, "base language of the preferred tag is not a fallback")
+
+	tr, fallback = i18n.Select([]*ent.ProblemTranslation{en}, []string{"ko", "en"})
+	assert.Same(t, en, tr)
+	assert.True(t, fallback)
+
+	tr, fallback = i18n.Select([]*ent.ProblemTranslation{ko, ja}, []string{"fr", "en"})
+	assert.Same(t, ja, tr, "any available translation is picked deterministically")
+	assert.True(t, fallback)
+
+	tr, _ = i18n.Select(nil, []string{"en"})
+	assert.Nil(t, tr)
+}
@@ -0,0 +1,124 @@
+package view
+
+import (
+	"context"
+	"sort"
+
+	"examination/internal/ent"
+	"examination/internal/ent/choice"
+	"examination/internal/features/content/service"
+	"examination/internal/features/exam/i18n"
+)
+
+// Exam is the render model of an exam with one translation selected per problem.
+type Exam struct {
+	*ent.Exam
+	// Locale is the most preferred locale of the request.
+	Locale string
+	// Locales lists every locale available in the exam, for the language switcher.
+	Locales []string
+	// Resolution is the edition the exam is rendered for; nil shows every problem.
+	Resolution *service.Resolution
+	Sections   []Section
+}
+
+// Section groups consecutive units of the flattened exam order.
+type Section struct {
+	// Section is nil for units placed directly under the exam.
+	*ent.Section
+	Units []Unit
+}
+
+// Unit is a question slot of the exam.
+type Unit struct {
+	*ent.Unit
+	// Number is the canonical question number.
+	Number int
+	// Topic is nil for units that are not grouped in a topic.
+	Topic *ent.Topic
+	// TopicStart is true for the first unit of a topic, where its heading goes.
+	TopicStart bool
+	Problems   []Problem
+}
+
+// Problem is a problem rendered in a single locale.
+type Problem struct {
+	*ent.Problem
+	Translation *ent.ProblemTranslation
+	// Fallback is true when Translation is not in the requested locale.
+	Fallback bool
+}
+
+// Build converts the flattened exam order into its render model, selecting
+// a translation for each problem along the locale preference chain. The
+// problems of the slots must have their translations and choices loaded.
+// Problems without any translation are skipped.
+func Build(e *ent.Exam, slots []service.Slot, prefs []string) *Exam {
+	v := &Exam{Exam: e}
+	if len(prefs) > 0 {
+		v.Locale = prefs[0]
+	}
+
+	locales := make(map[string]bool)
+	var current *Section
+	var lastTopic *ent.Topic
+	for _, slot := range slots {
+		if current == nil || current.Section != slot.Section {
+			v.Sections = append(v.Sections, Section{Section: slot.Section})
+			current = &v.Sections[len(v.Sections)-1]
+			lastTopic = nil
+		}
+
+		vu := buildUnit(slot, prefs, locales)
+		vu.TopicStart = slot.Topic != nil && slot.Topic != lastTopic
+		lastTopic = slot.Topic
+		current.Units = append(current.Units, vu)
+	}
+
+	for l := range locales {
+		v.Locales = append(v.Locales, l)
+	}
+	sort.Strings(v.Locales)
+	return v
+}
+
+func buildUnit(slot service.Slot, prefs []string, locales map[string]bool) Unit {
+	vu := Unit{Unit: slot.Unit, Number: slot.Number, Topic: slot.Topic}
+	for _, p := range slot.Unit.Edges.Problems {
+		for _, t := range p.Edges.Translations {
+			locales[t.Locale] = true
+		}
+		t, fallback := i18n.Select(p.Edges.Translations, prefs)
+		if t == nil {
+			continue
+		}
+		vu.Problems = append(vu.Problems, Problem{Problem: p, Translation: t, Fallback: fallback})
+	}
+	return vu
+}
+
+// Load flattens the exam with every translation and its choices and builds
+// its render model for the given locale preferences, keeping only the
+// problems of the resolved edition. A nil resolution keeps every problem.
+// Further filters, such as an attempt's layout, run after the edition's.
+func Load(ctx context.Context, seq *service.SequenceLogic, e *ent.Exam, prefs []string, res *service.Resolution, filters ...func([]service.Slot) []service.Slot) (*Exam, error) {
+	slots, err := seq.Flatten(ctx, e.ID, func(pq *ent.ProblemQuery) {
+		// Load every locale; the translation is picked per problem
+		// along the negotiated fallback chain.
+		pq.WithTranslations(func(ptq *ent.ProblemTranslationQuery) {
+			ptq.WithChoices(func(cq *ent.ChoiceQuery) {
+				cq.Order(ent.Asc(choice.FieldSeq))
+			})
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	slots = res.Filter(slots)
+	for _, filter := range filters {
+		slots = filter(slots)
+	}
+	v := Build(e, slots, prefs)
+	v.Resolution = res
+	return v, nil
+}
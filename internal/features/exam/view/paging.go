@@ -0,0 +1,85 @@
+package view
+
+// PageMode is how a paged exam is split into pages.
+type PageMode string
+
+const (
+	// PageBySection shows one section per page; consecutive units placed
+	// directly under the exam form a page of their own.
+	PageBySection PageMode = "section"
+	// PageByUnit shows one question slot per page.
+	PageByUnit PageMode = "unit"
+)
+
+// Pager splits an exam into pages. Each page is a Section holding the
+// units shown on it, so pages render like the sections of a full exam.
+type Pager struct {
+	Mode  PageMode
+	Pages []Section
+	// Palette lists every question in exam order.
+	Palette []PaletteItem
+}
+
+// PaletteItem is a question of the palette, linking to its page.
+type PaletteItem struct {
+	Number int
+	// Page is the 1-based page the question is on.
+	Page int
+	// ProblemIDs are the problems rendered for the question, to tell
+	// whether it was answered.
+	ProblemIDs []int
+}
+
+// Paginate splits the exam into pages. An unknown mode pages by section.
+func Paginate(v *Exam, mode PageMode) *Pager {
+	if mode != PageByUnit {
+		mode = PageBySection
+	}
+	p := &Pager{Mode: mode}
+	for _, s := range v.Sections {
+		if mode == PageBySection {
+			p.Pages = append(p.Pages, s)
+			continue
+		}
+		for _, u := range s.Units {
+			// Every page starts a new topic heading
+			u.TopicStart = u.Topic != nil
+			p.Pages = append(p.Pages, Section{Section: s.Section, Units: []Unit{u}})
+		}
+	}
+
+	for i, page := range p.Pages {
+		for _, u := range page.Units {
+			item := PaletteItem{Number: u.Number, Page: i + 1}
+			for _, prob := range u.Problems {
+				item.ProblemIDs = append(item.ProblemIDs, prob.ID)
+			}
+			p.Palette = append(p.Palette, item)
+		}
+	}
+	return p
+}
+
+// PageOf returns the 1-based page showing the question number, or 0 when
+// no page does.
+func (p *Pager) PageOf(number int) int {
+	for _, item := range p.Palette {
+		if item.Number == number {
+			return item.Page
+		}
+	}
+	return 0
+}
+
+// Questions returns the first and last question number of the 1-based
+// page, or zeros for a page without questions.
+func (p *Pager) Questions(page int) (first, last int) {
+	if page < 1 || page > len(p.Pages) {
+		return 0, 0
+	}
+	units := p.Pages[page-1].Units
+	if len(units) == 0 {
+		return 0, 0
+	}
+	return units[0].Number, units[len(units)-1].Number
+}
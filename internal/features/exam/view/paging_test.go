@@ -0,0 +1,70 @@
+package view_test
+
+import (
+	"testing"
+
+	"examination/internal/ent"
+	"examination/internal/features/exam/view"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testExam has a loose unit, a section with a topic of two units and a
+// section with one unit.
+func testExam() *view.Exam {
+	topic := &ent.Topic{ID: 1, Title: "Topic"}
+	problem := func(id int) view.Problem {
+		return view.Problem{Problem: &ent.Problem{ID: id}}
+	}
+	return &view.Exam{Sections: []view.Section{
+		{Units: []view.Unit{
+			{Number: 1, Problems: []view.Problem{problem(10)}},
+		}},
+		{Section: &ent.Section{ID: 1, Title: "A"}, Units: []view.Unit{
+			{Number: 2, Topic: topic, TopicStart: true, Problems: []view.Problem{problem(20), problem(21)}},
+			{Number: 3, Topic: topic, Problems: []view.Problem{problem(30)}},
+		}},
+		{Section: &ent.Section{ID: 2, Title: "B"}, Units: []view.Unit{
+			{Number: 4, Problems: []view.Problem{problem(40)}},
+		}},
+	}}
+}
+
+func TestPaginate_BySection(t *testing.T) {
+	p := view.Paginate(testExam(), "")
+
+	assert.Equal(t, view.PageBySection, p.Mode)
+	require.Len(t, p.Pages, 3)
+	assert.Equal(t, []view.PaletteItem{
+		{Number: 1, Page: 1, ProblemIDs: []int{10}},
+		{Number: 2, Page: 2, ProblemIDs: []int{20, 21}},
+		{Number: 3, Page: 2, ProblemIDs: []int{30}},
+		{Number: 4, Page: 3, ProblemIDs: []int{40}},
+	}, p.Palette)
+
+	first, last := p.Questions(2)
+	assert.Equal(t, 2, first)
+	assert.Equal(t, 3, last)
+	first, last = p.Questions(4)
+	assert.Zero(t, first)
+	assert.Zero(t, last)
+}
+
+func TestPaginate_ByUnit(t *testing.T) {
+	p := view.Paginate(testExam(), view.PageByUnit)
+
+	require.Len(t, p.Pages, 4)
+	// Units keep their section and each repeats its topic heading
+	third := p.Pages[2]
+	require.NotNil(t, third.Section)
+	assert.Equal(t, "A", third.Title)
+	require.Len(t, third.Units, 1)
+	assert.Equal(t, 3, third.Units[0].Number)
+	assert.True(t, third.Units[0].TopicStart)
+	assert.False(t, p.Pages[0].Units[0].TopicStart)
+
+	assert.Equal(t, 3, p.PageOf(3))
+	assert.Equal(t, 4, p.PageOf(4))
+	assert.Zero(t, p.PageOf(5))
+}
@@ -0,0 +1,26 @@
+// Package testutil holds helpers shared by the tests of the features.
+package testutil
+
+import (
+	"database/sql"
+	"fmt"
+	"testing"
+
+	"examination/internal/ent"
+	"examination/internal/ent/enttest"
+
+	"modernc.org/sqlite"
+)
+
+func init() {
+	sql.Register("sqlite3", &sqlite.Driver{})
+}
+
+// NewClient opens an isolated in-memory database with the schema applied.
+// The database is named after the test, so subtests get their own.
+func NewClient(t *testing.T) *ent.Client {
+	dsn := fmt.Sprintf("file:%s?mode=memory&cache=shared&_pragma=foreign_keys(1)", t.Name())
+	client := enttest.Open(t, "sqlite3", dsn)
+	t.Cleanup(func() { client.Close() })
+	return client
+}